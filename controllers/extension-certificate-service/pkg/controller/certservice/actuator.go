@@ -123,6 +123,30 @@ func (a *actuator) Delete(ctx context.Context, ex *extensionsv1alpha1.Extension)
 	return controller.WaitUntilManagedResourceDeleted(timeoutCtx, a.client, ex.Namespace, ShootResourcesName)
 }
 
+// Migrate the Extension resource.
+func (a *actuator) Migrate(ctx context.Context, ex *extensionsv1alpha1.Extension) error {
+	namespace := ex.GetNamespace()
+	if err := a.deleteCertBroker(ctx, namespace); err != nil {
+		return err
+	}
+
+	// Keep the RBAC objects in the shoot cluster, they are taken over by the managed resource created during restoration.
+	if err := controller.SetKeepObjects(ctx, a.client, namespace, ShootResourcesName, true); err != nil {
+		return err
+	}
+	if err := a.deleteRBAC(ctx, namespace); err != nil {
+		return err
+	}
+	timeoutCtx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+	defer cancel()
+	return controller.WaitUntilManagedResourceDeleted(timeoutCtx, a.client, namespace, ShootResourcesName)
+}
+
+// Restore the Extension resource.
+func (a *actuator) Restore(ctx context.Context, ex *extensionsv1alpha1.Extension) error {
+	return a.Reconcile(ctx, ex)
+}
+
 const (
 	managedCertPrefix = "managed-cert-"
 	ingressClassKey   = "kubernetes.io/ingress.class"
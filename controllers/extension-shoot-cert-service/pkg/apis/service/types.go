@@ -33,4 +33,43 @@ type IssuerConfig struct {
 	Name   string
 	Server string
 	Email  string
+
+	// PrivateKeySecretName is the name of a secret in the shoot namespace containing the private key of an existing ACME account.
+	PrivateKeySecretName *string
+	// ExternalAccountBinding contains the external account binding used to register the ACME account.
+	ExternalAccountBinding *ACMEExternalAccountBinding
+	// DNS01 contains the configuration for solving DNS-01 challenges.
+	DNS01 *DNS01Solver
+	// CA configures a certificate authority issuer instead of an ACME issuer.
+	CA *CAIssuer
+}
+
+// ACMEExternalAccountBinding contains information about an external account binding of an ACME account.
+type ACMEExternalAccountBinding struct {
+	// KeyID is the ID of the CA key that the external account is bound to.
+	KeyID string
+	// KeySecretName is the name of a secret in the shoot namespace containing the HMAC key of the external account.
+	KeySecretName string
+}
+
+// DNS01Solver contains the configuration for solving DNS-01 challenges.
+type DNS01Solver struct {
+	// Domains restricts the domains the issuer is used for.
+	Domains *DomainSelection
+	// PrecheckNameservers are the DNS servers (host:port) used to check the propagation of the challenge records.
+	PrecheckNameservers []string
+}
+
+// DomainSelection contains information about which domains shall be included/excluded.
+type DomainSelection struct {
+	// Include is a list of domains that shall be included.
+	Include []string
+	// Exclude is a list of domains that shall be excluded.
+	Exclude []string
+}
+
+// CAIssuer contains information about a certificate authority issuer.
+type CAIssuer struct {
+	// SecretName is the name of a TLS secret in the shoot namespace containing the CA certificate and private key.
+	SecretName string
 }
@@ -59,4 +59,51 @@ type IssuerConfig struct {
 	Name   string `json:"name"`
 	Server string `json:"server"`
 	Email  string `json:"email"`
+
+	// PrivateKeySecretName is the name of a secret in the shoot namespace containing the private key of an existing ACME account.
+	// +optional
+	PrivateKeySecretName *string `json:"privateKeySecretName,omitempty"`
+	// ExternalAccountBinding contains the external account binding used to register the ACME account.
+	// +optional
+	ExternalAccountBinding *ACMEExternalAccountBinding `json:"externalAccountBinding,omitempty"`
+	// DNS01 contains the configuration for solving DNS-01 challenges.
+	// +optional
+	DNS01 *DNS01Solver `json:"dns01,omitempty"`
+	// CA configures a certificate authority issuer instead of an ACME issuer.
+	// +optional
+	CA *CAIssuer `json:"ca,omitempty"`
+}
+
+// ACMEExternalAccountBinding contains information about an external account binding of an ACME account.
+type ACMEExternalAccountBinding struct {
+	// KeyID is the ID of the CA key that the external account is bound to.
+	KeyID string `json:"keyID"`
+	// KeySecretName is the name of a secret in the shoot namespace containing the HMAC key of the external account.
+	KeySecretName string `json:"keySecretName"`
+}
+
+// DNS01Solver contains the configuration for solving DNS-01 challenges.
+type DNS01Solver struct {
+	// Domains restricts the domains the issuer is used for.
+	// +optional
+	Domains *DomainSelection `json:"domains,omitempty"`
+	// PrecheckNameservers are the DNS servers (host:port) used to check the propagation of the challenge records.
+	// +optional
+	PrecheckNameservers []string `json:"precheckNameservers,omitempty"`
+}
+
+// DomainSelection contains information about which domains shall be included/excluded.
+type DomainSelection struct {
+	// Include is a list of domains that shall be included.
+	// +optional
+	Include []string `json:"include,omitempty"`
+	// Exclude is a list of domains that shall be excluded.
+	// +optional
+	Exclude []string `json:"exclude,omitempty"`
+}
+
+// CAIssuer contains information about a certificate authority issuer.
+type CAIssuer struct {
+	// SecretName is the name of a TLS secret in the shoot namespace containing the CA certificate and private key.
+	SecretName string `json:"secretName"`
 }
@@ -35,6 +35,26 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*ACMEExternalAccountBinding)(nil), (*service.ACMEExternalAccountBinding)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ACMEExternalAccountBinding_To_service_ACMEExternalAccountBinding(a.(*ACMEExternalAccountBinding), b.(*service.ACMEExternalAccountBinding), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*service.ACMEExternalAccountBinding)(nil), (*ACMEExternalAccountBinding)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_service_ACMEExternalAccountBinding_To_v1alpha1_ACMEExternalAccountBinding(a.(*service.ACMEExternalAccountBinding), b.(*ACMEExternalAccountBinding), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CAIssuer)(nil), (*service.CAIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CAIssuer_To_service_CAIssuer(a.(*CAIssuer), b.(*service.CAIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*service.CAIssuer)(nil), (*CAIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_service_CAIssuer_To_v1alpha1_CAIssuer(a.(*service.CAIssuer), b.(*CAIssuer), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CertConfig)(nil), (*service.CertConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CertConfig_To_service_CertConfig(a.(*CertConfig), b.(*service.CertConfig), scope)
 	}); err != nil {
@@ -45,6 +65,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DNS01Solver)(nil), (*service.DNS01Solver)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DNS01Solver_To_service_DNS01Solver(a.(*DNS01Solver), b.(*service.DNS01Solver), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*service.DNS01Solver)(nil), (*DNS01Solver)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_service_DNS01Solver_To_v1alpha1_DNS01Solver(a.(*service.DNS01Solver), b.(*DNS01Solver), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DomainSelection)(nil), (*service.DomainSelection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DomainSelection_To_service_DomainSelection(a.(*DomainSelection), b.(*service.DomainSelection), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*service.DomainSelection)(nil), (*DomainSelection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_service_DomainSelection_To_v1alpha1_DomainSelection(a.(*service.DomainSelection), b.(*DomainSelection), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*IssuerConfig)(nil), (*service.IssuerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_IssuerConfig_To_service_IssuerConfig(a.(*IssuerConfig), b.(*service.IssuerConfig), scope)
 	}); err != nil {
@@ -58,6 +98,48 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1alpha1_ACMEExternalAccountBinding_To_service_ACMEExternalAccountBinding(in *ACMEExternalAccountBinding, out *service.ACMEExternalAccountBinding, s conversion.Scope) error {
+	out.KeyID = in.KeyID
+	out.KeySecretName = in.KeySecretName
+	return nil
+}
+
+// Convert_v1alpha1_ACMEExternalAccountBinding_To_service_ACMEExternalAccountBinding is an autogenerated conversion function.
+func Convert_v1alpha1_ACMEExternalAccountBinding_To_service_ACMEExternalAccountBinding(in *ACMEExternalAccountBinding, out *service.ACMEExternalAccountBinding, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ACMEExternalAccountBinding_To_service_ACMEExternalAccountBinding(in, out, s)
+}
+
+func autoConvert_service_ACMEExternalAccountBinding_To_v1alpha1_ACMEExternalAccountBinding(in *service.ACMEExternalAccountBinding, out *ACMEExternalAccountBinding, s conversion.Scope) error {
+	out.KeyID = in.KeyID
+	out.KeySecretName = in.KeySecretName
+	return nil
+}
+
+// Convert_service_ACMEExternalAccountBinding_To_v1alpha1_ACMEExternalAccountBinding is an autogenerated conversion function.
+func Convert_service_ACMEExternalAccountBinding_To_v1alpha1_ACMEExternalAccountBinding(in *service.ACMEExternalAccountBinding, out *ACMEExternalAccountBinding, s conversion.Scope) error {
+	return autoConvert_service_ACMEExternalAccountBinding_To_v1alpha1_ACMEExternalAccountBinding(in, out, s)
+}
+
+func autoConvert_v1alpha1_CAIssuer_To_service_CAIssuer(in *CAIssuer, out *service.CAIssuer, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	return nil
+}
+
+// Convert_v1alpha1_CAIssuer_To_service_CAIssuer is an autogenerated conversion function.
+func Convert_v1alpha1_CAIssuer_To_service_CAIssuer(in *CAIssuer, out *service.CAIssuer, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CAIssuer_To_service_CAIssuer(in, out, s)
+}
+
+func autoConvert_service_CAIssuer_To_v1alpha1_CAIssuer(in *service.CAIssuer, out *CAIssuer, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	return nil
+}
+
+// Convert_service_CAIssuer_To_v1alpha1_CAIssuer is an autogenerated conversion function.
+func Convert_service_CAIssuer_To_v1alpha1_CAIssuer(in *service.CAIssuer, out *CAIssuer, s conversion.Scope) error {
+	return autoConvert_service_CAIssuer_To_v1alpha1_CAIssuer(in, out, s)
+}
+
 func autoConvert_v1alpha1_CertConfig_To_service_CertConfig(in *CertConfig, out *service.CertConfig, s conversion.Scope) error {
 	out.Issuers = *(*[]service.IssuerConfig)(unsafe.Pointer(&in.Issuers))
 	return nil
@@ -78,10 +160,58 @@ func Convert_service_CertConfig_To_v1alpha1_CertConfig(in *service.CertConfig, o
 	return autoConvert_service_CertConfig_To_v1alpha1_CertConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_DNS01Solver_To_service_DNS01Solver(in *DNS01Solver, out *service.DNS01Solver, s conversion.Scope) error {
+	out.Domains = (*service.DomainSelection)(unsafe.Pointer(in.Domains))
+	out.PrecheckNameservers = *(*[]string)(unsafe.Pointer(&in.PrecheckNameservers))
+	return nil
+}
+
+// Convert_v1alpha1_DNS01Solver_To_service_DNS01Solver is an autogenerated conversion function.
+func Convert_v1alpha1_DNS01Solver_To_service_DNS01Solver(in *DNS01Solver, out *service.DNS01Solver, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DNS01Solver_To_service_DNS01Solver(in, out, s)
+}
+
+func autoConvert_service_DNS01Solver_To_v1alpha1_DNS01Solver(in *service.DNS01Solver, out *DNS01Solver, s conversion.Scope) error {
+	out.Domains = (*DomainSelection)(unsafe.Pointer(in.Domains))
+	out.PrecheckNameservers = *(*[]string)(unsafe.Pointer(&in.PrecheckNameservers))
+	return nil
+}
+
+// Convert_service_DNS01Solver_To_v1alpha1_DNS01Solver is an autogenerated conversion function.
+func Convert_service_DNS01Solver_To_v1alpha1_DNS01Solver(in *service.DNS01Solver, out *DNS01Solver, s conversion.Scope) error {
+	return autoConvert_service_DNS01Solver_To_v1alpha1_DNS01Solver(in, out, s)
+}
+
+func autoConvert_v1alpha1_DomainSelection_To_service_DomainSelection(in *DomainSelection, out *service.DomainSelection, s conversion.Scope) error {
+	out.Include = *(*[]string)(unsafe.Pointer(&in.Include))
+	out.Exclude = *(*[]string)(unsafe.Pointer(&in.Exclude))
+	return nil
+}
+
+// Convert_v1alpha1_DomainSelection_To_service_DomainSelection is an autogenerated conversion function.
+func Convert_v1alpha1_DomainSelection_To_service_DomainSelection(in *DomainSelection, out *service.DomainSelection, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DomainSelection_To_service_DomainSelection(in, out, s)
+}
+
+func autoConvert_service_DomainSelection_To_v1alpha1_DomainSelection(in *service.DomainSelection, out *DomainSelection, s conversion.Scope) error {
+	out.Include = *(*[]string)(unsafe.Pointer(&in.Include))
+	out.Exclude = *(*[]string)(unsafe.Pointer(&in.Exclude))
+	return nil
+}
+
+// Convert_service_DomainSelection_To_v1alpha1_DomainSelection is an autogenerated conversion function.
+func Convert_service_DomainSelection_To_v1alpha1_DomainSelection(in *service.DomainSelection, out *DomainSelection, s conversion.Scope) error {
+	return autoConvert_service_DomainSelection_To_v1alpha1_DomainSelection(in, out, s)
+}
+
 func autoConvert_v1alpha1_IssuerConfig_To_service_IssuerConfig(in *IssuerConfig, out *service.IssuerConfig, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Server = in.Server
 	out.Email = in.Email
+	out.PrivateKeySecretName = (*string)(unsafe.Pointer(in.PrivateKeySecretName))
+	out.ExternalAccountBinding = (*service.ACMEExternalAccountBinding)(unsafe.Pointer(in.ExternalAccountBinding))
+	out.DNS01 = (*service.DNS01Solver)(unsafe.Pointer(in.DNS01))
+	out.CA = (*service.CAIssuer)(unsafe.Pointer(in.CA))
 	return nil
 }
 
@@ -94,6 +224,10 @@ func autoConvert_service_IssuerConfig_To_v1alpha1_IssuerConfig(in *service.Issue
 	out.Name = in.Name
 	out.Server = in.Server
 	out.Email = in.Email
+	out.PrivateKeySecretName = (*string)(unsafe.Pointer(in.PrivateKeySecretName))
+	out.ExternalAccountBinding = (*ACMEExternalAccountBinding)(unsafe.Pointer(in.ExternalAccountBinding))
+	out.DNS01 = (*DNS01Solver)(unsafe.Pointer(in.DNS01))
+	out.CA = (*CAIssuer)(unsafe.Pointer(in.CA))
 	return nil
 }
 
@@ -15,12 +15,14 @@
 package validation
 
 import (
+	"net"
 	"net/url"
 
 	"github.com/gardener/gardener-extensions/controllers/extension-shoot-cert-service/pkg/apis/service"
 	"github.com/gardener/gardener/pkg/utils"
 
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
@@ -46,14 +48,108 @@ func validateIssuers(issuers []service.IssuerConfig, fldPath *field.Path) field.
 		if names.Has(issuer.Name) {
 			allErrs = append(allErrs, field.Duplicate(indexFldPath.Child("name"), issuer.Name))
 		}
-		if _, err := url.ParseRequestURI(issuer.Server); err != nil {
-			allErrs = append(allErrs, field.Invalid(indexFldPath.Child("server"), issuer.Server, "must be a valid url"))
-		}
-		if !utils.TestEmail(issuer.Email) {
-			allErrs = append(allErrs, field.Invalid(indexFldPath.Child("email"), issuer.Email, "must a valid email address"))
+		if issuer.CA != nil {
+			allErrs = append(allErrs, validateCAIssuer(issuer, indexFldPath)...)
+		} else {
+			allErrs = append(allErrs, validateACMEIssuer(issuer, indexFldPath)...)
 		}
 		names.Insert(issuer.Name)
 	}
 
 	return allErrs
 }
+
+func validateACMEIssuer(issuer service.IssuerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if _, err := url.ParseRequestURI(issuer.Server); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("server"), issuer.Server, "must be a valid url"))
+	}
+	if !utils.TestEmail(issuer.Email) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("email"), issuer.Email, "must a valid email address"))
+	}
+	if issuer.PrivateKeySecretName != nil {
+		allErrs = append(allErrs, validateSecretName(*issuer.PrivateKeySecretName, fldPath.Child("privateKeySecretName"))...)
+	}
+	if eab := issuer.ExternalAccountBinding; eab != nil {
+		eabFldPath := fldPath.Child("externalAccountBinding")
+		if eab.KeyID == "" {
+			allErrs = append(allErrs, field.Required(eabFldPath.Child("keyID"), "must specify the key ID"))
+		}
+		allErrs = append(allErrs, validateSecretName(eab.KeySecretName, eabFldPath.Child("keySecretName"))...)
+	}
+	if issuer.DNS01 != nil {
+		allErrs = append(allErrs, validateDNS01Solver(issuer.DNS01, fldPath.Child("dns01"))...)
+	}
+
+	return allErrs
+}
+
+func validateCAIssuer(issuer service.IssuerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if issuer.Server != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("server"), "must not be set for CA issuers"))
+	}
+	if issuer.Email != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("email"), "must not be set for CA issuers"))
+	}
+	if issuer.PrivateKeySecretName != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("privateKeySecretName"), "must not be set for CA issuers"))
+	}
+	if issuer.ExternalAccountBinding != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("externalAccountBinding"), "must not be set for CA issuers"))
+	}
+	if issuer.DNS01 != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("dns01"), "must not be set for CA issuers"))
+	}
+	allErrs = append(allErrs, validateSecretName(issuer.CA.SecretName, fldPath.Child("ca", "secretName"))...)
+
+	return allErrs
+}
+
+func validateDNS01Solver(solver *service.DNS01Solver, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if solver.Domains != nil {
+		domainsFldPath := fldPath.Child("domains")
+		included := sets.NewString()
+		for i, domain := range solver.Domains.Include {
+			allErrs = append(allErrs, validateDomain(domain, domainsFldPath.Child("include").Index(i))...)
+			included.Insert(domain)
+		}
+		for i, domain := range solver.Domains.Exclude {
+			idxPath := domainsFldPath.Child("exclude").Index(i)
+			allErrs = append(allErrs, validateDomain(domain, idxPath)...)
+			if included.Has(domain) {
+				allErrs = append(allErrs, field.Invalid(idxPath, domain, "must not be included and excluded at the same time"))
+			}
+		}
+	}
+	for i, nameserver := range solver.PrecheckNameservers {
+		if _, _, err := net.SplitHostPort(nameserver); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("precheckNameservers").Index(i), nameserver, "must be in the format host:port"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateDomain(domain string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for _, msg := range validation.IsDNS1123Subdomain(domain) {
+		allErrs = append(allErrs, field.Invalid(fldPath, domain, msg))
+	}
+	return allErrs
+}
+
+func validateSecretName(name string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if name == "" {
+		return append(allErrs, field.Required(fldPath, "must specify the name of the secret"))
+	}
+	for _, msg := range validation.IsDNS1123Subdomain(name) {
+		allErrs = append(allErrs, field.Invalid(fldPath, name, msg))
+	}
+	return allErrs
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/gardener/gardener-extensions/controllers/extension-shoot-cert-service/pkg/apis/service"
 	"github.com/gardener/gardener-extensions/controllers/extension-shoot-cert-service/pkg/apis/service/validation"
+	"github.com/gardener/gardener-extensions/pkg/util"
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
@@ -84,5 +85,100 @@ var _ = Describe("Validation", func() {
 				},
 			},
 		}, BeEmpty()),
+		Entry("Valid ACME issuer with DNS-01 solver and external account binding", service.CertConfig{
+			Issuers: []service.IssuerConfig{
+				service.IssuerConfig{
+					Name:                 "issuer",
+					Server:               "https://acme.example.com/directory",
+					Email:                "john@example.com",
+					PrivateKeySecretName: util.StringPtr("issuer-account-key"),
+					ExternalAccountBinding: &service.ACMEExternalAccountBinding{
+						KeyID:         "my-key-id",
+						KeySecretName: "issuer-hmac-key",
+					},
+					DNS01: &service.DNS01Solver{
+						Domains: &service.DomainSelection{
+							Include: []string{"example.com"},
+							Exclude: []string{"private.example.com"},
+						},
+						PrecheckNameservers: []string{"8.8.8.8:53"},
+					},
+				},
+			},
+		}, BeEmpty()),
+		Entry("Invalid ACME issuer solver and secret references", service.CertConfig{
+			Issuers: []service.IssuerConfig{
+				service.IssuerConfig{
+					Name:                 "issuer",
+					Server:               "https://acme.example.com/directory",
+					Email:                "john@example.com",
+					PrivateKeySecretName: util.StringPtr(""),
+					ExternalAccountBinding: &service.ACMEExternalAccountBinding{
+						KeySecretName: "Invalid_Name",
+					},
+					DNS01: &service.DNS01Solver{
+						Domains: &service.DomainSelection{
+							Include: []string{"example.com"},
+							Exclude: []string{"example.com"},
+						},
+						PrecheckNameservers: []string{"8.8.8.8"},
+					},
+				},
+			},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("issuers[0].privateKeySecretName"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("issuers[0].externalAccountBinding.keyID"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("issuers[0].externalAccountBinding.keySecretName"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("issuers[0].dns01.domains.exclude[0]"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("issuers[0].dns01.precheckNameservers[0]"),
+			})),
+		)),
+		Entry("Valid CA issuer", service.CertConfig{
+			Issuers: []service.IssuerConfig{
+				service.IssuerConfig{
+					Name: "ca-issuer",
+					CA: &service.CAIssuer{
+						SecretName: "my-ca",
+					},
+				},
+			},
+		}, BeEmpty()),
+		Entry("Invalid CA issuer", service.CertConfig{
+			Issuers: []service.IssuerConfig{
+				service.IssuerConfig{
+					Name:   "ca-issuer",
+					Server: "https://acme.example.com/directory",
+					DNS01:  &service.DNS01Solver{},
+					CA:     &service.CAIssuer{},
+				},
+			},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("issuers[0].server"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("issuers[0].dns01"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("issuers[0].ca.secretName"),
+			})),
+		)),
 	)
 })
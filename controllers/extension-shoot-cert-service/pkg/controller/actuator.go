@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/gardener/gardener-extensions/controllers/extension-shoot-cert-service/pkg/apis/config"
@@ -26,9 +27,12 @@ import (
 	"github.com/gardener/gardener-extensions/controllers/extension-shoot-cert-service/pkg/apis/service/validation"
 	"github.com/gardener/gardener-extensions/controllers/extension-shoot-cert-service/pkg/imagevector"
 	"github.com/gardener/gardener-extensions/pkg/controller"
+	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
 	"github.com/gardener/gardener-extensions/pkg/controller/extension"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/chartrenderer"
 	"github.com/gardener/gardener/pkg/utils/chart"
@@ -36,9 +40,11 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
@@ -46,6 +52,20 @@ import (
 // ActuatorName is the name of the Certificate Service actuator.
 const ActuatorName = "shoot-cert-service-actuator"
 
+// ConditionTypeIssuersReady is the type of the Extension condition reporting the readiness of the certificate issuers.
+const ConditionTypeIssuersReady gardencorev1alpha1.ConditionType = "IssuersReady"
+
+const (
+	issuerStateReady = "Ready"
+	issuerStateError = "Error"
+
+	reasonIssuersReady    = "IssuersReady"
+	reasonIssuersPending  = "IssuersPending"
+	reasonIssuersNotReady = "IssuersNotReady"
+
+	issuersRequeueInterval = 30 * time.Second
+)
+
 // NewActuator returns an actuator responsible for Extension resources.
 func NewActuator(config config.Configuration) extension.Actuator {
 	return &actuator{
@@ -79,7 +99,11 @@ func (a *actuator) Reconcile(ctx context.Context, ex *extensionsv1alpha1.Extensi
 		}
 	}
 
-	return a.createSeedResources(ctx, ex, cluster, namespace)
+	if err := a.createSeedResources(ctx, ex, cluster, namespace); err != nil {
+		return err
+	}
+
+	return a.updateStatusIssuers(ctx, ex)
 }
 
 // Delete the Extension resource.
@@ -93,6 +117,26 @@ func (a *actuator) Delete(ctx context.Context, ex *extensionsv1alpha1.Extension)
 	return a.deleteSeedResources(ctx, namespace)
 }
 
+// Migrate the Extension resource.
+func (a *actuator) Migrate(ctx context.Context, ex *extensionsv1alpha1.Extension) error {
+	namespace := ex.GetNamespace()
+	a.logger.Info("Component is being migrated", "component", "cert-management", "namespace", namespace)
+	// Keep the objects in the shoot cluster, they are taken over by the managed resource created during restoration.
+	if err := controller.SetKeepObjects(ctx, a.client, namespace, v1alpha1.CertManagementResourceNameShoot, true); err != nil {
+		return err
+	}
+	if err := a.deleteShootResources(ctx, namespace); err != nil {
+		return err
+	}
+
+	return a.deleteSeedResources(ctx, namespace)
+}
+
+// Restore the Extension resource.
+func (a *actuator) Restore(ctx context.Context, ex *extensionsv1alpha1.Extension) error {
+	return a.Reconcile(ctx, ex)
+}
+
 // InjectConfig injects the rest config to this actuator.
 func (a *actuator) InjectConfig(config *rest.Config) error {
 	a.config = config
@@ -127,16 +171,55 @@ func (a *actuator) createIssuerValues(issuers ...service.IssuerConfig) ([]map[st
 		if issuer.Name == a.serviceConfig.IssuerName {
 			continue
 		}
-		issuerVal = append(issuerVal, map[string]interface{}{
+		issuerVal = append(issuerVal, issuerValues(issuer))
+	}
+
+	return issuerVal, nil
+}
+
+func issuerValues(issuer service.IssuerConfig) map[string]interface{} {
+	if issuer.CA != nil {
+		return map[string]interface{}{
 			"name": issuer.Name,
-			"acme": map[string]interface{}{
-				"email":  issuer.Email,
-				"server": issuer.Server,
+			"ca": map[string]interface{}{
+				"secretName": issuer.CA.SecretName,
 			},
-		})
+		}
 	}
 
-	return issuerVal, nil
+	acme := map[string]interface{}{
+		"email":  issuer.Email,
+		"server": issuer.Server,
+	}
+	if issuer.PrivateKeySecretName != nil {
+		acme["privateKeySecretName"] = *issuer.PrivateKeySecretName
+	}
+	if eab := issuer.ExternalAccountBinding; eab != nil {
+		acme["externalAccountBinding"] = map[string]interface{}{
+			"keyID":         eab.KeyID,
+			"keySecretName": eab.KeySecretName,
+		}
+	}
+	if dns01 := issuer.DNS01; dns01 != nil {
+		if dns01.Domains != nil {
+			domains := map[string]interface{}{}
+			if len(dns01.Domains.Include) > 0 {
+				domains["include"] = dns01.Domains.Include
+			}
+			if len(dns01.Domains.Exclude) > 0 {
+				domains["exclude"] = dns01.Domains.Exclude
+			}
+			acme["domains"] = domains
+		}
+		if len(dns01.PrecheckNameservers) > 0 {
+			acme["precheckNameservers"] = dns01.PrecheckNameservers
+		}
+	}
+
+	return map[string]interface{}{
+		"name": issuer.Name,
+		"acme": acme,
+	}
 }
 
 func (a *actuator) createSeedResources(ctx context.Context, ex *extensionsv1alpha1.Extension, cluster *controller.Cluster, namespace string) error {
@@ -210,6 +293,67 @@ func (a *actuator) createSeedResources(ctx context.Context, ex *extensionsv1alph
 	return a.createManagedResource(ctx, namespace, v1alpha1.CertManagementResourceNameSeed, "seed", renderer, v1alpha1.CertManagementChartNameSeed, certManagementConfig, nil)
 }
 
+// updateStatusIssuers reports the readiness of the issuers in the shoot namespace in the status of the given extension.
+// The status is only updated if the issuers ready condition has changed.
+func (a *actuator) updateStatusIssuers(ctx context.Context, ex *extensionsv1alpha1.Extension) error {
+	list := &unstructured.UnstructuredList{}
+	list.SetAPIVersion("cert.gardener.cloud/v1alpha1")
+	list.SetKind("IssuerList")
+	if err := a.client.List(ctx, list, client.InNamespace(ex.Namespace)); err != nil {
+		return errors.Wrap(err, "could not list issuers")
+	}
+
+	var failed, pending []string
+	for _, item := range list.Items {
+		state, _, _ := unstructured.NestedString(item.Object, "status", "state")
+		switch state {
+		case issuerStateReady:
+		case issuerStateError:
+			message, _, _ := unstructured.NestedString(item.Object, "status", "message")
+			failed = append(failed, fmt.Sprintf("%s (%s)", item.GetName(), message))
+		default:
+			pending = append(pending, item.GetName())
+		}
+	}
+
+	var (
+		status  = gardencorev1alpha1.ConditionTrue
+		reason  = reasonIssuersReady
+		message = "All issuers are ready"
+	)
+	switch {
+	case len(failed) > 0:
+		status, reason, message = gardencorev1alpha1.ConditionFalse, reasonIssuersNotReady, fmt.Sprintf("Issuers are not ready: %s", strings.Join(failed, ", "))
+	case len(pending) > 0:
+		status, reason, message = gardencorev1alpha1.ConditionProgressing, reasonIssuersPending, fmt.Sprintf("Waiting for issuers to become ready: %s", strings.Join(pending, ", "))
+	case len(list.Items) == 0:
+		status, reason, message = gardencorev1alpha1.ConditionProgressing, reasonIssuersPending, "Waiting for issuers to be created"
+	}
+
+	condition := gardencorev1alpha1helper.GetOrInitCondition(ex.Status.Conditions, ConditionTypeIssuersReady)
+	condition = gardencorev1alpha1helper.UpdatedCondition(condition, status, reason, message)
+
+	existing := gardencorev1alpha1helper.GetCondition(ex.Status.Conditions, ConditionTypeIssuersReady)
+	if existing == nil || existing.Status != condition.Status || existing.Message != condition.Message {
+		if err := controller.TryUpdateStatus(ctx, retry.DefaultBackoff, a.client, ex, func() error {
+			ex.Status.Conditions = gardencorev1alpha1helper.MergeConditions(ex.Status.Conditions, condition)
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	// The issuers are created asynchronously by the seed managed resource and are not watched, hence requeue until
+	// all of them report readiness.
+	if status != gardencorev1alpha1.ConditionTrue {
+		return &controllererrors.RequeueAfterError{
+			Cause:        errors.New(message),
+			RequeueAfter: issuersRequeueInterval,
+		}
+	}
+	return nil
+}
+
 func (a *actuator) createShootResources(ctx context.Context, cluster *controller.Cluster, namespace string) error {
 	values := map[string]interface{}{
 		"shootUserName": v1alpha1.CertManagementUserName,
@@ -17,6 +17,7 @@ package app
 import (
 	"context"
 
+	serviceinstall "github.com/gardener/gardener-extensions/controllers/extension-shoot-dns-service/pkg/apis/service/install"
 	"github.com/gardener/gardener-extensions/controllers/extension-shoot-dns-service/pkg/controller/config"
 	"github.com/gardener/gardener-extensions/controllers/extension-shoot-dns-service/pkg/service"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
@@ -65,6 +66,10 @@ func (o *Options) run(ctx context.Context) {
 		controllercmd.LogErrAndExit(err, "Could not update manager scheme")
 	}
 
+	if err := serviceinstall.AddToScheme(mgr.GetScheme()); err != nil {
+		controllercmd.LogErrAndExit(err, "Could not update manager scheme")
+	}
+
 	o.serviceOptions.Completed().Apply(&config.ServiceConfig)
 	o.controllerOptions.Completed().Apply(&config.ServiceConfig.ControllerOptions)
 	o.reconcileOptions.Completed().Apply(&config.ServiceConfig.IgnoreOperationAnnotation)
@@ -0,0 +1,54 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DNSConfig configuration resource
+type DNSConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Providers is a list of additional DNS providers used for the DNS entries of the shoot.
+	// +optional
+	Providers []DNSProvider `json:"providers,omitempty"`
+}
+
+// DNSProvider contains information about a DNS provider.
+type DNSProvider struct {
+	// Type is the type of the DNS provider, e.g. aws-route53.
+	Type string `json:"type"`
+	// SecretName is the name of the secret in the shoot namespace containing the credentials for the provider.
+	SecretName string `json:"secretName"`
+	// Domains contains information about which domains shall be included/excluded for this provider.
+	// +optional
+	Domains *DNSIncludeExclude `json:"domains,omitempty"`
+	// Zones contains information about which hosted zones shall be included/excluded for this provider.
+	// +optional
+	Zones *DNSIncludeExclude `json:"zones,omitempty"`
+}
+
+// DNSIncludeExclude contains information about which domains or zones shall be included/excluded.
+type DNSIncludeExclude struct {
+	// Include is a list of domains or zones that shall be included.
+	// +optional
+	Include []string `json:"include,omitempty"`
+	// Exclude is a list of domains or zones that shall be excluded.
+	// +optional
+	Exclude []string `json:"exclude,omitempty"`
+}
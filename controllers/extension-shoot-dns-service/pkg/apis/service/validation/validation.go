@@ -0,0 +1,104 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"github.com/gardener/gardener-extensions/controllers/extension-shoot-dns-service/pkg/apis/service"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateDNSConfig validates the passed configuration instance.
+func ValidateDNSConfig(config *service.DNSConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, validateProviders(config.Providers, field.NewPath("providers"))...)
+
+	return allErrs
+}
+
+func validateProviders(providers []service.DNSProvider, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		keys    = sets.NewString()
+	)
+
+	for i, provider := range providers {
+		indexFldPath := fldPath.Index(i)
+		if provider.Type == "" {
+			allErrs = append(allErrs, field.Required(indexFldPath.Child("type"), "must specify the provider type"))
+		}
+		if provider.SecretName == "" {
+			allErrs = append(allErrs, field.Required(indexFldPath.Child("secretName"), "must specify the name of the credentials secret"))
+		} else {
+			for _, msg := range validation.IsDNS1123Subdomain(provider.SecretName) {
+				allErrs = append(allErrs, field.Invalid(indexFldPath.Child("secretName"), provider.SecretName, msg))
+			}
+		}
+
+		key := provider.Type + "/" + provider.SecretName
+		if keys.Has(key) {
+			allErrs = append(allErrs, field.Duplicate(indexFldPath, key))
+		}
+		keys.Insert(key)
+
+		if provider.Domains != nil {
+			allErrs = append(allErrs, validateIncludeExclude(provider.Domains, indexFldPath.Child("domains"), validateDomain)...)
+		}
+		if provider.Zones != nil {
+			allErrs = append(allErrs, validateIncludeExclude(provider.Zones, indexFldPath.Child("zones"), validateZone)...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateIncludeExclude(includeExclude *service.DNSIncludeExclude, fldPath *field.Path, validateValue func(string, *field.Path) field.ErrorList) field.ErrorList {
+	var (
+		allErrs  = field.ErrorList{}
+		included = sets.NewString()
+	)
+
+	for i, value := range includeExclude.Include {
+		allErrs = append(allErrs, validateValue(value, fldPath.Child("include").Index(i))...)
+		included.Insert(value)
+	}
+	for i, value := range includeExclude.Exclude {
+		idxPath := fldPath.Child("exclude").Index(i)
+		allErrs = append(allErrs, validateValue(value, idxPath)...)
+		if included.Has(value) {
+			allErrs = append(allErrs, field.Invalid(idxPath, value, "must not be included and excluded at the same time"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateDomain(domain string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for _, msg := range validation.IsDNS1123Subdomain(domain) {
+		allErrs = append(allErrs, field.Invalid(fldPath, domain, msg))
+	}
+	return allErrs
+}
+
+func validateZone(zone string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if zone == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath, zone, "must not be empty"))
+	}
+	return allErrs
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	gomegatypes "github.com/onsi/gomega/types"
+
+	"github.com/gardener/gardener-extensions/controllers/extension-shoot-dns-service/pkg/apis/service"
+	"github.com/gardener/gardener-extensions/controllers/extension-shoot-dns-service/pkg/apis/service/validation"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("Validation", func() {
+	DescribeTable("#ValidateDNSConfig",
+		func(config service.DNSConfig, match gomegatypes.GomegaMatcher) {
+			err := validation.ValidateDNSConfig(&config)
+			Expect(err).To(match)
+		},
+		Entry("No providers", service.DNSConfig{}, BeEmpty()),
+		Entry("Valid provider", service.DNSConfig{
+			Providers: []service.DNSProvider{
+				{
+					Type:       "aws-route53",
+					SecretName: "my-aws-credentials",
+					Domains: &service.DNSIncludeExclude{
+						Include: []string{"example.com"},
+						Exclude: []string{"private.example.com"},
+					},
+					Zones: &service.DNSIncludeExclude{
+						Include: []string{"Z2XXXXXXXXXXXX"},
+					},
+				},
+			},
+		}, BeEmpty()),
+		Entry("Invalid provider", service.DNSConfig{
+			Providers: []service.DNSProvider{
+				{
+					Type:       "",
+					SecretName: "",
+				},
+			},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("providers[0].type"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("providers[0].secretName"),
+			})),
+		)),
+		Entry("Duplicate provider", service.DNSConfig{
+			Providers: []service.DNSProvider{
+				{
+					Type:       "aws-route53",
+					SecretName: "my-aws-credentials",
+				},
+				{
+					Type:       "aws-route53",
+					SecretName: "my-aws-credentials",
+				},
+			},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("providers[1]"),
+			})),
+		)),
+		Entry("Invalid domains and zones", service.DNSConfig{
+			Providers: []service.DNSProvider{
+				{
+					Type:       "aws-route53",
+					SecretName: "my-aws-credentials",
+					Domains: &service.DNSIncludeExclude{
+						Include: []string{"example.com", "Invalid_Domain"},
+						Exclude: []string{"example.com"},
+					},
+					Zones: &service.DNSIncludeExclude{
+						Exclude: []string{""},
+					},
+				},
+			},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providers[0].domains.include[1]"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providers[0].domains.exclude[0]"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providers[0].zones.exclude[0]"),
+			})),
+		)),
+	)
+})
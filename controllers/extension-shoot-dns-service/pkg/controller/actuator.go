@@ -20,6 +20,8 @@ import (
 	"path/filepath"
 	"time"
 
+	apisservice "github.com/gardener/gardener-extensions/controllers/extension-shoot-dns-service/pkg/apis/service"
+	"github.com/gardener/gardener-extensions/controllers/extension-shoot-dns-service/pkg/apis/service/validation"
 	controllerconfig "github.com/gardener/gardener-extensions/controllers/extension-shoot-dns-service/pkg/controller/config"
 	"github.com/gardener/gardener-extensions/controllers/extension-shoot-dns-service/pkg/imagevector"
 	"github.com/gardener/gardener-extensions/controllers/extension-shoot-dns-service/pkg/service"
@@ -37,8 +39,12 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
@@ -51,6 +57,8 @@ const (
 	ShootResourcesName = service.ExtensionServiceName + "-shoot"
 	// KeptShootResourcesName is the name for resource describing the resources applied to the shoot cluster that should not be deleted.
 	KeptShootResourcesName = service.ExtensionServiceName + "-shoot-keep"
+
+	dnsAPIVersion = "dns.gardener.cloud/v1alpha1"
 )
 
 // NewActuator returns an actuator responsible for Extension resources.
@@ -66,6 +74,7 @@ type actuator struct {
 	renderer chartrenderer.Interface
 	client   client.Client
 	config   *rest.Config
+	decoder  runtime.Decoder
 
 	controllerConfig controllerconfig.DNSServiceConfig
 
@@ -97,6 +106,12 @@ func (a *actuator) InjectClient(client client.Client) error {
 	return nil
 }
 
+// InjectScheme injects the given scheme into the reconciler.
+func (a *actuator) InjectScheme(scheme *runtime.Scheme) error {
+	a.decoder = serializer.NewCodecFactory(scheme).UniversalDecoder()
+	return nil
+}
+
 // Reconcile the Extension resource.
 func (a *actuator) Reconcile(ctx context.Context, ex *extensionsv1alpha1.Extension) error {
 	cluster, err := controller.GetCluster(ctx, a.client, ex.Namespace)
@@ -104,10 +119,23 @@ func (a *actuator) Reconcile(ctx context.Context, ex *extensionsv1alpha1.Extensi
 		return err
 	}
 
+	dnsConfig := &apisservice.DNSConfig{}
+	if ex.Spec.ProviderConfig != nil {
+		if _, _, err := a.decoder.Decode(ex.Spec.ProviderConfig.Raw, nil, dnsConfig); err != nil {
+			return fmt.Errorf("failed to decode provider config: %+v", err)
+		}
+		if errs := validation.ValidateDNSConfig(dnsConfig); len(errs) > 0 {
+			return errs.ToAggregate()
+		}
+	}
+
 	if err := a.createShootResources(ctx, cluster, ex.Namespace); err != nil {
 		return err
 	}
-	return a.createSeedResources(ctx, cluster, ex.Namespace)
+	if err := a.createSeedResources(ctx, cluster, ex.Namespace); err != nil {
+		return err
+	}
+	return a.reconcileDNSProviders(ctx, ex.Namespace, dnsConfig.Providers)
 }
 
 // Delete the Extension resource.
@@ -115,7 +143,37 @@ func (a *actuator) Delete(ctx context.Context, ex *extensionsv1alpha1.Extension)
 	if err := a.deleteSeedResources(ctx, ex.Namespace); err != nil {
 		return err
 	}
-	return a.deleteShootResources(ctx, ex.Namespace)
+	if err := a.deleteShootResources(ctx, ex.Namespace); err != nil {
+		return err
+	}
+	return a.deleteDNSProviders(ctx, ex.Namespace)
+}
+
+// Migrate the Extension resource.
+func (a *actuator) Migrate(ctx context.Context, ex *extensionsv1alpha1.Extension) error {
+	// Keep the objects in the shoot cluster, they are taken over by the managed resources created during restoration.
+	for _, name := range []string{ShootResourcesName, KeptShootResourcesName} {
+		if err := controller.SetKeepObjects(ctx, a.client, ex.Namespace, name, true); err != nil {
+			return err
+		}
+	}
+	if err := a.deleteShootResources(ctx, ex.Namespace); err != nil {
+		return err
+	}
+
+	a.logger.Info("Component is being migrated", "component", service.ExtensionServiceName, "namespace", ex.Namespace)
+	if err := controller.DeleteManagedResource(ctx, a.client, ex.Namespace, SeedResourcesName); err != nil {
+		return err
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+	defer cancel()
+	return controller.WaitUntilManagedResourceDeleted(timeoutCtx, a.client, ex.Namespace, SeedResourcesName)
+}
+
+// Restore the Extension resource.
+func (a *actuator) Restore(ctx context.Context, ex *extensionsv1alpha1.Extension) error {
+	return a.Reconcile(ctx, ex)
 }
 
 func (a *actuator) shootId(namespace string) string {
@@ -172,7 +230,7 @@ func (a *actuator) deleteSeedResources(ctx context.Context, namespace string) er
 
 	shootId := a.shootId(namespace)
 	list := &unstructured.UnstructuredList{}
-	list.SetAPIVersion("dns.gardener.cloud/v1alpha1")
+	list.SetAPIVersion(dnsAPIVersion)
 	list.SetKind("DNSEntry")
 	if err := a.client.List(ctx, list, client.InNamespace(namespace), client.MatchingLabels(map[string]string{shootId: "true"})); err != nil {
 		return nil
@@ -186,6 +244,131 @@ func (a *actuator) deleteSeedResources(ctx context.Context, namespace string) er
 	return nil
 }
 
+func (a *actuator) reconcileDNSProviders(ctx context.Context, namespace string, providers []apisservice.DNSProvider) error {
+	names := sets.NewString()
+	for i, provider := range providers {
+		name := fmt.Sprintf("%s%d", service.DNSProviderNamePrefix, i)
+		names.Insert(name)
+
+		obj := newDNSProvider(namespace, name)
+		if _, err := controllerutil.CreateOrUpdate(ctx, a.client, obj, func() error {
+			labels := obj.GetLabels()
+			if labels == nil {
+				labels = map[string]string{}
+			}
+			labels[a.shootId(namespace)] = "true"
+			obj.SetLabels(labels)
+
+			annotations := obj.GetAnnotations()
+			if annotations == nil {
+				annotations = map[string]string{}
+			}
+			annotations[service.DNSClassAnnotation] = service.DNSTargetClass
+			obj.SetAnnotations(annotations)
+
+			obj.Object["spec"] = dnsProviderSpec(provider)
+			return nil
+		}); err != nil {
+			return errors.Wrapf(err, "could not create or update DNS provider %s", name)
+		}
+	}
+
+	list, err := a.listDNSResources(ctx, "DNSProviderList", namespace)
+	if err != nil {
+		return err
+	}
+	for _, item := range list.Items {
+		if names.Has(item.GetName()) {
+			continue
+		}
+		a.logger.Info("Deleting stale DNS provider", "name", item.GetName(), "namespace", namespace)
+		if err := a.client.Delete(ctx, &item); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *actuator) deleteDNSProviders(ctx context.Context, namespace string) error {
+	providers, err := a.listDNSResources(ctx, "DNSProviderList", namespace)
+	if err != nil {
+		return err
+	}
+	if len(providers.Items) == 0 {
+		return nil
+	}
+
+	// The providers are still needed for deleting the DNS records of the remaining entries.
+	entries, err := a.listDNSResources(ctx, "DNSEntryList", namespace)
+	if err != nil {
+		return err
+	}
+	if len(entries.Items) > 0 {
+		return fmt.Errorf("waiting for %d DNS entries to be deleted before deleting DNS providers", len(entries.Items))
+	}
+
+	for _, item := range providers.Items {
+		if err := a.client.Delete(ctx, &item); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *actuator) listDNSResources(ctx context.Context, kind, namespace string) (*unstructured.UnstructuredList, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetAPIVersion(dnsAPIVersion)
+	list.SetKind(kind)
+	if err := a.client.List(ctx, list, client.InNamespace(namespace), client.MatchingLabels(map[string]string{a.shootId(namespace): "true"})); err != nil {
+		return nil, errors.Wrapf(err, "could not list %s", kind)
+	}
+	return list, nil
+}
+
+func newDNSProvider(namespace, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(dnsAPIVersion)
+	obj.SetKind("DNSProvider")
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	return obj
+}
+
+func dnsProviderSpec(provider apisservice.DNSProvider) map[string]interface{} {
+	spec := map[string]interface{}{
+		"type": provider.Type,
+		"secretRef": map[string]interface{}{
+			"name": provider.SecretName,
+		},
+	}
+	if provider.Domains != nil {
+		spec["domains"] = includeExcludeValues(provider.Domains)
+	}
+	if provider.Zones != nil {
+		spec["zones"] = includeExcludeValues(provider.Zones)
+	}
+	return spec
+}
+
+func includeExcludeValues(includeExclude *apisservice.DNSIncludeExclude) map[string]interface{} {
+	values := map[string]interface{}{}
+	if len(includeExclude.Include) > 0 {
+		values["include"] = toInterfaceSlice(includeExclude.Include)
+	}
+	if len(includeExclude.Exclude) > 0 {
+		values["exclude"] = toInterfaceSlice(includeExclude.Exclude)
+	}
+	return values
+}
+
+func toInterfaceSlice(values []string) []interface{} {
+	out := make([]interface{}, 0, len(values))
+	for _, v := range values {
+		out = append(out, v)
+	}
+	return out
+}
+
 func (a *actuator) createShootResources(ctx context.Context, cluster *controller.Cluster, namespace string) error {
 	crd := &unstructured.Unstructured{}
 	crd.SetAPIVersion("apiextensions.k8s.io/v1beta1")
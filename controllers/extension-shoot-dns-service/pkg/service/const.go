@@ -34,6 +34,13 @@ const (
 
 	// SecretName is the name of the secret used to store the access data for the shoot cluster.
 	SecretName = ExtensionServiceName
+
+	// DNSTargetClass is the DNS class of the entries and providers maintained in the shoot namespace of the seed.
+	DNSTargetClass = "gardendns"
+	// DNSClassAnnotation is the annotation used to assign a DNS class to DNS resources.
+	DNSClassAnnotation = "dns.gardener.cloud/class"
+	// DNSProviderNamePrefix is the prefix for the names of the DNS providers created for the shoot.
+	DNSProviderNamePrefix = ExtensionServiceName + "-"
 )
 
 // ChartsPath is the path to the charts
@@ -7,8 +7,10 @@ import (
 	shootcertservice "github.com/gardener/gardener-extensions/controllers/extension-shoot-cert-service/cmd/app"
 	dnsservice "github.com/gardener/gardener-extensions/controllers/extension-shoot-dns-service/cmd/app"
 	networkcalico "github.com/gardener/gardener-extensions/controllers/networking-calico/cmd/gardener-extension-networking-calico/app"
+	networkcilium "github.com/gardener/gardener-extensions/controllers/networking-cilium/cmd/gardener-extension-networking-cilium/app"
 	coreosalicloud "github.com/gardener/gardener-extensions/controllers/os-coreos-alicloud/cmd/gardener-extension-os-coreos-alicloud/app"
 	coreos "github.com/gardener/gardener-extensions/controllers/os-coreos/cmd/gardener-extension-os-coreos/app"
+	flatcar "github.com/gardener/gardener-extensions/controllers/os-flatcar/cmd/gardener-extension-os-flatcar/app"
 	jeos "github.com/gardener/gardener-extensions/controllers/os-suse-jeos/cmd/gardener-extension-os-suse-jeos/app"
 	ubuntualicloud "github.com/gardener/gardener-extensions/controllers/os-ubuntu-alicloud/cmd/gardener-extension-os-ubuntu-alicloud/app"
 	ubuntu "github.com/gardener/gardener-extensions/controllers/os-ubuntu/cmd/gardener-extension-os-ubuntu/app"
@@ -30,6 +32,7 @@ func NewHyperCommand(ctx context.Context) *cobra.Command {
 	cmd.AddCommand(
 		coreos.NewControllerCommand(ctx),
 		coreosalicloud.NewControllerCommand(ctx),
+		flatcar.NewControllerCommand(ctx),
 		jeos.NewControllerCommand(ctx),
 		ubuntu.NewControllerCommand(ctx),
 		ubuntualicloud.NewControllerCommand(ctx),
@@ -41,6 +44,7 @@ func NewHyperCommand(ctx context.Context) *cobra.Command {
 		providerpacket.NewControllerManagerCommand(ctx),
 		certservice.NewServiceControllerCommand(ctx),
 		networkcalico.NewControllerManagerCommand(ctx),
+		networkcilium.NewControllerManagerCommand(ctx),
 		dnsservice.NewServiceControllerCommand(ctx),
 		shootcertservice.NewServiceControllerCommand(ctx),
 	)
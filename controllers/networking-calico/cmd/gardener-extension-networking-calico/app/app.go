@@ -20,6 +20,7 @@ import (
 	"os"
 
 	calicocontroller "github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/controller"
+	calicohealthcheck "github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/controller/healthcheck"
 
 	"github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/calico"
 
@@ -45,6 +46,10 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 		calicoCtrlOpts = &controllercmd.ControllerOptions{
 			MaxConcurrentReconciles: 5,
 		}
+		// options for the health check controller
+		healthCheckCtrlOpts = &controllercmd.ControllerOptions{
+			MaxConcurrentReconciles: 5,
+		}
 		reconcileOpts = &controllercmd.ReconcilerOptions{
 			IgnoreOperationAnnotation: true,
 		}
@@ -53,6 +58,7 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			restOpts,
 			mgrOpts,
 			calicoCtrlOpts,
+			controllercmd.PrefixOption("healthcheck-", healthCheckCtrlOpts),
 			reconcileOpts,
 		)
 	)
@@ -79,11 +85,16 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			}
 
 			reconcileOpts.Completed().Apply(&calicocontroller.DefaultAddOptions.IgnoreOperationAnnotation)
+			healthCheckCtrlOpts.Completed().Apply(&calicohealthcheck.DefaultAddOptions.Controller)
 
 			if err := calicocontroller.AddToManager(mgr); err != nil {
 				controllercmd.LogErrAndExit(err, "Could not add controllers to manager")
 			}
 
+			if err := calicohealthcheck.AddToManager(mgr); err != nil {
+				controllercmd.LogErrAndExit(err, "Could not add health check controller to manager")
+			}
+
 			if err := mgr.Start(ctx.Done()); err != nil {
 				controllercmd.LogErrAndExit(err, "Error running manager")
 			}
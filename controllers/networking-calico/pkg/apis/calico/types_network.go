@@ -21,8 +21,31 @@ import (
 type Backend string
 
 const (
-	Bird Backend = "bird"
-	None Backend = "none"
+	Bird  Backend = "bird"
+	VXLan Backend = "vxlan"
+	None  Backend = "none"
+)
+
+// IPv4Pool is the encapsulation type of the IPv4 pool.
+type IPv4Pool string
+
+const (
+	// PoolIPIP is the IP-in-IP encapsulation.
+	PoolIPIP IPv4Pool = "ipip"
+	// PoolVXLan is the VXLAN encapsulation.
+	PoolVXLan IPv4Pool = "vxlan"
+)
+
+// IPv4PoolMode is the mode in which the encapsulation of the IPv4 pool is used.
+type IPv4PoolMode string
+
+const (
+	// Always encapsulates all traffic between pods.
+	Always IPv4PoolMode = "Always"
+	// CrossSubnet only encapsulates traffic between pods on nodes in different subnets.
+	CrossSubnet IPv4PoolMode = "CrossSubnet"
+	// Never disables the encapsulation.
+	Never IPv4PoolMode = "Never"
 )
 
 type CIDR string
@@ -41,6 +64,15 @@ type NetworkConfig struct {
 	// https://docs.projectcalico.org/v2.2/reference/node/configuration#ip-autodetection-methods
 	// +optional
 	IPAutoDetectionMethod *string
+	// IPv4 contains configuration for the IPv4 pool of the pod network.
+	// +optional
+	IPv4 *IPv4
+	// VethMTU is the MTU of the veth interfaces of the pods and of the tunnel devices. Defaults to 1440.
+	// +optional
+	VethMTU *int32
+	// Typha contains configuration for the Typha component.
+	// +optional
+	Typha *Typha
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -48,6 +80,14 @@ type NetworkConfig struct {
 // NetworkStatus contains information about created Network resources.
 type NetworkStatus struct {
 	metav1.TypeMeta
+	// Backend is the effective Calico backend.
+	Backend Backend
+	// IPv4 is the effective configuration of the IPv4 pool.
+	IPv4 IPv4Status
+	// VethMTU is the effective MTU of the veth interfaces.
+	VethMTU int32
+	// TyphaEnabled states whether Typha is enabled.
+	TyphaEnabled bool
 }
 
 // IPAM defines the block that configuration for the ip assignment plugin to be used
@@ -57,3 +97,27 @@ type IPAM struct {
 	// CIDR defines the CIDR block to be used
 	CIDR *CIDR
 }
+
+// IPv4 contains configuration for the IPv4 pool of the pod network.
+type IPv4 struct {
+	// Pool is the encapsulation type of the IPv4 pool (ipip or vxlan). Defaults to ipip.
+	// +optional
+	Pool *IPv4Pool
+	// Mode is the mode in which the encapsulation is used (Always, CrossSubnet or Never). Defaults to Always.
+	// +optional
+	Mode *IPv4PoolMode
+}
+
+// IPv4Status contains the effective configuration of the IPv4 pool.
+type IPv4Status struct {
+	// Pool is the encapsulation type of the IPv4 pool.
+	Pool IPv4Pool
+	// Mode is the mode in which the encapsulation is used.
+	Mode IPv4PoolMode
+}
+
+// Typha contains configuration for the Typha component.
+type Typha struct {
+	// Enabled states whether Typha is deployed. Typha is recommended for clusters with more than 50 nodes.
+	Enabled bool
+}
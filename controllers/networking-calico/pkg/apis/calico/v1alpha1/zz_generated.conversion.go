@@ -45,6 +45,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*IPv4)(nil), (*calico.IPv4)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IPv4_To_calico_IPv4(a.(*IPv4), b.(*calico.IPv4), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.IPv4)(nil), (*IPv4)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_IPv4_To_v1alpha1_IPv4(a.(*calico.IPv4), b.(*IPv4), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*IPv4Status)(nil), (*calico.IPv4Status)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IPv4Status_To_calico_IPv4Status(a.(*IPv4Status), b.(*calico.IPv4Status), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.IPv4Status)(nil), (*IPv4Status)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_IPv4Status_To_v1alpha1_IPv4Status(a.(*calico.IPv4Status), b.(*IPv4Status), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NetworkConfig)(nil), (*calico.NetworkConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NetworkConfig_To_calico_NetworkConfig(a.(*NetworkConfig), b.(*calico.NetworkConfig), scope)
 	}); err != nil {
@@ -65,6 +85,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Typha)(nil), (*calico.Typha)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Typha_To_calico_Typha(a.(*Typha), b.(*calico.Typha), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.Typha)(nil), (*Typha)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_Typha_To_v1alpha1_Typha(a.(*calico.Typha), b.(*Typha), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -90,10 +120,57 @@ func Convert_calico_IPAM_To_v1alpha1_IPAM(in *calico.IPAM, out *IPAM, s conversi
 	return autoConvert_calico_IPAM_To_v1alpha1_IPAM(in, out, s)
 }
 
+func autoConvert_v1alpha1_IPv4_To_calico_IPv4(in *IPv4, out *calico.IPv4, s conversion.Scope) error {
+	out.Pool = (*calico.IPv4Pool)(unsafe.Pointer(in.Pool))
+	out.Mode = (*calico.IPv4PoolMode)(unsafe.Pointer(in.Mode))
+	return nil
+}
+
+// Convert_v1alpha1_IPv4_To_calico_IPv4 is an autogenerated conversion function.
+func Convert_v1alpha1_IPv4_To_calico_IPv4(in *IPv4, out *calico.IPv4, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IPv4_To_calico_IPv4(in, out, s)
+}
+
+func autoConvert_calico_IPv4_To_v1alpha1_IPv4(in *calico.IPv4, out *IPv4, s conversion.Scope) error {
+	out.Pool = (*IPv4Pool)(unsafe.Pointer(in.Pool))
+	out.Mode = (*IPv4PoolMode)(unsafe.Pointer(in.Mode))
+	return nil
+}
+
+// Convert_calico_IPv4_To_v1alpha1_IPv4 is an autogenerated conversion function.
+func Convert_calico_IPv4_To_v1alpha1_IPv4(in *calico.IPv4, out *IPv4, s conversion.Scope) error {
+	return autoConvert_calico_IPv4_To_v1alpha1_IPv4(in, out, s)
+}
+
+func autoConvert_v1alpha1_IPv4Status_To_calico_IPv4Status(in *IPv4Status, out *calico.IPv4Status, s conversion.Scope) error {
+	out.Pool = calico.IPv4Pool(in.Pool)
+	out.Mode = calico.IPv4PoolMode(in.Mode)
+	return nil
+}
+
+// Convert_v1alpha1_IPv4Status_To_calico_IPv4Status is an autogenerated conversion function.
+func Convert_v1alpha1_IPv4Status_To_calico_IPv4Status(in *IPv4Status, out *calico.IPv4Status, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IPv4Status_To_calico_IPv4Status(in, out, s)
+}
+
+func autoConvert_calico_IPv4Status_To_v1alpha1_IPv4Status(in *calico.IPv4Status, out *IPv4Status, s conversion.Scope) error {
+	out.Pool = IPv4Pool(in.Pool)
+	out.Mode = IPv4PoolMode(in.Mode)
+	return nil
+}
+
+// Convert_calico_IPv4Status_To_v1alpha1_IPv4Status is an autogenerated conversion function.
+func Convert_calico_IPv4Status_To_v1alpha1_IPv4Status(in *calico.IPv4Status, out *IPv4Status, s conversion.Scope) error {
+	return autoConvert_calico_IPv4Status_To_v1alpha1_IPv4Status(in, out, s)
+}
+
 func autoConvert_v1alpha1_NetworkConfig_To_calico_NetworkConfig(in *NetworkConfig, out *calico.NetworkConfig, s conversion.Scope) error {
 	out.Backend = calico.Backend(in.Backend)
 	out.IPAM = (*calico.IPAM)(unsafe.Pointer(in.IPAM))
 	out.IPAutoDetectionMethod = (*string)(unsafe.Pointer(in.IPAutoDetectionMethod))
+	out.IPv4 = (*calico.IPv4)(unsafe.Pointer(in.IPv4))
+	out.VethMTU = (*int32)(unsafe.Pointer(in.VethMTU))
+	out.Typha = (*calico.Typha)(unsafe.Pointer(in.Typha))
 	return nil
 }
 
@@ -106,6 +183,9 @@ func autoConvert_calico_NetworkConfig_To_v1alpha1_NetworkConfig(in *calico.Netwo
 	out.Backend = Backend(in.Backend)
 	out.IPAM = (*IPAM)(unsafe.Pointer(in.IPAM))
 	out.IPAutoDetectionMethod = (*string)(unsafe.Pointer(in.IPAutoDetectionMethod))
+	out.IPv4 = (*IPv4)(unsafe.Pointer(in.IPv4))
+	out.VethMTU = (*int32)(unsafe.Pointer(in.VethMTU))
+	out.Typha = (*Typha)(unsafe.Pointer(in.Typha))
 	return nil
 }
 
@@ -115,6 +195,12 @@ func Convert_calico_NetworkConfig_To_v1alpha1_NetworkConfig(in *calico.NetworkCo
 }
 
 func autoConvert_v1alpha1_NetworkStatus_To_calico_NetworkStatus(in *NetworkStatus, out *calico.NetworkStatus, s conversion.Scope) error {
+	out.Backend = calico.Backend(in.Backend)
+	if err := Convert_v1alpha1_IPv4Status_To_calico_IPv4Status(&in.IPv4, &out.IPv4, s); err != nil {
+		return err
+	}
+	out.VethMTU = in.VethMTU
+	out.TyphaEnabled = in.TyphaEnabled
 	return nil
 }
 
@@ -124,6 +210,12 @@ func Convert_v1alpha1_NetworkStatus_To_calico_NetworkStatus(in *NetworkStatus, o
 }
 
 func autoConvert_calico_NetworkStatus_To_v1alpha1_NetworkStatus(in *calico.NetworkStatus, out *NetworkStatus, s conversion.Scope) error {
+	out.Backend = Backend(in.Backend)
+	if err := Convert_calico_IPv4Status_To_v1alpha1_IPv4Status(&in.IPv4, &out.IPv4, s); err != nil {
+		return err
+	}
+	out.VethMTU = in.VethMTU
+	out.TyphaEnabled = in.TyphaEnabled
 	return nil
 }
 
@@ -131,3 +223,23 @@ func autoConvert_calico_NetworkStatus_To_v1alpha1_NetworkStatus(in *calico.Netwo
 func Convert_calico_NetworkStatus_To_v1alpha1_NetworkStatus(in *calico.NetworkStatus, out *NetworkStatus, s conversion.Scope) error {
 	return autoConvert_calico_NetworkStatus_To_v1alpha1_NetworkStatus(in, out, s)
 }
+
+func autoConvert_v1alpha1_Typha_To_calico_Typha(in *Typha, out *calico.Typha, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1alpha1_Typha_To_calico_Typha is an autogenerated conversion function.
+func Convert_v1alpha1_Typha_To_calico_Typha(in *Typha, out *calico.Typha, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Typha_To_calico_Typha(in, out, s)
+}
+
+func autoConvert_calico_Typha_To_v1alpha1_Typha(in *calico.Typha, out *Typha, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_calico_Typha_To_v1alpha1_Typha is an autogenerated conversion function.
+func Convert_calico_Typha_To_v1alpha1_Typha(in *calico.Typha, out *Typha, s conversion.Scope) error {
+	return autoConvert_calico_Typha_To_v1alpha1_Typha(in, out, s)
+}
@@ -45,6 +45,48 @@ func (in *IPAM) DeepCopy() *IPAM {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPv4) DeepCopyInto(out *IPv4) {
+	*out = *in
+	if in.Pool != nil {
+		in, out := &in.Pool, &out.Pool
+		*out = new(IPv4Pool)
+		**out = **in
+	}
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(IPv4PoolMode)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPv4.
+func (in *IPv4) DeepCopy() *IPv4 {
+	if in == nil {
+		return nil
+	}
+	out := new(IPv4)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPv4Status) DeepCopyInto(out *IPv4Status) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPv4Status.
+func (in *IPv4Status) DeepCopy() *IPv4Status {
+	if in == nil {
+		return nil
+	}
+	out := new(IPv4Status)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkConfig) DeepCopyInto(out *NetworkConfig) {
 	*out = *in
@@ -59,6 +101,21 @@ func (in *NetworkConfig) DeepCopyInto(out *NetworkConfig) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IPv4 != nil {
+		in, out := &in.IPv4, &out.IPv4
+		*out = new(IPv4)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VethMTU != nil {
+		in, out := &in.VethMTU, &out.VethMTU
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Typha != nil {
+		in, out := &in.Typha, &out.Typha
+		*out = new(Typha)
+		**out = **in
+	}
 	return
 }
 
@@ -84,6 +141,7 @@ func (in *NetworkConfig) DeepCopyObject() runtime.Object {
 func (in *NetworkStatus) DeepCopyInto(out *NetworkStatus) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
+	out.IPv4 = in.IPv4
 	return
 }
 
@@ -104,3 +162,19 @@ func (in *NetworkStatus) DeepCopyObject() runtime.Object {
 	}
 	return nil
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Typha) DeepCopyInto(out *Typha) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Typha.
+func (in *Typha) DeepCopy() *Typha {
+	if in == nil {
+		return nil
+	}
+	out := new(Typha)
+	in.DeepCopyInto(out)
+	return out
+}
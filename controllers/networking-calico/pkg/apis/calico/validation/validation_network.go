@@ -0,0 +1,71 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+
+	apiscalico "github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/apis/calico"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	// minVethMTU is the minimum MTU of the veth interfaces.
+	minVethMTU = 576
+	// maxVethMTU is the maximum MTU of the veth interfaces, i.e., the MTU of jumbo frames.
+	maxVethMTU = 9000
+)
+
+var (
+	validBackends      = sets.NewString(string(apiscalico.Bird), string(apiscalico.VXLan), string(apiscalico.None))
+	validIPv4Pools     = sets.NewString(string(apiscalico.PoolIPIP), string(apiscalico.PoolVXLan))
+	validIPv4PoolModes = sets.NewString(string(apiscalico.Always), string(apiscalico.CrossSubnet), string(apiscalico.Never))
+)
+
+// ValidateNetworkConfig validates a NetworkConfig object.
+func ValidateNetworkConfig(config *apiscalico.NetworkConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(config.Backend) > 0 && !validBackends.Has(string(config.Backend)) {
+		allErrs = append(allErrs, field.NotSupported(field.NewPath("backend"), config.Backend, validBackends.List()))
+	}
+
+	if config.IPv4 != nil {
+		ipv4Path := field.NewPath("ipv4")
+
+		if config.IPv4.Pool != nil && !validIPv4Pools.Has(string(*config.IPv4.Pool)) {
+			allErrs = append(allErrs, field.NotSupported(ipv4Path.Child("pool"), *config.IPv4.Pool, validIPv4Pools.List()))
+		}
+		if config.IPv4.Mode != nil && !validIPv4PoolModes.Has(string(*config.IPv4.Mode)) {
+			allErrs = append(allErrs, field.NotSupported(ipv4Path.Child("mode"), *config.IPv4.Mode, validIPv4PoolModes.List()))
+		}
+
+		// IP-in-IP requires BGP to distribute the routes, hence, it cannot be used with the vxlan backend.
+		ipipUsed := config.IPv4.Pool == nil || *config.IPv4.Pool == apiscalico.PoolIPIP
+		if config.Backend == apiscalico.VXLan && ipipUsed && (config.IPv4.Mode == nil || *config.IPv4.Mode != apiscalico.Never) {
+			allErrs = append(allErrs, field.Invalid(ipv4Path.Child("pool"), config.IPv4.Pool, fmt.Sprintf("must be %q when using the %q backend", apiscalico.PoolVXLan, apiscalico.VXLan)))
+		}
+	} else if config.Backend == apiscalico.VXLan {
+		allErrs = append(allErrs, field.Required(field.NewPath("ipv4", "pool"), fmt.Sprintf("must be %q when using the %q backend", apiscalico.PoolVXLan, apiscalico.VXLan)))
+	}
+
+	if config.VethMTU != nil && (*config.VethMTU < minVethMTU || *config.VethMTU > maxVethMTU) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("vethMTU"), *config.VethMTU, fmt.Sprintf("must be between %d and %d", minVethMTU, maxVethMTU)))
+	}
+
+	return allErrs
+}
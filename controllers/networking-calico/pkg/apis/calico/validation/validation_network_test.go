@@ -0,0 +1,113 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apiscalico "github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/apis/calico"
+	. "github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/apis/calico/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("NetworkConfig validation", func() {
+	var (
+		networkConfig *apiscalico.NetworkConfig
+
+		vxlan       = apiscalico.PoolVXLan
+		crossSubnet = apiscalico.CrossSubnet
+		never       = apiscalico.Never
+	)
+
+	BeforeEach(func() {
+		mtu := int32(8950)
+		networkConfig = &apiscalico.NetworkConfig{
+			Backend: apiscalico.Bird,
+			IPv4: &apiscalico.IPv4{
+				Mode: &crossSubnet,
+			},
+			VethMTU: &mtu,
+			Typha:   &apiscalico.Typha{Enabled: false},
+		}
+	})
+
+	Describe("#ValidateNetworkConfig", func() {
+		It("should accept a valid configuration", func() {
+			Expect(ValidateNetworkConfig(networkConfig)).To(BeEmpty())
+		})
+
+		It("should accept an empty configuration", func() {
+			Expect(ValidateNetworkConfig(&apiscalico.NetworkConfig{})).To(BeEmpty())
+		})
+
+		It("should accept the vxlan backend with a vxlan pool", func() {
+			networkConfig.Backend = apiscalico.VXLan
+			networkConfig.IPv4.Pool = &vxlan
+
+			Expect(ValidateNetworkConfig(networkConfig)).To(BeEmpty())
+		})
+
+		It("should accept the vxlan backend without encapsulation", func() {
+			networkConfig.Backend = apiscalico.VXLan
+			networkConfig.IPv4.Mode = &never
+
+			Expect(ValidateNetworkConfig(networkConfig)).To(BeEmpty())
+		})
+
+		It("should forbid unsupported values", func() {
+			pool := apiscalico.IPv4Pool("gre")
+			mode := apiscalico.IPv4PoolMode("Sometimes")
+			networkConfig.Backend = "ospf"
+			networkConfig.IPv4 = &apiscalico.IPv4{Pool: &pool, Mode: &mode}
+
+			Expect(ValidateNetworkConfig(networkConfig)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeNotSupported), "Field": Equal("backend")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeNotSupported), "Field": Equal("ipv4.pool")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeNotSupported), "Field": Equal("ipv4.mode")})),
+			))
+		})
+
+		It("should forbid IP-in-IP with the vxlan backend", func() {
+			networkConfig.Backend = apiscalico.VXLan
+
+			Expect(ValidateNetworkConfig(networkConfig)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("ipv4.pool"),
+			}))))
+		})
+
+		It("should require a vxlan pool for the vxlan backend", func() {
+			networkConfig.Backend = apiscalico.VXLan
+			networkConfig.IPv4 = nil
+
+			Expect(ValidateNetworkConfig(networkConfig)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("ipv4.pool"),
+			}))))
+		})
+
+		It("should forbid an invalid veth MTU", func() {
+			mtu := int32(9001)
+			networkConfig.VethMTU = &mtu
+
+			Expect(ValidateNetworkConfig(networkConfig)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("vethMTU"),
+			}))))
+		})
+	})
+})
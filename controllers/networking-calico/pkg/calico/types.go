@@ -30,6 +30,11 @@ const (
 
 	// ReleaseName is the name of the Calico Release
 	ReleaseName = "calico"
+
+	// ManagedResourceName is the name of the ManagedResource containing the Calico components.
+	ManagedResourceName = "extension-networking-calico-config"
+	// NodeDaemonSetName is the name of the calico-node DaemonSet in the shoot cluster.
+	NodeDaemonSetName = "calico-node"
 )
 
 var (
@@ -82,7 +82,94 @@ var _ = Describe("Chart package test", func() {
 						"type":   networkConfig.IPAM.Type,
 						"subnet": *networkConfig.IPAM.CIDR,
 					},
+					"ipv4": map[string]interface{}{
+						"pool": calicov1alpha1.PoolIPIP,
+						"mode": calicov1alpha1.Always,
+					},
+					"felix": map[string]interface{}{
+						"ipinip": map[string]interface{}{
+							"enabled": false,
+						},
+						"vxlan": map[string]interface{}{
+							"enabled": false,
+						},
+					},
+					"veth_mtu": "1440",
+					"typha": map[string]interface{}{
+						"enabled": true,
+					},
+				},
+			}))
+		})
+
+		It("should correctly compute the encapsulation, MTU and Typha values", func() {
+			var (
+				pool = calicov1alpha1.PoolVXLan
+				mode = calicov1alpha1.CrossSubnet
+				mtu  = int32(8950)
+			)
+			networkConfig.Backend = calicov1alpha1.VXLan
+			networkConfig.IPv4 = &calicov1alpha1.IPv4{Pool: &pool, Mode: &mode}
+			networkConfig.VethMTU = &mtu
+			networkConfig.Typha = &calicov1alpha1.Typha{Enabled: false}
+
+			values := charts.ComputeCalicoChartValues(network, networkConfig)
+			Expect(values["config"]).To(Equal(map[string]interface{}{
+				"backend": calicov1alpha1.VXLan,
+				"ipam": map[string]interface{}{
+					"type":   networkConfig.IPAM.Type,
+					"subnet": *networkConfig.IPAM.CIDR,
+				},
+				"ipv4": map[string]interface{}{
+					"pool": calicov1alpha1.PoolVXLan,
+					"mode": calicov1alpha1.CrossSubnet,
+				},
+				"felix": map[string]interface{}{
+					"ipinip": map[string]interface{}{
+						"enabled": false,
+					},
+					"vxlan": map[string]interface{}{
+						"enabled": true,
+					},
+				},
+				"veth_mtu": "8950",
+				"typha": map[string]interface{}{
+					"enabled": false,
+				},
+			}))
+		})
+	})
+
+	Describe("#ComputeNetworkStatus", func() {
+		It("should return the default settings if no config is given", func() {
+			Expect(charts.ComputeNetworkStatus(nil)).To(Equal(&calicov1alpha1.NetworkStatus{
+				Backend: calicov1alpha1.Bird,
+				IPv4: calicov1alpha1.IPv4Status{
+					Pool: calicov1alpha1.PoolIPIP,
+					Mode: calicov1alpha1.Always,
+				},
+				VethMTU:      1440,
+				TyphaEnabled: true,
+			}))
+		})
+
+		It("should return the configured settings", func() {
+			var (
+				mode = calicov1alpha1.Never
+				mtu  = int32(8950)
+			)
+			networkConfig.IPv4 = &calicov1alpha1.IPv4{Mode: &mode}
+			networkConfig.VethMTU = &mtu
+			networkConfig.Typha = &calicov1alpha1.Typha{Enabled: false}
+
+			Expect(charts.ComputeNetworkStatus(networkConfig)).To(Equal(&calicov1alpha1.NetworkStatus{
+				Backend: calicov1alpha1.None,
+				IPv4: calicov1alpha1.IPv4Status{
+					Pool: calicov1alpha1.PoolIPIP,
+					Mode: calicov1alpha1.Never,
 				},
+				VethMTU:      8950,
+				TyphaEnabled: false,
 			}))
 		})
 	})
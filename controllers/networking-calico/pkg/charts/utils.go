@@ -15,6 +15,8 @@
 package charts
 
 import (
+	"strconv"
+
 	calicov1alpha1 "github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/apis/calico/v1alpha1"
 	"github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/calico"
 	"github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/imagevector"
@@ -25,8 +27,48 @@ import (
 const (
 	hostLocal  = "host-local"
 	usePodCIDR = "usePodCidr"
+
+	defaultVethMTU int32 = 1440
 )
 
+// ComputeNetworkStatus computes the effective Calico settings for the given network config, i.e., the settings that
+// are used to compute the calico chart values.
+func ComputeNetworkStatus(config *calicov1alpha1.NetworkConfig) *calicov1alpha1.NetworkStatus {
+	status := &calicov1alpha1.NetworkStatus{
+		Backend: calicov1alpha1.Bird,
+		IPv4: calicov1alpha1.IPv4Status{
+			Pool: calicov1alpha1.PoolIPIP,
+			Mode: calicov1alpha1.Always,
+		},
+		VethMTU:      defaultVethMTU,
+		TyphaEnabled: true,
+	}
+
+	if config == nil {
+		return status
+	}
+
+	if len(config.Backend) > 0 {
+		status.Backend = config.Backend
+	}
+	if config.IPv4 != nil {
+		if config.IPv4.Pool != nil {
+			status.IPv4.Pool = *config.IPv4.Pool
+		}
+		if config.IPv4.Mode != nil {
+			status.IPv4.Mode = *config.IPv4.Mode
+		}
+	}
+	if config.VethMTU != nil {
+		status.VethMTU = *config.VethMTU
+	}
+	if config.Typha != nil {
+		status.TyphaEnabled = config.Typha.Enabled
+	}
+
+	return status
+}
+
 // ComputeCalicoChartValues computes the values for the calico chart.
 func ComputeCalicoChartValues(network *extensionsv1alpha1.Network, config *calicov1alpha1.NetworkConfig) map[string]interface{} {
 	var (
@@ -44,8 +86,26 @@ func ComputeCalicoChartValues(network *extensionsv1alpha1.Network, config *calic
 				"podCIDR": network.Spec.PodCIDR,
 			},
 		}
+		status             = ComputeNetworkStatus(config)
 		calicoConfigValues = map[string]interface{}{
-			"backend": calicov1alpha1.Bird,
+			"backend": status.Backend,
+			"ipv4": map[string]interface{}{
+				"pool": status.IPv4.Pool,
+				"mode": status.IPv4.Mode,
+			},
+			"felix": map[string]interface{}{
+				"ipinip": map[string]interface{}{
+					// IP-in-IP requires BGP to distribute the routes.
+					"enabled": status.Backend != calicov1alpha1.None && status.IPv4.Pool == calicov1alpha1.PoolIPIP && status.IPv4.Mode != calicov1alpha1.Never,
+				},
+				"vxlan": map[string]interface{}{
+					"enabled": status.IPv4.Pool == calicov1alpha1.PoolVXLan && status.IPv4.Mode != calicov1alpha1.Never,
+				},
+			},
+			"veth_mtu": strconv.Itoa(int(status.VethMTU)),
+			"typha": map[string]interface{}{
+				"enabled": status.TyphaEnabled,
+			},
 		}
 		ipamConfig = map[string]interface{}{
 			"type":   hostLocal,
@@ -54,8 +114,6 @@ func ComputeCalicoChartValues(network *extensionsv1alpha1.Network, config *calic
 	)
 
 	if config != nil {
-		calicoConfigValues["backend"] = config.Backend
-
 		if config.IPAM != nil {
 			if len(config.IPAM.Type) > 0 {
 				ipamConfig["type"] = config.IPAM.Type
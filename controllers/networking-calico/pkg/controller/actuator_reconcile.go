@@ -17,7 +17,10 @@ package controller
 import (
 	"context"
 
+	apiscalico "github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/apis/calico"
 	calicov1alpha1 "github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/apis/calico/v1alpha1"
+	calicovalidation "github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/apis/calico/validation"
+	"github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/calico"
 	"github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/charts"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -30,7 +33,7 @@ import (
 )
 
 const (
-	calicoConfigSecretName = "extension-networking-calico-config"
+	calicoConfigSecretName = calico.ManagedResourceName
 )
 
 func withLocalObjectRefs(refs ...string) []corev1.LocalObjectReference {
@@ -47,6 +50,17 @@ func calicoSecret(cl client.Client, calicoConfig []byte, namespace string) (*man
 		WithNamespacedName(namespace, calicoConfigSecretName), withLocalObjectRefs(calicoConfigSecretName)
 }
 
+func validateNetworkConfig(networkConfig *calicov1alpha1.NetworkConfig) error {
+	internalNetworkConfig := &apiscalico.NetworkConfig{}
+	if err := Scheme.Convert(networkConfig, internalNetworkConfig, nil); err != nil {
+		return errors.Wrapf(err, "could not convert network config")
+	}
+	if errs := calicovalidation.ValidateNetworkConfig(internalNetworkConfig); len(errs) > 0 {
+		return errors.Wrapf(errs.ToAggregate(), "invalid network config")
+	}
+	return nil
+}
+
 // Reconcile implements Network.Actuator.
 func (a *actuator) Reconcile(ctx context.Context, network *extensionsv1alpha1.Network, cluster *extensionscontroller.Cluster) error {
 	var (
@@ -59,6 +73,9 @@ func (a *actuator) Reconcile(ctx context.Context, network *extensionsv1alpha1.Ne
 		if err != nil {
 			return err
 		}
+		if err := validateNetworkConfig(networkConfig); err != nil {
+			return err
+		}
 	}
 
 	// Create shoot chart renderer
@@ -20,6 +20,7 @@ import (
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 
 	calicov1alpha1 "github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/apis/calico/v1alpha1"
+	"github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/charts"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -47,11 +48,8 @@ func (a *actuator) updateProviderStatus(
 }
 
 func (a *actuator) ComputeNetworkStatus(networkConfig *calicov1alpha1.NetworkConfig) (*calicov1alpha1.NetworkStatus, error) {
-	var (
-		status = &calicov1alpha1.NetworkStatus{
-			TypeMeta: StatusTypeMeta,
-		}
-	)
+	status := charts.ComputeNetworkStatus(networkConfig)
+	status.TypeMeta = StatusTypeMeta
 
 	return status, nil
 }
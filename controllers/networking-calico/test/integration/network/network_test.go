@@ -0,0 +1,113 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package network_test
+
+import (
+	"context"
+	"time"
+
+	calicoinstall "github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/apis/calico/install"
+	"github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/calico"
+	networkcontroller "github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/controller"
+	"github.com/gardener/gardener-extensions/test/integration/framework"
+
+	resourcesv1alpha1 "github.com/gardener/gardener-resource-manager/pkg/apis/resources/v1alpha1"
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+var _ = Describe("Network", func() {
+	const namespace = "shoot--foo--bar"
+
+	var (
+		ctx = context.TODO()
+		env *framework.Environment
+	)
+
+	BeforeEach(func() {
+		if !framework.BinariesAvailable() {
+			Skip("etcd and kube-apiserver binaries are not available")
+		}
+
+		env = &framework.Environment{AddToScheme: []func(*runtime.Scheme) error{calicoinstall.AddToScheme, resourcesv1alpha1.AddToScheme}}
+		Expect(env.Start(ctx)).To(Succeed())
+
+		_, err := env.StartManager(func(mgr manager.Manager) error {
+			return networkcontroller.AddToManagerWithOptions(mgr, networkcontroller.AddOptions{IgnoreOperationAnnotation: true})
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = framework.CreateNamespace(ctx, env.Client(), namespace)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = framework.CreateCluster(ctx, env.Client(), namespace,
+			&gardenv1beta1.CloudProfile{ObjectMeta: metav1.ObjectMeta{Name: "profile"}},
+			&gardenv1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed"}},
+			&gardenv1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"},
+				Spec: gardenv1beta1.ShootSpec{
+					Kubernetes: gardenv1beta1.Kubernetes{Version: "1.15.2"},
+				},
+			},
+		)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		if env != nil {
+			Expect(env.Stop()).To(Succeed())
+		}
+	})
+
+	It("should deploy Calico and remove it again", func() {
+		network := &extensionsv1alpha1.Network{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "network"},
+			Spec: extensionsv1alpha1.NetworkSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: calico.Type},
+				PodCIDR:     "100.96.0.0/11",
+				ServiceCIDR: "100.64.0.0/13",
+			},
+		}
+		Expect(env.Client().Create(ctx, network)).To(Succeed())
+
+		Expect(framework.WaitForLastOperation(ctx, env.Client(), network, gardencorev1alpha1.LastOperationTypeCreate, gardencorev1alpha1.LastOperationStateSucceeded, time.Second, time.Minute)).To(Succeed())
+		Expect(network.Status.ProviderStatus).NotTo(BeNil())
+
+		key := client.ObjectKey{Namespace: namespace, Name: calico.ManagedResourceName}
+		Expect(env.Client().Get(ctx, key, &resourcesv1alpha1.ManagedResource{})).To(Succeed())
+		Expect(env.Client().Get(ctx, key, &corev1.Secret{})).To(Succeed())
+
+		Expect(env.Client().Delete(ctx, network)).To(Succeed())
+		Expect(wait.PollImmediate(time.Second, time.Minute, func() (bool, error) {
+			err := env.Client().Get(ctx, client.ObjectKey{Namespace: namespace, Name: network.Name}, network)
+			if apierrors.IsNotFound(err) {
+				return true, nil
+			}
+			return false, err
+		})).To(Succeed())
+
+		err := env.Client().Get(ctx, key, &resourcesv1alpha1.ManagedResource{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+})
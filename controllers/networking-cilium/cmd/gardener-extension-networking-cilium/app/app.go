@@ -0,0 +1,128 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	ciliumcontroller "github.com/gardener/gardener-extensions/controllers/networking-cilium/pkg/controller"
+	ciliumhealthcheck "github.com/gardener/gardener-extensions/controllers/networking-cilium/pkg/controller/healthcheck"
+	ciliumshootwebhook "github.com/gardener/gardener-extensions/controllers/networking-cilium/pkg/webhook/shoot"
+
+	"github.com/gardener/gardener-extensions/controllers/networking-cilium/pkg/cilium"
+
+	ciliuminstall "github.com/gardener/gardener-extensions/controllers/networking-cilium/pkg/apis/cilium/install"
+	"github.com/gardener/gardener-extensions/pkg/controller"
+
+	controllercmd "github.com/gardener/gardener-extensions/pkg/controller/cmd"
+	webhookcmd "github.com/gardener/gardener-extensions/pkg/webhook/cmd"
+	extensionshootwebhook "github.com/gardener/gardener-extensions/pkg/webhook/shoot"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// NewControllerManagerCommand creates a new command for running a Cilium controller.
+func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
+	var (
+		restOpts = &controllercmd.RESTOptions{}
+		mgrOpts  = &controllercmd.ManagerOptions{
+			LeaderElection:          true,
+			LeaderElectionID:        controllercmd.LeaderElectionNameID(cilium.Name),
+			LeaderElectionNamespace: os.Getenv("LEADER_ELECTION_NAMESPACE"),
+			WebhookServerPort:       443,
+		}
+		// options for the networking-cilium controller
+		ciliumCtrlOpts = &controllercmd.ControllerOptions{
+			MaxConcurrentReconciles: 5,
+		}
+		// options for the health check controller
+		healthCheckCtrlOpts = &controllercmd.ControllerOptions{
+			MaxConcurrentReconciles: 5,
+		}
+		reconcileOpts = &controllercmd.ReconcilerOptions{
+			IgnoreOperationAnnotation: true,
+		}
+
+		// options for the webhook server
+		webhookServerOptions = &webhookcmd.ServerOptions{
+			CertDir:   "/tmp/gardener-extensions-cert",
+			Namespace: os.Getenv("WEBHOOK_CONFIG_NAMESPACE"),
+		}
+		webhookSwitches = webhookcmd.NewSwitchOptions(
+			webhookcmd.Switch(extensionshootwebhook.WebhookName, ciliumshootwebhook.AddToManager),
+		)
+		webhookOptions = webhookcmd.NewAddToManagerOptions(cilium.Name, webhookServerOptions, webhookSwitches)
+
+		aggOption = controllercmd.NewOptionAggregator(
+			restOpts,
+			mgrOpts,
+			ciliumCtrlOpts,
+			controllercmd.PrefixOption("healthcheck-", healthCheckCtrlOpts),
+			reconcileOpts,
+			webhookOptions,
+		)
+	)
+
+	cmd := &cobra.Command{
+		Use: fmt.Sprintf("%s-controller-manager", cilium.Name),
+
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := aggOption.Complete(); err != nil {
+				controllercmd.LogErrAndExit(err, "Error completing options")
+			}
+
+			mgr, err := manager.New(restOpts.Completed().Config, mgrOpts.Completed().Options())
+			if err != nil {
+				controllercmd.LogErrAndExit(err, "Could not instantiate manager")
+			}
+
+			if err := controller.AddToScheme(mgr.GetScheme()); err != nil {
+				controllercmd.LogErrAndExit(err, "Could not update manager scheme")
+			}
+
+			if err := ciliuminstall.AddToScheme(mgr.GetScheme()); err != nil {
+				controllercmd.LogErrAndExit(err, "Could not update manager scheme")
+			}
+
+			reconcileOpts.Completed().Apply(&ciliumcontroller.DefaultAddOptions.IgnoreOperationAnnotation)
+			healthCheckCtrlOpts.Completed().Apply(&ciliumhealthcheck.DefaultAddOptions.Controller)
+
+			_, shootWebhooks, err := webhookOptions.Completed().AddToManager(mgr)
+			if err != nil {
+				controllercmd.LogErrAndExit(err, "Could not add webhooks to manager")
+			}
+			ciliumcontroller.DefaultAddOptions.ShootWebhooks = shootWebhooks
+
+			if err := ciliumcontroller.AddToManager(mgr); err != nil {
+				controllercmd.LogErrAndExit(err, "Could not add controllers to manager")
+			}
+
+			if err := ciliumhealthcheck.AddToManager(mgr); err != nil {
+				controllercmd.LogErrAndExit(err, "Could not add health check controller to manager")
+			}
+
+			if err := mgr.Start(ctx.Done()); err != nil {
+				controllercmd.LogErrAndExit(err, "Error running manager")
+			}
+		},
+	}
+
+	aggOption.AddFlags(cmd.Flags())
+
+	return cmd
+}
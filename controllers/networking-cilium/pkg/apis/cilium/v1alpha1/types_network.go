@@ -0,0 +1,82 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// KubeProxyReplacementMode defines to which extent Cilium replaces the functionality of kube-proxy.
+type KubeProxyReplacementMode string
+
+const (
+	// KubeProxyReplacementDisabled disables the kube-proxy replacement, kube-proxy keeps handling all services.
+	KubeProxyReplacementDisabled KubeProxyReplacementMode = "disabled"
+	// KubeProxyReplacementProbe enables the kube-proxy replacement for all features supported by the node's kernel.
+	KubeProxyReplacementProbe KubeProxyReplacementMode = "probe"
+	// KubeProxyReplacementPartial enables the kube-proxy replacement only for the explicitly enabled features.
+	KubeProxyReplacementPartial KubeProxyReplacementMode = "partial"
+	// KubeProxyReplacementStrict fully replaces kube-proxy. kube-proxy is not run on the nodes in this mode.
+	KubeProxyReplacementStrict KubeProxyReplacementMode = "strict"
+)
+
+// TunnelMode is the encapsulation used for the traffic between pods on different nodes.
+type TunnelMode string
+
+const (
+	// VXLan encapsulates the traffic with VXLAN.
+	VXLan TunnelMode = "vxlan"
+	// Geneve encapsulates the traffic with Geneve.
+	Geneve TunnelMode = "geneve"
+	// Disabled disables the encapsulation, the pod routes must be distributed by the underlying network.
+	Disabled TunnelMode = "disabled"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NetworkConfig configuration for the cilium networking plugin
+type NetworkConfig struct {
+	metav1.TypeMeta
+
+	// KubeProxyReplacement defines to which extent Cilium replaces kube-proxy (disabled, probe, partial or strict). Defaults to disabled.
+	// +optional
+	KubeProxyReplacement *KubeProxyReplacementMode
+	// Tunnel is the encapsulation used between the nodes (vxlan, geneve or disabled). Defaults to vxlan.
+	// +optional
+	Tunnel *TunnelMode
+	// Hubble contains configuration for the Hubble observability layer.
+	// +optional
+	Hubble *Hubble
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NetworkStatus contains information about created Network resources.
+type NetworkStatus struct {
+	metav1.TypeMeta
+
+	// KubeProxyReplacement is the effective kube-proxy replacement mode.
+	KubeProxyReplacement KubeProxyReplacementMode
+	// Tunnel is the effective encapsulation between the nodes.
+	Tunnel TunnelMode
+	// HubbleEnabled states whether Hubble is enabled.
+	HubbleEnabled bool
+}
+
+// Hubble contains configuration for the Hubble observability layer.
+type Hubble struct {
+	// Enabled states whether Hubble is deployed.
+	Enabled bool
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	apiscilium "github.com/gardener/gardener-extensions/controllers/networking-cilium/pkg/apis/cilium"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var (
+	validKubeProxyReplacementModes = sets.NewString(
+		string(apiscilium.KubeProxyReplacementDisabled),
+		string(apiscilium.KubeProxyReplacementProbe),
+		string(apiscilium.KubeProxyReplacementPartial),
+		string(apiscilium.KubeProxyReplacementStrict),
+	)
+	validTunnelModes = sets.NewString(string(apiscilium.VXLan), string(apiscilium.Geneve), string(apiscilium.Disabled))
+)
+
+// ValidateNetworkConfig validates a NetworkConfig object.
+func ValidateNetworkConfig(config *apiscilium.NetworkConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if config.KubeProxyReplacement != nil && !validKubeProxyReplacementModes.Has(string(*config.KubeProxyReplacement)) {
+		allErrs = append(allErrs, field.NotSupported(field.NewPath("kubeProxyReplacement"), *config.KubeProxyReplacement, validKubeProxyReplacementModes.List()))
+	}
+
+	if config.Tunnel != nil && !validTunnelModes.Has(string(*config.Tunnel)) {
+		allErrs = append(allErrs, field.NotSupported(field.NewPath("tunnel"), *config.Tunnel, validTunnelModes.List()))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apiscilium "github.com/gardener/gardener-extensions/controllers/networking-cilium/pkg/apis/cilium"
+	. "github.com/gardener/gardener-extensions/controllers/networking-cilium/pkg/apis/cilium/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("NetworkConfig validation", func() {
+	var (
+		networkConfig *apiscilium.NetworkConfig
+
+		strict = apiscilium.KubeProxyReplacementStrict
+		geneve = apiscilium.Geneve
+	)
+
+	BeforeEach(func() {
+		networkConfig = &apiscilium.NetworkConfig{
+			KubeProxyReplacement: &strict,
+			Tunnel:               &geneve,
+			Hubble:               &apiscilium.Hubble{Enabled: true},
+		}
+	})
+
+	Describe("#ValidateNetworkConfig", func() {
+		It("should accept a valid configuration", func() {
+			Expect(ValidateNetworkConfig(networkConfig)).To(BeEmpty())
+		})
+
+		It("should accept an empty configuration", func() {
+			Expect(ValidateNetworkConfig(&apiscilium.NetworkConfig{})).To(BeEmpty())
+		})
+
+		It("should forbid unsupported values", func() {
+			mode := apiscilium.KubeProxyReplacementMode("always")
+			tunnel := apiscilium.TunnelMode("gre")
+			networkConfig.KubeProxyReplacement = &mode
+			networkConfig.Tunnel = &tunnel
+
+			Expect(ValidateNetworkConfig(networkConfig)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeNotSupported), "Field": Equal("kubeProxyReplacement")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeNotSupported), "Field": Equal("tunnel")})),
+			))
+		})
+	})
+})
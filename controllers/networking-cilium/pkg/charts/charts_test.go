@@ -0,0 +1,174 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package charts_test
+
+import (
+	"fmt"
+
+	ciliumv1alpha1 "github.com/gardener/gardener-extensions/controllers/networking-cilium/pkg/apis/cilium/v1alpha1"
+	"github.com/gardener/gardener-extensions/controllers/networking-cilium/pkg/charts"
+	"github.com/gardener/gardener-extensions/controllers/networking-cilium/pkg/cilium"
+	"github.com/gardener/gardener-extensions/controllers/networking-cilium/pkg/imagevector"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	mockchartrenderer "github.com/gardener/gardener-extensions/pkg/mock/gardener/chartrenderer"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/chartrenderer"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/helm/pkg/manifest"
+)
+
+var _ = Describe("Chart package test", func() {
+	var (
+		network       *extensionsv1alpha1.Network
+		networkConfig *ciliumv1alpha1.NetworkConfig
+		cluster       *extensionscontroller.Cluster
+		objectMeta    = metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: "bar",
+		}
+		domain = "foo.bar.example.com"
+
+		strict = ciliumv1alpha1.KubeProxyReplacementStrict
+		geneve = ciliumv1alpha1.Geneve
+	)
+
+	BeforeEach(func() {
+		network = &extensionsv1alpha1.Network{
+			ObjectMeta: objectMeta,
+			Spec: extensionsv1alpha1.NetworkSpec{
+				ServiceCIDR: "10.0.0.0/8",
+				PodCIDR:     "12.0.0.0/8",
+			},
+		}
+		networkConfig = &ciliumv1alpha1.NetworkConfig{}
+		cluster = &extensionscontroller.Cluster{
+			CoreShoot: &gardencorev1alpha1.Shoot{
+				Spec: gardencorev1alpha1.ShootSpec{
+					DNS: &gardencorev1alpha1.DNS{Domain: &domain},
+				},
+			},
+		}
+	})
+
+	Describe("#ComputeCiliumChartValues", func() {
+		It("should correctly compute the cilium chart values", func() {
+			values, err := charts.ComputeCiliumChartValues(network, cluster, networkConfig)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"images": map[string]interface{}{
+					"cilium-agent":    imagevector.CiliumAgentImage(),
+					"cilium-operator": imagevector.CiliumOperatorImage(),
+					"hubble":          imagevector.HubbleImage(),
+				},
+				"global": map[string]string{
+					"podCIDR": network.Spec.PodCIDR,
+				},
+				"config": map[string]interface{}{
+					"kubeProxyReplacement": ciliumv1alpha1.KubeProxyReplacementDisabled,
+					"tunnel":               ciliumv1alpha1.VXLan,
+					"hubble": map[string]interface{}{
+						"enabled": false,
+					},
+				},
+			}))
+		})
+
+		It("should correctly compute the kube-proxy replacement, tunnel and Hubble values", func() {
+			networkConfig.KubeProxyReplacement = &strict
+			networkConfig.Tunnel = &geneve
+			networkConfig.Hubble = &ciliumv1alpha1.Hubble{Enabled: true}
+
+			values, err := charts.ComputeCiliumChartValues(network, cluster, networkConfig)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values["config"]).To(Equal(map[string]interface{}{
+				"kubeProxyReplacement": ciliumv1alpha1.KubeProxyReplacementStrict,
+				"tunnel":               ciliumv1alpha1.Geneve,
+				"hubble": map[string]interface{}{
+					"enabled": true,
+				},
+				"kubernetes": map[string]interface{}{
+					"serviceHost": "api." + domain,
+					"servicePort": 443,
+				},
+			}))
+		})
+
+		It("should fail to replace kube-proxy if the shoot has no domain", func() {
+			networkConfig.KubeProxyReplacement = &strict
+			cluster.CoreShoot.Spec.DNS = nil
+
+			_, err := charts.ComputeCiliumChartValues(network, cluster, networkConfig)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#ComputeNetworkStatus", func() {
+		It("should return the default settings if no config is given", func() {
+			Expect(charts.ComputeNetworkStatus(nil)).To(Equal(&ciliumv1alpha1.NetworkStatus{
+				KubeProxyReplacement: ciliumv1alpha1.KubeProxyReplacementDisabled,
+				Tunnel:               ciliumv1alpha1.VXLan,
+				HubbleEnabled:        false,
+			}))
+		})
+
+		It("should return the configured settings", func() {
+			networkConfig.KubeProxyReplacement = &strict
+			networkConfig.Hubble = &ciliumv1alpha1.Hubble{Enabled: true}
+
+			Expect(charts.ComputeNetworkStatus(networkConfig)).To(Equal(&ciliumv1alpha1.NetworkStatus{
+				KubeProxyReplacement: ciliumv1alpha1.KubeProxyReplacementStrict,
+				Tunnel:               ciliumv1alpha1.VXLan,
+				HubbleEnabled:        true,
+			}))
+		})
+	})
+
+	Describe("#IsKubeProxyReplaced", func() {
+		It("should only return true for the strict mode", func() {
+			probe := ciliumv1alpha1.KubeProxyReplacementProbe
+
+			Expect(charts.IsKubeProxyReplaced(nil)).To(BeFalse())
+			Expect(charts.IsKubeProxyReplaced(&ciliumv1alpha1.NetworkConfig{KubeProxyReplacement: &probe})).To(BeFalse())
+			Expect(charts.IsKubeProxyReplaced(&ciliumv1alpha1.NetworkConfig{KubeProxyReplacement: &strict})).To(BeTrue())
+		})
+	})
+
+	Describe("#RenderCiliumChart", func() {
+		var (
+			ctrl                = gomock.NewController(GinkgoT())
+			mockChartRenderer   = mockchartrenderer.NewMockInterface(ctrl)
+			testManifestContent = "test-content"
+			mkManifest          = func(name string) manifest.Manifest {
+				return manifest.Manifest{Name: fmt.Sprintf("test/templates/%s", name), Content: testManifestContent}
+			}
+		)
+		It("Render Cilium charts correctly", func() {
+			mockChartRenderer.EXPECT().Render(cilium.ChartPath, cilium.ReleaseName, metav1.NamespaceSystem, gomock.Any()).Return(&chartrenderer.RenderedChart{
+				ChartName: "test",
+				Manifests: []manifest.Manifest{
+					mkManifest(charts.CiliumConfigKey),
+				},
+			}, nil)
+
+			_, err := charts.RenderCiliumChart(mockChartRenderer, network, cluster, networkConfig)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,107 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package charts
+
+import (
+	"fmt"
+
+	ciliumv1alpha1 "github.com/gardener/gardener-extensions/controllers/networking-cilium/pkg/apis/cilium/v1alpha1"
+	"github.com/gardener/gardener-extensions/controllers/networking-cilium/pkg/cilium"
+	"github.com/gardener/gardener-extensions/controllers/networking-cilium/pkg/imagevector"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+const kubernetesServicePort = 443
+
+// ComputeNetworkStatus computes the effective Cilium settings for the given network config, i.e., the settings that
+// are used to compute the cilium chart values.
+func ComputeNetworkStatus(config *ciliumv1alpha1.NetworkConfig) *ciliumv1alpha1.NetworkStatus {
+	status := &ciliumv1alpha1.NetworkStatus{
+		KubeProxyReplacement: ciliumv1alpha1.KubeProxyReplacementDisabled,
+		Tunnel:               ciliumv1alpha1.VXLan,
+	}
+
+	if config == nil {
+		return status
+	}
+
+	if config.KubeProxyReplacement != nil {
+		status.KubeProxyReplacement = *config.KubeProxyReplacement
+	}
+	if config.Tunnel != nil {
+		status.Tunnel = *config.Tunnel
+	}
+	if config.Hubble != nil {
+		status.HubbleEnabled = config.Hubble.Enabled
+	}
+
+	return status
+}
+
+// IsKubeProxyReplaced returns true if Cilium fully replaces kube-proxy for the given network config.
+func IsKubeProxyReplaced(config *ciliumv1alpha1.NetworkConfig) bool {
+	return ComputeNetworkStatus(config).KubeProxyReplacement == ciliumv1alpha1.KubeProxyReplacementStrict
+}
+
+// ComputeCiliumChartValues computes the values for the cilium chart.
+func ComputeCiliumChartValues(network *extensionsv1alpha1.Network, cluster *extensionscontroller.Cluster, config *ciliumv1alpha1.NetworkConfig) (map[string]interface{}, error) {
+	var (
+		ciliumChartValues = map[string]interface{}{
+			"images": map[string]interface{}{
+				cilium.AgentImageName:    imagevector.CiliumAgentImage(),
+				cilium.OperatorImageName: imagevector.CiliumOperatorImage(),
+				cilium.HubbleImageName:   imagevector.HubbleImage(),
+			},
+			"global": map[string]string{
+				"podCIDR": network.Spec.PodCIDR,
+			},
+		}
+		status             = ComputeNetworkStatus(config)
+		ciliumConfigValues = map[string]interface{}{
+			"kubeProxyReplacement": status.KubeProxyReplacement,
+			"tunnel":               status.Tunnel,
+			"hubble": map[string]interface{}{
+				"enabled": status.HubbleEnabled,
+			},
+		}
+	)
+
+	// Without kube-proxy the agents cannot reach the kube-apiserver via the cluster IP of the kubernetes service.
+	if status.KubeProxyReplacement == ciliumv1alpha1.KubeProxyReplacementStrict {
+		domain := getShootDomain(cluster)
+		if domain == nil {
+			return nil, fmt.Errorf("kube-proxy replacement %q requires a shoot domain", status.KubeProxyReplacement)
+		}
+		ciliumConfigValues["kubernetes"] = map[string]interface{}{
+			"serviceHost": fmt.Sprintf("api.%s", *domain),
+			"servicePort": kubernetesServicePort,
+		}
+	}
+
+	ciliumChartValues["config"] = ciliumConfigValues
+
+	return ciliumChartValues, nil
+}
+
+func getShootDomain(cluster *extensionscontroller.Cluster) *string {
+	if cluster.Shoot != nil {
+		return cluster.Shoot.Spec.DNS.Domain
+	} else if cluster.CoreShoot != nil && cluster.CoreShoot.Spec.DNS != nil {
+		return cluster.CoreShoot.Spec.DNS.Domain
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cilium
+
+import "path/filepath"
+
+const (
+	Name = "networking-cilium"
+
+	// ImageNames
+	AgentImageName    = "cilium-agent"
+	OperatorImageName = "cilium-operator"
+	HubbleImageName   = "hubble"
+
+	// ReleaseName is the name of the Cilium Release
+	ReleaseName = "cilium"
+
+	// ManagedResourceName is the name of the ManagedResource containing the Cilium components.
+	ManagedResourceName = "extension-networking-cilium-config"
+	// ShootWebhooksResourceName is the name of the ManagedResource containing the shoot webhook configuration.
+	ShootWebhooksResourceName = "extension-networking-cilium-shoot-webhooks"
+	// AgentDaemonSetName is the name of the cilium-agent DaemonSet in the shoot cluster.
+	AgentDaemonSetName = "cilium"
+	// OperatorDeploymentName is the name of the cilium-operator Deployment in the shoot cluster.
+	OperatorDeploymentName = "cilium-operator"
+
+	// KubeProxyNodeSelectorKey is the node selector key added to the kube-proxy DaemonSet when Cilium fully replaces
+	// kube-proxy. No node carries this label, hence, no kube-proxy pods are scheduled.
+	KubeProxyNodeSelectorKey = "networking.extensions.gardener.cloud/kube-proxy"
+	// KubeProxyNodeSelectorValue is the node selector value added to the kube-proxy DaemonSet.
+	KubeProxyNodeSelectorValue = "enabled"
+)
+
+var (
+	// ChartsPath is the path to the charts
+	ChartsPath = filepath.Join("controllers", Name, "charts")
+	// InternalChartsPath is the path to the internal charts
+	InternalChartsPath = filepath.Join(ChartsPath, "internal")
+
+	// ChartPath path for internal Cilium Chart
+	ChartPath = filepath.Join(InternalChartsPath, "cilium")
+)
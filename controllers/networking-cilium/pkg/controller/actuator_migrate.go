@@ -0,0 +1,41 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/controllers/networking-cilium/pkg/cilium"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// Migrate implements Network.Actuator.
+func (a *actuator) Migrate(ctx context.Context, network *extensionsv1alpha1.Network, cluster *extensionscontroller.Cluster) error {
+	// Keep the cilium objects in the shoot cluster while the managed resources are removed from the seed.
+	for _, name := range []string{ciliumConfigSecretName, cilium.ShootWebhooksResourceName} {
+		if err := extensionscontroller.SetKeepObjects(ctx, a.client, network.Namespace, name, true); err != nil {
+			return err
+		}
+	}
+
+	return a.Delete(ctx, network, cluster)
+}
+
+// Restore implements Network.Actuator.
+func (a *actuator) Restore(ctx context.Context, network *extensionsv1alpha1.Network, cluster *extensionscontroller.Cluster) error {
+	return a.Reconcile(ctx, network, cluster)
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	apiscilium "github.com/gardener/gardener-extensions/controllers/networking-cilium/pkg/apis/cilium"
+	ciliumv1alpha1 "github.com/gardener/gardener-extensions/controllers/networking-cilium/pkg/apis/cilium/v1alpha1"
+	ciliumvalidation "github.com/gardener/gardener-extensions/controllers/networking-cilium/pkg/apis/cilium/validation"
+	"github.com/gardener/gardener-extensions/controllers/networking-cilium/pkg/charts"
+	"github.com/gardener/gardener-extensions/controllers/networking-cilium/pkg/cilium"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	extensionswebhookshoot "github.com/gardener/gardener-extensions/pkg/webhook/shoot"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	"github.com/gardener/gardener-resource-manager/pkg/manager"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	ciliumConfigSecretName = cilium.ManagedResourceName
+)
+
+func withLocalObjectRefs(refs ...string) []corev1.LocalObjectReference {
+	var localObjectRefs []corev1.LocalObjectReference
+	for _, ref := range refs {
+		localObjectRefs = append(localObjectRefs, corev1.LocalObjectReference{Name: ref})
+	}
+	return localObjectRefs
+}
+
+func ciliumSecret(cl client.Client, ciliumConfig []byte, namespace string) (*manager.Secret, []corev1.LocalObjectReference) {
+	return manager.NewSecret(cl).
+		WithKeyValues(map[string][]byte{charts.CiliumConfigKey: ciliumConfig}).
+		WithNamespacedName(namespace, ciliumConfigSecretName), withLocalObjectRefs(ciliumConfigSecretName)
+}
+
+func validateNetworkConfig(networkConfig *ciliumv1alpha1.NetworkConfig) error {
+	internalNetworkConfig := &apiscilium.NetworkConfig{}
+	if err := Scheme.Convert(networkConfig, internalNetworkConfig, nil); err != nil {
+		return errors.Wrapf(err, "could not convert network config")
+	}
+	if errs := ciliumvalidation.ValidateNetworkConfig(internalNetworkConfig); len(errs) > 0 {
+		return errors.Wrapf(errs.ToAggregate(), "invalid network config")
+	}
+	return nil
+}
+
+// Reconcile implements Network.Actuator.
+func (a *actuator) Reconcile(ctx context.Context, network *extensionsv1alpha1.Network, cluster *extensionscontroller.Cluster) error {
+	var (
+		networkConfig *ciliumv1alpha1.NetworkConfig
+		err           error
+	)
+
+	if network.Spec.ProviderConfig != nil {
+		networkConfig, err = CiliumNetworkConfigFromNetworkResource(network)
+		if err != nil {
+			return err
+		}
+		if err := validateNetworkConfig(networkConfig); err != nil {
+			return err
+		}
+	}
+
+	// Create shoot chart renderer
+	chartRenderer, err := a.chartRendererFactory.NewChartRendererForShoot(extensionscontroller.GetKubernetesVersion(cluster))
+	if err != nil {
+		return errors.Wrapf(err, "could not create chart renderer for shoot '%s'", network.Namespace)
+	}
+
+	ciliumChart, err := charts.RenderCiliumChart(chartRenderer, network, cluster, networkConfig)
+	if err != nil {
+		return err
+	}
+
+	secret, secretRefs := ciliumSecret(a.client, ciliumChart, network.Namespace)
+	if err := secret.Reconcile(ctx); err != nil {
+		return err
+	}
+
+	if err := manager.
+		NewManagedResource(a.client).
+		WithNamespacedName(network.Namespace, ciliumConfigSecretName).
+		WithSecretRefs(secretRefs).
+		WithInjectedLabels(map[string]string{common.ShootNoCleanup: "true"}).
+		Reconcile(ctx); err != nil {
+		return err
+	}
+
+	// kube-proxy is only disabled by the shoot webhook once Cilium has been deployed with the kube-proxy replacement.
+	timeoutCtx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+	defer cancel()
+	if err := extensionscontroller.WaitUntilManagedResourceHealthy(timeoutCtx, a.client, network.Namespace, ciliumConfigSecretName); err != nil {
+		return errors.Wrapf(err, "could not wait for managed resource '%s/%s' to become healthy", network.Namespace, ciliumConfigSecretName)
+	}
+
+	if err := a.reconcileShootWebhooks(ctx, network.Namespace, charts.IsKubeProxyReplaced(networkConfig)); err != nil {
+		return err
+	}
+
+	return a.updateProviderStatus(ctx, network, networkConfig)
+}
+
+func (a *actuator) reconcileShootWebhooks(ctx context.Context, namespace string, kubeProxyReplaced bool) error {
+	shootWebhooks := a.shootWebhooks.Get()
+	if len(shootWebhooks) == 0 {
+		return nil
+	}
+
+	if kubeProxyReplaced {
+		return extensionswebhookshoot.ReconcileWebhookConfig(ctx, a.client, namespace, cilium.Name, cilium.ShootWebhooksResourceName, a.webhookServerPort, shootWebhooks)
+	}
+	return extensionswebhookshoot.DeleteWebhookConfig(ctx, a.client, namespace, cilium.Name, cilium.ShootWebhooksResourceName)
+}
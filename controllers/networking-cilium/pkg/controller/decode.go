@@ -0,0 +1,53 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"fmt"
+
+	"github.com/gardener/gardener-extensions/controllers/networking-cilium/pkg/apis/cilium/install"
+	ciliumv1alpha1 "github.com/gardener/gardener-extensions/controllers/networking-cilium/pkg/apis/cilium/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+)
+
+var (
+	// Scheme is a scheme with the types relevant for Network actuators.
+	Scheme *runtime.Scheme
+
+	decoder runtime.Decoder
+)
+
+func init() {
+	Scheme = runtime.NewScheme()
+	utilruntime.Must(install.AddToScheme(Scheme))
+
+	decoder = serializer.NewCodecFactory(Scheme).UniversalDecoder()
+}
+
+// CiliumNetworkConfigFromNetworkResource extracts the NetworkConfig from the
+// ProviderConfig section of the given Network resource.
+func CiliumNetworkConfigFromNetworkResource(network *extensionsv1alpha1.Network) (*ciliumv1alpha1.NetworkConfig, error) {
+	config := &ciliumv1alpha1.NetworkConfig{}
+	if network.Spec.ProviderConfig != nil && network.Spec.ProviderConfig.Raw != nil {
+		if _, _, err := decoder.Decode(network.Spec.ProviderConfig.Raw, nil, config); err != nil {
+			return nil, err
+		}
+		return config, nil
+	}
+	return nil, fmt.Errorf("provider config is not set on the network resource")
+}
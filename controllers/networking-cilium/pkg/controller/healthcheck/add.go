@@ -0,0 +1,79 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck
+
+import (
+	"time"
+
+	"github.com/gardener/gardener-extensions/controllers/networking-cilium/pkg/cilium"
+	"github.com/gardener/gardener-extensions/pkg/controller/healthcheck"
+	"github.com/gardener/gardener-extensions/pkg/controller/healthcheck/general"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+var (
+	// DefaultAddOptions are the default AddOptions for AddToManager.
+	DefaultAddOptions = AddOptions{
+		SyncPeriod: healthcheck.DefaultSyncPeriod,
+	}
+)
+
+// AddOptions are options to apply when adding the Cilium health check controller to the manager.
+type AddOptions struct {
+	// Controller are the controller.Options.
+	Controller controller.Options
+	// SyncPeriod is the period after which the health checks are executed again.
+	SyncPeriod time.Duration
+}
+
+// AddToManagerWithOptions adds the health check controller for the Network resources with the given Options to the
+// given manager.
+func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) error {
+	return healthcheck.Add(
+		mgr,
+		extensionsv1alpha1.NetworkResource,
+		func() runtime.Object { return &extensionsv1alpha1.Network{} },
+		healthcheck.NewActuator(cilium.Type, extensionsv1alpha1.NetworkResource, []healthcheck.ConditionTypeToHealthCheck{
+			{
+				ConditionType: gardencorev1alpha1.ShootSystemComponentsHealthy,
+				HealthCheck:   general.NewManagedResourceHealthChecker(cilium.ManagedResourceName),
+			},
+			{
+				ConditionType: gardencorev1alpha1.ShootSystemComponentsHealthy,
+				HealthCheck:   general.NewShootDaemonSetHealthChecker(metav1.NamespaceSystem, cilium.AgentDaemonSetName),
+			},
+			{
+				ConditionType: gardencorev1alpha1.ShootSystemComponentsHealthy,
+				HealthCheck:   general.NewShootDeploymentHealthChecker(metav1.NamespaceSystem, cilium.OperatorDeploymentName),
+			},
+		}),
+		healthcheck.AddArgs{
+			ControllerOptions: opts.Controller,
+			Predicates:        healthcheck.DefaultPredicates(cilium.Type),
+			SyncPeriod:        opts.SyncPeriod,
+		},
+	)
+}
+
+// AddToManager adds the health check controller with the default Options.
+func AddToManager(mgr manager.Manager) error {
+	return AddToManagerWithOptions(mgr, DefaultAddOptions)
+}
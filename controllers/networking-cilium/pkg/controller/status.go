@@ -0,0 +1,55 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+
+	ciliumv1alpha1 "github.com/gardener/gardener-extensions/controllers/networking-cilium/pkg/apis/cilium/v1alpha1"
+	"github.com/gardener/gardener-extensions/controllers/networking-cilium/pkg/charts"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+)
+
+func (a *actuator) updateProviderStatus(
+	ctx context.Context,
+	network *extensionsv1alpha1.Network,
+	config *ciliumv1alpha1.NetworkConfig,
+) error {
+	status, err := a.ComputeNetworkStatus(config)
+	if err != nil {
+		return err
+	}
+
+	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, a.client, network, func() error {
+		network.Status.ProviderStatus = &runtime.RawExtension{Object: status}
+		network.Status.LastOperation = extensionscontroller.LastOperation(gardencorev1alpha1.LastOperationTypeReconcile,
+			gardencorev1alpha1.LastOperationStateSucceeded,
+			100,
+			"Cilium was configured successfully")
+		return nil
+	})
+}
+
+func (a *actuator) ComputeNetworkStatus(networkConfig *ciliumv1alpha1.NetworkConfig) (*ciliumv1alpha1.NetworkStatus, error) {
+	status := charts.ComputeNetworkStatus(networkConfig)
+	status.TypeMeta = StatusTypeMeta
+
+	return status, nil
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/controllers/networking-cilium/pkg/cilium"
+
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+// mutateKubeProxyDaemonSet disables kube-proxy by adding a node selector that is not matched by any node. The DaemonSet
+// itself is kept as it is managed by Gardener.
+func (m *mutator) mutateKubeProxyDaemonSet(ctx context.Context, daemonSet *appsv1.DaemonSet) error {
+	ps := &daemonSet.Spec.Template.Spec
+	if ps.NodeSelector == nil {
+		ps.NodeSelector = map[string]string{}
+	}
+	ps.NodeSelector[cilium.KubeProxyNodeSelectorKey] = cilium.KubeProxyNodeSelectorValue
+
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/controllers/networking-cilium/pkg/cilium"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Mutator", func() {
+	var (
+		ctx     = context.TODO()
+		mutator = NewMutator()
+
+		newDaemonSet = func(name string, nodeSelector map[string]string) *appsv1.DaemonSet {
+			return &appsv1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: metav1.NamespaceSystem,
+				},
+				Spec: appsv1.DaemonSetSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							NodeSelector: nodeSelector,
+						},
+					},
+				},
+			}
+		}
+	)
+
+	Describe("#Mutate", func() {
+		It("should add the node selector to the kube-proxy daemon set", func() {
+			daemonSet := newDaemonSet("kube-proxy", map[string]string{"beta.kubernetes.io/os": "linux"})
+
+			Expect(mutator.Mutate(ctx, daemonSet)).To(Succeed())
+			Expect(daemonSet.Spec.Template.Spec.NodeSelector).To(Equal(map[string]string{
+				"beta.kubernetes.io/os":         "linux",
+				cilium.KubeProxyNodeSelectorKey: cilium.KubeProxyNodeSelectorValue,
+			}))
+		})
+
+		It("should add the node selector if the kube-proxy daemon set has none", func() {
+			daemonSet := newDaemonSet("kube-proxy", nil)
+
+			Expect(mutator.Mutate(ctx, daemonSet)).To(Succeed())
+			Expect(daemonSet.Spec.Template.Spec.NodeSelector).To(Equal(map[string]string{
+				cilium.KubeProxyNodeSelectorKey: cilium.KubeProxyNodeSelectorValue,
+			}))
+		})
+
+		It("should not mutate other daemon sets", func() {
+			daemonSet := newDaemonSet("cilium", nil)
+
+			Expect(mutator.Mutate(ctx, daemonSet)).To(Succeed())
+			Expect(daemonSet.Spec.Template.Spec.NodeSelector).To(BeNil())
+		})
+
+		It("should not mutate a kube-proxy daemon set that is being deleted", func() {
+			daemonSet := newDaemonSet("kube-proxy", nil)
+			now := metav1.Now()
+			daemonSet.DeletionTimestamp = &now
+
+			Expect(mutator.Mutate(ctx, daemonSet)).To(Succeed())
+			Expect(daemonSet.Spec.Template.Spec.NodeSelector).To(BeNil())
+		})
+	})
+})
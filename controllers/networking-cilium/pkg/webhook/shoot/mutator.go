@@ -0,0 +1,64 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+
+	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
+
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+type mutator struct {
+	logger logr.Logger
+}
+
+// NewMutator creates a new Mutator that mutates resources in the shoot cluster. It disables the kube-proxy DaemonSet
+// and leaves all other objects untouched. The webhook is only registered in shoot clusters in which Cilium fully
+// replaces kube-proxy, and only after Cilium has been deployed there.
+func NewMutator() extensionswebhook.Mutator {
+	return &mutator{
+		logger: log.Log.WithName("shoot-mutator"),
+	}
+}
+
+// Mutate mutates resources.
+func (m *mutator) Mutate(ctx context.Context, obj runtime.Object) error {
+	acc, err := meta.Accessor(obj)
+	if err != nil {
+		return errors.Wrapf(err, "could not create accessor during webhook")
+	}
+	// If the object does have a deletion timestamp then we don't want to mutate anything.
+	if acc.GetDeletionTimestamp() != nil {
+		return nil
+	}
+
+	switch x := obj.(type) {
+	case *appsv1.DaemonSet:
+		switch x.Name {
+		case common.KubeProxyDaemonSetName:
+			extensionswebhook.LogMutation(logger, x.Kind, x.Namespace, x.Name)
+			return m.mutateKubeProxyDaemonSet(ctx, x)
+		}
+	}
+	return nil
+}
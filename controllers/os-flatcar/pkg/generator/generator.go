@@ -0,0 +1,68 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"text/template"
+
+	commongenerator "github.com/gardener/gardener-extensions/pkg/controller/operatingsystemconfig/oscommon/generator"
+	"github.com/gardener/gardener-extensions/pkg/controller/operatingsystemconfig/oscommon/ignition"
+	template_gen "github.com/gardener/gardener-extensions/pkg/controller/operatingsystemconfig/oscommon/template"
+	"github.com/gobuffalo/packr/v2"
+	"k8s.io/apimachinery/pkg/util/runtime"
+)
+
+var cmd = "/usr/bin/env bash %s"
+var flatcarGenerator *FlatcarGenerator
+
+//go:generate packr2
+
+func init() {
+	box := packr.New("templates", "./templates")
+	reconcileScriptTemplateString, err := box.FindString("reconcile-flatcar.template")
+	runtime.Must(err)
+
+	reconcileScriptTemplate, err := template.New("reconcile-script").Parse(reconcileScriptTemplateString)
+	runtime.Must(err)
+
+	flatcarGenerator = &FlatcarGenerator{
+		ignitionGenerator: ignition.NewIgnitionGenerator(ignition.SpecVersion2, cmd,
+			ignition.Unit{Name: "update-engine.service", Mask: true},
+			ignition.Unit{Name: "locksmithd.service", Mask: true},
+		),
+		scriptGenerator: template_gen.NewCloudInitGenerator(reconcileScriptTemplate, template_gen.DefaultUnitsPath, cmd),
+	}
+}
+
+// FlatcarGenerator generates the configuration for Flatcar Container Linux. Ignition only runs during the
+// first boot of a machine, hence bootstrapping configurations are generated as Ignition configs while all
+// other configurations are generated as shell scripts that are executed on the running machine.
+type FlatcarGenerator struct {
+	ignitionGenerator commongenerator.Generator
+	scriptGenerator   commongenerator.Generator
+}
+
+// Generate generates the Flatcar configuration from the given OperatingSystemConfig.
+func (g *FlatcarGenerator) Generate(data *commongenerator.OperatingSystemConfig) ([]byte, *string, error) {
+	if data.Bootstrap {
+		return g.ignitionGenerator.Generate(data)
+	}
+	return g.scriptGenerator.Generate(data)
+}
+
+// Generator is the generator which will generate the Ignition configs and reconcile scripts.
+func Generator() *FlatcarGenerator {
+	return flatcarGenerator
+}
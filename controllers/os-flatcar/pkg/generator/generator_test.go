@@ -0,0 +1,62 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator_test
+
+import (
+	flatcar_generator "github.com/gardener/gardener-extensions/controllers/os-flatcar/pkg/generator"
+	"github.com/gardener/gardener-extensions/pkg/controller/operatingsystemconfig/oscommon/generator"
+	"github.com/gardener/gardener-extensions/pkg/controller/operatingsystemconfig/oscommon/generator/test"
+	"github.com/gobuffalo/packr"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Flatcar OS Generator Test", func() {
+	var box = packr.NewBox("./testfiles")
+
+	Describe("Conformance Tests", func() {
+		test.DescribeTest(flatcar_generator.Generator(), box)()
+	})
+
+	Describe("Reconcile Script", func() {
+		It("should render the reconcile script and command correctly", func() {
+			var (
+				permissions = int32(0600)
+				path        = "/var/lib/cloud-config-downloader/downloads/cloud_config"
+			)
+
+			expectedScript, err := box.Find("reconcile-script")
+			Expect(err).NotTo(HaveOccurred())
+
+			script, cmd, err := flatcar_generator.Generator().Generate(&generator.OperatingSystemConfig{
+				Files: []*generator.File{
+					{Path: "/foo", Content: []byte("bar"), Permissions: &permissions},
+				},
+				Units: []*generator.Unit{
+					{
+						Name:    "docker.service",
+						Content: []byte("unit"),
+						DropIns: []*generator.DropIn{{Name: "10-docker-opts.conf", Content: []byte("override")}},
+					},
+				},
+				Path: &path,
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(script).To(Equal(expectedScript))
+			Expect(*cmd).To(Equal("/usr/bin/env bash " + path))
+		})
+	})
+})
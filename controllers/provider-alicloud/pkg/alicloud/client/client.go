@@ -124,27 +124,124 @@ func (c *storageClient) CreateBucketIfNotExists(ctx context.Context, bucketName
 	return nil
 }
 
+// UpdateBucketConfig converges the default encryption, versioning, ACL and lifecycle configuration of the OSS bucket
+// with name <bucketName> to the given <config>.
+func (c *storageClient) UpdateBucketConfig(ctx context.Context, bucketName string, config *BucketConfig) error {
+	if config.Encryption {
+		rule := oss.ServerEncryptionRule{SSEDefault: oss.SSEDefaultRule{SSEAlgorithm: string(oss.AESAlgorithm)}}
+		if len(config.KMSKeyID) > 0 {
+			rule.SSEDefault = oss.SSEDefaultRule{SSEAlgorithm: string(oss.KMSAlgorithm), KMSMasterKeyID: config.KMSKeyID}
+		}
+		if err := c.client.SetBucketEncryption(bucketName, rule); err != nil {
+			return err
+		}
+	} else if err := c.client.DeleteBucketEncryption(bucketName); err != nil {
+		return err
+	}
+
+	// A bucket that has been versioned once can only be suspended, hence it is only suspended if versioning is
+	// currently enabled to leave buckets that were never versioned untouched.
+	versioning, err := c.client.GetBucketVersioning(bucketName)
+	if err != nil {
+		return err
+	}
+	if enabled := versioning.Status == string(oss.VersionEnabled); enabled != config.Versioning {
+		status := oss.VersionSuspended
+		if config.Versioning {
+			status = oss.VersionEnabled
+		}
+		if err := c.client.SetBucketVersioning(bucketName, oss.VersioningConfig{Status: string(status)}); err != nil {
+			return err
+		}
+	}
+
+	if config.BlockPublicAccess {
+		if err := c.client.SetBucketACL(bucketName, oss.ACLPrivate); err != nil {
+			return err
+		}
+	}
+
+	if config.ExpirationDays > 0 {
+		return c.client.SetBucketLifecycle(bucketName, []oss.LifecycleRule{
+			{
+				ID:         "expiration",
+				Prefix:     "",
+				Status:     "Enabled",
+				Expiration: &oss.LifecycleExpiration{Days: config.ExpirationDays},
+			},
+		})
+	}
+	return c.client.DeleteBucketLifecycle(bucketName)
+}
+
 // DeleteBucketIfExists deletes the Alicloud OSS bucket with name <bucketName>. If it does not exist,
-// no error is returned.
+// no error is returned. A bucket that is not empty is emptied including all object versions and
+// delete markers before its deletion is retried.
 func (c *storageClient) DeleteBucketIfExists(ctx context.Context, bucketName string) error {
-	if err := c.client.DeleteBucket(bucketName); err != nil {
-		if ossErr, ok := err.(oss.ServiceError); ok {
-			switch ossErr.StatusCode {
-			case http.StatusNotFound:
-				return nil
+	for attempt := 1; ; attempt++ {
+		err := c.client.DeleteBucket(bucketName)
+		if err == nil {
+			return nil
+		}
 
-			case http.StatusConflict:
-				if err := c.DeleteObjectsWithPrefix(ctx, bucketName, ""); err != nil {
-					return err
-				}
-				return c.DeleteBucketIfExists(ctx, bucketName)
+		ossErr, ok := err.(oss.ServiceError)
+		if !ok {
+			return err
+		}
+		switch {
+		case ossErr.StatusCode == http.StatusNotFound:
+			return nil
+		case ossErr.StatusCode == http.StatusConflict && attempt < maxDeleteBucketAttempts:
+			if err := c.deleteObjectVersions(bucketName); err != nil {
+				return err
+			}
+		default:
+			return ossErr
+		}
+	}
+}
+
+// deleteObjectVersions deletes all objects of the OSS bucket with name <bucketName> including all their versions
+// and delete markers.
+func (c *storageClient) deleteObjectVersions(bucketName string) error {
+	bucket, err := c.client.Bucket(bucketName)
+	if err != nil {
+		return err
+	}
+
+	keyMarker, versionIDMarker := "", ""
+	for {
+		lsRes, err := bucket.ListObjectVersions(oss.KeyMarker(keyMarker), oss.VersionIdMarker(versionIDMarker), oss.MaxKeys(1000))
+		if err != nil {
+			return err
+		}
+
+		var objects []oss.DeleteObject
+		for _, version := range lsRes.ObjectVersions {
+			objects = append(objects, oss.DeleteObject{Key: version.Key, VersionId: version.VersionId})
+		}
+		for _, deleteMarker := range lsRes.ObjectDeleteMarkers {
+			objects = append(objects, oss.DeleteObject{Key: deleteMarker.Key, VersionId: deleteMarker.VersionId})
+		}
 
-			default:
-				return ossErr
+		// A page contains at most 1000 versions and 1000 delete markers, but at most 1000 objects can be
+		// deleted with one request.
+		for len(objects) > 0 {
+			n := len(objects)
+			if n > 1000 {
+				n = 1000
 			}
+			if _, err := bucket.DeleteObjectVersions(objects[:n], oss.DeleteObjectsQuiet(true)); err != nil {
+				return err
+			}
+			objects = objects[n:]
+		}
+
+		if !lsRes.IsTruncated {
+			return nil
 		}
+		keyMarker, versionIDMarker = lsRes.NextKeyMarker, lsRes.NextVersionIdMarker
 	}
-	return nil
 }
 
 // ComputeStorageEndpoint computes the OSS storage endpoint based on the given region.
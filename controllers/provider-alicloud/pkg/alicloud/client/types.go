@@ -20,8 +20,14 @@ import (
 	alicloudvpc "github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 )
 
-// DefaultInternetChargeType is used for EIP
-const DefaultInternetChargeType = "PayByTraffic"
+const (
+	// DefaultInternetChargeType is used for EIP
+	DefaultInternetChargeType = "PayByTraffic"
+
+	// maxDeleteBucketAttempts is the maximum number of attempts to empty and delete a bucket. Objects that are
+	// written concurrently may prevent the deletion of a bucket.
+	maxDeleteBucketAttempts = 3
+)
 
 // VPC is the interface to the Alicloud VPC service.
 type VPC interface {
@@ -44,4 +50,20 @@ type Storage interface {
 	DeleteObjectsWithPrefix(ctx context.Context, bucketName, prefix string) error
 	CreateBucketIfNotExists(ctx context.Context, bucketName string) error
 	DeleteBucketIfExists(ctx context.Context, bucketName string) error
+	UpdateBucketConfig(ctx context.Context, bucketName string, config *BucketConfig) error
+}
+
+// BucketConfig contains the desired configuration of an OSS bucket.
+type BucketConfig struct {
+	// Encryption indicates whether default server-side encryption is enabled for the bucket.
+	Encryption bool
+	// KMSKeyID is the ID of the KMS key used for the default server-side encryption. If it is empty, OSS-managed
+	// keys are used.
+	KMSKeyID string
+	// Versioning indicates whether object versioning is enabled for the bucket.
+	Versioning bool
+	// BlockPublicAccess indicates whether the ACL of the bucket is enforced to be private.
+	BlockPublicAccess bool
+	// ExpirationDays is the number of days after which objects expire. Zero means that objects never expire.
+	ExpirationDays int
 }
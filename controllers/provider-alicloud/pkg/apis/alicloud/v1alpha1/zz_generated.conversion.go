@@ -24,6 +24,7 @@ import (
 	unsafe "unsafe"
 
 	alicloud "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -35,6 +36,46 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*BackupBucketConfig)(nil), (*alicloud.BackupBucketConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BackupBucketConfig_To_alicloud_BackupBucketConfig(a.(*BackupBucketConfig), b.(*alicloud.BackupBucketConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.BackupBucketConfig)(nil), (*BackupBucketConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(a.(*alicloud.BackupBucketConfig), b.(*BackupBucketConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BackupBucketStatus)(nil), (*alicloud.BackupBucketStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BackupBucketStatus_To_alicloud_BackupBucketStatus(a.(*BackupBucketStatus), b.(*alicloud.BackupBucketStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.BackupBucketStatus)(nil), (*BackupBucketStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_BackupBucketStatus_To_v1alpha1_BackupBucketStatus(a.(*alicloud.BackupBucketStatus), b.(*BackupBucketStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BucketEncryption)(nil), (*alicloud.BucketEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BucketEncryption_To_alicloud_BucketEncryption(a.(*BucketEncryption), b.(*alicloud.BucketEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.BucketEncryption)(nil), (*BucketEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_BucketEncryption_To_v1alpha1_BucketEncryption(a.(*alicloud.BucketEncryption), b.(*BucketEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BucketLifecycle)(nil), (*alicloud.BucketLifecycle)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BucketLifecycle_To_alicloud_BucketLifecycle(a.(*BucketLifecycle), b.(*alicloud.BucketLifecycle), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.BucketLifecycle)(nil), (*BucketLifecycle)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_BucketLifecycle_To_v1alpha1_BucketLifecycle(a.(*alicloud.BucketLifecycle), b.(*BucketLifecycle), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudControllerManagerConfig)(nil), (*alicloud.CloudControllerManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CloudControllerManagerConfig_To_alicloud_CloudControllerManagerConfig(a.(*CloudControllerManagerConfig), b.(*alicloud.CloudControllerManagerConfig), scope)
 	}); err != nil {
@@ -85,6 +126,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MachineControllerManagerSettings)(nil), (*alicloud.MachineControllerManagerSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineControllerManagerSettings_To_alicloud_MachineControllerManagerSettings(a.(*MachineControllerManagerSettings), b.(*alicloud.MachineControllerManagerSettings), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.MachineControllerManagerSettings)(nil), (*MachineControllerManagerSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_MachineControllerManagerSettings_To_v1alpha1_MachineControllerManagerSettings(a.(*alicloud.MachineControllerManagerSettings), b.(*MachineControllerManagerSettings), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MachineImage)(nil), (*alicloud.MachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_MachineImage_To_alicloud_MachineImage(a.(*MachineImage), b.(*alicloud.MachineImage), scope)
 	}); err != nil {
@@ -165,6 +216,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WorkerConfig)(nil), (*alicloud.WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerConfig_To_alicloud_WorkerConfig(a.(*WorkerConfig), b.(*alicloud.WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.WorkerConfig)(nil), (*WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_WorkerConfig_To_v1alpha1_WorkerConfig(a.(*alicloud.WorkerConfig), b.(*WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*WorkerStatus)(nil), (*alicloud.WorkerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_WorkerStatus_To_alicloud_WorkerStatus(a.(*WorkerStatus), b.(*alicloud.WorkerStatus), scope)
 	}); err != nil {
@@ -188,6 +249,98 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1alpha1_BackupBucketConfig_To_alicloud_BackupBucketConfig(in *BackupBucketConfig, out *alicloud.BackupBucketConfig, s conversion.Scope) error {
+	out.Encryption = (*alicloud.BucketEncryption)(unsafe.Pointer(in.Encryption))
+	out.Versioning = in.Versioning
+	out.BlockPublicAccess = in.BlockPublicAccess
+	out.Lifecycle = (*alicloud.BucketLifecycle)(unsafe.Pointer(in.Lifecycle))
+	return nil
+}
+
+// Convert_v1alpha1_BackupBucketConfig_To_alicloud_BackupBucketConfig is an autogenerated conversion function.
+func Convert_v1alpha1_BackupBucketConfig_To_alicloud_BackupBucketConfig(in *BackupBucketConfig, out *alicloud.BackupBucketConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BackupBucketConfig_To_alicloud_BackupBucketConfig(in, out, s)
+}
+
+func autoConvert_alicloud_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *alicloud.BackupBucketConfig, out *BackupBucketConfig, s conversion.Scope) error {
+	out.Encryption = (*BucketEncryption)(unsafe.Pointer(in.Encryption))
+	out.Versioning = in.Versioning
+	out.BlockPublicAccess = in.BlockPublicAccess
+	out.Lifecycle = (*BucketLifecycle)(unsafe.Pointer(in.Lifecycle))
+	return nil
+}
+
+// Convert_alicloud_BackupBucketConfig_To_v1alpha1_BackupBucketConfig is an autogenerated conversion function.
+func Convert_alicloud_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *alicloud.BackupBucketConfig, out *BackupBucketConfig, s conversion.Scope) error {
+	return autoConvert_alicloud_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_BackupBucketStatus_To_alicloud_BackupBucketStatus(in *BackupBucketStatus, out *alicloud.BackupBucketStatus, s conversion.Scope) error {
+	out.Encryption = (*alicloud.BucketEncryption)(unsafe.Pointer(in.Encryption))
+	out.Versioning = in.Versioning
+	out.PublicAccessBlocked = in.PublicAccessBlocked
+	out.Lifecycle = (*alicloud.BucketLifecycle)(unsafe.Pointer(in.Lifecycle))
+	return nil
+}
+
+// Convert_v1alpha1_BackupBucketStatus_To_alicloud_BackupBucketStatus is an autogenerated conversion function.
+func Convert_v1alpha1_BackupBucketStatus_To_alicloud_BackupBucketStatus(in *BackupBucketStatus, out *alicloud.BackupBucketStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BackupBucketStatus_To_alicloud_BackupBucketStatus(in, out, s)
+}
+
+func autoConvert_alicloud_BackupBucketStatus_To_v1alpha1_BackupBucketStatus(in *alicloud.BackupBucketStatus, out *BackupBucketStatus, s conversion.Scope) error {
+	out.Encryption = (*BucketEncryption)(unsafe.Pointer(in.Encryption))
+	out.Versioning = in.Versioning
+	out.PublicAccessBlocked = in.PublicAccessBlocked
+	out.Lifecycle = (*BucketLifecycle)(unsafe.Pointer(in.Lifecycle))
+	return nil
+}
+
+// Convert_alicloud_BackupBucketStatus_To_v1alpha1_BackupBucketStatus is an autogenerated conversion function.
+func Convert_alicloud_BackupBucketStatus_To_v1alpha1_BackupBucketStatus(in *alicloud.BackupBucketStatus, out *BackupBucketStatus, s conversion.Scope) error {
+	return autoConvert_alicloud_BackupBucketStatus_To_v1alpha1_BackupBucketStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_BucketEncryption_To_alicloud_BucketEncryption(in *BucketEncryption, out *alicloud.BucketEncryption, s conversion.Scope) error {
+	out.KMSKeyID = (*string)(unsafe.Pointer(in.KMSKeyID))
+	return nil
+}
+
+// Convert_v1alpha1_BucketEncryption_To_alicloud_BucketEncryption is an autogenerated conversion function.
+func Convert_v1alpha1_BucketEncryption_To_alicloud_BucketEncryption(in *BucketEncryption, out *alicloud.BucketEncryption, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BucketEncryption_To_alicloud_BucketEncryption(in, out, s)
+}
+
+func autoConvert_alicloud_BucketEncryption_To_v1alpha1_BucketEncryption(in *alicloud.BucketEncryption, out *BucketEncryption, s conversion.Scope) error {
+	out.KMSKeyID = (*string)(unsafe.Pointer(in.KMSKeyID))
+	return nil
+}
+
+// Convert_alicloud_BucketEncryption_To_v1alpha1_BucketEncryption is an autogenerated conversion function.
+func Convert_alicloud_BucketEncryption_To_v1alpha1_BucketEncryption(in *alicloud.BucketEncryption, out *BucketEncryption, s conversion.Scope) error {
+	return autoConvert_alicloud_BucketEncryption_To_v1alpha1_BucketEncryption(in, out, s)
+}
+
+func autoConvert_v1alpha1_BucketLifecycle_To_alicloud_BucketLifecycle(in *BucketLifecycle, out *alicloud.BucketLifecycle, s conversion.Scope) error {
+	out.ExpirationDays = in.ExpirationDays
+	return nil
+}
+
+// Convert_v1alpha1_BucketLifecycle_To_alicloud_BucketLifecycle is an autogenerated conversion function.
+func Convert_v1alpha1_BucketLifecycle_To_alicloud_BucketLifecycle(in *BucketLifecycle, out *alicloud.BucketLifecycle, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BucketLifecycle_To_alicloud_BucketLifecycle(in, out, s)
+}
+
+func autoConvert_alicloud_BucketLifecycle_To_v1alpha1_BucketLifecycle(in *alicloud.BucketLifecycle, out *BucketLifecycle, s conversion.Scope) error {
+	out.ExpirationDays = in.ExpirationDays
+	return nil
+}
+
+// Convert_alicloud_BucketLifecycle_To_v1alpha1_BucketLifecycle is an autogenerated conversion function.
+func Convert_alicloud_BucketLifecycle_To_v1alpha1_BucketLifecycle(in *alicloud.BucketLifecycle, out *BucketLifecycle, s conversion.Scope) error {
+	return autoConvert_alicloud_BucketLifecycle_To_v1alpha1_BucketLifecycle(in, out, s)
+}
+
 func autoConvert_v1alpha1_CloudControllerManagerConfig_To_alicloud_CloudControllerManagerConfig(in *CloudControllerManagerConfig, out *alicloud.CloudControllerManagerConfig, s conversion.Scope) error {
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
 	return nil
@@ -300,6 +453,28 @@ func Convert_alicloud_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in *
 	return autoConvert_alicloud_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_MachineControllerManagerSettings_To_alicloud_MachineControllerManagerSettings(in *MachineControllerManagerSettings, out *alicloud.MachineControllerManagerSettings, s conversion.Scope) error {
+	out.MachineDrainTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineDrainTimeout))
+	out.MachineCreationTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineCreationTimeout))
+	return nil
+}
+
+// Convert_v1alpha1_MachineControllerManagerSettings_To_alicloud_MachineControllerManagerSettings is an autogenerated conversion function.
+func Convert_v1alpha1_MachineControllerManagerSettings_To_alicloud_MachineControllerManagerSettings(in *MachineControllerManagerSettings, out *alicloud.MachineControllerManagerSettings, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineControllerManagerSettings_To_alicloud_MachineControllerManagerSettings(in, out, s)
+}
+
+func autoConvert_alicloud_MachineControllerManagerSettings_To_v1alpha1_MachineControllerManagerSettings(in *alicloud.MachineControllerManagerSettings, out *MachineControllerManagerSettings, s conversion.Scope) error {
+	out.MachineDrainTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineDrainTimeout))
+	out.MachineCreationTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineCreationTimeout))
+	return nil
+}
+
+// Convert_alicloud_MachineControllerManagerSettings_To_v1alpha1_MachineControllerManagerSettings is an autogenerated conversion function.
+func Convert_alicloud_MachineControllerManagerSettings_To_v1alpha1_MachineControllerManagerSettings(in *alicloud.MachineControllerManagerSettings, out *MachineControllerManagerSettings, s conversion.Scope) error {
+	return autoConvert_alicloud_MachineControllerManagerSettings_To_v1alpha1_MachineControllerManagerSettings(in, out, s)
+}
+
 func autoConvert_v1alpha1_MachineImage_To_alicloud_MachineImage(in *MachineImage, out *alicloud.MachineImage, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Version = in.Version
@@ -486,6 +661,26 @@ func Convert_alicloud_VSwitch_To_v1alpha1_VSwitch(in *alicloud.VSwitch, out *VSw
 	return autoConvert_alicloud_VSwitch_To_v1alpha1_VSwitch(in, out, s)
 }
 
+func autoConvert_v1alpha1_WorkerConfig_To_alicloud_WorkerConfig(in *WorkerConfig, out *alicloud.WorkerConfig, s conversion.Scope) error {
+	out.MachineControllerManagerSettings = (*alicloud.MachineControllerManagerSettings)(unsafe.Pointer(in.MachineControllerManagerSettings))
+	return nil
+}
+
+// Convert_v1alpha1_WorkerConfig_To_alicloud_WorkerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerConfig_To_alicloud_WorkerConfig(in *WorkerConfig, out *alicloud.WorkerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerConfig_To_alicloud_WorkerConfig(in, out, s)
+}
+
+func autoConvert_alicloud_WorkerConfig_To_v1alpha1_WorkerConfig(in *alicloud.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	out.MachineControllerManagerSettings = (*MachineControllerManagerSettings)(unsafe.Pointer(in.MachineControllerManagerSettings))
+	return nil
+}
+
+// Convert_alicloud_WorkerConfig_To_v1alpha1_WorkerConfig is an autogenerated conversion function.
+func Convert_alicloud_WorkerConfig_To_v1alpha1_WorkerConfig(in *alicloud.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	return autoConvert_alicloud_WorkerConfig_To_v1alpha1_WorkerConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_WorkerStatus_To_alicloud_WorkerStatus(in *WorkerStatus, out *alicloud.WorkerStatus, s conversion.Scope) error {
 	out.MachineImages = *(*[]alicloud.MachineImage)(unsafe.Pointer(&in.MachineImages))
 	return nil
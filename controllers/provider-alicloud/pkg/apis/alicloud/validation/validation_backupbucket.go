@@ -0,0 +1,36 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	apisalicloud "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateBackupBucketConfig validates a BackupBucketConfig object.
+func ValidateBackupBucketConfig(config *apisalicloud.BackupBucketConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if encryption := config.Encryption; encryption != nil && encryption.KMSKeyID != nil && len(*encryption.KMSKeyID) == 0 {
+		allErrs = append(allErrs, field.Required(field.NewPath("encryption", "kmsKeyID"), "must not provide an empty kms key id"))
+	}
+
+	if lifecycle := config.Lifecycle; lifecycle != nil && lifecycle.ExpirationDays <= 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("lifecycle", "expirationDays"), lifecycle.ExpirationDays, "expiration days must be positive"))
+	}
+
+	return allErrs
+}
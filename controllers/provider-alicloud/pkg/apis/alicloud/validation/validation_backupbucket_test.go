@@ -0,0 +1,71 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisalicloud "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud"
+	. "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("BackupBucketConfig validation", func() {
+	Describe("#ValidateBackupBucketConfig", func() {
+		var config *apisalicloud.BackupBucketConfig
+
+		BeforeEach(func() {
+			config = &apisalicloud.BackupBucketConfig{
+				Encryption: &apisalicloud.BucketEncryption{
+					KMSKeyID: pointer.StringPtr("0e478b7a-4262-4802-b8cb-00d3fb40826e"),
+				},
+				Versioning:        true,
+				BlockPublicAccess: true,
+				Lifecycle: &apisalicloud.BucketLifecycle{
+					ExpirationDays: 30,
+				},
+			}
+		})
+
+		It("should allow a valid configuration", func() {
+			Expect(ValidateBackupBucketConfig(config)).To(BeEmpty())
+		})
+
+		It("should allow encryption with OSS-managed keys", func() {
+			config.Encryption.KMSKeyID = nil
+
+			Expect(ValidateBackupBucketConfig(config)).To(BeEmpty())
+		})
+
+		It("should forbid an empty kms key id and non-positive expiration days", func() {
+			config.Encryption.KMSKeyID = pointer.StringPtr("")
+			config.Lifecycle.ExpirationDays = 0
+
+			Expect(ValidateBackupBucketConfig(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("encryption.kmsKeyID"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("lifecycle.expirationDays"),
+				})),
+			))
+		})
+	})
+})
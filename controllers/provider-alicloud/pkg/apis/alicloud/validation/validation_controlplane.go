@@ -0,0 +1,43 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	apisalicloud "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateControlPlaneConfig validates a ControlPlaneConfig object.
+func ValidateControlPlaneConfig(controlPlaneConfig *apisalicloud.ControlPlaneConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(controlPlaneConfig.Zone) == 0 {
+		allErrs = append(allErrs, field.Required(field.NewPath("zone"), "must provide the name of a zone"))
+	}
+
+	return allErrs
+}
+
+// ValidateControlPlaneConfigUpdate validates a ControlPlaneConfig object before an update. The zone cannot be changed.
+func ValidateControlPlaneConfigUpdate(oldConfig, newConfig *apisalicloud.ControlPlaneConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if newConfig.Zone != oldConfig.Zone {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("zone"), newConfig.Zone, "field is immutable"))
+	}
+
+	return allErrs
+}
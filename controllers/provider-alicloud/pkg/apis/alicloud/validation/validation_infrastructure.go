@@ -0,0 +1,119 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	apisalicloud "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud"
+	cidrvalidation "github.com/gardener/gardener-extensions/pkg/util/validation/cidr"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateInfrastructureConfig validates a InfrastructureConfig object. The worker subnets are validated against the node,
+// pod and service CIDRs of the shoot, empty or invalid CIDRs of the shoot are ignored.
+func ValidateInfrastructureConfig(infra *apisalicloud.InfrastructureConfig, nodesCIDR, podsCIDR, servicesCIDR string) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var (
+		networksPath = field.NewPath("networks")
+		vpcPath      = networksPath.Child("vpc")
+		zonesPath    = networksPath.Child("zones")
+
+		nodes    = cidrvalidation.NewCIDR(nodesCIDR, field.NewPath("nodes"))
+		pods     = cidrvalidation.NewCIDR(podsCIDR, field.NewPath("pods"))
+		services = cidrvalidation.NewCIDR(servicesCIDR, field.NewPath("services"))
+		vpc      *cidrvalidation.CIDR
+	)
+
+	switch {
+	case infra.Networks.VPC.ID == nil && infra.Networks.VPC.CIDR == nil:
+		allErrs = append(allErrs, field.Required(vpcPath, "must provide either the id of an existing VPC or the cidr of a VPC to create"))
+	case infra.Networks.VPC.ID != nil && infra.Networks.VPC.CIDR != nil:
+		allErrs = append(allErrs, field.Forbidden(vpcPath, "must not provide both the id of an existing VPC and the cidr of a VPC to create"))
+	case infra.Networks.VPC.ID != nil && len(*infra.Networks.VPC.ID) == 0:
+		allErrs = append(allErrs, field.Required(vpcPath.Child("id"), "must provide the id of an existing VPC"))
+	case infra.Networks.VPC.CIDR != nil:
+		vpc = cidrvalidation.NewCIDR(*infra.Networks.VPC.CIDR, vpcPath.Child("cidr"))
+		allErrs = append(allErrs, vpc.ValidateParse()...)
+	}
+
+	if len(infra.Networks.Zones) == 0 {
+		allErrs = append(allErrs, field.Required(zonesPath, "must provide at least one zone"))
+	}
+
+	var (
+		names   = sets.NewString()
+		workers []*cidrvalidation.CIDR
+	)
+	for i, zone := range infra.Networks.Zones {
+		idxPath := zonesPath.Index(i)
+
+		if len(zone.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide the name of the zone"))
+		} else if names.Has(zone.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), zone.Name))
+		}
+		names.Insert(zone.Name)
+
+		worker := cidrvalidation.NewCIDR(zone.Worker, idxPath.Child("worker"))
+		allErrs = append(allErrs, worker.ValidateParse()...)
+
+		workers = append(workers, worker)
+	}
+
+	allErrs = append(allErrs, cidrvalidation.ValidateNoOverlaps(workers...)...)
+	if vpc != nil {
+		allErrs = append(allErrs, vpc.ValidateSubset(workers...)...)
+	}
+	allErrs = append(allErrs, nodes.ValidateSubset(workers...)...)
+	allErrs = append(allErrs, pods.ValidateNotOverlap(workers...)...)
+	allErrs = append(allErrs, services.ValidateNotOverlap(workers...)...)
+
+	return allErrs
+}
+
+// ValidateInfrastructureConfigUpdate validates a InfrastructureConfig object before an update. The VPC and the
+// existing zones cannot be changed, but new zones can be added.
+func ValidateInfrastructureConfigUpdate(oldConfig, newConfig *apisalicloud.InfrastructureConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var (
+		networksPath = field.NewPath("networks")
+		zonesPath    = networksPath.Child("zones")
+	)
+
+	if !apiequality.Semantic.DeepEqual(newConfig.Networks.VPC, oldConfig.Networks.VPC) {
+		allErrs = append(allErrs, field.Invalid(networksPath.Child("vpc"), newConfig.Networks.VPC, "field is immutable"))
+	}
+
+	newZones := make(map[string]apisalicloud.Zone, len(newConfig.Networks.Zones))
+	for _, zone := range newConfig.Networks.Zones {
+		newZones[zone.Name] = zone
+	}
+	for i, oldZone := range oldConfig.Networks.Zones {
+		newZone, ok := newZones[oldZone.Name]
+		if !ok {
+			allErrs = append(allErrs, field.Forbidden(zonesPath, "must not remove zone "+oldZone.Name))
+			continue
+		}
+		if !apiequality.Semantic.DeepEqual(newZone, oldZone) {
+			allErrs = append(allErrs, field.Invalid(zonesPath.Index(i), newZone, "must not change an existing zone"))
+		}
+	}
+
+	return allErrs
+}
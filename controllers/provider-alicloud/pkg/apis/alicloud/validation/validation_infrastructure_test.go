@@ -0,0 +1,139 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisalicloud "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud"
+	. "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("InfrastructureConfig validation", func() {
+	var (
+		infrastructureConfig *apisalicloud.InfrastructureConfig
+
+		nodes    = "10.250.0.0/16"
+		pods     = "100.96.0.0/11"
+		services = "100.64.0.0/13"
+	)
+
+	BeforeEach(func() {
+		infrastructureConfig = &apisalicloud.InfrastructureConfig{
+			Networks: apisalicloud.Networks{
+				VPC: apisalicloud.VPC{
+					CIDR: pointer.StringPtr("10.250.0.0/16"),
+				},
+				Zones: []apisalicloud.Zone{
+					{
+						Name:   "cn-beijing-f",
+						Worker: "10.250.0.0/19",
+					},
+					{
+						Name:   "cn-beijing-g",
+						Worker: "10.250.32.0/19",
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#ValidateInfrastructureConfig", func() {
+		It("should accept a valid configuration", func() {
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, pods, services)).To(BeEmpty())
+		})
+
+		It("should forbid providing both an existing VPC and a VPC CIDR", func() {
+			infrastructureConfig.Networks.VPC.ID = pointer.StringPtr("vpc-123456")
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, pods, services)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("networks.vpc"),
+			}))))
+		})
+
+		It("should forbid duplicate zones", func() {
+			infrastructureConfig.Networks.Zones[1].Name = infrastructureConfig.Networks.Zones[0].Name
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, pods, services)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("networks.zones[1].name"),
+			}))))
+		})
+
+		It("should forbid overlapping worker subnets", func() {
+			infrastructureConfig.Networks.Zones[1].Worker = "10.250.16.0/20"
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, pods, services)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.zones[1].worker"),
+			}))))
+		})
+
+		It("should forbid worker subnets outside of the VPC and the node network", func() {
+			infrastructureConfig.Networks.Zones[1].Worker = "10.251.0.0/19"
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, pods, services)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.zones[1].worker"),
+					"Detail": ContainSubstring("networks.vpc.cidr"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.zones[1].worker"),
+					"Detail": ContainSubstring("nodes"),
+				})),
+			))
+		})
+
+		It("should forbid worker subnets overlapping with the service network", func() {
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, pods, "10.250.0.0/24")).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("networks.zones[0].worker"),
+				"Detail": ContainSubstring("services"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateInfrastructureConfigUpdate", func() {
+		It("should allow adding zones", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Zones = append(newInfrastructureConfig.Networks.Zones, apisalicloud.Zone{
+				Name:   "cn-beijing-h",
+				Worker: "10.250.64.0/19",
+			})
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)).To(BeEmpty())
+		})
+
+		It("should forbid changing the VPC and changing or removing existing zones", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.VPC.CIDR = pointer.StringPtr("10.0.0.0/8")
+			newInfrastructureConfig.Networks.Zones = newInfrastructureConfig.Networks.Zones[:1]
+			newInfrastructureConfig.Networks.Zones[0].Worker = "10.250.128.0/19"
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("networks.vpc")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("networks.zones[0]")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("networks.zones")})),
+			))
+		})
+	})
+})
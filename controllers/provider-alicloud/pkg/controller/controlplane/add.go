@@ -20,6 +20,7 @@ import (
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/controller/controlplane"
 	"github.com/gardener/gardener-extensions/pkg/controller/controlplane/genericactuator"
+	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
 	"github.com/gardener/gardener-extensions/pkg/util"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 
@@ -51,6 +52,7 @@ func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) error {
 			imagevector.ImageVector(), alicloud.CloudProviderConfigName, nil, mgr.GetWebhookServer().Port, logger),
 		ControllerOptions: opts.Controller,
 		Predicates:        controlplane.DefaultPredicates(alicloud.Type, opts.IgnoreOperationAnnotation),
+		ErrorMatchers:     []controllererrors.Matcher{controllererrors.AlicloudMatcher},
 	})
 }
 
@@ -266,13 +266,32 @@ func computeProviderStatusVSwitches(infrastructure *alicloudv1alpha1.Infrastruct
 	return vswitchesToReturn, nil
 }
 
+// errorMatchers classify the errors of the Alicloud API and of the Terraform jobs.
+var errorMatchers = []controllererrors.Matcher{
+	controllererrors.TerraformMatcher(controllererrors.AlicloudMatcher),
+	controllererrors.AlicloudMatcher,
+}
+
 // Reconcile implements infrastructure.Actuator.
 func (a *actuator) Reconcile(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *extensioncontroller.Cluster) error {
+	return controllererrors.Classify(a.reconcile(ctx, infra, cluster), errorMatchers...)
+}
+
+// Delete implements infrastructure.Actuator.
+func (a *actuator) Delete(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *extensioncontroller.Cluster) error {
+	return controllererrors.Classify(a.delete(ctx, infra, cluster), errorMatchers...)
+}
+
+func (a *actuator) reconcile(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *extensioncontroller.Cluster) error {
 	config, credentials, err := a.getConfigAndCredentialsForInfra(ctx, infra)
 	if err != nil {
 		return err
 	}
 
+	if err := extensionsterraformer.RestoreInfrastructureState(ctx, a.client, infra, TerraformerPurpose); err != nil {
+		return err
+	}
+
 	tf, err := a.newTerraformer(infra, credentials)
 	if err != nil {
 		return err
@@ -288,7 +307,13 @@ func (a *actuator) Reconcile(ctx context.Context, infra *extensionsv1alpha1.Infr
 		return err
 	}
 
-	if err := tf.InitializeWith(initializer).Apply(); err != nil {
+	tf = tf.InitializeWith(initializer)
+
+	if extensionsterraformer.IsPlanRequested(infra) {
+		return extensionsterraformer.PlanInfrastructure(ctx, a.client, tf, infra)
+	}
+
+	if err := tf.Apply(); err != nil {
 		a.logger.Error(err, "failed to apply the terraform config", "infrastructure", infra.Name)
 		return &controllererrors.RequeueAfterError{
 			Cause:        err,
@@ -296,6 +321,11 @@ func (a *actuator) Reconcile(ctx context.Context, infra *extensionsv1alpha1.Infr
 		}
 	}
 
+	state, err := extensionsterraformer.ExportState(tf)
+	if err != nil {
+		return err
+	}
+
 	status, err := a.extractStatus(tf, config)
 	if err != nil {
 		return err
@@ -303,17 +333,24 @@ func (a *actuator) Reconcile(ctx context.Context, infra *extensionsv1alpha1.Infr
 
 	return extensioncontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, a.client, infra, func() error {
 		infra.Status.ProviderStatus = &runtime.RawExtension{Object: status}
+		if len(state) > 0 {
+			infra.Status.State = state
+		}
+		infra.Status.Conditions = extensionsterraformer.RemovePlanCondition(infra.Status.Conditions)
 		return nil
 	})
 }
 
-// Delete implements infrastructure.Actuator.
-func (a *actuator) Delete(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *extensioncontroller.Cluster) error {
-	_, credentials, err := a.getConfigAndCredentialsForInfra(ctx, infra)
+func (a *actuator) delete(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *extensioncontroller.Cluster) error {
+	config, credentials, err := a.getConfigAndCredentialsForInfra(ctx, infra)
 	if err != nil {
 		return err
 	}
 
+	if err := extensionsterraformer.RestoreInfrastructureState(ctx, a.client, infra, TerraformerPurpose); err != nil {
+		return err
+	}
+
 	tf, err := a.newTerraformer(infra, credentials)
 	if err != nil {
 		return err
@@ -324,8 +361,47 @@ func (a *actuator) Delete(ctx context.Context, infra *extensionsv1alpha1.Infrast
 		return err
 	}
 	if !configExists {
-		return nil
+		if len(infra.Status.State) == 0 {
+			return nil
+		}
+
+		// The Terraform configuration is missing but the state has been restored from the status, e.g. after the
+		// seed namespace has been recreated. It has to be rendered again so that the infrastructure can be destroyed.
+		initializerValues, err := a.getInitializerValues(tf, infra, config, credentials)
+		if err != nil {
+			return err
+		}
+
+		initializer, err := a.newInitializer(infra, config, initializerValues)
+		if err != nil {
+			return err
+		}
+		tf = tf.InitializeWith(initializer)
 	}
 
 	return tf.Destroy()
 }
+
+// Migrate implements infrastructure.Actuator.
+func (a *actuator) Migrate(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *extensioncontroller.Cluster) error {
+	_, credentials, err := a.getConfigAndCredentialsForInfra(ctx, infra)
+	if err != nil {
+		return err
+	}
+
+	tf, err := a.newTerraformer(infra, credentials)
+	if err != nil {
+		return err
+	}
+
+	return extensionsterraformer.MigrateInfrastructureState(ctx, a.client, tf, infra)
+}
+
+// Restore implements infrastructure.Actuator.
+func (a *actuator) Restore(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *extensioncontroller.Cluster) error {
+	if err := extensionsterraformer.RestoreState(ctx, a.client, infra.Namespace, infra.Name, TerraformerPurpose, infra.Status.State); err != nil {
+		return err
+	}
+
+	return a.Reconcile(ctx, infra, cluster)
+}
@@ -29,6 +29,9 @@ import (
 	mockalicloudclient "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/mock/provider-alicloud/alicloud/client"
 	mockinfrastructure "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/mock/provider-alicloud/controller/infrastructure"
 	"github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
+	faketerraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer/fake"
 	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
 	mockchartrenderer "github.com/gardener/gardener-extensions/pkg/mock/gardener-extensions/gardener/chartrenderer"
 	mockterraformer "github.com/gardener/gardener-extensions/pkg/mock/gardener-extensions/gardener/terraformer"
@@ -147,6 +150,7 @@ var _ = Describe("Actuator", func() {
 					natGatewayID    = "natGatewayID"
 					securityGroupID = "sgID"
 					keyPairName     = "keyPairName"
+					state           = `{"version":3}`
 				)
 
 				describeNATGatewaysReq := vpc.CreateDescribeNatGatewaysRequest()
@@ -215,6 +219,8 @@ var _ = Describe("Actuator", func() {
 
 					terraformer.EXPECT().Apply(),
 
+					terraformer.EXPECT().GetState().Return([]byte(state), nil),
+
 					terraformer.EXPECT().GetStateOutputVariables(TerraformerOutputKeyVPCID, TerraformerOutputKeyVPCCIDR, TerraformerOutputKeySecurityGroupID, TerraformerOutputKeyKeyPairName).
 						Return(map[string]string{
 							TerraformerOutputKeyVPCID:           vpcID,
@@ -247,6 +253,195 @@ var _ = Describe("Actuator", func() {
 					},
 					KeyPairName: keyPairName,
 				}))
+
+				decompressed, err := extensionsterraformer.DecompressState(infra.Status.State)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(decompressed)).To(Equal(state))
+			})
+		})
+	})
+
+	Context("Actuator with fake Terraformer", func() {
+		var (
+			ctx                   context.Context
+			logger                *logr.MockLogger
+			alicloudClientFactory *mockalicloudclient.MockFactory
+			vpcClient             *mockalicloudclient.MockVPC
+			terraformerFactory    *faketerraformer.Factory
+			chartRendererFactory  *mockchartrenderer.MockFactory
+			chartRenderer         *mockgardenerchartrenderer.MockInterface
+			terraformChartOps     *mockinfrastructure.MockTerraformChartOps
+			c                     *mockclient.MockClient
+			restConfig            rest.Config
+
+			config          alicloudv1alpha1.InfrastructureConfig
+			infra           extensionsv1alpha1.Infrastructure
+			cluster         controller.Cluster
+			tf              *faketerraformer.Terraformer
+			accessKeyID     = "accessKeyID"
+			accessKeySecret = "accessKeySecret"
+			region          = "region"
+			secretRef       = corev1.SecretReference{Namespace: "secretns", Name: "secret"}
+
+			initializerValues = InitializerValues{}
+			chartValues       = map[string]interface{}{}
+			renderedChart     = &chartrenderer.RenderedChart{
+				Manifests: []manifest.Manifest{
+					mkManifest(chart.TerraformMainTFFilename, "main"),
+					mkManifest(chart.TerraformVariablesTFFilename, "variables"),
+					mkManifest(chart.TerraformTFVarsFilename, "tfVars"),
+				},
+			}
+		)
+
+		BeforeEach(func() {
+			ctx = context.TODO()
+			logger = logr.NewMockLogger(ctrl)
+			alicloudClientFactory = mockalicloudclient.NewMockFactory(ctrl)
+			vpcClient = mockalicloudclient.NewMockVPC(ctrl)
+			terraformerFactory = faketerraformer.NewFactory()
+			chartRendererFactory = mockchartrenderer.NewMockFactory(ctrl)
+			chartRenderer = mockgardenerchartrenderer.NewMockInterface(ctrl)
+			terraformChartOps = mockinfrastructure.NewMockTerraformChartOps(ctrl)
+			c = mockclient.NewMockClient(ctrl)
+
+			cidr := "192.168.0.0/16"
+			config = alicloudv1alpha1.InfrastructureConfig{
+				Networks: alicloudv1alpha1.Networks{
+					VPC: alicloudv1alpha1.VPC{
+						CIDR: &cidr,
+					},
+				},
+			}
+			infra = extensionsv1alpha1.Infrastructure{
+				Spec: extensionsv1alpha1.InfrastructureSpec{
+					ProviderConfig: &runtime.RawExtension{
+						Raw: ExpectEncode(runtime.Encode(serializer, &config)),
+					},
+					Region:    region,
+					SecretRef: secretRef,
+				},
+			}
+			cluster = controller.Cluster{}
+			tf = terraformerFactory.Terraformer(TerraformerPurpose, infra.Namespace, infra.Name)
+		})
+
+		expectGetSecret := func() *gomock.Call {
+			return c.EXPECT().Get(ctx, client.ObjectKey{Namespace: secretRef.Namespace, Name: secretRef.Name}, gomock.AssignableToTypeOf(&corev1.Secret{})).
+				SetArg(2, corev1.Secret{
+					Data: map[string][]byte{
+						alicloud.AccessKeyID:     []byte(accessKeyID),
+						alicloud.AccessKeySecret: []byte(accessKeySecret),
+					},
+				})
+		}
+
+		newActuator := func() infrastructure.Actuator {
+			actuator := NewActuatorWithDeps(logger, alicloudClientFactory, terraformerFactory, chartRendererFactory, terraformChartOps)
+			chartRendererFactory.EXPECT().NewForConfig(&restConfig).Return(chartRenderer, nil)
+			ExpectInject(inject.ClientInto(c, actuator))
+			ExpectInject(inject.SchemeInto(scheme, actuator))
+			ExpectInject(inject.ConfigInto(&restConfig, actuator))
+			return actuator
+		}
+
+		Describe("#Reconcile", func() {
+			It("should create the infrastructure and report the Terraform outputs", func() {
+				var (
+					actuator = newActuator()
+					oldState = `{"version":3}`
+				)
+
+				tf.State = []byte(oldState)
+				tf.StateOutputVariablesAfterApply = map[string]string{
+					TerraformerOutputKeyVPCID:           "vpcID",
+					TerraformerOutputKeyVPCCIDR:         "vpcCIDR",
+					TerraformerOutputKeySecurityGroupID: "sgID",
+					TerraformerOutputKeyKeyPairName:     "keyPairName",
+				}
+
+				gomock.InOrder(
+					expectGetSecret(),
+					alicloudClientFactory.EXPECT().NewVPC(region, accessKeyID, accessKeySecret).Return(vpcClient, nil),
+					terraformChartOps.EXPECT().ComputeCreateVPCInitializerValues(&config, alicloudclient.DefaultInternetChargeType).Return(&initializerValues),
+					terraformChartOps.EXPECT().ComputeChartValues(&infra, &config, &initializerValues).Return(chartValues),
+					chartRenderer.EXPECT().Render(alicloud.InfraChartPath, alicloud.InfraRelease, infra.Namespace, chartValues).Return(renderedChart, nil),
+					c.EXPECT().Status().Return(c),
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: infra.Namespace, Name: infra.Name}, &infra),
+					c.EXPECT().Update(ctx, &infra),
+				)
+
+				Expect(actuator.Reconcile(ctx, &infra, &cluster)).To(Succeed())
+
+				Expect(tf.Image).To(Equal(imagevector.TerraformerImage()))
+				Expect(tf.Applications).To(ConsistOf(faketerraformer.Application{
+					Files: chart.TerraformFiles{
+						Main:      "main",
+						Variables: "variables",
+						TFVars:    []byte("tfVars"),
+					},
+					VariablesEnvironment: map[string]string{
+						common.TerraformVarAccessKeyID:     accessKeyID,
+						common.TerraformVarAccessKeySecret: accessKeySecret,
+					},
+				}))
+				Expect(infra.Status.ProviderStatus.Object).To(Equal(&alicloudv1alpha1.InfrastructureStatus{
+					TypeMeta: StatusTypeMeta,
+					VPC: alicloudv1alpha1.VPCStatus{
+						ID: "vpcID",
+						SecurityGroups: []alicloudv1alpha1.SecurityGroup{
+							{
+								Purpose: alicloudv1alpha1.PurposeNodes,
+								ID:      "sgID",
+							},
+						},
+					},
+					KeyPairName: "keyPairName",
+				}))
+
+				decompressed, err := extensionsterraformer.DecompressState(infra.Status.State)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decompressed).To(Equal(tf.State))
+				Expect(string(decompressed)).To(ContainSubstring(`"vpc_id":{"type":"string","value":"vpcID"}`))
+			})
+
+			It("should requeue if the Terraform configuration cannot be applied", func() {
+				actuator := newActuator()
+				tf.ApplyErr = fmt.Errorf("apply failed")
+
+				gomock.InOrder(
+					expectGetSecret(),
+					alicloudClientFactory.EXPECT().NewVPC(region, accessKeyID, accessKeySecret).Return(vpcClient, nil),
+					terraformChartOps.EXPECT().ComputeCreateVPCInitializerValues(&config, alicloudclient.DefaultInternetChargeType).Return(&initializerValues),
+					terraformChartOps.EXPECT().ComputeChartValues(&infra, &config, &initializerValues).Return(chartValues),
+					chartRenderer.EXPECT().Render(alicloud.InfraChartPath, alicloud.InfraRelease, infra.Namespace, chartValues).Return(renderedChart, nil),
+				)
+				logger.EXPECT().Error(tf.ApplyErr, gomock.Any(), gomock.Any())
+
+				Expect(actuator.Reconcile(ctx, &infra, &cluster)).To(MatchError(ContainSubstring("apply failed")))
+				Expect(tf.Applications).To(BeEmpty())
+			})
+		})
+
+		Describe("#Delete", func() {
+			It("should destroy the existing infrastructure", func() {
+				actuator := newActuator()
+				tf.Configuration = &chart.TerraformFiles{Main: "main", Variables: "variables", TFVars: []byte("tfVars")}
+
+				expectGetSecret()
+
+				Expect(actuator.Delete(ctx, &infra, &cluster)).To(Succeed())
+				Expect(tf.Destroyed).To(BeTrue())
+				Expect(tf.CleanedUp).To(BeTrue())
+			})
+
+			It("should do nothing if neither configuration nor state exist", func() {
+				actuator := newActuator()
+
+				expectGetSecret()
+
+				Expect(actuator.Delete(ctx, &infra, &cluster)).To(Succeed())
+				Expect(tf.Destroyed).To(BeFalse())
 			})
 		})
 	})
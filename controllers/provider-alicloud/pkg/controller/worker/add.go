@@ -17,6 +17,7 @@ package worker
 import (
 	"github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/alicloud"
 	"github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/config"
+	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
 	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 	machinescheme "github.com/gardener/machine-controller-manager/pkg/client/clientset/versioned/scheme"
 	apiextensionsscheme "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/scheme"
@@ -54,6 +55,7 @@ func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) error {
 		Actuator:          NewActuator(opts.MachineImages),
 		ControllerOptions: opts.Controller,
 		Predicates:        worker.DefaultPredicates(alicloud.Type, opts.IgnoreOperationAnnotation),
+		ErrorMatchers:     []controllererrors.Matcher{controllererrors.AlicloudMatcher},
 	})
 }
 
@@ -23,11 +23,14 @@ import (
 	alicloudapi "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud"
 	apisalicloud "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud"
 	alicloudapihelper "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud/helper"
+	alicloudvalidation "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud/validation"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -101,6 +104,11 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 	}
 
 	for _, pool := range w.worker.Spec.Pools {
+		workerConfig, err := w.decodeWorkerConfig(pool)
+		if err != nil {
+			return err
+		}
+
 		zoneLen := len(pool.Zones)
 
 		machineImageID, err := w.findMachineImageForRegion(pool.MachineImage.Name, pool.MachineImage.Version, w.worker.Spec.Region)
@@ -167,6 +175,8 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 				Labels:         pool.Labels,
 				Annotations:    pool.Annotations,
 				Taints:         pool.Taints,
+
+				MachineConfiguration: computeMachineConfiguration(workerConfig.MachineControllerManagerSettings),
 			})
 
 			machineClassSpec["name"] = className
@@ -183,3 +193,30 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 
 	return nil
 }
+
+func (w *workerDelegate) decodeWorkerConfig(pool extensionsv1alpha1.WorkerPool) (*apisalicloud.WorkerConfig, error) {
+	workerConfig := &apisalicloud.WorkerConfig{}
+	if pool.ProviderConfig == nil || pool.ProviderConfig.Raw == nil {
+		return workerConfig, nil
+	}
+
+	if _, _, err := w.decoder.Decode(pool.ProviderConfig.Raw, nil, workerConfig); err != nil {
+		return nil, errors.Wrapf(err, "could not decode provider config of worker pool %q", pool.Name)
+	}
+	if errs := alicloudvalidation.ValidateWorkerConfig(workerConfig); len(errs) > 0 {
+		return nil, errors.Wrapf(errs.ToAggregate(), "invalid provider config of worker pool %q", pool.Name)
+	}
+	return workerConfig, nil
+}
+
+// computeMachineConfiguration computes the timeouts for the rollout of the machines out of the given settings.
+func computeMachineConfiguration(settings *apisalicloud.MachineControllerManagerSettings) *worker.MachineConfiguration {
+	if settings == nil {
+		return nil
+	}
+
+	return &worker.MachineConfiguration{
+		DrainTimeout:    settings.MachineDrainTimeout,
+		CreationTimeout: settings.MachineCreationTimeout,
+	}
+}
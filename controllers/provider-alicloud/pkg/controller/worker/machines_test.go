@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"time"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/alicloud"
 	apisalicloud "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud"
@@ -424,6 +425,52 @@ var _ = Describe("Machines", func() {
 				Expect(result).To(Equal(machineDeployments))
 			})
 
+			It("should set the machine configuration of the pools with machine controller manager settings", func() {
+				expectGetSecretCallToWork(c, alicloudAccessKeyID, alicloudAccessKeySecret)
+
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&alicloudv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: alicloudv1alpha1.SchemeGroupVersion.String(),
+							Kind:       "WorkerConfig",
+						},
+						MachineControllerManagerSettings: &alicloudv1alpha1.MachineControllerManagerSettings{
+							MachineDrainTimeout: &metav1.Duration{Duration: time.Hour},
+						},
+					}),
+				}
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImages, chartApplier, "", w, cluster)
+
+				machineDeployments, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(machineDeployments).To(HaveLen(4))
+				Expect(machineDeployments[0].MachineConfiguration).To(Equal(&worker.MachineConfiguration{
+					DrainTimeout: &metav1.Duration{Duration: time.Hour},
+				}))
+				Expect(machineDeployments[2].MachineConfiguration).To(BeNil())
+			})
+
+			It("should fail because the worker config is invalid", func() {
+				expectGetSecretCallToWork(c, alicloudAccessKeyID, alicloudAccessKeySecret)
+
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&alicloudv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: alicloudv1alpha1.SchemeGroupVersion.String(),
+							Kind:       "WorkerConfig",
+						},
+						MachineControllerManagerSettings: &alicloudv1alpha1.MachineControllerManagerSettings{
+							MachineCreationTimeout: &metav1.Duration{},
+						},
+					}),
+				}
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImages, chartApplier, "", w, cluster)
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).To(HaveOccurred())
+				Expect(result).To(BeNil())
+			})
+
 			It("should fail because the secret cannot be read", func() {
 				c.EXPECT().
 					Get(context.TODO(), gomock.Any(), gomock.AssignableToTypeOf(&corev1.Secret{})).
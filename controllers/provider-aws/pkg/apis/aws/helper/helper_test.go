@@ -85,6 +85,29 @@ var _ = Describe("Helper", func() {
 		Entry("entry not found (no version)", []aws.MachineImage{{Name: "bar", Version: "1.2.3"}}, "foo", "1.2.3", nil, true),
 		Entry("entry exists", []aws.MachineImage{{Name: "bar", Version: "1.2.3"}}, "bar", "1.2.3", &aws.MachineImage{Name: "bar", Version: "1.2.3"}, false),
 	)
+
+	DescribeTable("#IsCSIDriverEnabled",
+		func(config *aws.ControlPlaneConfig, expected bool) {
+			Expect(IsCSIDriverEnabled(config)).To(Equal(expected))
+		},
+
+		Entry("config is nil", nil, false),
+		Entry("storage is nil", &aws.ControlPlaneConfig{}, false),
+		Entry("driver is disabled", &aws.ControlPlaneConfig{Storage: &aws.Storage{CSIMigration: true}}, false),
+		Entry("driver is enabled", &aws.ControlPlaneConfig{Storage: &aws.Storage{CSIDriver: true}}, true),
+	)
+
+	DescribeTable("#IsCSIMigrationEnabled",
+		func(config *aws.ControlPlaneConfig, expected bool) {
+			Expect(IsCSIMigrationEnabled(config)).To(Equal(expected))
+		},
+
+		Entry("config is nil", nil, false),
+		Entry("storage is nil", &aws.ControlPlaneConfig{}, false),
+		Entry("driver is disabled", &aws.ControlPlaneConfig{Storage: &aws.Storage{CSIMigration: true}}, false),
+		Entry("migration is disabled", &aws.ControlPlaneConfig{Storage: &aws.Storage{CSIDriver: true}}, false),
+		Entry("migration is enabled", &aws.ControlPlaneConfig{Storage: &aws.Storage{CSIDriver: true, CSIMigration: true}}, true),
+	)
 })
 
 func expectResults(result, expected interface{}, err error, expectErr bool) {
@@ -20,6 +20,75 @@ import (
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// WorkerConfig contains configuration settings for the worker nodes.
+type WorkerConfig struct {
+	metav1.TypeMeta
+
+	// DataVolumes contains configuration for the additional disks attached to the machines.
+	DataVolumes []DataVolume
+	// SpotInstance contains configuration for running the machines as spot instances.
+	SpotInstance *SpotInstance
+	// InstanceMetadataOptions contains configuration for the instance metadata service of the machines.
+	InstanceMetadataOptions *InstanceMetadataOptions
+	// Tags is a map of additional tags that are added to the machines.
+	Tags map[string]string
+	// MachineControllerManagerSettings contains configuration for the rollout of the machines of the worker pool.
+	MachineControllerManagerSettings *MachineControllerManagerSettings
+}
+
+// DataVolume contains configuration for an additional disk attached to the machines.
+type DataVolume struct {
+	// Name is the name of the data volume.
+	Name string
+	// Type is the EBS volume type of the data volume.
+	Type *string
+	// Size is the size of the data volume.
+	Size string
+	// IOPS is the number of I/O operations per second the data volume supports.
+	IOPS *int64
+	// Encrypted indicates whether the data volume is encrypted.
+	Encrypted *bool
+	// KMSKeyID is the identifier of the KMS key used to encrypt the data volume.
+	KMSKeyID *string
+}
+
+// SpotInstance contains configuration for running the machines as spot instances.
+type SpotInstance struct {
+	// MaxPrice is the maximum hourly price in USD that is paid for a spot instance. If it is not set, the
+	// on-demand price of the machine type is used as maximum price.
+	MaxPrice *string
+}
+
+// InstanceMetadataOptions contains configuration for the instance metadata service of the machines.
+type InstanceMetadataOptions struct {
+	// HTTPTokens defines whether the usage of session tokens (IMDSv2) is required or optional.
+	HTTPTokens *HTTPTokensValue
+	// HTTPPutResponseHopLimit is the hop limit of the PUT response of the instance metadata requests.
+	HTTPPutResponseHopLimit *int64
+}
+
+// HTTPTokensValue is a value for the usage of session tokens of the instance metadata service.
+type HTTPTokensValue string
+
+const (
+	// HTTPTokensRequired requires the usage of session tokens (IMDSv2) for instance metadata requests.
+	HTTPTokensRequired HTTPTokensValue = "required"
+	// HTTPTokensOptional allows instance metadata requests without session tokens (IMDSv1).
+	HTTPTokensOptional HTTPTokensValue = "optional"
+)
+
+// MachineControllerManagerSettings contains configuration for the rollout of the machines of a worker pool. The
+// machine-controller-manager only supports these timeouts for all machines of a shoot, hence the longest timeouts of
+// all worker pools apply to the machines of all worker pools.
+type MachineControllerManagerSettings struct {
+	// MachineDrainTimeout is the maximum duration for draining a machine that is replaced.
+	MachineDrainTimeout *metav1.Duration
+	// MachineCreationTimeout is the maximum duration for creating a machine until its node has joined the cluster.
+	MachineCreationTimeout *metav1.Duration
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // WorkerStatus contains information about created worker resources.
 type WorkerStatus struct {
 	metav1.TypeMeta
@@ -49,9 +49,12 @@ func init() {
 func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&CloudProfileConfig{},
+		&BackupBucketConfig{},
+		&BackupBucketStatus{},
 		&InfrastructureConfig{},
 		&InfrastructureStatus{},
 		&ControlPlaneConfig{},
+		&WorkerConfig{},
 		&WorkerStatus{},
 	)
 	return nil
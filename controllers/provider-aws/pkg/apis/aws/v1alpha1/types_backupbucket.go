@@ -0,0 +1,71 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BackupBucketConfig contains configuration settings for the backup bucket.
+type BackupBucketConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Encryption contains the default server-side encryption settings of the bucket.
+	// +optional
+	Encryption *BucketEncryption `json:"encryption,omitempty"`
+	// Versioning indicates whether object versioning is enabled for the bucket.
+	// +optional
+	Versioning bool `json:"versioning,omitempty"`
+	// BlockPublicAccess indicates whether all public access to the bucket and its objects is blocked.
+	// +optional
+	BlockPublicAccess bool `json:"blockPublicAccess,omitempty"`
+	// Lifecycle contains the lifecycle rules of the bucket.
+	// +optional
+	Lifecycle *BucketLifecycle `json:"lifecycle,omitempty"`
+}
+
+// BucketEncryption contains the default server-side encryption settings of a bucket.
+type BucketEncryption struct {
+	// KMSKeyID is the ID or ARN of the customer-managed KMS key used to encrypt the objects. If it is not set,
+	// the objects are encrypted with S3-managed keys.
+	// +optional
+	KMSKeyID *string `json:"kmsKeyID,omitempty"`
+}
+
+// BucketLifecycle contains the lifecycle rules of a bucket.
+type BucketLifecycle struct {
+	// ExpirationDays is the number of days after which objects and noncurrent object versions expire.
+	ExpirationDays int32 `json:"expirationDays"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BackupBucketStatus contains information about the settings applied to the backup bucket.
+type BackupBucketStatus struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Encryption contains the default server-side encryption settings applied to the bucket.
+	// +optional
+	Encryption *BucketEncryption `json:"encryption,omitempty"`
+	// Versioning indicates whether object versioning is enabled for the bucket.
+	Versioning bool `json:"versioning"`
+	// PublicAccessBlocked indicates whether all public access to the bucket and its objects is blocked.
+	PublicAccessBlocked bool `json:"publicAccessBlocked"`
+	// Lifecycle contains the lifecycle rules applied to the bucket.
+	// +optional
+	Lifecycle *BucketLifecycle `json:"lifecycle,omitempty"`
+}
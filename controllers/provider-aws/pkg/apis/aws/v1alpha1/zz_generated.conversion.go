@@ -24,6 +24,7 @@ import (
 	unsafe "unsafe"
 
 	aws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -35,6 +36,46 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*BackupBucketConfig)(nil), (*aws.BackupBucketConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BackupBucketConfig_To_aws_BackupBucketConfig(a.(*BackupBucketConfig), b.(*aws.BackupBucketConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.BackupBucketConfig)(nil), (*BackupBucketConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(a.(*aws.BackupBucketConfig), b.(*BackupBucketConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BackupBucketStatus)(nil), (*aws.BackupBucketStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BackupBucketStatus_To_aws_BackupBucketStatus(a.(*BackupBucketStatus), b.(*aws.BackupBucketStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.BackupBucketStatus)(nil), (*BackupBucketStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_BackupBucketStatus_To_v1alpha1_BackupBucketStatus(a.(*aws.BackupBucketStatus), b.(*BackupBucketStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BucketEncryption)(nil), (*aws.BucketEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BucketEncryption_To_aws_BucketEncryption(a.(*BucketEncryption), b.(*aws.BucketEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.BucketEncryption)(nil), (*BucketEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_BucketEncryption_To_v1alpha1_BucketEncryption(a.(*aws.BucketEncryption), b.(*BucketEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BucketLifecycle)(nil), (*aws.BucketLifecycle)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BucketLifecycle_To_aws_BucketLifecycle(a.(*BucketLifecycle), b.(*aws.BucketLifecycle), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.BucketLifecycle)(nil), (*BucketLifecycle)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_BucketLifecycle_To_v1alpha1_BucketLifecycle(a.(*aws.BucketLifecycle), b.(*BucketLifecycle), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudControllerManagerConfig)(nil), (*aws.CloudControllerManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CloudControllerManagerConfig_To_aws_CloudControllerManagerConfig(a.(*CloudControllerManagerConfig), b.(*aws.CloudControllerManagerConfig), scope)
 	}); err != nil {
@@ -65,6 +106,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DataVolume)(nil), (*aws.DataVolume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DataVolume_To_aws_DataVolume(a.(*DataVolume), b.(*aws.DataVolume), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.DataVolume)(nil), (*DataVolume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_DataVolume_To_v1alpha1_DataVolume(a.(*aws.DataVolume), b.(*DataVolume), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*EC2)(nil), (*aws.EC2)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_EC2_To_aws_EC2(a.(*EC2), b.(*aws.EC2), scope)
 	}); err != nil {
@@ -105,6 +156,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*InstanceMetadataOptions)(nil), (*aws.InstanceMetadataOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InstanceMetadataOptions_To_aws_InstanceMetadataOptions(a.(*InstanceMetadataOptions), b.(*aws.InstanceMetadataOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.InstanceMetadataOptions)(nil), (*InstanceMetadataOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_InstanceMetadataOptions_To_v1alpha1_InstanceMetadataOptions(a.(*aws.InstanceMetadataOptions), b.(*InstanceMetadataOptions), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*InstanceProfile)(nil), (*aws.InstanceProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_InstanceProfile_To_aws_InstanceProfile(a.(*InstanceProfile), b.(*aws.InstanceProfile), scope)
 	}); err != nil {
@@ -115,6 +176,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MachineControllerManagerSettings)(nil), (*aws.MachineControllerManagerSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineControllerManagerSettings_To_aws_MachineControllerManagerSettings(a.(*MachineControllerManagerSettings), b.(*aws.MachineControllerManagerSettings), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.MachineControllerManagerSettings)(nil), (*MachineControllerManagerSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_MachineControllerManagerSettings_To_v1alpha1_MachineControllerManagerSettings(a.(*aws.MachineControllerManagerSettings), b.(*MachineControllerManagerSettings), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MachineImage)(nil), (*aws.MachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_MachineImage_To_aws_MachineImage(a.(*MachineImage), b.(*aws.MachineImage), scope)
 	}); err != nil {
@@ -185,6 +256,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SpotInstance)(nil), (*aws.SpotInstance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SpotInstance_To_aws_SpotInstance(a.(*SpotInstance), b.(*aws.SpotInstance), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.SpotInstance)(nil), (*SpotInstance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_SpotInstance_To_v1alpha1_SpotInstance(a.(*aws.SpotInstance), b.(*SpotInstance), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Storage)(nil), (*aws.Storage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Storage_To_aws_Storage(a.(*Storage), b.(*aws.Storage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.Storage)(nil), (*Storage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_Storage_To_v1alpha1_Storage(a.(*aws.Storage), b.(*Storage), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Subnet)(nil), (*aws.Subnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Subnet_To_aws_Subnet(a.(*Subnet), b.(*aws.Subnet), scope)
 	}); err != nil {
@@ -215,6 +306,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WorkerConfig)(nil), (*aws.WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerConfig_To_aws_WorkerConfig(a.(*WorkerConfig), b.(*aws.WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.WorkerConfig)(nil), (*WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_WorkerConfig_To_v1alpha1_WorkerConfig(a.(*aws.WorkerConfig), b.(*WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*WorkerStatus)(nil), (*aws.WorkerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_WorkerStatus_To_aws_WorkerStatus(a.(*WorkerStatus), b.(*aws.WorkerStatus), scope)
 	}); err != nil {
@@ -238,6 +339,98 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1alpha1_BackupBucketConfig_To_aws_BackupBucketConfig(in *BackupBucketConfig, out *aws.BackupBucketConfig, s conversion.Scope) error {
+	out.Encryption = (*aws.BucketEncryption)(unsafe.Pointer(in.Encryption))
+	out.Versioning = in.Versioning
+	out.BlockPublicAccess = in.BlockPublicAccess
+	out.Lifecycle = (*aws.BucketLifecycle)(unsafe.Pointer(in.Lifecycle))
+	return nil
+}
+
+// Convert_v1alpha1_BackupBucketConfig_To_aws_BackupBucketConfig is an autogenerated conversion function.
+func Convert_v1alpha1_BackupBucketConfig_To_aws_BackupBucketConfig(in *BackupBucketConfig, out *aws.BackupBucketConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BackupBucketConfig_To_aws_BackupBucketConfig(in, out, s)
+}
+
+func autoConvert_aws_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *aws.BackupBucketConfig, out *BackupBucketConfig, s conversion.Scope) error {
+	out.Encryption = (*BucketEncryption)(unsafe.Pointer(in.Encryption))
+	out.Versioning = in.Versioning
+	out.BlockPublicAccess = in.BlockPublicAccess
+	out.Lifecycle = (*BucketLifecycle)(unsafe.Pointer(in.Lifecycle))
+	return nil
+}
+
+// Convert_aws_BackupBucketConfig_To_v1alpha1_BackupBucketConfig is an autogenerated conversion function.
+func Convert_aws_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *aws.BackupBucketConfig, out *BackupBucketConfig, s conversion.Scope) error {
+	return autoConvert_aws_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_BackupBucketStatus_To_aws_BackupBucketStatus(in *BackupBucketStatus, out *aws.BackupBucketStatus, s conversion.Scope) error {
+	out.Encryption = (*aws.BucketEncryption)(unsafe.Pointer(in.Encryption))
+	out.Versioning = in.Versioning
+	out.PublicAccessBlocked = in.PublicAccessBlocked
+	out.Lifecycle = (*aws.BucketLifecycle)(unsafe.Pointer(in.Lifecycle))
+	return nil
+}
+
+// Convert_v1alpha1_BackupBucketStatus_To_aws_BackupBucketStatus is an autogenerated conversion function.
+func Convert_v1alpha1_BackupBucketStatus_To_aws_BackupBucketStatus(in *BackupBucketStatus, out *aws.BackupBucketStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BackupBucketStatus_To_aws_BackupBucketStatus(in, out, s)
+}
+
+func autoConvert_aws_BackupBucketStatus_To_v1alpha1_BackupBucketStatus(in *aws.BackupBucketStatus, out *BackupBucketStatus, s conversion.Scope) error {
+	out.Encryption = (*BucketEncryption)(unsafe.Pointer(in.Encryption))
+	out.Versioning = in.Versioning
+	out.PublicAccessBlocked = in.PublicAccessBlocked
+	out.Lifecycle = (*BucketLifecycle)(unsafe.Pointer(in.Lifecycle))
+	return nil
+}
+
+// Convert_aws_BackupBucketStatus_To_v1alpha1_BackupBucketStatus is an autogenerated conversion function.
+func Convert_aws_BackupBucketStatus_To_v1alpha1_BackupBucketStatus(in *aws.BackupBucketStatus, out *BackupBucketStatus, s conversion.Scope) error {
+	return autoConvert_aws_BackupBucketStatus_To_v1alpha1_BackupBucketStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_BucketEncryption_To_aws_BucketEncryption(in *BucketEncryption, out *aws.BucketEncryption, s conversion.Scope) error {
+	out.KMSKeyID = (*string)(unsafe.Pointer(in.KMSKeyID))
+	return nil
+}
+
+// Convert_v1alpha1_BucketEncryption_To_aws_BucketEncryption is an autogenerated conversion function.
+func Convert_v1alpha1_BucketEncryption_To_aws_BucketEncryption(in *BucketEncryption, out *aws.BucketEncryption, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BucketEncryption_To_aws_BucketEncryption(in, out, s)
+}
+
+func autoConvert_aws_BucketEncryption_To_v1alpha1_BucketEncryption(in *aws.BucketEncryption, out *BucketEncryption, s conversion.Scope) error {
+	out.KMSKeyID = (*string)(unsafe.Pointer(in.KMSKeyID))
+	return nil
+}
+
+// Convert_aws_BucketEncryption_To_v1alpha1_BucketEncryption is an autogenerated conversion function.
+func Convert_aws_BucketEncryption_To_v1alpha1_BucketEncryption(in *aws.BucketEncryption, out *BucketEncryption, s conversion.Scope) error {
+	return autoConvert_aws_BucketEncryption_To_v1alpha1_BucketEncryption(in, out, s)
+}
+
+func autoConvert_v1alpha1_BucketLifecycle_To_aws_BucketLifecycle(in *BucketLifecycle, out *aws.BucketLifecycle, s conversion.Scope) error {
+	out.ExpirationDays = in.ExpirationDays
+	return nil
+}
+
+// Convert_v1alpha1_BucketLifecycle_To_aws_BucketLifecycle is an autogenerated conversion function.
+func Convert_v1alpha1_BucketLifecycle_To_aws_BucketLifecycle(in *BucketLifecycle, out *aws.BucketLifecycle, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BucketLifecycle_To_aws_BucketLifecycle(in, out, s)
+}
+
+func autoConvert_aws_BucketLifecycle_To_v1alpha1_BucketLifecycle(in *aws.BucketLifecycle, out *BucketLifecycle, s conversion.Scope) error {
+	out.ExpirationDays = in.ExpirationDays
+	return nil
+}
+
+// Convert_aws_BucketLifecycle_To_v1alpha1_BucketLifecycle is an autogenerated conversion function.
+func Convert_aws_BucketLifecycle_To_v1alpha1_BucketLifecycle(in *aws.BucketLifecycle, out *BucketLifecycle, s conversion.Scope) error {
+	return autoConvert_aws_BucketLifecycle_To_v1alpha1_BucketLifecycle(in, out, s)
+}
+
 func autoConvert_v1alpha1_CloudControllerManagerConfig_To_aws_CloudControllerManagerConfig(in *CloudControllerManagerConfig, out *aws.CloudControllerManagerConfig, s conversion.Scope) error {
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
 	return nil
@@ -280,6 +473,7 @@ func Convert_aws_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in *aws.Cloud
 
 func autoConvert_v1alpha1_ControlPlaneConfig_To_aws_ControlPlaneConfig(in *ControlPlaneConfig, out *aws.ControlPlaneConfig, s conversion.Scope) error {
 	out.CloudControllerManager = (*aws.CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.Storage = (*aws.Storage)(unsafe.Pointer(in.Storage))
 	return nil
 }
 
@@ -290,6 +484,7 @@ func Convert_v1alpha1_ControlPlaneConfig_To_aws_ControlPlaneConfig(in *ControlPl
 
 func autoConvert_aws_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *aws.ControlPlaneConfig, out *ControlPlaneConfig, s conversion.Scope) error {
 	out.CloudControllerManager = (*CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.Storage = (*Storage)(unsafe.Pointer(in.Storage))
 	return nil
 }
 
@@ -298,6 +493,36 @@ func Convert_aws_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *aws.Contr
 	return autoConvert_aws_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_DataVolume_To_aws_DataVolume(in *DataVolume, out *aws.DataVolume, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = (*string)(unsafe.Pointer(in.Type))
+	out.Size = in.Size
+	out.IOPS = (*int64)(unsafe.Pointer(in.IOPS))
+	out.Encrypted = (*bool)(unsafe.Pointer(in.Encrypted))
+	out.KMSKeyID = (*string)(unsafe.Pointer(in.KMSKeyID))
+	return nil
+}
+
+// Convert_v1alpha1_DataVolume_To_aws_DataVolume is an autogenerated conversion function.
+func Convert_v1alpha1_DataVolume_To_aws_DataVolume(in *DataVolume, out *aws.DataVolume, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DataVolume_To_aws_DataVolume(in, out, s)
+}
+
+func autoConvert_aws_DataVolume_To_v1alpha1_DataVolume(in *aws.DataVolume, out *DataVolume, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = (*string)(unsafe.Pointer(in.Type))
+	out.Size = in.Size
+	out.IOPS = (*int64)(unsafe.Pointer(in.IOPS))
+	out.Encrypted = (*bool)(unsafe.Pointer(in.Encrypted))
+	out.KMSKeyID = (*string)(unsafe.Pointer(in.KMSKeyID))
+	return nil
+}
+
+// Convert_aws_DataVolume_To_v1alpha1_DataVolume is an autogenerated conversion function.
+func Convert_aws_DataVolume_To_v1alpha1_DataVolume(in *aws.DataVolume, out *DataVolume, s conversion.Scope) error {
+	return autoConvert_aws_DataVolume_To_v1alpha1_DataVolume(in, out, s)
+}
+
 func autoConvert_v1alpha1_EC2_To_aws_EC2(in *EC2, out *aws.EC2, s conversion.Scope) error {
 	out.KeyName = in.KeyName
 	return nil
@@ -400,6 +625,28 @@ func Convert_aws_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in *aws.I
 	return autoConvert_aws_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_InstanceMetadataOptions_To_aws_InstanceMetadataOptions(in *InstanceMetadataOptions, out *aws.InstanceMetadataOptions, s conversion.Scope) error {
+	out.HTTPTokens = (*aws.HTTPTokensValue)(unsafe.Pointer(in.HTTPTokens))
+	out.HTTPPutResponseHopLimit = (*int64)(unsafe.Pointer(in.HTTPPutResponseHopLimit))
+	return nil
+}
+
+// Convert_v1alpha1_InstanceMetadataOptions_To_aws_InstanceMetadataOptions is an autogenerated conversion function.
+func Convert_v1alpha1_InstanceMetadataOptions_To_aws_InstanceMetadataOptions(in *InstanceMetadataOptions, out *aws.InstanceMetadataOptions, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InstanceMetadataOptions_To_aws_InstanceMetadataOptions(in, out, s)
+}
+
+func autoConvert_aws_InstanceMetadataOptions_To_v1alpha1_InstanceMetadataOptions(in *aws.InstanceMetadataOptions, out *InstanceMetadataOptions, s conversion.Scope) error {
+	out.HTTPTokens = (*HTTPTokensValue)(unsafe.Pointer(in.HTTPTokens))
+	out.HTTPPutResponseHopLimit = (*int64)(unsafe.Pointer(in.HTTPPutResponseHopLimit))
+	return nil
+}
+
+// Convert_aws_InstanceMetadataOptions_To_v1alpha1_InstanceMetadataOptions is an autogenerated conversion function.
+func Convert_aws_InstanceMetadataOptions_To_v1alpha1_InstanceMetadataOptions(in *aws.InstanceMetadataOptions, out *InstanceMetadataOptions, s conversion.Scope) error {
+	return autoConvert_aws_InstanceMetadataOptions_To_v1alpha1_InstanceMetadataOptions(in, out, s)
+}
+
 func autoConvert_v1alpha1_InstanceProfile_To_aws_InstanceProfile(in *InstanceProfile, out *aws.InstanceProfile, s conversion.Scope) error {
 	out.Purpose = in.Purpose
 	out.Name = in.Name
@@ -422,6 +669,28 @@ func Convert_aws_InstanceProfile_To_v1alpha1_InstanceProfile(in *aws.InstancePro
 	return autoConvert_aws_InstanceProfile_To_v1alpha1_InstanceProfile(in, out, s)
 }
 
+func autoConvert_v1alpha1_MachineControllerManagerSettings_To_aws_MachineControllerManagerSettings(in *MachineControllerManagerSettings, out *aws.MachineControllerManagerSettings, s conversion.Scope) error {
+	out.MachineDrainTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineDrainTimeout))
+	out.MachineCreationTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineCreationTimeout))
+	return nil
+}
+
+// Convert_v1alpha1_MachineControllerManagerSettings_To_aws_MachineControllerManagerSettings is an autogenerated conversion function.
+func Convert_v1alpha1_MachineControllerManagerSettings_To_aws_MachineControllerManagerSettings(in *MachineControllerManagerSettings, out *aws.MachineControllerManagerSettings, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineControllerManagerSettings_To_aws_MachineControllerManagerSettings(in, out, s)
+}
+
+func autoConvert_aws_MachineControllerManagerSettings_To_v1alpha1_MachineControllerManagerSettings(in *aws.MachineControllerManagerSettings, out *MachineControllerManagerSettings, s conversion.Scope) error {
+	out.MachineDrainTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineDrainTimeout))
+	out.MachineCreationTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineCreationTimeout))
+	return nil
+}
+
+// Convert_aws_MachineControllerManagerSettings_To_v1alpha1_MachineControllerManagerSettings is an autogenerated conversion function.
+func Convert_aws_MachineControllerManagerSettings_To_v1alpha1_MachineControllerManagerSettings(in *aws.MachineControllerManagerSettings, out *MachineControllerManagerSettings, s conversion.Scope) error {
+	return autoConvert_aws_MachineControllerManagerSettings_To_v1alpha1_MachineControllerManagerSettings(in, out, s)
+}
+
 func autoConvert_v1alpha1_MachineImage_To_aws_MachineImage(in *MachineImage, out *aws.MachineImage, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Version = in.Version
@@ -582,6 +851,48 @@ func Convert_aws_SecurityGroup_To_v1alpha1_SecurityGroup(in *aws.SecurityGroup,
 	return autoConvert_aws_SecurityGroup_To_v1alpha1_SecurityGroup(in, out, s)
 }
 
+func autoConvert_v1alpha1_SpotInstance_To_aws_SpotInstance(in *SpotInstance, out *aws.SpotInstance, s conversion.Scope) error {
+	out.MaxPrice = (*string)(unsafe.Pointer(in.MaxPrice))
+	return nil
+}
+
+// Convert_v1alpha1_SpotInstance_To_aws_SpotInstance is an autogenerated conversion function.
+func Convert_v1alpha1_SpotInstance_To_aws_SpotInstance(in *SpotInstance, out *aws.SpotInstance, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SpotInstance_To_aws_SpotInstance(in, out, s)
+}
+
+func autoConvert_aws_SpotInstance_To_v1alpha1_SpotInstance(in *aws.SpotInstance, out *SpotInstance, s conversion.Scope) error {
+	out.MaxPrice = (*string)(unsafe.Pointer(in.MaxPrice))
+	return nil
+}
+
+// Convert_aws_SpotInstance_To_v1alpha1_SpotInstance is an autogenerated conversion function.
+func Convert_aws_SpotInstance_To_v1alpha1_SpotInstance(in *aws.SpotInstance, out *SpotInstance, s conversion.Scope) error {
+	return autoConvert_aws_SpotInstance_To_v1alpha1_SpotInstance(in, out, s)
+}
+
+func autoConvert_v1alpha1_Storage_To_aws_Storage(in *Storage, out *aws.Storage, s conversion.Scope) error {
+	out.CSIDriver = in.CSIDriver
+	out.CSIMigration = in.CSIMigration
+	return nil
+}
+
+// Convert_v1alpha1_Storage_To_aws_Storage is an autogenerated conversion function.
+func Convert_v1alpha1_Storage_To_aws_Storage(in *Storage, out *aws.Storage, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Storage_To_aws_Storage(in, out, s)
+}
+
+func autoConvert_aws_Storage_To_v1alpha1_Storage(in *aws.Storage, out *Storage, s conversion.Scope) error {
+	out.CSIDriver = in.CSIDriver
+	out.CSIMigration = in.CSIMigration
+	return nil
+}
+
+// Convert_aws_Storage_To_v1alpha1_Storage is an autogenerated conversion function.
+func Convert_aws_Storage_To_v1alpha1_Storage(in *aws.Storage, out *Storage, s conversion.Scope) error {
+	return autoConvert_aws_Storage_To_v1alpha1_Storage(in, out, s)
+}
+
 func autoConvert_v1alpha1_Subnet_To_aws_Subnet(in *Subnet, out *aws.Subnet, s conversion.Scope) error {
 	out.Purpose = in.Purpose
 	out.ID = in.ID
@@ -652,6 +963,34 @@ func Convert_aws_VPCStatus_To_v1alpha1_VPCStatus(in *aws.VPCStatus, out *VPCStat
 	return autoConvert_aws_VPCStatus_To_v1alpha1_VPCStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_WorkerConfig_To_aws_WorkerConfig(in *WorkerConfig, out *aws.WorkerConfig, s conversion.Scope) error {
+	out.DataVolumes = *(*[]aws.DataVolume)(unsafe.Pointer(&in.DataVolumes))
+	out.SpotInstance = (*aws.SpotInstance)(unsafe.Pointer(in.SpotInstance))
+	out.InstanceMetadataOptions = (*aws.InstanceMetadataOptions)(unsafe.Pointer(in.InstanceMetadataOptions))
+	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
+	out.MachineControllerManagerSettings = (*aws.MachineControllerManagerSettings)(unsafe.Pointer(in.MachineControllerManagerSettings))
+	return nil
+}
+
+// Convert_v1alpha1_WorkerConfig_To_aws_WorkerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerConfig_To_aws_WorkerConfig(in *WorkerConfig, out *aws.WorkerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerConfig_To_aws_WorkerConfig(in, out, s)
+}
+
+func autoConvert_aws_WorkerConfig_To_v1alpha1_WorkerConfig(in *aws.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	out.DataVolumes = *(*[]DataVolume)(unsafe.Pointer(&in.DataVolumes))
+	out.SpotInstance = (*SpotInstance)(unsafe.Pointer(in.SpotInstance))
+	out.InstanceMetadataOptions = (*InstanceMetadataOptions)(unsafe.Pointer(in.InstanceMetadataOptions))
+	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
+	out.MachineControllerManagerSettings = (*MachineControllerManagerSettings)(unsafe.Pointer(in.MachineControllerManagerSettings))
+	return nil
+}
+
+// Convert_aws_WorkerConfig_To_v1alpha1_WorkerConfig is an autogenerated conversion function.
+func Convert_aws_WorkerConfig_To_v1alpha1_WorkerConfig(in *aws.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	return autoConvert_aws_WorkerConfig_To_v1alpha1_WorkerConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_WorkerStatus_To_aws_WorkerStatus(in *WorkerStatus, out *aws.WorkerStatus, s conversion.Scope) error {
 	out.MachineImages = *(*[]aws.MachineImage)(unsafe.Pointer(&in.MachineImages))
 	return nil
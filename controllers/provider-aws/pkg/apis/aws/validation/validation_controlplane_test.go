@@ -0,0 +1,63 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	. "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("ControlPlaneConfig validation", func() {
+	var controlPlaneConfig *apisaws.ControlPlaneConfig
+
+	BeforeEach(func() {
+		controlPlaneConfig = &apisaws.ControlPlaneConfig{
+			CloudControllerManager: &apisaws.CloudControllerManagerConfig{
+				FeatureGates: map[string]bool{"CustomResourceValidation": true},
+			},
+		}
+	})
+
+	Describe("#ValidateControlPlaneConfig", func() {
+		It("should accept a valid configuration", func() {
+			controlPlaneConfig.Storage = &apisaws.Storage{CSIDriver: true, CSIMigration: true}
+
+			Expect(ValidateControlPlaneConfig(controlPlaneConfig)).To(BeEmpty())
+		})
+
+		It("should forbid empty feature gate names", func() {
+			controlPlaneConfig.CloudControllerManager.FeatureGates[""] = true
+
+			Expect(ValidateControlPlaneConfig(controlPlaneConfig)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("cloudControllerManager.featureGates"),
+			}))))
+		})
+
+		It("should forbid the CSI migration without the CSI driver", func() {
+			controlPlaneConfig.Storage = &apisaws.Storage{CSIMigration: true}
+
+			Expect(ValidateControlPlaneConfig(controlPlaneConfig)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("storage.csiMigration"),
+			}))))
+		})
+	})
+})
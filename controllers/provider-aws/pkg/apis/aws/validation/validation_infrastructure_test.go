@@ -0,0 +1,203 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	. "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("InfrastructureConfig validation", func() {
+	var (
+		infrastructureConfig *apisaws.InfrastructureConfig
+
+		nodes    = "10.250.0.0/16"
+		pods     = "100.96.0.0/11"
+		services = "100.64.0.0/13"
+	)
+
+	BeforeEach(func() {
+		infrastructureConfig = &apisaws.InfrastructureConfig{
+			Networks: apisaws.Networks{
+				VPC: apisaws.VPC{
+					CIDR: pointer.StringPtr("10.250.0.0/16"),
+				},
+				Zones: []apisaws.Zone{
+					{
+						Name:     "eu-west-1a",
+						Workers:  "10.250.0.0/19",
+						Public:   "10.250.96.0/22",
+						Internal: "10.250.112.0/22",
+					},
+					{
+						Name:     "eu-west-1b",
+						Workers:  "10.250.32.0/19",
+						Public:   "10.250.100.0/22",
+						Internal: "10.250.116.0/22",
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#ValidateInfrastructureConfig", func() {
+		It("should accept a valid configuration", func() {
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, pods, services)).To(BeEmpty())
+		})
+
+		It("should accept an existing VPC", func() {
+			infrastructureConfig.Networks.VPC = apisaws.VPC{ID: pointer.StringPtr("vpc-123456")}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, pods, services)).To(BeEmpty())
+		})
+
+		It("should forbid providing both an existing VPC and a VPC CIDR", func() {
+			infrastructureConfig.Networks.VPC.ID = pointer.StringPtr("vpc-123456")
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, pods, services)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("networks.vpc"),
+			}))))
+		})
+
+		It("should require a VPC", func() {
+			infrastructureConfig.Networks.VPC = apisaws.VPC{}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, pods, services)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("networks.vpc"),
+			}))))
+		})
+
+		It("should require at least one zone", func() {
+			infrastructureConfig.Networks.Zones = nil
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, pods, services)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("networks.zones"),
+			}))))
+		})
+
+		It("should forbid duplicate zones", func() {
+			infrastructureConfig.Networks.Zones[1].Name = infrastructureConfig.Networks.Zones[0].Name
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, pods, services)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("networks.zones[1].name"),
+			}))))
+		})
+
+		It("should forbid invalid subnets", func() {
+			infrastructureConfig.Networks.Zones[0].Public = "10.250.96.0"
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, pods, services)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.zones[0].public"),
+			}))))
+		})
+
+		It("should forbid overlapping subnets", func() {
+			infrastructureConfig.Networks.Zones[1].Internal = "10.250.112.0/24"
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, pods, services)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.zones[1].internal"),
+			}))))
+		})
+
+		It("should forbid subnets outside of the VPC and the node network", func() {
+			infrastructureConfig.Networks.Zones[1].Workers = "10.251.0.0/19"
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, pods, services)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.zones[1].workers"),
+					"Detail": ContainSubstring("networks.vpc.cidr"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.zones[1].workers"),
+					"Detail": ContainSubstring("nodes"),
+				})),
+			))
+		})
+
+		It("should forbid subnets overlapping with the pod and service networks", func() {
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, "10.250.0.0/24", "10.250.96.0/24")).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.zones[0].workers"),
+					"Detail": ContainSubstring("pods"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.zones[0].public"),
+					"Detail": ContainSubstring("services"),
+				})),
+			))
+		})
+
+		It("should ignore the shoot networks if they are not given", func() {
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, "", "", "")).To(BeEmpty())
+		})
+	})
+
+	Describe("#ValidateInfrastructureConfigUpdate", func() {
+		It("should allow adding zones", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Zones = append(newInfrastructureConfig.Networks.Zones, apisaws.Zone{
+				Name:     "eu-west-1c",
+				Workers:  "10.250.64.0/19",
+				Public:   "10.250.104.0/22",
+				Internal: "10.250.120.0/22",
+			})
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)).To(BeEmpty())
+		})
+
+		It("should forbid changing the VPC", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.VPC.CIDR = pointer.StringPtr("10.0.0.0/8")
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.vpc"),
+			}))))
+		})
+
+		It("should forbid changing or removing existing zones", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Zones = newInfrastructureConfig.Networks.Zones[:1]
+			newInfrastructureConfig.Networks.Zones[0].Workers = "10.250.128.0/19"
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.zones[0]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.zones"),
+				})),
+			))
+		})
+	})
+})
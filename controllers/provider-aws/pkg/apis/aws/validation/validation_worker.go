@@ -0,0 +1,128 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// MaxDataVolumes is the maximum number of data volumes that can be attached to the machines of a worker pool.
+const MaxDataVolumes = 11
+
+var (
+	validVolumeTypes    = sets.NewString("gp2", "io1", "st1", "sc1", "standard")
+	validHTTPTokens     = sets.NewString(string(apisaws.HTTPTokensRequired), string(apisaws.HTTPTokensOptional))
+	reservedTagPrefixes = []string{"kubernetes.io/cluster/", "kubernetes.io/role/"}
+)
+
+// ValidateWorkerConfig validates a WorkerConfig object.
+func ValidateWorkerConfig(workerConfig *apisaws.WorkerConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	dataVolumesPath := field.NewPath("dataVolumes")
+	if len(workerConfig.DataVolumes) > MaxDataVolumes {
+		allErrs = append(allErrs, field.Forbidden(dataVolumesPath, fmt.Sprintf("must not provide more than %d data volumes", MaxDataVolumes)))
+	}
+
+	names := sets.NewString()
+	for i, dataVolume := range workerConfig.DataVolumes {
+		idxPath := dataVolumesPath.Index(i)
+
+		if len(dataVolume.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else if names.Has(dataVolume.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), dataVolume.Name))
+		}
+		names.Insert(dataVolume.Name)
+
+		if len(dataVolume.Size) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("size"), "must provide a size"))
+		} else if size, err := resource.ParseQuantity(dataVolume.Size); err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("size"), dataVolume.Size, fmt.Sprintf("could not parse size: %v", err)))
+		} else if size.Sign() <= 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("size"), dataVolume.Size, "size must be positive"))
+		}
+
+		if dataVolume.Type != nil && !validVolumeTypes.Has(*dataVolume.Type) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("type"), *dataVolume.Type, validVolumeTypes.List()))
+		}
+
+		isIO1 := dataVolume.Type != nil && *dataVolume.Type == "io1"
+		switch {
+		case dataVolume.IOPS == nil && isIO1:
+			allErrs = append(allErrs, field.Required(idxPath.Child("iops"), "must provide iops for volumes of type io1"))
+		case dataVolume.IOPS != nil && !isIO1:
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("iops"), "iops can only be provided for volumes of type io1"))
+		case dataVolume.IOPS != nil && *dataVolume.IOPS <= 0:
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("iops"), *dataVolume.IOPS, "iops must be positive"))
+		}
+
+		if dataVolume.KMSKeyID != nil && (dataVolume.Encrypted == nil || !*dataVolume.Encrypted) {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("kmsKeyID"), "kms key can only be provided for encrypted volumes"))
+		}
+	}
+
+	if spotInstance := workerConfig.SpotInstance; spotInstance != nil && spotInstance.MaxPrice != nil {
+		maxPricePath := field.NewPath("spotInstance", "maxPrice")
+		if maxPrice, err := strconv.ParseFloat(*spotInstance.MaxPrice, 64); err != nil {
+			allErrs = append(allErrs, field.Invalid(maxPricePath, *spotInstance.MaxPrice, fmt.Sprintf("could not parse max price: %v", err)))
+		} else if maxPrice <= 0 {
+			allErrs = append(allErrs, field.Invalid(maxPricePath, *spotInstance.MaxPrice, "max price must be positive"))
+		}
+	}
+
+	if options := workerConfig.InstanceMetadataOptions; options != nil {
+		optionsPath := field.NewPath("instanceMetadataOptions")
+		if options.HTTPTokens != nil && !validHTTPTokens.Has(string(*options.HTTPTokens)) {
+			allErrs = append(allErrs, field.NotSupported(optionsPath.Child("httpTokens"), *options.HTTPTokens, validHTTPTokens.List()))
+		}
+		if limit := options.HTTPPutResponseHopLimit; limit != nil && (*limit < 1 || *limit > 64) {
+			allErrs = append(allErrs, field.Invalid(optionsPath.Child("httpPutResponseHopLimit"), *limit, "hop limit must be between 1 and 64"))
+		}
+	}
+
+	if settings := workerConfig.MachineControllerManagerSettings; settings != nil {
+		settingsPath := field.NewPath("machineControllerManagerSettings")
+		if settings.MachineDrainTimeout != nil && settings.MachineDrainTimeout.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(settingsPath.Child("machineDrainTimeout"), settings.MachineDrainTimeout.Duration.String(), "timeout must be positive"))
+		}
+		if settings.MachineCreationTimeout != nil && settings.MachineCreationTimeout.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(settingsPath.Child("machineCreationTimeout"), settings.MachineCreationTimeout.Duration.String(), "timeout must be positive"))
+		}
+	}
+
+	tagsPath := field.NewPath("tags")
+	for key := range workerConfig.Tags {
+		if len(key) == 0 {
+			allErrs = append(allErrs, field.Required(tagsPath, "must not provide an empty tag key"))
+			continue
+		}
+		for _, prefix := range reservedTagPrefixes {
+			if strings.HasPrefix(key, prefix) {
+				allErrs = append(allErrs, field.Forbidden(tagsPath.Key(key), fmt.Sprintf("tags with prefix %q are reserved", prefix)))
+			}
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,204 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	"time"
+
+	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	. "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("ValidateWorkerConfig", func() {
+	Describe("#ValidateWorkerConfig", func() {
+		var workerConfig *apisaws.WorkerConfig
+
+		BeforeEach(func() {
+			httpTokens := apisaws.HTTPTokensRequired
+
+			workerConfig = &apisaws.WorkerConfig{
+				DataVolumes: []apisaws.DataVolume{
+					{
+						Name: "data",
+						Type: pointer.StringPtr("io1"),
+						Size: "50Gi",
+						IOPS: pointer.Int64Ptr(1000),
+					},
+					{
+						Name:      "encrypted",
+						Size:      "20Gi",
+						Encrypted: pointer.BoolPtr(true),
+						KMSKeyID:  pointer.StringPtr("arn:aws:kms:eu-west-1:123456789012:key/abcd"),
+					},
+				},
+				SpotInstance: &apisaws.SpotInstance{
+					MaxPrice: pointer.StringPtr("0.12"),
+				},
+				InstanceMetadataOptions: &apisaws.InstanceMetadataOptions{
+					HTTPTokens:              &httpTokens,
+					HTTPPutResponseHopLimit: pointer.Int64Ptr(2),
+				},
+				Tags: map[string]string{
+					"team": "foo",
+				},
+			}
+		})
+
+		It("should allow a valid configuration", func() {
+			Expect(ValidateWorkerConfig(workerConfig)).To(BeEmpty())
+		})
+
+		It("should allow an empty configuration", func() {
+			Expect(ValidateWorkerConfig(&apisaws.WorkerConfig{})).To(BeEmpty())
+		})
+
+		Context("data volume validation", func() {
+			It("should forbid data volumes without name and size", func() {
+				workerConfig.DataVolumes = []apisaws.DataVolume{{}}
+
+				errorList := ValidateWorkerConfig(workerConfig)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("dataVolumes[0].name"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("dataVolumes[0].size"),
+				}))))
+			})
+
+			It("should forbid duplicate names, invalid sizes and unsupported types", func() {
+				workerConfig.DataVolumes[1].Name = "data"
+				workerConfig.DataVolumes[1].Size = "foo"
+				workerConfig.DataVolumes[1].Type = pointer.StringPtr("gp3000")
+
+				errorList := ValidateWorkerConfig(workerConfig)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("dataVolumes[1].name"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("dataVolumes[1].size"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("dataVolumes[1].type"),
+				}))))
+			})
+
+			It("should require iops for io1 volumes only", func() {
+				workerConfig.DataVolumes[0].IOPS = nil
+				workerConfig.DataVolumes[1].IOPS = pointer.Int64Ptr(100)
+
+				errorList := ValidateWorkerConfig(workerConfig)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("dataVolumes[0].iops"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("dataVolumes[1].iops"),
+				}))))
+			})
+
+			It("should forbid kms keys for unencrypted volumes", func() {
+				workerConfig.DataVolumes[1].Encrypted = nil
+
+				errorList := ValidateWorkerConfig(workerConfig)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("dataVolumes[1].kmsKeyID"),
+				}))))
+			})
+
+			It("should forbid too many data volumes", func() {
+				workerConfig.DataVolumes = make([]apisaws.DataVolume, MaxDataVolumes+1)
+				for i := range workerConfig.DataVolumes {
+					workerConfig.DataVolumes[i] = apisaws.DataVolume{Name: string(rune('a' + i)), Size: "10Gi"}
+				}
+
+				errorList := ValidateWorkerConfig(workerConfig)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("dataVolumes"),
+				}))))
+			})
+		})
+
+		It("should forbid invalid spot instance max prices", func() {
+			workerConfig.SpotInstance.MaxPrice = pointer.StringPtr("-1")
+
+			errorList := ValidateWorkerConfig(workerConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spotInstance.maxPrice"),
+			}))))
+		})
+
+		It("should forbid invalid instance metadata options", func() {
+			httpTokens := apisaws.HTTPTokensValue("foo")
+			workerConfig.InstanceMetadataOptions.HTTPTokens = &httpTokens
+			workerConfig.InstanceMetadataOptions.HTTPPutResponseHopLimit = pointer.Int64Ptr(65)
+
+			errorList := ValidateWorkerConfig(workerConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("instanceMetadataOptions.httpTokens"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("instanceMetadataOptions.httpPutResponseHopLimit"),
+			}))))
+		})
+
+		It("should forbid non-positive machine controller manager timeouts", func() {
+			workerConfig.MachineControllerManagerSettings = &apisaws.MachineControllerManagerSettings{
+				MachineDrainTimeout:    &metav1.Duration{Duration: -time.Minute},
+				MachineCreationTimeout: &metav1.Duration{},
+			}
+
+			errorList := ValidateWorkerConfig(workerConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("machineControllerManagerSettings.machineDrainTimeout"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("machineControllerManagerSettings.machineCreationTimeout"),
+			}))))
+		})
+
+		It("should forbid reserved tag keys", func() {
+			workerConfig.Tags["kubernetes.io/cluster/foo"] = "1"
+
+			errorList := ValidateWorkerConfig(workerConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("tags[kubernetes.io/cluster/foo]"),
+			}))))
+		})
+	})
+})
@@ -47,6 +47,7 @@ func NewClient(accessKeyID, secretAccessKey, region string) (Interface, error) {
 	return &Client{
 		EC2: ec2.New(s, config),
 		ELB: elb.New(s, config),
+		IAM: NewIAM(s, config),
 		STS: sts.New(s, config),
 		S3:  s3.New(s, config),
 	}, nil
@@ -246,22 +247,151 @@ func (c *Client) CreateBucketIfNotExists(ctx context.Context, bucket, region str
 	return nil
 }
 
+// UpdateBucketConfig converges the default encryption, versioning, public access block and lifecycle configuration
+// of the s3 bucket with name <bucket> to the given <config>.
+func (c *Client) UpdateBucketConfig(ctx context.Context, bucket string, config *BucketConfig) error {
+	if config.Encryption {
+		rule := &s3.ServerSideEncryptionByDefault{SSEAlgorithm: aws.String(s3.ServerSideEncryptionAes256)}
+		if len(config.KMSKeyID) > 0 {
+			rule = &s3.ServerSideEncryptionByDefault{SSEAlgorithm: aws.String(s3.ServerSideEncryptionAwsKms), KMSMasterKeyID: aws.String(config.KMSKeyID)}
+		}
+		if _, err := c.S3.PutBucketEncryptionWithContext(ctx, &s3.PutBucketEncryptionInput{
+			Bucket: aws.String(bucket),
+			ServerSideEncryptionConfiguration: &s3.ServerSideEncryptionConfiguration{
+				Rules: []*s3.ServerSideEncryptionRule{{ApplyServerSideEncryptionByDefault: rule}},
+			},
+		}); err != nil {
+			return err
+		}
+	} else if _, err := c.S3.DeleteBucketEncryptionWithContext(ctx, &s3.DeleteBucketEncryptionInput{Bucket: aws.String(bucket)}); err != nil && !isErrorCode(err, errCodeNoSuchEncryptionConfiguration) {
+		return err
+	}
+
+	// A bucket that has been versioned once can only be suspended, hence it is only suspended if versioning is
+	// currently enabled to leave buckets that were never versioned untouched.
+	versioning, err := c.S3.GetBucketVersioningWithContext(ctx, &s3.GetBucketVersioningInput{Bucket: aws.String(bucket)})
+	if err != nil {
+		return err
+	}
+	if enabled := aws.StringValue(versioning.Status) == s3.BucketVersioningStatusEnabled; enabled != config.Versioning {
+		status := s3.BucketVersioningStatusSuspended
+		if config.Versioning {
+			status = s3.BucketVersioningStatusEnabled
+		}
+		if _, err := c.S3.PutBucketVersioningWithContext(ctx, &s3.PutBucketVersioningInput{
+			Bucket:                  aws.String(bucket),
+			VersioningConfiguration: &s3.VersioningConfiguration{Status: aws.String(status)},
+		}); err != nil {
+			return err
+		}
+	}
+
+	if config.BlockPublicAccess {
+		if _, err := c.S3.PutPublicAccessBlockWithContext(ctx, &s3.PutPublicAccessBlockInput{
+			Bucket: aws.String(bucket),
+			PublicAccessBlockConfiguration: &s3.PublicAccessBlockConfiguration{
+				BlockPublicAcls:       aws.Bool(true),
+				BlockPublicPolicy:     aws.Bool(true),
+				IgnorePublicAcls:      aws.Bool(true),
+				RestrictPublicBuckets: aws.Bool(true),
+			},
+		}); err != nil {
+			return err
+		}
+	} else if _, err := c.S3.DeletePublicAccessBlockWithContext(ctx, &s3.DeletePublicAccessBlockInput{Bucket: aws.String(bucket)}); err != nil && !isErrorCode(err, errCodeNoSuchPublicAccessBlockConfiguration) {
+		return err
+	}
+
+	if config.ExpirationDays > 0 {
+		if _, err := c.S3.PutBucketLifecycleConfigurationWithContext(ctx, &s3.PutBucketLifecycleConfigurationInput{
+			Bucket: aws.String(bucket),
+			LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
+				Rules: []*s3.LifecycleRule{
+					{
+						ID:                          aws.String("expiration"),
+						Status:                      aws.String(s3.ExpirationStatusEnabled),
+						Filter:                      &s3.LifecycleRuleFilter{Prefix: aws.String("")},
+						Expiration:                  &s3.LifecycleExpiration{Days: aws.Int64(config.ExpirationDays)},
+						NoncurrentVersionExpiration: &s3.NoncurrentVersionExpiration{NoncurrentDays: aws.Int64(config.ExpirationDays)},
+					},
+				},
+			},
+		}); err != nil {
+			return err
+		}
+	} else if _, err := c.S3.DeleteBucketLifecycleWithContext(ctx, &s3.DeleteBucketLifecycleInput{Bucket: aws.String(bucket)}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // DeleteBucketIfExists deletes the s3 bucket with name <bucket>. If it does not exist,
-// no error is returned.
+// no error is returned. A bucket that is not empty is emptied including all object versions
+// and delete markers before its deletion is retried.
 func (c *Client) DeleteBucketIfExists(ctx context.Context, bucket string) error {
-	if _, err := c.S3.DeleteBucketWithContext(ctx, &s3.DeleteBucketInput{Bucket: aws.String(bucket)}); err != nil {
-		if aerr, ok := err.(awserr.Error); ok {
-			if aerr.Code() == s3.ErrCodeNoSuchBucket {
-				return nil
-			}
-			if aerr.Code() == errCodeBucketNotEmpty {
-				if err := c.DeleteObjectsWithPrefix(ctx, bucket, ""); err != nil {
-					return err
-				}
-				return c.DeleteBucketIfExists(ctx, bucket)
+	for attempt := 1; ; attempt++ {
+		_, err := c.S3.DeleteBucketWithContext(ctx, &s3.DeleteBucketInput{Bucket: aws.String(bucket)})
+		if err == nil {
+			return nil
+		}
+
+		aerr, ok := err.(awserr.Error)
+		if !ok {
+			return err
+		}
+		switch {
+		case aerr.Code() == s3.ErrCodeNoSuchBucket:
+			return nil
+		case aerr.Code() == errCodeBucketNotEmpty && attempt < maxDeleteBucketAttempts:
+			if err := c.deleteObjectVersions(ctx, bucket); err != nil {
+				return err
 			}
+		default:
+			return err
+		}
+	}
+}
+
+// deleteObjectVersions deletes all objects of the s3 bucket with name <bucket> including all
+// their versions and delete markers.
+func (c *Client) deleteObjectVersions(ctx context.Context, bucket string) error {
+	var objectIDs []*s3.ObjectIdentifier
+	if err := c.S3.ListObjectVersionsPagesWithContext(ctx, &s3.ListObjectVersionsInput{Bucket: aws.String(bucket)}, func(page *s3.ListObjectVersionsOutput, lastPage bool) bool {
+		for _, version := range page.Versions {
+			objectIDs = append(objectIDs, &s3.ObjectIdentifier{Key: version.Key, VersionId: version.VersionId})
 		}
+		for _, deleteMarker := range page.DeleteMarkers {
+			objectIDs = append(objectIDs, &s3.ObjectIdentifier{Key: deleteMarker.Key, VersionId: deleteMarker.VersionId})
+		}
+		return !lastPage
+	}); err != nil {
 		return err
 	}
+
+	for len(objectIDs) > 0 {
+		n := len(objectIDs)
+		if n > maxObjectsPerDeletion {
+			n = maxObjectsPerDeletion
+		}
+
+		out, err := c.S3.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: objectIDs[:n],
+				Quiet:   aws.Bool(true),
+			},
+		})
+		if err != nil {
+			return err
+		}
+		for _, deleteError := range out.Errors {
+			if aws.StringValue(deleteError.Code) != s3.ErrCodeNoSuchKey {
+				return fmt.Errorf("could not delete object %s (version %s): %s", aws.StringValue(deleteError.Key), aws.StringValue(deleteError.VersionId), aws.StringValue(deleteError.Message))
+			}
+		}
+
+		objectIDs = objectIDs[n:]
+	}
 	return nil
 }
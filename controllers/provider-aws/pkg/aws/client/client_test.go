@@ -0,0 +1,95 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client_test
+
+import (
+	"context"
+
+	. "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws/client"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// fakeS3 serves a bucket with the object versions and delete markers it has been created with. The bucket can
+// only be deleted once all of them have been deleted, and never if objects are written concurrently.
+type fakeS3 struct {
+	s3iface.S3API
+
+	versions                   []*s3.ObjectVersion
+	deleteMarkers              []*s3.DeleteMarkerEntry
+	objectsWrittenConcurrently bool
+
+	deleteBucketCalls int
+	deletedObjects    []*s3.ObjectIdentifier
+}
+
+func (f *fakeS3) DeleteBucketWithContext(_ aws.Context, _ *s3.DeleteBucketInput, _ ...request.Option) (*s3.DeleteBucketOutput, error) {
+	f.deleteBucketCalls++
+	if f.objectsWrittenConcurrently || len(f.versions)+len(f.deleteMarkers) > len(f.deletedObjects) {
+		return nil, awserr.New("BucketNotEmpty", "The bucket you tried to delete is not empty", nil)
+	}
+	return &s3.DeleteBucketOutput{}, nil
+}
+
+func (f *fakeS3) ListObjectVersionsPagesWithContext(_ aws.Context, _ *s3.ListObjectVersionsInput, fn func(*s3.ListObjectVersionsOutput, bool) bool, _ ...request.Option) error {
+	fn(&s3.ListObjectVersionsOutput{Versions: f.versions, DeleteMarkers: f.deleteMarkers}, true)
+	return nil
+}
+
+func (f *fakeS3) DeleteObjectsWithContext(_ aws.Context, input *s3.DeleteObjectsInput, _ ...request.Option) (*s3.DeleteObjectsOutput, error) {
+	f.deletedObjects = append(f.deletedObjects, input.Delete.Objects...)
+	return &s3.DeleteObjectsOutput{}, nil
+}
+
+var _ = Describe("Client", func() {
+	var ctx = context.TODO()
+
+	Describe("#DeleteBucketIfExists", func() {
+		It("should delete all object versions and delete markers before deleting the bucket", func() {
+			fake := &fakeS3{
+				versions: []*s3.ObjectVersion{
+					{Key: aws.String("a"), VersionId: aws.String("1")},
+					{Key: aws.String("a"), VersionId: aws.String("2")},
+				},
+				deleteMarkers: []*s3.DeleteMarkerEntry{
+					{Key: aws.String("b"), VersionId: aws.String("3")},
+				},
+			}
+			client := &Client{S3: fake}
+
+			Expect(client.DeleteBucketIfExists(ctx, "bucket")).To(Succeed())
+			Expect(fake.deleteBucketCalls).To(Equal(2))
+			Expect(fake.deletedObjects).To(ConsistOf(
+				&s3.ObjectIdentifier{Key: aws.String("a"), VersionId: aws.String("1")},
+				&s3.ObjectIdentifier{Key: aws.String("a"), VersionId: aws.String("2")},
+				&s3.ObjectIdentifier{Key: aws.String("b"), VersionId: aws.String("3")},
+			))
+		})
+
+		It("should give up if the bucket does not become empty", func() {
+			fake := &fakeS3{objectsWrittenConcurrently: true}
+			client := &Client{S3: fake}
+
+			Expect(client.DeleteBucketIfExists(ctx, "bucket")).To(HaveOccurred())
+			Expect(fake.deleteBucketCalls).To(Equal(3))
+		})
+	})
+})
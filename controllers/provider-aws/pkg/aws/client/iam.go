@@ -0,0 +1,306 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	awsclient "github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/aws/aws-sdk-go/private/protocol/query"
+)
+
+// The vendored AWS SDK does not contain the IAM service. This file implements the few IAM operations that are
+// required to manage the roles and instance profiles of a shoot on top of the generic SDK client and the query
+// protocol, mirroring the generated code of the SDK.
+
+const (
+	// errCodeNoSuchEntity is the IAM service response error code "NoSuchEntity".
+	errCodeNoSuchEntity = "NoSuchEntity"
+	// errCodeEntityAlreadyExists is the IAM service response error code "EntityAlreadyExists".
+	errCodeEntityAlreadyExists = "EntityAlreadyExists"
+)
+
+// IAMAPI contains the IAM operations used by the Client.
+type IAMAPI interface {
+	GetRoleWithContext(aws.Context, *GetRoleInput, ...request.Option) (*GetRoleOutput, error)
+	CreateRoleWithContext(aws.Context, *CreateRoleInput, ...request.Option) (*CreateRoleOutput, error)
+	DeleteRoleWithContext(aws.Context, *DeleteRoleInput, ...request.Option) (*DeleteRoleOutput, error)
+	PutRolePolicyWithContext(aws.Context, *PutRolePolicyInput, ...request.Option) (*PutRolePolicyOutput, error)
+	DeleteRolePolicyWithContext(aws.Context, *DeleteRolePolicyInput, ...request.Option) (*DeleteRolePolicyOutput, error)
+	GetInstanceProfileWithContext(aws.Context, *GetInstanceProfileInput, ...request.Option) (*GetInstanceProfileOutput, error)
+	CreateInstanceProfileWithContext(aws.Context, *CreateInstanceProfileInput, ...request.Option) (*CreateInstanceProfileOutput, error)
+	DeleteInstanceProfileWithContext(aws.Context, *DeleteInstanceProfileInput, ...request.Option) (*DeleteInstanceProfileOutput, error)
+	AddRoleToInstanceProfileWithContext(aws.Context, *AddRoleToInstanceProfileInput, ...request.Option) (*AddRoleToInstanceProfileOutput, error)
+	RemoveRoleFromInstanceProfileWithContext(aws.Context, *RemoveRoleFromInstanceProfileInput, ...request.Option) (*RemoveRoleFromInstanceProfileOutput, error)
+}
+
+// IAM is a client for the AWS IAM service.
+type IAM struct {
+	*awsclient.Client
+}
+
+// NewIAM creates a new IAM client for the given configuration provider.
+func NewIAM(p awsclient.ConfigProvider, cfgs ...*aws.Config) *IAM {
+	c := p.ClientConfig("iam", cfgs...)
+	svc := &IAM{
+		Client: awsclient.New(
+			*c.Config,
+			metadata.ClientInfo{
+				ServiceName:   "iam",
+				ServiceID:     "IAM",
+				SigningName:   c.SigningName,
+				SigningRegion: c.SigningRegion,
+				Endpoint:      c.Endpoint,
+				APIVersion:    "2010-05-08",
+			},
+			c.Handlers,
+		),
+	}
+
+	svc.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	svc.Handlers.Build.PushBackNamed(query.BuildHandler)
+	svc.Handlers.Unmarshal.PushBackNamed(query.UnmarshalHandler)
+	svc.Handlers.UnmarshalMeta.PushBackNamed(query.UnmarshalMetaHandler)
+	svc.Handlers.UnmarshalError.PushBackNamed(query.UnmarshalErrorHandler)
+
+	return svc
+}
+
+func (c *IAM) send(ctx aws.Context, name string, input, output interface{}, opts ...request.Option) error {
+	req := c.NewRequest(&request.Operation{Name: name, HTTPMethod: "POST", HTTPPath: "/"}, input, output)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return req.Send()
+}
+
+// IAMRole is an AWS IAM role.
+type IAMRole struct {
+	_ struct{} `type:"structure"`
+
+	Arn                      *string `type:"string"`
+	AssumeRolePolicyDocument *string `type:"string"`
+	Path                     *string `type:"string"`
+	RoleId                   *string `type:"string"`
+	RoleName                 *string `type:"string"`
+}
+
+// IAMInstanceProfile is an AWS IAM instance profile.
+type IAMInstanceProfile struct {
+	_ struct{} `type:"structure"`
+
+	Arn                 *string    `type:"string"`
+	InstanceProfileId   *string    `type:"string"`
+	InstanceProfileName *string    `type:"string"`
+	Path                *string    `type:"string"`
+	Roles               []*IAMRole `type:"list"`
+}
+
+// GetRoleInput is the input of the GetRole operation.
+type GetRoleInput struct {
+	_ struct{} `type:"structure"`
+
+	RoleName *string `type:"string" required:"true"`
+}
+
+// GetRoleOutput is the output of the GetRole operation.
+type GetRoleOutput struct {
+	_ struct{} `type:"structure"`
+
+	Role *IAMRole `type:"structure"`
+}
+
+// GetRoleWithContext retrieves information about the specified role.
+func (c *IAM) GetRoleWithContext(ctx aws.Context, input *GetRoleInput, opts ...request.Option) (*GetRoleOutput, error) {
+	output := &GetRoleOutput{}
+	return output, c.send(ctx, "GetRole", input, output, opts...)
+}
+
+// CreateRoleInput is the input of the CreateRole operation.
+type CreateRoleInput struct {
+	_ struct{} `type:"structure"`
+
+	AssumeRolePolicyDocument *string `type:"string" required:"true"`
+	Path                     *string `type:"string"`
+	RoleName                 *string `type:"string" required:"true"`
+}
+
+// CreateRoleOutput is the output of the CreateRole operation.
+type CreateRoleOutput struct {
+	_ struct{} `type:"structure"`
+
+	Role *IAMRole `type:"structure"`
+}
+
+// CreateRoleWithContext creates a new role.
+func (c *IAM) CreateRoleWithContext(ctx aws.Context, input *CreateRoleInput, opts ...request.Option) (*CreateRoleOutput, error) {
+	output := &CreateRoleOutput{}
+	return output, c.send(ctx, "CreateRole", input, output, opts...)
+}
+
+// DeleteRoleInput is the input of the DeleteRole operation.
+type DeleteRoleInput struct {
+	_ struct{} `type:"structure"`
+
+	RoleName *string `type:"string" required:"true"`
+}
+
+// DeleteRoleOutput is the output of the DeleteRole operation.
+type DeleteRoleOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// DeleteRoleWithContext deletes the specified role.
+func (c *IAM) DeleteRoleWithContext(ctx aws.Context, input *DeleteRoleInput, opts ...request.Option) (*DeleteRoleOutput, error) {
+	output := &DeleteRoleOutput{}
+	return output, c.send(ctx, "DeleteRole", input, output, opts...)
+}
+
+// PutRolePolicyInput is the input of the PutRolePolicy operation.
+type PutRolePolicyInput struct {
+	_ struct{} `type:"structure"`
+
+	PolicyDocument *string `type:"string" required:"true"`
+	PolicyName     *string `type:"string" required:"true"`
+	RoleName       *string `type:"string" required:"true"`
+}
+
+// PutRolePolicyOutput is the output of the PutRolePolicy operation.
+type PutRolePolicyOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// PutRolePolicyWithContext adds or updates an inline policy of the specified role.
+func (c *IAM) PutRolePolicyWithContext(ctx aws.Context, input *PutRolePolicyInput, opts ...request.Option) (*PutRolePolicyOutput, error) {
+	output := &PutRolePolicyOutput{}
+	return output, c.send(ctx, "PutRolePolicy", input, output, opts...)
+}
+
+// DeleteRolePolicyInput is the input of the DeleteRolePolicy operation.
+type DeleteRolePolicyInput struct {
+	_ struct{} `type:"structure"`
+
+	PolicyName *string `type:"string" required:"true"`
+	RoleName   *string `type:"string" required:"true"`
+}
+
+// DeleteRolePolicyOutput is the output of the DeleteRolePolicy operation.
+type DeleteRolePolicyOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// DeleteRolePolicyWithContext deletes the specified inline policy of the specified role.
+func (c *IAM) DeleteRolePolicyWithContext(ctx aws.Context, input *DeleteRolePolicyInput, opts ...request.Option) (*DeleteRolePolicyOutput, error) {
+	output := &DeleteRolePolicyOutput{}
+	return output, c.send(ctx, "DeleteRolePolicy", input, output, opts...)
+}
+
+// GetInstanceProfileInput is the input of the GetInstanceProfile operation.
+type GetInstanceProfileInput struct {
+	_ struct{} `type:"structure"`
+
+	InstanceProfileName *string `type:"string" required:"true"`
+}
+
+// GetInstanceProfileOutput is the output of the GetInstanceProfile operation.
+type GetInstanceProfileOutput struct {
+	_ struct{} `type:"structure"`
+
+	InstanceProfile *IAMInstanceProfile `type:"structure"`
+}
+
+// GetInstanceProfileWithContext retrieves information about the specified instance profile.
+func (c *IAM) GetInstanceProfileWithContext(ctx aws.Context, input *GetInstanceProfileInput, opts ...request.Option) (*GetInstanceProfileOutput, error) {
+	output := &GetInstanceProfileOutput{}
+	return output, c.send(ctx, "GetInstanceProfile", input, output, opts...)
+}
+
+// CreateInstanceProfileInput is the input of the CreateInstanceProfile operation.
+type CreateInstanceProfileInput struct {
+	_ struct{} `type:"structure"`
+
+	InstanceProfileName *string `type:"string" required:"true"`
+	Path                *string `type:"string"`
+}
+
+// CreateInstanceProfileOutput is the output of the CreateInstanceProfile operation.
+type CreateInstanceProfileOutput struct {
+	_ struct{} `type:"structure"`
+
+	InstanceProfile *IAMInstanceProfile `type:"structure"`
+}
+
+// CreateInstanceProfileWithContext creates a new instance profile.
+func (c *IAM) CreateInstanceProfileWithContext(ctx aws.Context, input *CreateInstanceProfileInput, opts ...request.Option) (*CreateInstanceProfileOutput, error) {
+	output := &CreateInstanceProfileOutput{}
+	return output, c.send(ctx, "CreateInstanceProfile", input, output, opts...)
+}
+
+// DeleteInstanceProfileInput is the input of the DeleteInstanceProfile operation.
+type DeleteInstanceProfileInput struct {
+	_ struct{} `type:"structure"`
+
+	InstanceProfileName *string `type:"string" required:"true"`
+}
+
+// DeleteInstanceProfileOutput is the output of the DeleteInstanceProfile operation.
+type DeleteInstanceProfileOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// DeleteInstanceProfileWithContext deletes the specified instance profile.
+func (c *IAM) DeleteInstanceProfileWithContext(ctx aws.Context, input *DeleteInstanceProfileInput, opts ...request.Option) (*DeleteInstanceProfileOutput, error) {
+	output := &DeleteInstanceProfileOutput{}
+	return output, c.send(ctx, "DeleteInstanceProfile", input, output, opts...)
+}
+
+// AddRoleToInstanceProfileInput is the input of the AddRoleToInstanceProfile operation.
+type AddRoleToInstanceProfileInput struct {
+	_ struct{} `type:"structure"`
+
+	InstanceProfileName *string `type:"string" required:"true"`
+	RoleName            *string `type:"string" required:"true"`
+}
+
+// AddRoleToInstanceProfileOutput is the output of the AddRoleToInstanceProfile operation.
+type AddRoleToInstanceProfileOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// AddRoleToInstanceProfileWithContext adds the specified role to the specified instance profile.
+func (c *IAM) AddRoleToInstanceProfileWithContext(ctx aws.Context, input *AddRoleToInstanceProfileInput, opts ...request.Option) (*AddRoleToInstanceProfileOutput, error) {
+	output := &AddRoleToInstanceProfileOutput{}
+	return output, c.send(ctx, "AddRoleToInstanceProfile", input, output, opts...)
+}
+
+// RemoveRoleFromInstanceProfileInput is the input of the RemoveRoleFromInstanceProfile operation.
+type RemoveRoleFromInstanceProfileInput struct {
+	_ struct{} `type:"structure"`
+
+	InstanceProfileName *string `type:"string" required:"true"`
+	RoleName            *string `type:"string" required:"true"`
+}
+
+// RemoveRoleFromInstanceProfileOutput is the output of the RemoveRoleFromInstanceProfile operation.
+type RemoveRoleFromInstanceProfileOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// RemoveRoleFromInstanceProfileWithContext removes the specified role from the specified instance profile.
+func (c *IAM) RemoveRoleFromInstanceProfileWithContext(ctx aws.Context, input *RemoveRoleFromInstanceProfileInput, opts ...request.Option) (*RemoveRoleFromInstanceProfileOutput, error) {
+	output := &RemoveRoleFromInstanceProfileOutput{}
+	return output, c.send(ctx, "RemoveRoleFromInstanceProfile", input, output, opts...)
+}
@@ -0,0 +1,880 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	errCodeInvalidPermissionDuplicate = "InvalidPermission.Duplicate"
+	errCodeInvalidPermissionNotFound  = "InvalidPermission.NotFound"
+	errCodeInvalidKeyPairNotFound     = "InvalidKeyPair.NotFound"
+)
+
+// FindVPCByTags returns the ID of the VPC with the given <tags>. If there is no such VPC, the returned string
+// will be empty.
+func (c *Client) FindVPCByTags(ctx context.Context, tags Tags) (string, error) {
+	output, err := c.EC2.DescribeVpcsWithContext(ctx, &ec2.DescribeVpcsInput{Filters: tags.filters()})
+	if err != nil {
+		return "", err
+	}
+	if len(output.Vpcs) == 0 {
+		return "", nil
+	}
+	return aws.StringValue(output.Vpcs[0].VpcId), nil
+}
+
+// EnsureVPC creates the given <vpc> with DNS support and its DHCP options if they do not exist yet, and returns
+// the ID of the VPC. The VPC is identified by its tags.
+func (c *Client) EnsureVPC(ctx context.Context, vpc *VPC) (string, error) {
+	vpcID, err := c.FindVPCByTags(ctx, vpc.Tags)
+	if err != nil {
+		return "", err
+	}
+
+	if len(vpcID) == 0 {
+		output, err := c.EC2.CreateVpcWithContext(ctx, &ec2.CreateVpcInput{CidrBlock: aws.String(vpc.CIDRBlock)})
+		if err != nil {
+			return "", err
+		}
+		vpcID = aws.StringValue(output.Vpc.VpcId)
+
+		if err := c.EC2.WaitUntilVpcAvailableWithContext(ctx, &ec2.DescribeVpcsInput{VpcIds: aws.StringSlice([]string{vpcID})}); err != nil {
+			return "", err
+		}
+	}
+	if err := c.createTags(ctx, vpcID, vpc.Tags); err != nil {
+		return "", err
+	}
+
+	for _, input := range []*ec2.ModifyVpcAttributeInput{
+		{VpcId: aws.String(vpcID), EnableDnsSupport: &ec2.AttributeBooleanValue{Value: aws.Bool(true)}},
+		{VpcId: aws.String(vpcID), EnableDnsHostnames: &ec2.AttributeBooleanValue{Value: aws.Bool(true)}},
+	} {
+		if _, err := c.EC2.ModifyVpcAttributeWithContext(ctx, input); err != nil {
+			return "", err
+		}
+	}
+
+	dhcpOptionsID, err := c.ensureDHCPOptions(ctx, vpc.DHCPDomainName, vpc.Tags)
+	if err != nil {
+		return "", err
+	}
+	if _, err := c.EC2.AssociateDhcpOptionsWithContext(ctx, &ec2.AssociateDhcpOptionsInput{
+		DhcpOptionsId: aws.String(dhcpOptionsID),
+		VpcId:         aws.String(vpcID),
+	}); err != nil {
+		return "", err
+	}
+
+	return vpcID, nil
+}
+
+func (c *Client) ensureDHCPOptions(ctx context.Context, domainName string, tags Tags) (string, error) {
+	output, err := c.EC2.DescribeDhcpOptionsWithContext(ctx, &ec2.DescribeDhcpOptionsInput{Filters: tags.filters()})
+	if err != nil {
+		return "", err
+	}
+	if len(output.DhcpOptions) > 0 {
+		return aws.StringValue(output.DhcpOptions[0].DhcpOptionsId), nil
+	}
+
+	createOutput, err := c.EC2.CreateDhcpOptionsWithContext(ctx, &ec2.CreateDhcpOptionsInput{
+		DhcpConfigurations: []*ec2.NewDhcpConfiguration{
+			{Key: aws.String("domain-name"), Values: aws.StringSlice([]string{domainName})},
+			{Key: aws.String("domain-name-servers"), Values: aws.StringSlice([]string{"AmazonProvidedDNS"})},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	dhcpOptionsID := aws.StringValue(createOutput.DhcpOptions.DhcpOptionsId)
+
+	return dhcpOptionsID, c.createTags(ctx, dhcpOptionsID, tags)
+}
+
+// EnsureInternetGateway creates an internet gateway with the given <tags> and attaches it to the VPC <vpcID>
+// if there is no internet gateway attached yet. It returns the ID of the attached internet gateway.
+func (c *Client) EnsureInternetGateway(ctx context.Context, vpcID string, tags Tags) (string, error) {
+	if internetGatewayID, err := c.GetInternetGateway(ctx, vpcID); err == nil {
+		return internetGatewayID, nil
+	}
+
+	output, err := c.EC2.DescribeInternetGatewaysWithContext(ctx, &ec2.DescribeInternetGatewaysInput{Filters: tags.filters()})
+	if err != nil {
+		return "", err
+	}
+
+	var internetGatewayID string
+	if len(output.InternetGateways) > 0 {
+		internetGatewayID = aws.StringValue(output.InternetGateways[0].InternetGatewayId)
+	} else {
+		createOutput, err := c.EC2.CreateInternetGatewayWithContext(ctx, &ec2.CreateInternetGatewayInput{})
+		if err != nil {
+			return "", err
+		}
+		internetGatewayID = aws.StringValue(createOutput.InternetGateway.InternetGatewayId)
+
+		if err := c.createTags(ctx, internetGatewayID, tags); err != nil {
+			return "", err
+		}
+	}
+
+	if _, err := c.EC2.AttachInternetGatewayWithContext(ctx, &ec2.AttachInternetGatewayInput{
+		InternetGatewayId: aws.String(internetGatewayID),
+		VpcId:             aws.String(vpcID),
+	}); err != nil {
+		return "", err
+	}
+
+	return internetGatewayID, nil
+}
+
+// EnsureSubnet creates the given <subnet> if it does not exist yet, and returns its ID. The subnet is identified
+// by its VPC and CIDR block.
+func (c *Client) EnsureSubnet(ctx context.Context, subnet *Subnet) (string, error) {
+	output, err := c.EC2.DescribeSubnetsWithContext(ctx, &ec2.DescribeSubnetsInput{
+		Filters: []*ec2.Filter{
+			newFilter("vpc-id", subnet.VPCID),
+			newFilter("cidr-block", subnet.CIDRBlock),
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var subnetID string
+	if len(output.Subnets) > 0 {
+		subnetID = aws.StringValue(output.Subnets[0].SubnetId)
+	} else {
+		createOutput, err := c.EC2.CreateSubnetWithContext(ctx, &ec2.CreateSubnetInput{
+			VpcId:            aws.String(subnet.VPCID),
+			CidrBlock:        aws.String(subnet.CIDRBlock),
+			AvailabilityZone: aws.String(subnet.AvailabilityZone),
+		})
+		if err != nil {
+			return "", err
+		}
+		subnetID = aws.StringValue(createOutput.Subnet.SubnetId)
+	}
+
+	return subnetID, c.createTags(ctx, subnetID, subnet.Tags)
+}
+
+// EnsureElasticIP allocates an elastic IP with the given <tags> if it does not exist yet, and returns its
+// allocation ID.
+func (c *Client) EnsureElasticIP(ctx context.Context, tags Tags) (string, error) {
+	output, err := c.EC2.DescribeAddressesWithContext(ctx, &ec2.DescribeAddressesInput{Filters: tags.filters()})
+	if err != nil {
+		return "", err
+	}
+	if len(output.Addresses) > 0 {
+		return aws.StringValue(output.Addresses[0].AllocationId), nil
+	}
+
+	allocateOutput, err := c.EC2.AllocateAddressWithContext(ctx, &ec2.AllocateAddressInput{Domain: aws.String(ec2.DomainTypeVpc)})
+	if err != nil {
+		return "", err
+	}
+	allocationID := aws.StringValue(allocateOutput.AllocationId)
+
+	return allocationID, c.createTags(ctx, allocationID, tags)
+}
+
+// EnsureNATGateway creates a NAT gateway with the given <tags> in the subnet <subnetID> using the elastic IP
+// <allocationID> if it does not exist yet. It waits until the NAT gateway is available and returns its ID.
+func (c *Client) EnsureNATGateway(ctx context.Context, subnetID, allocationID string, tags Tags) (string, error) {
+	output, err := c.EC2.DescribeNatGatewaysWithContext(ctx, &ec2.DescribeNatGatewaysInput{
+		Filter: append(tags.filters(),
+			newFilter("subnet-id", subnetID),
+			newFilter("state", ec2.NatGatewayStatePending, ec2.NatGatewayStateAvailable),
+		),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var natGatewayID string
+	if len(output.NatGateways) > 0 {
+		natGatewayID = aws.StringValue(output.NatGateways[0].NatGatewayId)
+	} else {
+		createOutput, err := c.EC2.CreateNatGatewayWithContext(ctx, &ec2.CreateNatGatewayInput{
+			AllocationId: aws.String(allocationID),
+			SubnetId:     aws.String(subnetID),
+		})
+		if err != nil {
+			return "", err
+		}
+		natGatewayID = aws.StringValue(createOutput.NatGateway.NatGatewayId)
+
+		if err := c.createTags(ctx, natGatewayID, tags); err != nil {
+			return "", err
+		}
+	}
+
+	return natGatewayID, c.EC2.WaitUntilNatGatewayAvailableWithContext(ctx, &ec2.DescribeNatGatewaysInput{NatGatewayIds: aws.StringSlice([]string{natGatewayID})})
+}
+
+// EnsureRouteTable creates the given <routeTable> if it does not exist yet, and ensures that its routes and
+// subnet associations are present. It returns the ID of the route table. The route table is identified by its
+// VPC and tags.
+func (c *Client) EnsureRouteTable(ctx context.Context, routeTable *RouteTable) (string, error) {
+	output, err := c.EC2.DescribeRouteTablesWithContext(ctx, &ec2.DescribeRouteTablesInput{
+		Filters: append(routeTable.Tags.filters(), newFilter("vpc-id", routeTable.VPCID)),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var table *ec2.RouteTable
+	if len(output.RouteTables) > 0 {
+		table = output.RouteTables[0]
+	} else {
+		createOutput, err := c.EC2.CreateRouteTableWithContext(ctx, &ec2.CreateRouteTableInput{VpcId: aws.String(routeTable.VPCID)})
+		if err != nil {
+			return "", err
+		}
+		table = createOutput.RouteTable
+
+		if err := c.createTags(ctx, aws.StringValue(table.RouteTableId), routeTable.Tags); err != nil {
+			return "", err
+		}
+	}
+	routeTableID := aws.StringValue(table.RouteTableId)
+
+	for _, route := range routeTable.Routes {
+		if err := c.ensureRoute(ctx, table, route); err != nil {
+			return "", err
+		}
+	}
+
+	for _, subnetID := range routeTable.SubnetIDs {
+		if err := c.ensureRouteTableAssociation(ctx, table, subnetID); err != nil {
+			return "", err
+		}
+	}
+
+	return routeTableID, nil
+}
+
+func (c *Client) ensureRoute(ctx context.Context, table *ec2.RouteTable, route Route) error {
+	for _, existing := range table.Routes {
+		if aws.StringValue(existing.DestinationCidrBlock) != route.DestinationCIDRBlock {
+			continue
+		}
+		if aws.StringValue(existing.GatewayId) == route.GatewayID && aws.StringValue(existing.NatGatewayId) == route.NATGatewayID {
+			return nil
+		}
+
+		input := &ec2.ReplaceRouteInput{
+			RouteTableId:         table.RouteTableId,
+			DestinationCidrBlock: aws.String(route.DestinationCIDRBlock),
+		}
+		if len(route.GatewayID) > 0 {
+			input.GatewayId = aws.String(route.GatewayID)
+		}
+		if len(route.NATGatewayID) > 0 {
+			input.NatGatewayId = aws.String(route.NATGatewayID)
+		}
+		_, err := c.EC2.ReplaceRouteWithContext(ctx, input)
+		return err
+	}
+
+	input := &ec2.CreateRouteInput{
+		RouteTableId:         table.RouteTableId,
+		DestinationCidrBlock: aws.String(route.DestinationCIDRBlock),
+	}
+	if len(route.GatewayID) > 0 {
+		input.GatewayId = aws.String(route.GatewayID)
+	}
+	if len(route.NATGatewayID) > 0 {
+		input.NatGatewayId = aws.String(route.NATGatewayID)
+	}
+	_, err := c.EC2.CreateRouteWithContext(ctx, input)
+	return err
+}
+
+func (c *Client) ensureRouteTableAssociation(ctx context.Context, table *ec2.RouteTable, subnetID string) error {
+	for _, association := range table.Associations {
+		if aws.StringValue(association.SubnetId) == subnetID {
+			return nil
+		}
+	}
+
+	output, err := c.EC2.DescribeRouteTablesWithContext(ctx, &ec2.DescribeRouteTablesInput{
+		Filters: []*ec2.Filter{newFilter("association.subnet-id", subnetID)},
+	})
+	if err != nil {
+		return err
+	}
+	for _, other := range output.RouteTables {
+		for _, association := range other.Associations {
+			if aws.StringValue(association.SubnetId) == subnetID {
+				_, err := c.EC2.ReplaceRouteTableAssociationWithContext(ctx, &ec2.ReplaceRouteTableAssociationInput{
+					AssociationId: association.RouteTableAssociationId,
+					RouteTableId:  table.RouteTableId,
+				})
+				return err
+			}
+		}
+	}
+
+	_, err = c.EC2.AssociateRouteTableWithContext(ctx, &ec2.AssociateRouteTableInput{
+		RouteTableId: table.RouteTableId,
+		SubnetId:     aws.String(subnetID),
+	})
+	return err
+}
+
+// EnsureSecurityGroup creates the given <securityGroup> if it does not exist yet, and ensures that exactly its rules
+// are present, i.e. missing rules are authorized and all other rules are revoked. It returns the ID of the security
+// group. The security group is identified by its VPC and name.
+func (c *Client) EnsureSecurityGroup(ctx context.Context, securityGroup *SecurityGroup) (string, error) {
+	output, err := c.EC2.DescribeSecurityGroupsWithContext(ctx, &ec2.DescribeSecurityGroupsInput{
+		Filters: []*ec2.Filter{
+			newFilter("vpc-id", securityGroup.VPCID),
+			newFilter("group-name", securityGroup.Name),
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		groupID                       string
+		currentIngress, currentEgress []*ec2.IpPermission
+	)
+	if len(output.SecurityGroups) > 0 {
+		group := output.SecurityGroups[0]
+		groupID = aws.StringValue(group.GroupId)
+		currentIngress, currentEgress = group.IpPermissions, group.IpPermissionsEgress
+	} else {
+		createOutput, err := c.EC2.CreateSecurityGroupWithContext(ctx, &ec2.CreateSecurityGroupInput{
+			VpcId:       aws.String(securityGroup.VPCID),
+			GroupName:   aws.String(securityGroup.Name),
+			Description: aws.String(securityGroup.Description),
+		})
+		if err != nil {
+			return "", err
+		}
+		groupID = aws.StringValue(createOutput.GroupId)
+
+		// AWS adds a rule allowing all outgoing traffic to every new security group.
+		currentEgress = []*ec2.IpPermission{{IpProtocol: aws.String("-1"), IpRanges: []*ec2.IpRange{{CidrIp: aws.String("0.0.0.0/0")}}}}
+	}
+
+	if err := c.createTags(ctx, groupID, securityGroup.Tags); err != nil {
+		return "", err
+	}
+
+	var desiredIngress, desiredEgress []*ec2.IpPermission
+	for _, rule := range securityGroup.Rules {
+		switch rule.Type {
+		case SecurityGroupRuleTypeIngress:
+			desiredIngress = append(desiredIngress, rule.ipPermission(groupID))
+		case SecurityGroupRuleTypeEgress:
+			desiredEgress = append(desiredEgress, rule.ipPermission(groupID))
+		default:
+			return "", fmt.Errorf("unknown security group rule type %q", rule.Type)
+		}
+	}
+
+	authorizeIngress, revokeIngress := diffIPPermissions(currentIngress, desiredIngress)
+	authorizeEgress, revokeEgress := diffIPPermissions(currentEgress, desiredEgress)
+
+	if len(revokeIngress) > 0 {
+		if _, err := c.EC2.RevokeSecurityGroupIngressWithContext(ctx, &ec2.RevokeSecurityGroupIngressInput{GroupId: aws.String(groupID), IpPermissions: revokeIngress}); err != nil && !isErrorCode(err, errCodeInvalidPermissionNotFound) {
+			return "", err
+		}
+	}
+	if len(revokeEgress) > 0 {
+		if _, err := c.EC2.RevokeSecurityGroupEgressWithContext(ctx, &ec2.RevokeSecurityGroupEgressInput{GroupId: aws.String(groupID), IpPermissions: revokeEgress}); err != nil && !isErrorCode(err, errCodeInvalidPermissionNotFound) {
+			return "", err
+		}
+	}
+	if len(authorizeIngress) > 0 {
+		if _, err := c.EC2.AuthorizeSecurityGroupIngressWithContext(ctx, &ec2.AuthorizeSecurityGroupIngressInput{GroupId: aws.String(groupID), IpPermissions: authorizeIngress}); err != nil && !isErrorCode(err, errCodeInvalidPermissionDuplicate) {
+			return "", err
+		}
+	}
+	if len(authorizeEgress) > 0 {
+		if _, err := c.EC2.AuthorizeSecurityGroupEgressWithContext(ctx, &ec2.AuthorizeSecurityGroupEgressInput{GroupId: aws.String(groupID), IpPermissions: authorizeEgress}); err != nil && !isErrorCode(err, errCodeInvalidPermissionDuplicate) {
+			return "", err
+		}
+	}
+
+	return groupID, nil
+}
+
+// diffIPPermissions splits the <current> and <desired> permissions into permissions with a single CIDR block or
+// security group each and returns the ones that have to be authorized and the ones that have to be revoked.
+func diffIPPermissions(current, desired []*ec2.IpPermission) (authorize, revoke []*ec2.IpPermission) {
+	var (
+		currentKeys = make(map[string]bool)
+		desiredKeys = make(map[string]bool)
+	)
+
+	for _, permission := range splitIPPermissions(current) {
+		currentKeys[ipPermissionKey(permission)] = true
+	}
+	for _, permission := range splitIPPermissions(desired) {
+		key := ipPermissionKey(permission)
+		desiredKeys[key] = true
+		if !currentKeys[key] {
+			authorize = append(authorize, permission)
+		}
+	}
+	for _, permission := range splitIPPermissions(current) {
+		if !desiredKeys[ipPermissionKey(permission)] {
+			revoke = append(revoke, permission)
+		}
+	}
+	return authorize, revoke
+}
+
+// splitIPPermissions returns a permission for every IPv4 and IPv6 CIDR block, prefix list and security group of the
+// given permissions.
+func splitIPPermissions(permissions []*ec2.IpPermission) []*ec2.IpPermission {
+	var out []*ec2.IpPermission
+	for _, permission := range permissions {
+		for _, ipRange := range permission.IpRanges {
+			out = append(out, &ec2.IpPermission{
+				IpProtocol: permission.IpProtocol,
+				FromPort:   permission.FromPort,
+				ToPort:     permission.ToPort,
+				IpRanges:   []*ec2.IpRange{{CidrIp: ipRange.CidrIp}},
+			})
+		}
+		for _, ipv6Range := range permission.Ipv6Ranges {
+			out = append(out, &ec2.IpPermission{
+				IpProtocol: permission.IpProtocol,
+				FromPort:   permission.FromPort,
+				ToPort:     permission.ToPort,
+				Ipv6Ranges: []*ec2.Ipv6Range{{CidrIpv6: ipv6Range.CidrIpv6}},
+			})
+		}
+		for _, prefixList := range permission.PrefixListIds {
+			out = append(out, &ec2.IpPermission{
+				IpProtocol:    permission.IpProtocol,
+				FromPort:      permission.FromPort,
+				ToPort:        permission.ToPort,
+				PrefixListIds: []*ec2.PrefixListId{{PrefixListId: prefixList.PrefixListId}},
+			})
+		}
+		for _, pair := range permission.UserIdGroupPairs {
+			out = append(out, &ec2.IpPermission{
+				IpProtocol:       permission.IpProtocol,
+				FromPort:         permission.FromPort,
+				ToPort:           permission.ToPort,
+				UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: pair.GroupId}},
+			})
+		}
+	}
+	return out
+}
+
+func ipPermissionKey(permission *ec2.IpPermission) string {
+	var target string
+	switch {
+	case len(permission.IpRanges) > 0:
+		target = aws.StringValue(permission.IpRanges[0].CidrIp)
+	case len(permission.Ipv6Ranges) > 0:
+		target = aws.StringValue(permission.Ipv6Ranges[0].CidrIpv6)
+	case len(permission.PrefixListIds) > 0:
+		target = aws.StringValue(permission.PrefixListIds[0].PrefixListId)
+	case len(permission.UserIdGroupPairs) > 0:
+		target = aws.StringValue(permission.UserIdGroupPairs[0].GroupId)
+	}
+
+	protocol := aws.StringValue(permission.IpProtocol)
+	if protocol == "-1" {
+		return fmt.Sprintf("%s/%s", protocol, target)
+	}
+	return fmt.Sprintf("%s/%d-%d/%s", protocol, aws.Int64Value(permission.FromPort), aws.Int64Value(permission.ToPort), target)
+}
+
+func (r SecurityGroupRule) ipPermission(groupID string) *ec2.IpPermission {
+	permission := &ec2.IpPermission{IpProtocol: aws.String(r.Protocol)}
+	if r.Protocol != "-1" {
+		permission.FromPort = aws.Int64(r.FromPort)
+		permission.ToPort = aws.Int64(r.ToPort)
+	}
+	for _, cidrBlock := range r.CIDRBlocks {
+		permission.IpRanges = append(permission.IpRanges, &ec2.IpRange{CidrIp: aws.String(cidrBlock)})
+	}
+	if r.Self {
+		permission.UserIdGroupPairs = append(permission.UserIdGroupPairs, &ec2.UserIdGroupPair{GroupId: aws.String(groupID)})
+	}
+	if len(r.SourceSecurityGroupID) > 0 {
+		permission.UserIdGroupPairs = append(permission.UserIdGroupPairs, &ec2.UserIdGroupPair{GroupId: aws.String(r.SourceSecurityGroupID)})
+	}
+	return permission
+}
+
+// EnsureKeyPair imports the <publicKey> as key pair with the given <name> if it does not exist yet.
+func (c *Client) EnsureKeyPair(ctx context.Context, name string, publicKey []byte) error {
+	if _, err := c.EC2.DescribeKeyPairsWithContext(ctx, &ec2.DescribeKeyPairsInput{KeyNames: aws.StringSlice([]string{name})}); err == nil || !isErrorCode(err, errCodeInvalidKeyPairNotFound) {
+		return err
+	}
+
+	_, err := c.EC2.ImportKeyPairWithContext(ctx, &ec2.ImportKeyPairInput{
+		KeyName:           aws.String(name),
+		PublicKeyMaterial: publicKey,
+	})
+	return err
+}
+
+// DeleteNATGateways deletes the NAT gateways in the VPC <vpcID> with the given <tags> and waits until they are
+// gone.
+func (c *Client) DeleteNATGateways(ctx context.Context, vpcID string, tags Tags) error {
+	input := &ec2.DescribeNatGatewaysInput{
+		Filter: append(tags.filters(),
+			newFilter("vpc-id", vpcID),
+			newFilter("state", ec2.NatGatewayStatePending, ec2.NatGatewayStateAvailable, ec2.NatGatewayStateDeleting),
+		),
+	}
+
+	output, err := c.EC2.DescribeNatGatewaysWithContext(ctx, input)
+	if err != nil {
+		return err
+	}
+	for _, natGateway := range output.NatGateways {
+		if aws.StringValue(natGateway.State) == ec2.NatGatewayStateDeleting {
+			continue
+		}
+		if _, err := c.EC2.DeleteNatGatewayWithContext(ctx, &ec2.DeleteNatGatewayInput{NatGatewayId: natGateway.NatGatewayId}); err != nil {
+			return err
+		}
+	}
+
+	return wait.PollImmediateUntil(5*time.Second, func() (bool, error) {
+		output, err := c.EC2.DescribeNatGatewaysWithContext(ctx, input)
+		if err != nil {
+			return false, err
+		}
+		return len(output.NatGateways) == 0, nil
+	}, ctx.Done())
+}
+
+// DeleteElasticIPs releases the elastic IPs with the given <tags>.
+func (c *Client) DeleteElasticIPs(ctx context.Context, tags Tags) error {
+	output, err := c.EC2.DescribeAddressesWithContext(ctx, &ec2.DescribeAddressesInput{Filters: tags.filters()})
+	if err != nil {
+		return err
+	}
+	for _, address := range output.Addresses {
+		if _, err := c.EC2.ReleaseAddressWithContext(ctx, &ec2.ReleaseAddressInput{AllocationId: address.AllocationId}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteRouteTables deletes the route tables in the VPC <vpcID> with the given <tags> including their subnet
+// associations.
+func (c *Client) DeleteRouteTables(ctx context.Context, vpcID string, tags Tags) error {
+	output, err := c.EC2.DescribeRouteTablesWithContext(ctx, &ec2.DescribeRouteTablesInput{
+		Filters: append(tags.filters(), newFilter("vpc-id", vpcID)),
+	})
+	if err != nil {
+		return err
+	}
+	for _, routeTable := range output.RouteTables {
+		for _, association := range routeTable.Associations {
+			if aws.BoolValue(association.Main) {
+				continue
+			}
+			if _, err := c.EC2.DisassociateRouteTableWithContext(ctx, &ec2.DisassociateRouteTableInput{AssociationId: association.RouteTableAssociationId}); err != nil {
+				return err
+			}
+		}
+		if _, err := c.EC2.DeleteRouteTableWithContext(ctx, &ec2.DeleteRouteTableInput{RouteTableId: routeTable.RouteTableId}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteSubnets deletes the subnets in the VPC <vpcID> with the given <tags>.
+func (c *Client) DeleteSubnets(ctx context.Context, vpcID string, tags Tags) error {
+	output, err := c.EC2.DescribeSubnetsWithContext(ctx, &ec2.DescribeSubnetsInput{
+		Filters: append(tags.filters(), newFilter("vpc-id", vpcID)),
+	})
+	if err != nil {
+		return err
+	}
+	for _, subnet := range output.Subnets {
+		if _, err := c.EC2.DeleteSubnetWithContext(ctx, &ec2.DeleteSubnetInput{SubnetId: subnet.SubnetId}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteSecurityGroups deletes the security groups in the VPC <vpcID> with the given <tags>. The rules of all
+// groups are revoked first as the groups may reference each other.
+func (c *Client) DeleteSecurityGroups(ctx context.Context, vpcID string, tags Tags) error {
+	output, err := c.EC2.DescribeSecurityGroupsWithContext(ctx, &ec2.DescribeSecurityGroupsInput{
+		Filters: append(tags.filters(), newFilter("vpc-id", vpcID)),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, group := range output.SecurityGroups {
+		if len(group.IpPermissions) > 0 {
+			if _, err := c.EC2.RevokeSecurityGroupIngressWithContext(ctx, &ec2.RevokeSecurityGroupIngressInput{GroupId: group.GroupId, IpPermissions: group.IpPermissions}); err != nil && !isErrorCode(err, errCodeInvalidPermissionNotFound) {
+				return err
+			}
+		}
+		if len(group.IpPermissionsEgress) > 0 {
+			if _, err := c.EC2.RevokeSecurityGroupEgressWithContext(ctx, &ec2.RevokeSecurityGroupEgressInput{GroupId: group.GroupId, IpPermissions: group.IpPermissionsEgress}); err != nil && !isErrorCode(err, errCodeInvalidPermissionNotFound) {
+				return err
+			}
+		}
+	}
+
+	for _, group := range output.SecurityGroups {
+		if err := c.DeleteSecurityGroup(ctx, aws.StringValue(group.GroupId)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteInternetGateway detaches the internet gateway with the given <tags> from the VPC <vpcID> and deletes it.
+func (c *Client) DeleteInternetGateway(ctx context.Context, vpcID string, tags Tags) error {
+	output, err := c.EC2.DescribeInternetGatewaysWithContext(ctx, &ec2.DescribeInternetGatewaysInput{Filters: tags.filters()})
+	if err != nil {
+		return err
+	}
+	for _, internetGateway := range output.InternetGateways {
+		for _, attachment := range internetGateway.Attachments {
+			if aws.StringValue(attachment.VpcId) != vpcID {
+				continue
+			}
+			if _, err := c.EC2.DetachInternetGatewayWithContext(ctx, &ec2.DetachInternetGatewayInput{
+				InternetGatewayId: internetGateway.InternetGatewayId,
+				VpcId:             aws.String(vpcID),
+			}); err != nil {
+				return err
+			}
+		}
+		if _, err := c.EC2.DeleteInternetGatewayWithContext(ctx, &ec2.DeleteInternetGatewayInput{InternetGatewayId: internetGateway.InternetGatewayId}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteVPC deletes the VPC <vpcID> and the DHCP options if they carry the given <tags>. VPCs that have not been
+// created for the given tags are left untouched.
+func (c *Client) DeleteVPC(ctx context.Context, vpcID string, tags Tags) error {
+	output, err := c.EC2.DescribeVpcsWithContext(ctx, &ec2.DescribeVpcsInput{
+		Filters: append(tags.filters(), newFilter("vpc-id", vpcID)),
+	})
+	if err != nil {
+		return err
+	}
+	for _, vpc := range output.Vpcs {
+		if _, err := c.EC2.DeleteVpcWithContext(ctx, &ec2.DeleteVpcInput{VpcId: vpc.VpcId}); err != nil {
+			return err
+		}
+	}
+
+	dhcpOutput, err := c.EC2.DescribeDhcpOptionsWithContext(ctx, &ec2.DescribeDhcpOptionsInput{Filters: tags.filters()})
+	if err != nil {
+		return err
+	}
+	for _, dhcpOptions := range dhcpOutput.DhcpOptions {
+		if _, err := c.EC2.DeleteDhcpOptionsWithContext(ctx, &ec2.DeleteDhcpOptionsInput{DhcpOptionsId: dhcpOptions.DhcpOptionsId}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteKeyPair deletes the key pair with the given <name>. If it does not exist, no error is returned.
+func (c *Client) DeleteKeyPair(ctx context.Context, name string) error {
+	if _, err := c.EC2.DeleteKeyPairWithContext(ctx, &ec2.DeleteKeyPairInput{KeyName: aws.String(name)}); err != nil && !isErrorCode(err, errCodeInvalidKeyPairNotFound) {
+		return err
+	}
+	return nil
+}
+
+// EnsureIAMRole creates the given <role> if it does not exist yet, puts its inline policy, and returns the ARN
+// of the role.
+func (c *Client) EnsureIAMRole(ctx context.Context, role *Role) (string, error) {
+	var arn string
+
+	output, err := c.IAM.GetRoleWithContext(ctx, &GetRoleInput{RoleName: aws.String(role.Name)})
+	switch {
+	case err == nil:
+		arn = aws.StringValue(output.Role.Arn)
+	case isErrorCode(err, errCodeNoSuchEntity):
+		createOutput, err := c.IAM.CreateRoleWithContext(ctx, &CreateRoleInput{
+			RoleName:                 aws.String(role.Name),
+			Path:                     aws.String(role.Path),
+			AssumeRolePolicyDocument: aws.String(role.AssumeRolePolicyDocument),
+		})
+		if err != nil {
+			return "", err
+		}
+		arn = aws.StringValue(createOutput.Role.Arn)
+	default:
+		return "", err
+	}
+
+	if _, err := c.IAM.PutRolePolicyWithContext(ctx, &PutRolePolicyInput{
+		RoleName:       aws.String(role.Name),
+		PolicyName:     aws.String(role.PolicyName),
+		PolicyDocument: aws.String(role.PolicyDocument),
+	}); err != nil {
+		return "", err
+	}
+
+	return arn, nil
+}
+
+// EnsureIAMInstanceProfile creates the instance profile with the given <name> if it does not exist yet, and
+// ensures that the role <roleName> is its only role.
+func (c *Client) EnsureIAMInstanceProfile(ctx context.Context, name, roleName string) error {
+	var roles []*IAMRole
+
+	output, err := c.IAM.GetInstanceProfileWithContext(ctx, &GetInstanceProfileInput{InstanceProfileName: aws.String(name)})
+	switch {
+	case err == nil:
+		roles = output.InstanceProfile.Roles
+	case isErrorCode(err, errCodeNoSuchEntity):
+		if _, err := c.IAM.CreateInstanceProfileWithContext(ctx, &CreateInstanceProfileInput{
+			InstanceProfileName: aws.String(name),
+			Path:                aws.String("/"),
+		}); err != nil && !isErrorCode(err, errCodeEntityAlreadyExists) {
+			return err
+		}
+	default:
+		return err
+	}
+
+	hasRole := false
+	for _, role := range roles {
+		if aws.StringValue(role.RoleName) == roleName {
+			hasRole = true
+			continue
+		}
+		if _, err := c.IAM.RemoveRoleFromInstanceProfileWithContext(ctx, &RemoveRoleFromInstanceProfileInput{
+			InstanceProfileName: aws.String(name),
+			RoleName:            role.RoleName,
+		}); err != nil {
+			return err
+		}
+	}
+	if hasRole {
+		return nil
+	}
+
+	_, err = c.IAM.AddRoleToInstanceProfileWithContext(ctx, &AddRoleToInstanceProfileInput{
+		InstanceProfileName: aws.String(name),
+		RoleName:            aws.String(roleName),
+	})
+	return err
+}
+
+// DeleteIAMInstanceProfile removes all roles from the instance profile with the given <name> and deletes it.
+// If it does not exist, no error is returned.
+func (c *Client) DeleteIAMInstanceProfile(ctx context.Context, name string) error {
+	output, err := c.IAM.GetInstanceProfileWithContext(ctx, &GetInstanceProfileInput{InstanceProfileName: aws.String(name)})
+	if err != nil {
+		if isErrorCode(err, errCodeNoSuchEntity) {
+			return nil
+		}
+		return err
+	}
+
+	for _, role := range output.InstanceProfile.Roles {
+		if _, err := c.IAM.RemoveRoleFromInstanceProfileWithContext(ctx, &RemoveRoleFromInstanceProfileInput{
+			InstanceProfileName: aws.String(name),
+			RoleName:            role.RoleName,
+		}); err != nil && !isErrorCode(err, errCodeNoSuchEntity) {
+			return err
+		}
+	}
+
+	if _, err := c.IAM.DeleteInstanceProfileWithContext(ctx, &DeleteInstanceProfileInput{InstanceProfileName: aws.String(name)}); err != nil && !isErrorCode(err, errCodeNoSuchEntity) {
+		return err
+	}
+	return nil
+}
+
+// DeleteIAMRole deletes the inline policy <policyName> of the role with the given <name> and the role itself.
+// If they do not exist, no error is returned.
+func (c *Client) DeleteIAMRole(ctx context.Context, name, policyName string) error {
+	if _, err := c.IAM.DeleteRolePolicyWithContext(ctx, &DeleteRolePolicyInput{
+		RoleName:   aws.String(name),
+		PolicyName: aws.String(policyName),
+	}); err != nil && !isErrorCode(err, errCodeNoSuchEntity) {
+		return err
+	}
+
+	if _, err := c.IAM.DeleteRoleWithContext(ctx, &DeleteRoleInput{RoleName: aws.String(name)}); err != nil && !isErrorCode(err, errCodeNoSuchEntity) {
+		return err
+	}
+	return nil
+}
+
+func (c *Client) createTags(ctx context.Context, resourceID string, tags Tags) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	var ec2Tags []*ec2.Tag
+	for key, value := range tags {
+		ec2Tags = append(ec2Tags, &ec2.Tag{Key: aws.String(key), Value: aws.String(value)})
+	}
+
+	_, err := c.EC2.CreateTagsWithContext(ctx, &ec2.CreateTagsInput{
+		Resources: aws.StringSlice([]string{resourceID}),
+		Tags:      ec2Tags,
+	})
+	return err
+}
+
+func (t Tags) filters() []*ec2.Filter {
+	var filters []*ec2.Filter
+	for key, value := range t {
+		filters = append(filters, newFilter(fmt.Sprintf("tag:%s", key), value))
+	}
+	return filters
+}
+
+func newFilter(name string, values ...string) *ec2.Filter {
+	return &ec2.Filter{
+		Name:   aws.String(name),
+		Values: aws.StringSlice(values),
+	}
+}
+
+func isErrorCode(err error, code string) bool {
+	aerr, ok := err.(awserr.Error)
+	return ok && aerr.Code() == code
+}
@@ -0,0 +1,194 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client_test
+
+import (
+	"context"
+
+	. "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws/client"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// fakeEC2 records the security group rule changes and serves the security groups it has been created with.
+type fakeEC2 struct {
+	ec2iface.EC2API
+
+	securityGroups []*ec2.SecurityGroup
+
+	authorizedIngress, authorizedEgress []*ec2.IpPermission
+	revokedIngress, revokedEgress       []*ec2.IpPermission
+}
+
+func (f *fakeEC2) DescribeSecurityGroupsWithContext(_ aws.Context, _ *ec2.DescribeSecurityGroupsInput, _ ...request.Option) (*ec2.DescribeSecurityGroupsOutput, error) {
+	return &ec2.DescribeSecurityGroupsOutput{SecurityGroups: f.securityGroups}, nil
+}
+
+func (f *fakeEC2) CreateSecurityGroupWithContext(_ aws.Context, _ *ec2.CreateSecurityGroupInput, _ ...request.Option) (*ec2.CreateSecurityGroupOutput, error) {
+	return &ec2.CreateSecurityGroupOutput{GroupId: aws.String("sg-new")}, nil
+}
+
+func (f *fakeEC2) CreateTagsWithContext(_ aws.Context, _ *ec2.CreateTagsInput, _ ...request.Option) (*ec2.CreateTagsOutput, error) {
+	return &ec2.CreateTagsOutput{}, nil
+}
+
+func (f *fakeEC2) AuthorizeSecurityGroupIngressWithContext(_ aws.Context, input *ec2.AuthorizeSecurityGroupIngressInput, _ ...request.Option) (*ec2.AuthorizeSecurityGroupIngressOutput, error) {
+	f.authorizedIngress = append(f.authorizedIngress, input.IpPermissions...)
+	return &ec2.AuthorizeSecurityGroupIngressOutput{}, nil
+}
+
+func (f *fakeEC2) AuthorizeSecurityGroupEgressWithContext(_ aws.Context, input *ec2.AuthorizeSecurityGroupEgressInput, _ ...request.Option) (*ec2.AuthorizeSecurityGroupEgressOutput, error) {
+	f.authorizedEgress = append(f.authorizedEgress, input.IpPermissions...)
+	return &ec2.AuthorizeSecurityGroupEgressOutput{}, nil
+}
+
+func (f *fakeEC2) RevokeSecurityGroupIngressWithContext(_ aws.Context, input *ec2.RevokeSecurityGroupIngressInput, _ ...request.Option) (*ec2.RevokeSecurityGroupIngressOutput, error) {
+	f.revokedIngress = append(f.revokedIngress, input.IpPermissions...)
+	return &ec2.RevokeSecurityGroupIngressOutput{}, nil
+}
+
+func (f *fakeEC2) RevokeSecurityGroupEgressWithContext(_ aws.Context, input *ec2.RevokeSecurityGroupEgressInput, _ ...request.Option) (*ec2.RevokeSecurityGroupEgressOutput, error) {
+	f.revokedEgress = append(f.revokedEgress, input.IpPermissions...)
+	return &ec2.RevokeSecurityGroupEgressOutput{}, nil
+}
+
+func cidrPermission(protocol string, fromPort, toPort int64, cidrBlock string) *ec2.IpPermission {
+	return &ec2.IpPermission{
+		IpProtocol: aws.String(protocol),
+		FromPort:   aws.Int64(fromPort),
+		ToPort:     aws.Int64(toPort),
+		IpRanges:   []*ec2.IpRange{{CidrIp: aws.String(cidrBlock)}},
+	}
+}
+
+func allTrafficPermission(cidrBlock string) *ec2.IpPermission {
+	return &ec2.IpPermission{
+		IpProtocol: aws.String("-1"),
+		IpRanges:   []*ec2.IpRange{{CidrIp: aws.String(cidrBlock)}},
+	}
+}
+
+var _ = Describe("Infrastructure", func() {
+	var (
+		ctx = context.TODO()
+
+		fake   *fakeEC2
+		client *Client
+
+		securityGroup *SecurityGroup
+	)
+
+	BeforeEach(func() {
+		fake = &fakeEC2{}
+		client = &Client{EC2: fake}
+
+		securityGroup = &SecurityGroup{
+			VPCID:       "vpc-1234",
+			Name:        "shoot--foo--bar-nodes",
+			Description: "Security group for nodes",
+			Rules: []SecurityGroupRule{
+				{Type: SecurityGroupRuleTypeIngress, Protocol: "tcp", FromPort: 30000, ToPort: 32767, CIDRBlocks: []string{"0.0.0.0/0", "10.250.0.0/16"}},
+				{Type: SecurityGroupRuleTypeEgress, Protocol: "-1", CIDRBlocks: []string{"0.0.0.0/0"}},
+			},
+		}
+	})
+
+	Describe("#EnsureSecurityGroup", func() {
+		It("should create the security group and authorize its rules", func() {
+			Expect(client.EnsureSecurityGroup(ctx, securityGroup)).To(Equal("sg-new"))
+
+			Expect(fake.authorizedIngress).To(ConsistOf(
+				cidrPermission("tcp", 30000, 32767, "0.0.0.0/0"),
+				cidrPermission("tcp", 30000, 32767, "10.250.0.0/16"),
+			))
+			Expect(fake.authorizedEgress).To(BeEmpty())
+			Expect(fake.revokedIngress).To(BeEmpty())
+			Expect(fake.revokedEgress).To(BeEmpty())
+		})
+
+		It("should authorize missing rules and revoke unwanted rules of an existing security group", func() {
+			fake.securityGroups = []*ec2.SecurityGroup{{
+				GroupId: aws.String("sg-1234"),
+				IpPermissions: []*ec2.IpPermission{
+					{
+						IpProtocol: aws.String("tcp"),
+						FromPort:   aws.Int64(30000),
+						ToPort:     aws.Int64(32767),
+						IpRanges: []*ec2.IpRange{
+							{CidrIp: aws.String("0.0.0.0/0")},
+							{CidrIp: aws.String("192.168.0.0/16")},
+						},
+					},
+					{
+						IpProtocol:       aws.String("tcp"),
+						FromPort:         aws.Int64(22),
+						ToPort:           aws.Int64(22),
+						UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: aws.String("sg-bastions")}},
+					},
+				},
+				IpPermissionsEgress: []*ec2.IpPermission{
+					allTrafficPermission("0.0.0.0/0"),
+					cidrPermission("udp", 53, 53, "10.0.0.0/8"),
+				},
+			}}
+
+			Expect(client.EnsureSecurityGroup(ctx, securityGroup)).To(Equal("sg-1234"))
+
+			Expect(fake.authorizedIngress).To(ConsistOf(cidrPermission("tcp", 30000, 32767, "10.250.0.0/16")))
+			Expect(fake.authorizedEgress).To(BeEmpty())
+			Expect(fake.revokedIngress).To(ConsistOf(
+				cidrPermission("tcp", 30000, 32767, "192.168.0.0/16"),
+				&ec2.IpPermission{
+					IpProtocol:       aws.String("tcp"),
+					FromPort:         aws.Int64(22),
+					ToPort:           aws.Int64(22),
+					UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: aws.String("sg-bastions")}},
+				},
+			))
+			Expect(fake.revokedEgress).To(ConsistOf(cidrPermission("udp", 53, 53, "10.0.0.0/8")))
+		})
+
+		It("should revoke the default egress rule of a new security group if it is not desired", func() {
+			securityGroup.Rules = securityGroup.Rules[:1]
+
+			Expect(client.EnsureSecurityGroup(ctx, securityGroup)).To(Equal("sg-new"))
+
+			Expect(fake.revokedEgress).To(ConsistOf(allTrafficPermission("0.0.0.0/0")))
+		})
+
+		It("should not change a security group whose rules are up to date", func() {
+			fake.securityGroups = []*ec2.SecurityGroup{{
+				GroupId: aws.String("sg-1234"),
+				IpPermissions: []*ec2.IpPermission{
+					cidrPermission("tcp", 30000, 32767, "0.0.0.0/0"),
+					cidrPermission("tcp", 30000, 32767, "10.250.0.0/16"),
+				},
+				IpPermissionsEgress: []*ec2.IpPermission{allTrafficPermission("0.0.0.0/0")},
+			}}
+
+			Expect(client.EnsureSecurityGroup(ctx, securityGroup)).To(Equal("sg-1234"))
+
+			Expect(fake.authorizedIngress).To(BeEmpty())
+			Expect(fake.authorizedEgress).To(BeEmpty())
+			Expect(fake.revokedIngress).To(BeEmpty())
+			Expect(fake.revokedEgress).To(BeEmpty())
+		})
+	})
+})
@@ -32,6 +32,16 @@ const (
 	//
 	// The specified bucket us exist.
 	errCodeBucketNotEmpty = "BucketNotEmpty"
+	// errCodeNoSuchEncryptionConfiguration is returned if a bucket has no default encryption configuration.
+	errCodeNoSuchEncryptionConfiguration = "ServerSideEncryptionConfigurationNotFoundError"
+	// errCodeNoSuchPublicAccessBlockConfiguration is returned if a bucket has no public access block configuration.
+	errCodeNoSuchPublicAccessBlockConfiguration = "NoSuchPublicAccessBlockConfiguration"
+
+	// maxDeleteBucketAttempts is the maximum number of attempts to empty and delete a bucket. Objects that are
+	// written concurrently may prevent the deletion of a bucket.
+	maxDeleteBucketAttempts = 3
+	// maxObjectsPerDeletion is the maximum number of objects that can be deleted with one request.
+	maxObjectsPerDeletion = 1000
 )
 
 // Interface is an interface which must be implemented by AWS clients.
@@ -43,22 +53,166 @@ type Interface interface {
 	DeleteObjectsWithPrefix(ctx context.Context, bucket, prefix string) error
 	CreateBucketIfNotExists(ctx context.Context, bucket, region string) error
 	DeleteBucketIfExists(ctx context.Context, bucket string) error
+	UpdateBucketConfig(ctx context.Context, bucket string, config *BucketConfig) error
 
 	// The following functions are only temporary needed due to https://github.com/gardener/gardener/issues/129.
 	ListKubernetesELBs(ctx context.Context, vpcID, clusterName string) ([]string, error)
 	ListKubernetesSecurityGroups(ctx context.Context, vpcID, clusterName string) ([]string, error)
 	DeleteELB(ctx context.Context, name string) error
 	DeleteSecurityGroup(ctx context.Context, id string) error
+
+	// EC2 infrastructure wrappers
+	FindVPCByTags(ctx context.Context, tags Tags) (string, error)
+	EnsureVPC(ctx context.Context, vpc *VPC) (string, error)
+	EnsureInternetGateway(ctx context.Context, vpcID string, tags Tags) (string, error)
+	EnsureSubnet(ctx context.Context, subnet *Subnet) (string, error)
+	EnsureElasticIP(ctx context.Context, tags Tags) (string, error)
+	EnsureNATGateway(ctx context.Context, subnetID, allocationID string, tags Tags) (string, error)
+	EnsureRouteTable(ctx context.Context, routeTable *RouteTable) (string, error)
+	EnsureSecurityGroup(ctx context.Context, securityGroup *SecurityGroup) (string, error)
+	EnsureKeyPair(ctx context.Context, name string, publicKey []byte) error
+	DeleteNATGateways(ctx context.Context, vpcID string, tags Tags) error
+	DeleteElasticIPs(ctx context.Context, tags Tags) error
+	DeleteRouteTables(ctx context.Context, vpcID string, tags Tags) error
+	DeleteSubnets(ctx context.Context, vpcID string, tags Tags) error
+	DeleteSecurityGroups(ctx context.Context, vpcID string, tags Tags) error
+	DeleteInternetGateway(ctx context.Context, vpcID string, tags Tags) error
+	DeleteVPC(ctx context.Context, vpcID string, tags Tags) error
+	DeleteKeyPair(ctx context.Context, name string) error
+
+	// IAM wrappers
+	EnsureIAMRole(ctx context.Context, role *Role) (string, error)
+	EnsureIAMInstanceProfile(ctx context.Context, name, roleName string) error
+	DeleteIAMInstanceProfile(ctx context.Context, name string) error
+	DeleteIAMRole(ctx context.Context, name, policyName string) error
+}
+
+// Tags are the tags of an AWS resource.
+type Tags map[string]string
+
+// VPC contains the desired configuration of an AWS VPC.
+type VPC struct {
+	// CIDRBlock is the CIDR block of the VPC.
+	CIDRBlock string
+	// DHCPDomainName is the domain name of the DHCP options associated with the VPC.
+	DHCPDomainName string
+	// Tags are the tags of the VPC and its DHCP options. They identify the VPC.
+	Tags Tags
+}
+
+// Subnet contains the desired configuration of an AWS subnet.
+type Subnet struct {
+	// VPCID is the ID of the VPC of the subnet.
+	VPCID string
+	// CIDRBlock is the CIDR block of the subnet. It identifies the subnet in the VPC.
+	CIDRBlock string
+	// AvailabilityZone is the availability zone of the subnet.
+	AvailabilityZone string
+	// Tags are the tags of the subnet.
+	Tags Tags
+}
+
+// RouteTable contains the desired configuration of an AWS route table.
+type RouteTable struct {
+	// VPCID is the ID of the VPC of the route table.
+	VPCID string
+	// Routes are the routes of the route table.
+	Routes []Route
+	// SubnetIDs are the IDs of the subnets that are associated with the route table.
+	SubnetIDs []string
+	// Tags are the tags of the route table. They identify the route table in the VPC.
+	Tags Tags
+}
+
+// Route is a route of an AWS route table. Exactly one of GatewayID and NATGatewayID must be set.
+type Route struct {
+	// DestinationCIDRBlock is the destination CIDR block of the route.
+	DestinationCIDRBlock string
+	// GatewayID is the ID of the internet gateway of the route.
+	GatewayID string
+	// NATGatewayID is the ID of the NAT gateway of the route.
+	NATGatewayID string
+}
+
+// SecurityGroup contains the desired configuration of an AWS security group.
+type SecurityGroup struct {
+	// VPCID is the ID of the VPC of the security group.
+	VPCID string
+	// Name is the name of the security group. It identifies the security group in the VPC.
+	Name string
+	// Description is the description of the security group.
+	Description string
+	// Rules are the rules of the security group.
+	Rules []SecurityGroupRule
+	// Tags are the tags of the security group.
+	Tags Tags
+}
+
+const (
+	// SecurityGroupRuleTypeIngress is the type of security group rules for incoming traffic.
+	SecurityGroupRuleTypeIngress = "ingress"
+	// SecurityGroupRuleTypeEgress is the type of security group rules for outgoing traffic.
+	SecurityGroupRuleTypeEgress = "egress"
+)
+
+// SecurityGroupRule is a rule of an AWS security group.
+type SecurityGroupRule struct {
+	// Type is the type of the rule, either ingress or egress.
+	Type string
+	// Protocol is the protocol of the rule, "-1" means all protocols.
+	Protocol string
+	// FromPort is the start of the port range of the rule.
+	FromPort int64
+	// ToPort is the end of the port range of the rule.
+	ToPort int64
+	// CIDRBlocks are the CIDR blocks the rule applies to.
+	CIDRBlocks []string
+	// Self indicates that the rule applies to the security group itself.
+	Self bool
+	// SourceSecurityGroupID is the ID of another security group the rule applies to.
+	SourceSecurityGroupID string
+}
+
+// BucketConfig contains the desired configuration of an S3 bucket.
+type BucketConfig struct {
+	// Encryption indicates whether default server-side encryption is enabled for the bucket.
+	Encryption bool
+	// KMSKeyID is the ID or ARN of the KMS key used for the default server-side encryption. If it is empty,
+	// S3-managed keys are used.
+	KMSKeyID string
+	// Versioning indicates whether object versioning is enabled for the bucket.
+	Versioning bool
+	// BlockPublicAccess indicates whether all public access to the bucket and its objects is blocked.
+	BlockPublicAccess bool
+	// ExpirationDays is the number of days after which objects and noncurrent object versions expire. Zero
+	// means that objects never expire.
+	ExpirationDays int64
+}
+
+// Role contains the desired configuration of an AWS IAM role with an inline policy.
+type Role struct {
+	// Name is the name of the role.
+	Name string
+	// Path is the path of the role.
+	Path string
+	// AssumeRolePolicyDocument is the trust policy of the role.
+	AssumeRolePolicyDocument string
+	// PolicyName is the name of the inline policy of the role.
+	PolicyName string
+	// PolicyDocument is the inline policy of the role.
+	PolicyDocument string
 }
 
 // Client is a struct containing several clients for the different AWS services it needs to interact with.
 // * EC2 is the standard client for the EC2 service.
 // * ELB is the standard client for the ELB service.
+// * IAM is the client for the IAM service.
 // * STS is the standard client for the STS service.
 // * S3 is the standard client for the S3 service.
 type Client struct {
 	EC2 ec2iface.EC2API
 	ELB elbiface.ELBAPI
+	IAM IAMAPI
 	STS stsiface.STSAPI
 	S3  s3iface.S3API
 }
@@ -32,6 +32,22 @@ const (
 	ETCDBackupRestoreImageName = "etcd-backup-restore"
 	// AWSLBReadvertiserImageName is the name of the AWSLBReadvertiser image.
 	AWSLBReadvertiserImageName = "aws-lb-readvertiser"
+	// CSIAttacherImageName is the name of the CSI attacher image.
+	CSIAttacherImageName = "csi-attacher"
+	// CSINodeDriverRegistrarImageName is the name of the CSI driver registrar image.
+	CSINodeDriverRegistrarImageName = "csi-node-driver-registrar"
+	// CSIProvisionerImageName is the name of the CSI provisioner image.
+	CSIProvisionerImageName = "csi-provisioner"
+	// CSISnapshotterImageName is the name of the CSI snapshotter image.
+	CSISnapshotterImageName = "csi-snapshotter"
+	// CSIDriverImageName is the name of the AWS EBS CSI driver image.
+	CSIDriverImageName = "aws-ebs-csi-driver"
+
+	// CSIMinimumKubernetesVersion is the minimum Kubernetes version for which the AWS EBS CSI driver can be deployed
+	// and the in-tree volume plugin can be migrated to it, see the storage section of the ControlPlaneConfig.
+	CSIMinimumKubernetesVersion = "1.14"
+	// CSIMigrationFeatureGate is the name of the feature gate that migrates the in-tree AWS EBS volume plugin to CSI.
+	CSIMigrationFeatureGate = "CSIMigrationAWS"
 
 	// AccessKeyID is a constant for the key in a cloud provider secret and backup secret that holds the AWS access key id.
 	AccessKeyID = "accessKeyID"
@@ -64,8 +80,14 @@ const (
 	// BastionsRole role for bastions
 	BastionsRole = "bastions_role_arn"
 
+	// AnnotationKeyUseNativeReconciler is the key of an annotation on Infrastructure or Shoot resources that selects the
+	// reconciler which manages the infrastructure directly via the AWS API instead of Terraform if its value is "true".
+	AnnotationKeyUseNativeReconciler = "aws.provider.extensions.gardener.cloud/use-native-reconciler"
+
 	// CloudProviderConfigName is the name of the configmap containing the cloud provider config.
 	CloudProviderConfigName = "cloud-provider-config"
+	// CloudControllerManagerName is a constant for the name of the cloud-controller-manager.
+	CloudControllerManagerName = "cloud-controller-manager"
 	// MachineControllerManagerName is a constant for the name of the machine-controller-manager.
 	MachineControllerManagerName = "machine-controller-manager"
 	// MachineControllerManagerVpaName is the name of the VerticalPodAutoscaler of the machine-controller-manager deployment.
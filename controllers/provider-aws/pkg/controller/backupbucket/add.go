@@ -17,6 +17,7 @@ package backupbucket
 import (
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
 	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket"
+	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
 
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -42,6 +43,7 @@ func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) error {
 		Actuator:          newActuator(),
 		ControllerOptions: opts.Controller,
 		Predicates:        backupbucket.DefaultPredicates(aws.Type, opts.IgnoreOperationAnnotation),
+		ErrorMatchers:     []controllererrors.Matcher{controllererrors.AWSMatcher},
 	})
 }
 
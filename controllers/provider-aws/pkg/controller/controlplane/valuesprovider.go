@@ -35,6 +35,7 @@ import (
 	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
@@ -47,6 +48,10 @@ const (
 	cloudControllerManagerDeploymentName = "cloud-controller-manager"
 	cloudControllerManagerServerName     = "cloud-controller-manager-server"
 	awsLBReadvertiserDeploymentName      = "aws-lb-readvertiser"
+	csiDriverControllerDeploymentName    = "csi-driver-controller"
+	csiAttacherName                      = "csi-attacher"
+	csiProvisionerName                   = "csi-provisioner"
+	csiSnapshotterName                   = "csi-snapshotter"
 )
 
 var controlPlaneSecrets = &secrets.Secrets{
@@ -72,6 +77,45 @@ var controlPlaneSecrets = &secrets.Secrets{
 					APIServerURL: v1alpha1constants.DeploymentNameKubeAPIServer,
 				},
 			},
+			&secrets.ControlPlaneSecretConfig{
+				CertificateSecretConfig: &secrets.CertificateSecretConfig{
+					Name:         csiAttacherName,
+					CommonName:   "system:csi-attacher",
+					Organization: []string{user.SystemPrivilegedGroup},
+					CertType:     secrets.ClientCert,
+					SigningCA:    cas[v1alpha1constants.SecretNameCACluster],
+				},
+				KubeConfigRequest: &secrets.KubeConfigRequest{
+					ClusterName:  clusterName,
+					APIServerURL: v1alpha1constants.DeploymentNameKubeAPIServer,
+				},
+			},
+			&secrets.ControlPlaneSecretConfig{
+				CertificateSecretConfig: &secrets.CertificateSecretConfig{
+					Name:         csiProvisionerName,
+					CommonName:   "system:csi-provisioner",
+					Organization: []string{user.SystemPrivilegedGroup},
+					CertType:     secrets.ClientCert,
+					SigningCA:    cas[v1alpha1constants.SecretNameCACluster],
+				},
+				KubeConfigRequest: &secrets.KubeConfigRequest{
+					ClusterName:  clusterName,
+					APIServerURL: v1alpha1constants.DeploymentNameKubeAPIServer,
+				},
+			},
+			&secrets.ControlPlaneSecretConfig{
+				CertificateSecretConfig: &secrets.CertificateSecretConfig{
+					Name:         csiSnapshotterName,
+					CommonName:   "system:csi-snapshotter",
+					Organization: []string{user.SystemPrivilegedGroup},
+					CertType:     secrets.ClientCert,
+					SigningCA:    cas[v1alpha1constants.SecretNameCACluster],
+				},
+				KubeConfigRequest: &secrets.KubeConfigRequest{
+					ClusterName:  clusterName,
+					APIServerURL: v1alpha1constants.DeploymentNameKubeAPIServer,
+				},
+			},
 			&secrets.ControlPlaneSecretConfig{
 				CertificateSecretConfig: &secrets.CertificateSecretConfig{
 					Name:       cloudControllerManagerServerName,
@@ -126,23 +170,61 @@ var configChart = &chart.Chart{
 	},
 }
 
-var ccmChart = &chart.Chart{
-	Name:   "cloud-controller-manager",
-	Path:   filepath.Join(aws.InternalChartsPath, "cloud-controller-manager"),
-	Images: []string{aws.HyperkubeImageName},
-	Objects: []*chart.Object{
-		{Type: &corev1.Service{}, Name: "cloud-controller-manager"},
-		{Type: &appsv1.Deployment{}, Name: "cloud-controller-manager"},
-		{Type: &corev1.ConfigMap{}, Name: "cloud-controller-manager-monitoring-config"},
+var controlPlaneChart = &chart.Chart{
+	Name: "seed-controlplane",
+	Path: filepath.Join(aws.InternalChartsPath, "seed-controlplane"),
+	SubCharts: []*chart.Chart{
+		{
+			Name:   "cloud-controller-manager",
+			Images: []string{aws.HyperkubeImageName},
+			Objects: []*chart.Object{
+				{Type: &corev1.Service{}, Name: "cloud-controller-manager"},
+				{Type: &appsv1.Deployment{}, Name: "cloud-controller-manager"},
+				{Type: &corev1.ConfigMap{}, Name: "cloud-controller-manager-monitoring-config"},
+			},
+		},
+		{
+			Name:   "csi-driver-controller",
+			Images: []string{aws.CSIDriverImageName, aws.CSIAttacherImageName, aws.CSIProvisionerImageName, aws.CSISnapshotterImageName},
+			Objects: []*chart.Object{
+				{Type: &appsv1.Deployment{}, Name: csiDriverControllerDeploymentName},
+			},
+		},
 	},
 }
 
-var ccmShootChart = &chart.Chart{
-	Name: "cloud-controller-manager-shoot",
-	Path: filepath.Join(aws.InternalChartsPath, "cloud-controller-manager-shoot"),
-	Objects: []*chart.Object{
-		{Type: &rbacv1.ClusterRole{}, Name: "system:controller:cloud-node-controller"},
-		{Type: &rbacv1.ClusterRoleBinding{}, Name: "system:controller:cloud-node-controller"},
+var controlPlaneShootChart = &chart.Chart{
+	Name: "shoot-system-components",
+	Path: filepath.Join(aws.InternalChartsPath, "shoot-system-components"),
+	SubCharts: []*chart.Chart{
+		{
+			Name: "cloud-controller-manager",
+			Objects: []*chart.Object{
+				{Type: &rbacv1.ClusterRole{}, Name: "system:controller:cloud-node-controller"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "system:controller:cloud-node-controller"},
+			},
+		},
+		{
+			Name:   "csi-driver-node",
+			Images: []string{aws.CSIDriverImageName, aws.CSINodeDriverRegistrarImageName},
+			Objects: []*chart.Object{
+				{Type: &appsv1.DaemonSet{}, Name: "csi-driver-node"},
+				{Type: &corev1.ServiceAccount{}, Name: "csi-driver-node"},
+				{Type: &rbacv1.ClusterRole{}, Name: "garden.sapcloud.io:psp:kube-system:csi-driver-node"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "garden.sapcloud.io:psp:csi-driver-node"},
+				{Type: &policyv1beta1.PodSecurityPolicy{}, Name: "gardener.kube-system.csi-driver-node"},
+				{Type: &rbacv1.ClusterRole{}, Name: "garden.sapcloud.io:kube-system:csi-attacher"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "garden.sapcloud.io:csi-attacher"},
+				{Type: &rbacv1.Role{}, Name: "csi-attacher"},
+				{Type: &rbacv1.RoleBinding{}, Name: "csi-attacher"},
+				{Type: &rbacv1.ClusterRole{}, Name: "garden.sapcloud.io:kube-system:csi-provisioner"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "garden.sapcloud.io:csi-provisioner"},
+				{Type: &rbacv1.Role{}, Name: "csi-provisioner"},
+				{Type: &rbacv1.RoleBinding{}, Name: "csi-provisioner"},
+				{Type: &rbacv1.ClusterRole{}, Name: "garden.sapcloud.io:kube-system:csi-snapshotter"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "garden.sapcloud.io:csi-snapshotter"},
+			},
+		},
 	},
 }
 
@@ -218,8 +300,46 @@ func (vp *valuesProvider) GetControlPlaneChartValues(
 		return nil, errors.Wrapf(err, "could not decode providerConfig of controlplane '%s'", util.ObjectName(cp))
 	}
 
-	// Get CCM chart values
-	return getCCMChartValues(cpConfig, cp, cluster, checksums, scaledDown)
+	// Get control plane chart values
+	return getControlPlaneChartValues(cpConfig, cp, cluster, checksums, scaledDown)
+}
+
+// GetControlPlaneShootChartValues returns the values for the control plane shoot chart applied by the generic actuator.
+func (vp *valuesProvider) GetControlPlaneShootChartValues(
+	ctx context.Context,
+	cp *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
+) (map[string]interface{}, error) {
+	// Decode providerConfig
+	cpConfig := &apisaws.ControlPlaneConfig{}
+	if _, _, err := vp.decoder.Decode(cp.Spec.ProviderConfig.Raw, nil, cpConfig); err != nil {
+		return nil, errors.Wrapf(err, "could not decode providerConfig of controlplane '%s'", util.ObjectName(cp))
+	}
+
+	// Get control plane shoot chart values
+	return getControlPlaneShootChartValues(cpConfig, cluster)
+}
+
+// GetStorageClassesChartValues returns the values for the storage classes chart applied by the generic actuator.
+func (vp *valuesProvider) GetStorageClassesChartValues(
+	ctx context.Context,
+	cp *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
+) (map[string]interface{}, error) {
+	// Decode providerConfig
+	cpConfig := &apisaws.ControlPlaneConfig{}
+	if _, _, err := vp.decoder.Decode(cp.Spec.ProviderConfig.Raw, nil, cpConfig); err != nil {
+		return nil, errors.Wrapf(err, "could not decode providerConfig of controlplane '%s'", util.ObjectName(cp))
+	}
+
+	csiEnabled, err := isCSIEnabled(cpConfig, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"csiEnabled": csiEnabled,
+	}, nil
 }
 
 // GetControlPlaneExposureChartValues deploys the aws-lb-readvertiser.
@@ -265,30 +385,74 @@ func getConfigChartValues(
 	}, nil
 }
 
-// getCCMChartValues collects and returns the CCM chart values.
-func getCCMChartValues(
+// getControlPlaneChartValues collects and returns the control plane chart values.
+func getControlPlaneChartValues(
 	cpConfig *apisaws.ControlPlaneConfig,
 	cp *extensionsv1alpha1.ControlPlane,
 	cluster *extensionscontroller.Cluster,
 	checksums map[string]string,
 	scaledDown bool,
 ) (map[string]interface{}, error) {
+	csiEnabled, err := isCSIEnabled(cpConfig, cluster)
+	if err != nil {
+		return nil, err
+	}
+
 	values := map[string]interface{}{
-		"replicas":          extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, 1),
-		"clusterName":       cp.Namespace,
-		"kubernetesVersion": extensionscontroller.GetKubernetesVersion(cluster),
-		"podNetwork":        extensionscontroller.GetPodNetwork(cluster),
-		"podAnnotations": map[string]interface{}{
-			"checksum/secret-cloud-controller-manager":        checksums[cloudControllerManagerDeploymentName],
-			"checksum/secret-cloud-controller-manager-server": checksums[cloudControllerManagerServerName],
-			"checksum/secret-cloudprovider":                   checksums[v1alpha1constants.SecretNameCloudProvider],
-			"checksum/configmap-cloud-provider-config":        checksums[aws.CloudProviderConfigName],
+		"cloud-controller-manager": map[string]interface{}{
+			"replicas":          extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, 1),
+			"clusterName":       cp.Namespace,
+			"kubernetesVersion": extensionscontroller.GetKubernetesVersion(cluster),
+			"podNetwork":        extensionscontroller.GetPodNetwork(cluster),
+			"podAnnotations": map[string]interface{}{
+				"checksum/secret-cloud-controller-manager":        checksums[cloudControllerManagerDeploymentName],
+				"checksum/secret-cloud-controller-manager-server": checksums[cloudControllerManagerServerName],
+				"checksum/secret-cloudprovider":                   checksums[v1alpha1constants.SecretNameCloudProvider],
+				"checksum/configmap-cloud-provider-config":        checksums[aws.CloudProviderConfigName],
+			},
+		},
+		"csi-driver-controller": map[string]interface{}{
+			"enabled":  csiEnabled,
+			"replicas": extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, 1),
+			"region":   cp.Spec.Region,
+			"podAnnotations": map[string]interface{}{
+				"checksum/secret-" + csiAttacherName:                           checksums[csiAttacherName],
+				"checksum/secret-" + csiProvisionerName:                        checksums[csiProvisionerName],
+				"checksum/secret-" + csiSnapshotterName:                        checksums[csiSnapshotterName],
+				"checksum/secret-" + v1alpha1constants.SecretNameCloudProvider: checksums[v1alpha1constants.SecretNameCloudProvider],
+			},
 		},
 	}
 
 	if cpConfig.CloudControllerManager != nil {
-		values["featureGates"] = cpConfig.CloudControllerManager.FeatureGates
+		values["cloud-controller-manager"].(map[string]interface{})["featureGates"] = cpConfig.CloudControllerManager.FeatureGates
 	}
 
 	return values, nil
 }
+
+// getControlPlaneShootChartValues collects and returns the control plane shoot chart values.
+func getControlPlaneShootChartValues(
+	cpConfig *apisaws.ControlPlaneConfig,
+	cluster *extensionscontroller.Cluster,
+) (map[string]interface{}, error) {
+	csiEnabled, err := isCSIEnabled(cpConfig, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"csi-driver-node": map[string]interface{}{
+			"enabled": csiEnabled,
+		},
+	}, nil
+}
+
+// isCSIEnabled returns whether the CSI driver is deployed, i.e. whether it is enabled in the control plane config and
+// supported by the Kubernetes version of the shoot.
+func isCSIEnabled(cpConfig *apisaws.ControlPlaneConfig, cluster *extensionscontroller.Cluster) (bool, error) {
+	if !helper.IsCSIDriverEnabled(cpConfig) {
+		return false, nil
+	}
+	return extensionscontroller.IsKubernetesVersionAtLeast(cluster, aws.CSIMinimumKubernetesVersion)
+}
@@ -55,6 +55,7 @@ var _ = Describe("ValuesProvider", func() {
 				Namespace: namespace,
 			},
 			Spec: extensionsv1alpha1.ControlPlaneSpec{
+				Region: "eu-west-1",
 				ProviderConfig: &runtime.RawExtension{
 					Raw: encode(&apisaws.ControlPlaneConfig{
 						CloudControllerManager: &apisaws.CloudControllerManagerConfig{
@@ -81,6 +82,23 @@ var _ = Describe("ValuesProvider", func() {
 			},
 		}
 
+		csiCP = &extensionsv1alpha1.ControlPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "control-plane",
+				Namespace: namespace,
+			},
+			Spec: extensionsv1alpha1.ControlPlaneSpec{
+				Region: "eu-west-1",
+				ProviderConfig: &runtime.RawExtension{
+					Raw: encode(&apisaws.ControlPlaneConfig{
+						Storage: &apisaws.Storage{
+							CSIDriver: true,
+						},
+					}),
+				},
+			},
+		}
+
 		cidr    = "10.250.0.0/19"
 		cluster = &extensionscontroller.Cluster{
 			CoreShoot: &gardencorev1alpha1.Shoot{
@@ -94,6 +112,18 @@ var _ = Describe("ValuesProvider", func() {
 				},
 			},
 		}
+		csiCluster = &extensionscontroller.Cluster{
+			CoreShoot: &gardencorev1alpha1.Shoot{
+				Spec: gardencorev1alpha1.ShootSpec{
+					Networking: gardencorev1alpha1.Networking{
+						Pods: &cidr,
+					},
+					Kubernetes: gardencorev1alpha1.Kubernetes{
+						Version: "1.15.2",
+					},
+				},
+			},
+		}
 		cpService = &corev1.Service{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      v1alpha1constants.DeploymentNameKubeAPIServer,
@@ -114,6 +144,9 @@ var _ = Describe("ValuesProvider", func() {
 			"cloud-controller-manager":                "3d791b164a808638da9a8df03924be2a41e34cd664e42231c00fe369e3588272",
 			"cloud-controller-manager-server":         "6dff2a2e6f14444b66d8e4a351c049f7e89ee24ba3eaab95dbec40ba6bdebb52",
 			awsLBReadvertiserDeploymentName:           "599aeee0cbbfdab4ea29c642cb04a6c9a3eb90ec21b41570efb987958f99d4b1",
+			"csi-attacher":                            "2da58ad61c401a2af779a909d22fb42eed93a1524cbfdab974ceedb413fcb914",
+			"csi-provisioner":                         "f75b42d40ab501428c383dfb2336cb1fc892bbee1fc1d739675171e4acc4d911",
+			"csi-snapshotter":                         "bf417a5f8a1f9a7e2fe2ee7d3cc4d7a3f6cd8d0e3f1bbbd5cb1c36d7c6e2ee0c",
 		}
 
 		configChartValues = map[string]interface{}{
@@ -123,22 +156,45 @@ var _ = Describe("ValuesProvider", func() {
 			"zone":        "eu-west-1a",
 		}
 
-		ccmChartValues = map[string]interface{}{
-			"replicas":          1,
-			"clusterName":       namespace,
-			"kubernetesVersion": "1.13.4",
-			"podNetwork":        cidr,
-			"podAnnotations": map[string]interface{}{
-				"checksum/secret-cloud-controller-manager":        "3d791b164a808638da9a8df03924be2a41e34cd664e42231c00fe369e3588272",
-				"checksum/secret-cloud-controller-manager-server": "6dff2a2e6f14444b66d8e4a351c049f7e89ee24ba3eaab95dbec40ba6bdebb52",
-				"checksum/secret-cloudprovider":                   "8bafb35ff1ac60275d62e1cbd495aceb511fb354f74a20f7d06ecb48b3a68432",
-				"checksum/configmap-cloud-provider-config":        "08a7bc7fe8f59b055f173145e211760a83f02cf89635cef26ebb351378635606",
+		controlPlaneChartValues = map[string]interface{}{
+			"cloud-controller-manager": map[string]interface{}{
+				"replicas":          1,
+				"clusterName":       namespace,
+				"kubernetesVersion": "1.13.4",
+				"podNetwork":        cidr,
+				"podAnnotations": map[string]interface{}{
+					"checksum/secret-cloud-controller-manager":        "3d791b164a808638da9a8df03924be2a41e34cd664e42231c00fe369e3588272",
+					"checksum/secret-cloud-controller-manager-server": "6dff2a2e6f14444b66d8e4a351c049f7e89ee24ba3eaab95dbec40ba6bdebb52",
+					"checksum/secret-cloudprovider":                   "8bafb35ff1ac60275d62e1cbd495aceb511fb354f74a20f7d06ecb48b3a68432",
+					"checksum/configmap-cloud-provider-config":        "08a7bc7fe8f59b055f173145e211760a83f02cf89635cef26ebb351378635606",
+				},
+				"featureGates": map[string]bool{
+					"CustomResourceValidation": true,
+				},
 			},
-			"featureGates": map[string]bool{
-				"CustomResourceValidation": true,
+			"csi-driver-controller": map[string]interface{}{
+				"enabled":  false,
+				"replicas": 1,
+				"region":   "eu-west-1",
+				"podAnnotations": map[string]interface{}{
+					"checksum/secret-csi-attacher":    "2da58ad61c401a2af779a909d22fb42eed93a1524cbfdab974ceedb413fcb914",
+					"checksum/secret-csi-provisioner": "f75b42d40ab501428c383dfb2336cb1fc892bbee1fc1d739675171e4acc4d911",
+					"checksum/secret-csi-snapshotter": "bf417a5f8a1f9a7e2fe2ee7d3cc4d7a3f6cd8d0e3f1bbbd5cb1c36d7c6e2ee0c",
+					"checksum/secret-cloudprovider":   "8bafb35ff1ac60275d62e1cbd495aceb511fb354f74a20f7d06ecb48b3a68432",
+				},
 			},
 		}
 
+		controlPlaneShootChartValues = map[string]interface{}{
+			"csi-driver-node": map[string]interface{}{
+				"enabled": false,
+			},
+		}
+
+		storageClassesChartValues = map[string]interface{}{
+			"csiEnabled": false,
+		}
+
 		cpExposureChartValues = map[string]interface{}{
 			"domain":   "10.10.10.1",
 			"replicas": 1,
@@ -182,7 +238,77 @@ var _ = Describe("ValuesProvider", func() {
 			// Call GetControlPlaneChartValues method and check the result
 			values, err := vp.GetControlPlaneChartValues(context.TODO(), cp, cluster, checksums, false)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(values).To(Equal(ccmChartValues))
+			Expect(values).To(Equal(controlPlaneChartValues))
+		})
+	})
+
+	Describe("#GetControlPlaneShootChartValues", func() {
+		It("should return correct control plane shoot chart values", func() {
+			// Create valuesProvider
+			vp := NewValuesProvider(logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetControlPlaneShootChartValues method and check the result
+			values, err := vp.GetControlPlaneShootChartValues(context.TODO(), cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(controlPlaneShootChartValues))
+		})
+
+		It("should enable the CSI node plugin if the CSI driver is enabled", func() {
+			// Create valuesProvider
+			vp := NewValuesProvider(logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetControlPlaneShootChartValues method and check the result
+			values, err := vp.GetControlPlaneShootChartValues(context.TODO(), csiCP, csiCluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"csi-driver-node": map[string]interface{}{
+					"enabled": true,
+				},
+			}))
+		})
+	})
+
+	Describe("#GetStorageClassesChartValues", func() {
+		It("should return correct storage classes chart values", func() {
+			// Create valuesProvider
+			vp := NewValuesProvider(logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetStorageClassesChartValues method and check the result
+			values, err := vp.GetStorageClassesChartValues(context.TODO(), cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(storageClassesChartValues))
+		})
+
+		It("should not enable the CSI storage classes if the CSI driver is not enabled", func() {
+			// Create valuesProvider
+			vp := NewValuesProvider(logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetStorageClassesChartValues method and check the result
+			values, err := vp.GetStorageClassesChartValues(context.TODO(), cp, csiCluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(storageClassesChartValues))
+		})
+
+		It("should enable the CSI storage classes if the CSI driver is enabled", func() {
+			// Create valuesProvider
+			vp := NewValuesProvider(logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetStorageClassesChartValues method and check the result
+			values, err := vp.GetStorageClassesChartValues(context.TODO(), csiCP, csiCluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"csiEnabled": true,
+			}))
 		})
 	})
 
@@ -19,10 +19,12 @@ import (
 	"fmt"
 	"time"
 
+	awsapi "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
 	awsclient "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws/client"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -36,11 +38,19 @@ import (
 )
 
 func (a *actuator) delete(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	if useNativeReconciler(infrastructure, cluster) {
+		return a.deleteNative(ctx, infrastructure)
+	}
+
 	tf, err := a.newTerraformer(aws.TerraformerPurposeInfra, infrastructure.Namespace, infrastructure.Name)
 	if err != nil {
 		return fmt.Errorf("could not create the Terraformer: %+v", err)
 	}
 
+	if err := extensionsterraformer.RestoreInfrastructureState(ctx, a.client, infrastructure, aws.TerraformerPurposeInfra); err != nil {
+		return fmt.Errorf("could not restore Terraform state: %+v", err)
+	}
+
 	configExists, err := tf.ConfigExists()
 	if err != nil {
 		return fmt.Errorf("terraform configuration was not found: %+v", err)
@@ -61,6 +71,26 @@ func (a *actuator) delete(ctx context.Context, infrastructure *extensionsv1alpha
 		return err
 	}
 
+	// The Terraform configuration is missing if the state has been restored from the status, e.g. after the seed
+	// namespace has been recreated. It has to be rendered again so that the infrastructure can be destroyed.
+	// Without a provider config there is nothing to render, hence the Terraformer is left without a configuration.
+	if !configExists && infrastructure.Spec.ProviderConfig != nil && len(infrastructure.Spec.ProviderConfig.Raw) > 0 {
+		infrastructureConfig := &awsapi.InfrastructureConfig{}
+		if _, _, err := a.decoder.Decode(infrastructure.Spec.ProviderConfig.Raw, nil, infrastructureConfig); err != nil {
+			return fmt.Errorf("could not decode provider config: %+v", err)
+		}
+
+		initializer, err := a.newInitializer(ctx, infrastructure, infrastructureConfig, providerSecret)
+		if err != nil {
+			return err
+		}
+		tf.InitializeWith(initializer)
+
+		if configExists, err = tf.ConfigExists(); err != nil {
+			return fmt.Errorf("terraform configuration was not found: %+v", err)
+		}
+	}
+
 	awsClient, err := awsclient.NewClient(string(providerSecret.Data[aws.AccessKeyID]), string(providerSecret.Data[aws.SecretAccessKey]), infrastructure.Spec.Region)
 	if err != nil {
 		return err
@@ -72,7 +102,7 @@ func (a *actuator) delete(ctx context.Context, infrastructure *extensionsv1alpha
 		destroyKubernetesLoadBalancersAndSecurityGroups = g.Add(flow.Task{
 			Name: "Destroying Kubernetes load balancers and security groups",
 			Fn: flow.TaskFn(func(ctx context.Context) error {
-				if err := a.destroyKubernetesLoadBalancersAndSecurityGroups(ctx, awsClient, vpcID, infrastructure.Namespace); err != nil {
+				if err := destroyKubernetesLoadBalancersAndSecurityGroups(ctx, awsClient, vpcID, infrastructure.Namespace); err != nil {
 					return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("Failed to destroy load balancers and security groups: %+v", err.Error()))
 				}
 				return nil
@@ -80,8 +110,10 @@ func (a *actuator) delete(ctx context.Context, infrastructure *extensionsv1alpha
 		})
 
 		_ = g.Add(flow.Task{
-			Name:         "Destroying Shoot infrastructure",
-			Fn:           flow.SimpleTaskFn(tf.SetVariablesEnvironment(generateTerraformInfraVariablesEnvironment(providerSecret)).Destroy),
+			Name: "Destroying Shoot infrastructure",
+			Fn: flow.SimpleTaskFn(func() error {
+				return tf.SetVariablesEnvironment(generateTerraformInfraVariablesEnvironment(providerSecret)).Destroy()
+			}),
 			Dependencies: flow.NewTaskIDs(destroyKubernetesLoadBalancersAndSecurityGroups),
 		})
 
@@ -98,7 +130,7 @@ func (a *actuator) delete(ctx context.Context, infrastructure *extensionsv1alpha
 	return nil
 }
 
-func (a *actuator) destroyKubernetesLoadBalancersAndSecurityGroups(ctx context.Context, awsClient awsclient.Interface, vpcID, clusterName string) error {
+func destroyKubernetesLoadBalancersAndSecurityGroups(ctx context.Context, awsClient awsclient.Interface, vpcID, clusterName string) error {
 	loadBalancers, err := awsClient.ListKubernetesELBs(ctx, vpcID, clusterName)
 	if err != nil {
 		return err
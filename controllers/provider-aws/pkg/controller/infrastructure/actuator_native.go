@@ -0,0 +1,418 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	awsapi "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	awsv1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/v1alpha1"
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws/client"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	allIPv4CIDRBlock = "0.0.0.0/0"
+
+	assumeRolePolicyDocument = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "ec2.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}`
+
+	bastionsPolicyDocument = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "ec2:DescribeRegions"
+      ],
+      "Resource": [
+        "*"
+      ]
+    }
+  ]
+}`
+
+	nodesPolicyDocument = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "ec2:DescribeInstances"
+      ],
+      "Resource": [
+        "*"
+      ]
+    },
+    {
+      "Effect": "Allow",
+      "Action": [
+        "ecr:GetAuthorizationToken",
+        "ecr:BatchCheckLayerAvailability",
+        "ecr:GetDownloadUrlForLayer",
+        "ecr:GetRepositoryPolicy",
+        "ecr:DescribeRepositories",
+        "ecr:ListImages",
+        "ecr:BatchGetImage"
+      ],
+      "Resource": [
+        "*"
+      ]
+    }
+  ]
+}`
+)
+
+func useNativeReconciler(infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) bool {
+	if kutil.HasMetaDataAnnotation(infrastructure, aws.AnnotationKeyUseNativeReconciler, "true") {
+		return true
+	}
+	return cluster != nil && cluster.Shoot != nil && kutil.HasMetaDataAnnotation(cluster.Shoot, aws.AnnotationKeyUseNativeReconciler, "true")
+}
+
+func (a *actuator) reconcileNative(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, infrastructureConfig *awsapi.InfrastructureConfig, providerSecret *corev1.Secret) error {
+	awsClient, err := awsclient.NewClient(string(providerSecret.Data[aws.AccessKeyID]), string(providerSecret.Data[aws.SecretAccessKey]), infrastructure.Spec.Region)
+	if err != nil {
+		return err
+	}
+
+	status, err := ReconcileNative(ctx, awsClient, infrastructure, infrastructureConfig)
+	if err != nil {
+		a.logger.Error(err, "failed to reconcile the infrastructure natively", "infrastructure", infrastructure.Name)
+		return &controllererrors.RequeueAfterError{
+			Cause:        err,
+			RequeueAfter: 30 * time.Second,
+		}
+	}
+
+	return a.setProviderStatus(ctx, infrastructure, status)
+}
+
+func (a *actuator) deleteNative(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure) error {
+	infrastructureConfig := &awsapi.InfrastructureConfig{}
+	if _, _, err := a.decoder.Decode(infrastructure.Spec.ProviderConfig.Raw, nil, infrastructureConfig); err != nil {
+		return fmt.Errorf("could not decode provider config: %+v", err)
+	}
+
+	providerSecret := &corev1.Secret{}
+	if err := a.client.Get(ctx, kutil.Key(infrastructure.Spec.SecretRef.Namespace, infrastructure.Spec.SecretRef.Name), providerSecret); err != nil {
+		return err
+	}
+
+	awsClient, err := awsclient.NewClient(string(providerSecret.Data[aws.AccessKeyID]), string(providerSecret.Data[aws.SecretAccessKey]), infrastructure.Spec.Region)
+	if err != nil {
+		return err
+	}
+
+	if err := DeleteNative(ctx, awsClient, infrastructure, infrastructureConfig); err != nil {
+		a.logger.Error(err, "failed to delete the infrastructure natively", "infrastructure", infrastructure.Name)
+		return &controllererrors.RequeueAfterError{
+			Cause:        err,
+			RequeueAfter: 30 * time.Second,
+		}
+	}
+
+	// The infrastructure may have been created by Terraform before the native reconciler has been selected, hence
+	// the Terraform configuration is cleaned up as well.
+	tf, err := a.newTerraformer(aws.TerraformerPurposeInfra, infrastructure.Namespace, infrastructure.Name)
+	if err != nil {
+		return fmt.Errorf("could not create the Terraformer: %+v", err)
+	}
+	return tf.CleanupConfiguration(ctx)
+}
+
+// ReconcileNative creates or updates the AWS infrastructure of the given Infrastructure resource with the given
+// client and returns its provider status. The resources are named and tagged like the ones of the Terraform chart so
+// that infrastructures created by Terraform are adopted.
+func ReconcileNative(ctx context.Context, awsClient awsclient.Interface, infrastructure *extensionsv1alpha1.Infrastructure, infrastructureConfig *awsapi.InfrastructureConfig) (*awsv1alpha1.InfrastructureStatus, error) {
+	var (
+		clusterName = infrastructure.Namespace
+		output      = map[string]string{}
+
+		vpcID, internetGatewayID string
+		err                      error
+	)
+
+	switch {
+	case infrastructureConfig.Networks.VPC.ID != nil:
+		vpcID = *infrastructureConfig.Networks.VPC.ID
+		if internetGatewayID, err = awsClient.GetInternetGateway(ctx, vpcID); err != nil {
+			return nil, err
+		}
+	case infrastructureConfig.Networks.VPC.CIDR != nil:
+		if vpcID, err = awsClient.EnsureVPC(ctx, &awsclient.VPC{
+			CIDRBlock:      *infrastructureConfig.Networks.VPC.CIDR,
+			DHCPDomainName: dhcpDomainName(infrastructure.Spec.Region),
+			Tags:           commonTags(clusterName),
+		}); err != nil {
+			return nil, fmt.Errorf("could not ensure VPC: %+v", err)
+		}
+		if internetGatewayID, err = awsClient.EnsureInternetGateway(ctx, vpcID, commonTags(clusterName)); err != nil {
+			return nil, fmt.Errorf("could not ensure internet gateway: %+v", err)
+		}
+	default:
+		return nil, fmt.Errorf("neither VPC id nor VPC CIDR is specified")
+	}
+	output[aws.VPCIDKey] = vpcID
+
+	bastionsSecurityGroupID, err := awsClient.EnsureSecurityGroup(ctx, &awsclient.SecurityGroup{
+		VPCID:       vpcID,
+		Name:        fmt.Sprintf("%s-bastions", clusterName),
+		Description: "Security group for bastions",
+		Rules: []awsclient.SecurityGroupRule{
+			{Type: awsclient.SecurityGroupRuleTypeIngress, Protocol: "tcp", FromPort: 22, ToPort: 22, CIDRBlocks: []string{allIPv4CIDRBlock}},
+			{Type: awsclient.SecurityGroupRuleTypeEgress, Protocol: "-1", CIDRBlocks: []string{allIPv4CIDRBlock}},
+		},
+		Tags: tagsWithSuffix(clusterName, "bastions"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not ensure bastions security group: %+v", err)
+	}
+
+	nodesSecurityGroupRules := []awsclient.SecurityGroupRule{
+		{Type: awsclient.SecurityGroupRuleTypeIngress, Protocol: "-1", Self: true},
+		{Type: awsclient.SecurityGroupRuleTypeIngress, Protocol: "tcp", FromPort: 30000, ToPort: 32767, CIDRBlocks: []string{allIPv4CIDRBlock}},
+		{Type: awsclient.SecurityGroupRuleTypeIngress, Protocol: "udp", FromPort: 30000, ToPort: 32767, CIDRBlocks: []string{allIPv4CIDRBlock}},
+		{Type: awsclient.SecurityGroupRuleTypeIngress, Protocol: "tcp", FromPort: 22, ToPort: 22, SourceSecurityGroupID: bastionsSecurityGroupID},
+		{Type: awsclient.SecurityGroupRuleTypeEgress, Protocol: "-1", CIDRBlocks: []string{allIPv4CIDRBlock}},
+	}
+	for _, zone := range infrastructureConfig.Networks.Zones {
+		for _, cidrBlock := range []string{zone.Internal, zone.Public} {
+			nodesSecurityGroupRules = append(nodesSecurityGroupRules,
+				awsclient.SecurityGroupRule{Type: awsclient.SecurityGroupRuleTypeIngress, Protocol: "tcp", FromPort: 30000, ToPort: 32767, CIDRBlocks: []string{cidrBlock}},
+				awsclient.SecurityGroupRule{Type: awsclient.SecurityGroupRuleTypeIngress, Protocol: "udp", FromPort: 30000, ToPort: 32767, CIDRBlocks: []string{cidrBlock}},
+			)
+		}
+	}
+	if output[aws.SecurityGroupsNodes], err = awsClient.EnsureSecurityGroup(ctx, &awsclient.SecurityGroup{
+		VPCID:       vpcID,
+		Name:        fmt.Sprintf("%s-nodes", clusterName),
+		Description: "Security group for nodes",
+		Rules:       nodesSecurityGroupRules,
+		Tags:        tagsWithSuffix(clusterName, "nodes"),
+	}); err != nil {
+		return nil, fmt.Errorf("could not ensure nodes security group: %+v", err)
+	}
+
+	var publicSubnetIDs []string
+	for zoneIndex, zone := range infrastructureConfig.Networks.Zones {
+		nodesSubnetID, err := awsClient.EnsureSubnet(ctx, &awsclient.Subnet{
+			VPCID:            vpcID,
+			CIDRBlock:        zone.Workers,
+			AvailabilityZone: zone.Name,
+			Tags:             tagsWithSuffix(clusterName, fmt.Sprintf("nodes-z%d", zoneIndex)),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not ensure nodes subnet in zone %s: %+v", zone.Name, err)
+		}
+		output[fmt.Sprintf("%s%d", aws.SubnetNodesPrefix, zoneIndex)] = nodesSubnetID
+
+		privateUtilitySubnetTags := tagsWithSuffix(clusterName, fmt.Sprintf("private-utility-z%d", zoneIndex))
+		privateUtilitySubnetTags["kubernetes.io/role/internal-elb"] = "use"
+		privateUtilitySubnetID, err := awsClient.EnsureSubnet(ctx, &awsclient.Subnet{
+			VPCID:            vpcID,
+			CIDRBlock:        zone.Internal,
+			AvailabilityZone: zone.Name,
+			Tags:             privateUtilitySubnetTags,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not ensure private utility subnet in zone %s: %+v", zone.Name, err)
+		}
+
+		publicUtilitySubnetTags := tagsWithSuffix(clusterName, fmt.Sprintf("public-utility-z%d", zoneIndex))
+		publicUtilitySubnetTags["kubernetes.io/role/elb"] = "use"
+		publicUtilitySubnetID, err := awsClient.EnsureSubnet(ctx, &awsclient.Subnet{
+			VPCID:            vpcID,
+			CIDRBlock:        zone.Public,
+			AvailabilityZone: zone.Name,
+			Tags:             publicUtilitySubnetTags,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not ensure public utility subnet in zone %s: %+v", zone.Name, err)
+		}
+		output[fmt.Sprintf("%s%d", aws.SubnetPublicPrefix, zoneIndex)] = publicUtilitySubnetID
+		publicSubnetIDs = append(publicSubnetIDs, publicUtilitySubnetID)
+
+		allocationID, err := awsClient.EnsureElasticIP(ctx, tagsWithSuffix(clusterName, fmt.Sprintf("eip-natgw-z%d", zoneIndex)))
+		if err != nil {
+			return nil, fmt.Errorf("could not ensure elastic IP in zone %s: %+v", zone.Name, err)
+		}
+
+		natGatewayID, err := awsClient.EnsureNATGateway(ctx, publicUtilitySubnetID, allocationID, tagsWithSuffix(clusterName, fmt.Sprintf("natgw-z%d", zoneIndex)))
+		if err != nil {
+			return nil, fmt.Errorf("could not ensure NAT gateway in zone %s: %+v", zone.Name, err)
+		}
+
+		if _, err := awsClient.EnsureRouteTable(ctx, &awsclient.RouteTable{
+			VPCID:     vpcID,
+			Routes:    []awsclient.Route{{DestinationCIDRBlock: allIPv4CIDRBlock, NATGatewayID: natGatewayID}},
+			SubnetIDs: []string{privateUtilitySubnetID, nodesSubnetID},
+			Tags:      tagsWithSuffix(clusterName, fmt.Sprintf("private-%s", zone.Name)),
+		}); err != nil {
+			return nil, fmt.Errorf("could not ensure private route table in zone %s: %+v", zone.Name, err)
+		}
+	}
+
+	if _, err := awsClient.EnsureRouteTable(ctx, &awsclient.RouteTable{
+		VPCID:     vpcID,
+		Routes:    []awsclient.Route{{DestinationCIDRBlock: allIPv4CIDRBlock, GatewayID: internetGatewayID}},
+		SubnetIDs: publicSubnetIDs,
+		Tags:      commonTags(clusterName),
+	}); err != nil {
+		return nil, fmt.Errorf("could not ensure main route table: %+v", err)
+	}
+
+	for _, purpose := range []string{"bastions", "nodes"} {
+		name := fmt.Sprintf("%s-%s", clusterName, purpose)
+		policyDocument := bastionsPolicyDocument
+		if purpose == awsapi.PurposeNodes {
+			policyDocument = nodesPolicyDocument
+		}
+
+		arn, err := awsClient.EnsureIAMRole(ctx, &awsclient.Role{
+			Name:                     name,
+			Path:                     "/",
+			AssumeRolePolicyDocument: assumeRolePolicyDocument,
+			PolicyName:               name,
+			PolicyDocument:           policyDocument,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not ensure IAM role %s: %+v", name, err)
+		}
+		if err := awsClient.EnsureIAMInstanceProfile(ctx, name, name); err != nil {
+			return nil, fmt.Errorf("could not ensure IAM instance profile %s: %+v", name, err)
+		}
+
+		if purpose == awsapi.PurposeNodes {
+			output[aws.NodesRole] = arn
+			output[aws.IAMInstanceProfileNodes] = name
+		}
+	}
+
+	keyName := keyPairName(clusterName)
+	if err := awsClient.EnsureKeyPair(ctx, keyName, infrastructure.Spec.SSHPublicKey); err != nil {
+		return nil, fmt.Errorf("could not ensure key pair: %+v", err)
+	}
+	output[aws.SSHKeyName] = keyName
+
+	return computeProviderStatus(infrastructureConfig, output)
+}
+
+// DeleteNative deletes the AWS infrastructure of the given Infrastructure resource with the given client. Only
+// resources tagged for the cluster are deleted, i.e., a VPC that has not been created for the cluster is kept.
+func DeleteNative(ctx context.Context, awsClient awsclient.Interface, infrastructure *extensionsv1alpha1.Infrastructure, infrastructureConfig *awsapi.InfrastructureConfig) error {
+	var (
+		clusterName = infrastructure.Namespace
+		clusterTags = awsclient.Tags{clusterTagKey(clusterName): "1"}
+
+		vpcID string
+		err   error
+	)
+
+	if infrastructureConfig.Networks.VPC.ID != nil {
+		vpcID = *infrastructureConfig.Networks.VPC.ID
+	} else if vpcID, err = awsClient.FindVPCByTags(ctx, commonTags(clusterName)); err != nil {
+		return err
+	}
+
+	if len(vpcID) > 0 {
+		if err := destroyKubernetesLoadBalancersAndSecurityGroups(ctx, awsClient, vpcID, clusterName); err != nil {
+			return fmt.Errorf("could not destroy load balancers and security groups: %+v", err)
+		}
+		if err := awsClient.DeleteNATGateways(ctx, vpcID, clusterTags); err != nil {
+			return fmt.Errorf("could not delete NAT gateways: %+v", err)
+		}
+	}
+	if err := awsClient.DeleteElasticIPs(ctx, clusterTags); err != nil {
+		return fmt.Errorf("could not delete elastic IPs: %+v", err)
+	}
+	if len(vpcID) > 0 {
+		if err := awsClient.DeleteRouteTables(ctx, vpcID, clusterTags); err != nil {
+			return fmt.Errorf("could not delete route tables: %+v", err)
+		}
+		if err := awsClient.DeleteSubnets(ctx, vpcID, clusterTags); err != nil {
+			return fmt.Errorf("could not delete subnets: %+v", err)
+		}
+		if err := awsClient.DeleteSecurityGroups(ctx, vpcID, clusterTags); err != nil {
+			return fmt.Errorf("could not delete security groups: %+v", err)
+		}
+		if err := awsClient.DeleteInternetGateway(ctx, vpcID, commonTags(clusterName)); err != nil {
+			return fmt.Errorf("could not delete internet gateway: %+v", err)
+		}
+		if err := awsClient.DeleteVPC(ctx, vpcID, commonTags(clusterName)); err != nil {
+			return fmt.Errorf("could not delete VPC: %+v", err)
+		}
+	}
+
+	for _, purpose := range []string{"bastions", "nodes"} {
+		name := fmt.Sprintf("%s-%s", clusterName, purpose)
+		if err := awsClient.DeleteIAMInstanceProfile(ctx, name); err != nil {
+			return fmt.Errorf("could not delete IAM instance profile %s: %+v", name, err)
+		}
+		if err := awsClient.DeleteIAMRole(ctx, name, name); err != nil {
+			return fmt.Errorf("could not delete IAM role %s: %+v", name, err)
+		}
+	}
+
+	return awsClient.DeleteKeyPair(ctx, keyPairName(clusterName))
+}
+
+func dhcpDomainName(region string) string {
+	if region == "us-east-1" {
+		return "ec2.internal"
+	}
+	return fmt.Sprintf("%s.compute.internal", region)
+}
+
+func keyPairName(clusterName string) string {
+	return fmt.Sprintf("%s-ssh-publickey", clusterName)
+}
+
+func clusterTagKey(clusterName string) string {
+	return fmt.Sprintf("kubernetes.io/cluster/%s", clusterName)
+}
+
+func commonTags(clusterName string) awsclient.Tags {
+	return awsclient.Tags{
+		"Name":                     clusterName,
+		clusterTagKey(clusterName): "1",
+	}
+}
+
+func tagsWithSuffix(clusterName, suffix string) awsclient.Tags {
+	return awsclient.Tags{
+		"Name":                     fmt.Sprintf("%s-%s", clusterName, suffix),
+		clusterTagKey(clusterName): "1",
+	}
+}
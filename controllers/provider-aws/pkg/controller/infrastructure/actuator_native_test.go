@@ -0,0 +1,254 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure_test
+
+import (
+	"context"
+	"fmt"
+
+	awsapi "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	awsv1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/v1alpha1"
+	awsclient "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws/client"
+	. "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/controller/infrastructure"
+	mockawsclient "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/mock/provider-aws/aws/client"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Native", func() {
+	var (
+		ctrl      *gomock.Controller
+		awsClient *mockawsclient.MockInterface
+
+		ctx         = context.TODO()
+		clusterName = "shoot--foo--bar"
+		clusterTag  = fmt.Sprintf("kubernetes.io/cluster/%s", clusterName)
+		commonTags  = awsclient.Tags{"Name": clusterName, clusterTag: "1"}
+
+		infrastructure       *extensionsv1alpha1.Infrastructure
+		infrastructureConfig *awsapi.InfrastructureConfig
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		awsClient = mockawsclient.NewMockInterface(ctrl)
+
+		vpcCIDR := "10.250.0.0/16"
+		infrastructure = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Namespace: clusterName, Name: "infrastructure"},
+			Spec: extensionsv1alpha1.InfrastructureSpec{
+				Region:       "eu-west-1",
+				SSHPublicKey: []byte("ssh-rsa AAAA"),
+			},
+		}
+		infrastructureConfig = &awsapi.InfrastructureConfig{
+			Networks: awsapi.Networks{
+				VPC: awsapi.VPC{CIDR: &vpcCIDR},
+				Zones: []awsapi.Zone{
+					{
+						Name:     "eu-west-1a",
+						Workers:  "10.250.0.0/19",
+						Public:   "10.250.96.0/22",
+						Internal: "10.250.112.0/22",
+					},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#ReconcileNative", func() {
+		It("should create the infrastructure and compute the provider status", func() {
+			var (
+				vpcID             = "vpc-1"
+				internetGatewayID = "igw-1"
+				bastionsGroupID   = "sg-bastions"
+				nodesGroupID      = "sg-nodes"
+				nodesSubnetID     = "subnet-nodes"
+				privateSubnetID   = "subnet-private"
+				publicSubnetID    = "subnet-public"
+				allocationID      = "eipalloc-1"
+				natGatewayID      = "nat-1"
+				nodesRoleARN      = "arn:aws:iam::123456789012:role/shoot--foo--bar-nodes"
+			)
+
+			gomock.InOrder(
+				awsClient.EXPECT().EnsureVPC(ctx, &awsclient.VPC{
+					CIDRBlock:      "10.250.0.0/16",
+					DHCPDomainName: "eu-west-1.compute.internal",
+					Tags:           commonTags,
+				}).Return(vpcID, nil),
+				awsClient.EXPECT().EnsureInternetGateway(ctx, vpcID, commonTags).Return(internetGatewayID, nil),
+				awsClient.EXPECT().EnsureSecurityGroup(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, securityGroup *awsclient.SecurityGroup) (string, error) {
+					Expect(securityGroup.Name).To(Equal(clusterName + "-bastions"))
+					Expect(securityGroup.VPCID).To(Equal(vpcID))
+					return bastionsGroupID, nil
+				}),
+				awsClient.EXPECT().EnsureSecurityGroup(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, securityGroup *awsclient.SecurityGroup) (string, error) {
+					Expect(securityGroup.Name).To(Equal(clusterName + "-nodes"))
+					Expect(securityGroup.Rules).To(ContainElement(awsclient.SecurityGroupRule{
+						Type:                  awsclient.SecurityGroupRuleTypeIngress,
+						Protocol:              "tcp",
+						FromPort:              22,
+						ToPort:                22,
+						SourceSecurityGroupID: bastionsGroupID,
+					}))
+					Expect(securityGroup.Rules).To(ContainElement(awsclient.SecurityGroupRule{
+						Type:       awsclient.SecurityGroupRuleTypeIngress,
+						Protocol:   "udp",
+						FromPort:   30000,
+						ToPort:     32767,
+						CIDRBlocks: []string{"10.250.112.0/22"},
+					}))
+					return nodesGroupID, nil
+				}),
+				awsClient.EXPECT().EnsureSubnet(ctx, &awsclient.Subnet{
+					VPCID:            vpcID,
+					CIDRBlock:        "10.250.0.0/19",
+					AvailabilityZone: "eu-west-1a",
+					Tags:             awsclient.Tags{"Name": clusterName + "-nodes-z0", clusterTag: "1"},
+				}).Return(nodesSubnetID, nil),
+				awsClient.EXPECT().EnsureSubnet(ctx, &awsclient.Subnet{
+					VPCID:            vpcID,
+					CIDRBlock:        "10.250.112.0/22",
+					AvailabilityZone: "eu-west-1a",
+					Tags:             awsclient.Tags{"Name": clusterName + "-private-utility-z0", clusterTag: "1", "kubernetes.io/role/internal-elb": "use"},
+				}).Return(privateSubnetID, nil),
+				awsClient.EXPECT().EnsureSubnet(ctx, &awsclient.Subnet{
+					VPCID:            vpcID,
+					CIDRBlock:        "10.250.96.0/22",
+					AvailabilityZone: "eu-west-1a",
+					Tags:             awsclient.Tags{"Name": clusterName + "-public-utility-z0", clusterTag: "1", "kubernetes.io/role/elb": "use"},
+				}).Return(publicSubnetID, nil),
+				awsClient.EXPECT().EnsureElasticIP(ctx, awsclient.Tags{"Name": clusterName + "-eip-natgw-z0", clusterTag: "1"}).Return(allocationID, nil),
+				awsClient.EXPECT().EnsureNATGateway(ctx, publicSubnetID, allocationID, awsclient.Tags{"Name": clusterName + "-natgw-z0", clusterTag: "1"}).Return(natGatewayID, nil),
+				awsClient.EXPECT().EnsureRouteTable(ctx, &awsclient.RouteTable{
+					VPCID:     vpcID,
+					Routes:    []awsclient.Route{{DestinationCIDRBlock: "0.0.0.0/0", NATGatewayID: natGatewayID}},
+					SubnetIDs: []string{privateSubnetID, nodesSubnetID},
+					Tags:      awsclient.Tags{"Name": clusterName + "-private-eu-west-1a", clusterTag: "1"},
+				}).Return("rtb-private", nil),
+				awsClient.EXPECT().EnsureRouteTable(ctx, &awsclient.RouteTable{
+					VPCID:     vpcID,
+					Routes:    []awsclient.Route{{DestinationCIDRBlock: "0.0.0.0/0", GatewayID: internetGatewayID}},
+					SubnetIDs: []string{publicSubnetID},
+					Tags:      commonTags,
+				}).Return("rtb-main", nil),
+				awsClient.EXPECT().EnsureIAMRole(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, role *awsclient.Role) (string, error) {
+					Expect(role.Name).To(Equal(clusterName + "-bastions"))
+					return "arn:bastions", nil
+				}),
+				awsClient.EXPECT().EnsureIAMInstanceProfile(ctx, clusterName+"-bastions", clusterName+"-bastions"),
+				awsClient.EXPECT().EnsureIAMRole(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, role *awsclient.Role) (string, error) {
+					Expect(role.Name).To(Equal(clusterName + "-nodes"))
+					Expect(role.PolicyName).To(Equal(clusterName + "-nodes"))
+					return nodesRoleARN, nil
+				}),
+				awsClient.EXPECT().EnsureIAMInstanceProfile(ctx, clusterName+"-nodes", clusterName+"-nodes"),
+				awsClient.EXPECT().EnsureKeyPair(ctx, clusterName+"-ssh-publickey", []byte("ssh-rsa AAAA")),
+			)
+
+			status, err := ReconcileNative(ctx, awsClient, infrastructure, infrastructureConfig)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.VPC.ID).To(Equal(vpcID))
+			Expect(status.VPC.Subnets).To(ConsistOf(
+				awsv1alpha1.Subnet{Purpose: awsapi.PurposeNodes, ID: nodesSubnetID, Zone: "eu-west-1a"},
+				awsv1alpha1.Subnet{Purpose: awsapi.PurposePublic, ID: publicSubnetID, Zone: "eu-west-1a"},
+			))
+			Expect(status.VPC.SecurityGroups).To(Equal([]awsv1alpha1.SecurityGroup{{Purpose: awsapi.PurposeNodes, ID: nodesGroupID}}))
+			Expect(status.EC2.KeyName).To(Equal(clusterName + "-ssh-publickey"))
+			Expect(status.IAM.InstanceProfiles).To(Equal([]awsv1alpha1.InstanceProfile{{Purpose: awsapi.PurposeNodes, Name: clusterName + "-nodes"}}))
+			Expect(status.IAM.Roles).To(Equal([]awsv1alpha1.Role{{Purpose: awsapi.PurposeNodes, ARN: nodesRoleARN}}))
+		})
+
+		It("should use an existing VPC and its internet gateway", func() {
+			vpcID := "vpc-existing"
+			infrastructureConfig.Networks.VPC = awsapi.VPC{ID: &vpcID}
+
+			awsClient.EXPECT().GetInternetGateway(ctx, vpcID).Return("igw-existing", nil)
+			awsClient.EXPECT().EnsureSecurityGroup(ctx, gomock.Any()).Return("sg", nil).Times(2)
+			awsClient.EXPECT().EnsureSubnet(ctx, gomock.Any()).Return("subnet", nil).Times(3)
+			awsClient.EXPECT().EnsureElasticIP(ctx, gomock.Any()).Return("eipalloc", nil)
+			awsClient.EXPECT().EnsureNATGateway(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return("nat", nil)
+			awsClient.EXPECT().EnsureRouteTable(ctx, gomock.Any()).Return("rtb", nil).Times(2)
+			awsClient.EXPECT().EnsureIAMRole(ctx, gomock.Any()).Return("arn", nil).Times(2)
+			awsClient.EXPECT().EnsureIAMInstanceProfile(ctx, gomock.Any(), gomock.Any()).Times(2)
+			awsClient.EXPECT().EnsureKeyPair(ctx, gomock.Any(), gomock.Any())
+
+			status, err := ReconcileNative(ctx, awsClient, infrastructure, infrastructureConfig)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.VPC.ID).To(Equal(vpcID))
+		})
+
+		It("should fail if the VPC cannot be ensured", func() {
+			awsClient.EXPECT().EnsureVPC(ctx, gomock.Any()).Return("", fmt.Errorf("error"))
+
+			_, err := ReconcileNative(ctx, awsClient, infrastructure, infrastructureConfig)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#DeleteNative", func() {
+		It("should delete the infrastructure", func() {
+			var (
+				vpcID       = "vpc-1"
+				clusterTags = awsclient.Tags{clusterTag: "1"}
+			)
+
+			gomock.InOrder(
+				awsClient.EXPECT().FindVPCByTags(ctx, commonTags).Return(vpcID, nil),
+				awsClient.EXPECT().ListKubernetesELBs(ctx, vpcID, clusterName).Return([]string{"elb"}, nil),
+				awsClient.EXPECT().ListKubernetesSecurityGroups(ctx, vpcID, clusterName).Return([]string{"sg"}, nil),
+				awsClient.EXPECT().DeleteELB(ctx, "elb"),
+				awsClient.EXPECT().DeleteSecurityGroup(ctx, "sg"),
+				awsClient.EXPECT().DeleteNATGateways(ctx, vpcID, clusterTags),
+				awsClient.EXPECT().DeleteElasticIPs(ctx, clusterTags),
+				awsClient.EXPECT().DeleteRouteTables(ctx, vpcID, clusterTags),
+				awsClient.EXPECT().DeleteSubnets(ctx, vpcID, clusterTags),
+				awsClient.EXPECT().DeleteSecurityGroups(ctx, vpcID, clusterTags),
+				awsClient.EXPECT().DeleteInternetGateway(ctx, vpcID, commonTags),
+				awsClient.EXPECT().DeleteVPC(ctx, vpcID, commonTags),
+				awsClient.EXPECT().DeleteIAMInstanceProfile(ctx, clusterName+"-bastions"),
+				awsClient.EXPECT().DeleteIAMRole(ctx, clusterName+"-bastions", clusterName+"-bastions"),
+				awsClient.EXPECT().DeleteIAMInstanceProfile(ctx, clusterName+"-nodes"),
+				awsClient.EXPECT().DeleteIAMRole(ctx, clusterName+"-nodes", clusterName+"-nodes"),
+				awsClient.EXPECT().DeleteKeyPair(ctx, clusterName+"-ssh-publickey"),
+			)
+
+			Expect(DeleteNative(ctx, awsClient, infrastructure, infrastructureConfig)).To(Succeed())
+		})
+
+		It("should only delete the global resources if the VPC is already gone", func() {
+			gomock.InOrder(
+				awsClient.EXPECT().FindVPCByTags(ctx, commonTags).Return("", nil),
+				awsClient.EXPECT().DeleteElasticIPs(ctx, awsclient.Tags{clusterTag: "1"}),
+				awsClient.EXPECT().DeleteIAMInstanceProfile(ctx, clusterName+"-bastions"),
+				awsClient.EXPECT().DeleteIAMRole(ctx, clusterName+"-bastions", clusterName+"-bastions"),
+				awsClient.EXPECT().DeleteIAMInstanceProfile(ctx, clusterName+"-nodes"),
+				awsClient.EXPECT().DeleteIAMRole(ctx, clusterName+"-nodes", clusterName+"-nodes"),
+				awsClient.EXPECT().DeleteKeyPair(ctx, clusterName+"-ssh-publickey"),
+			)
+
+			Expect(DeleteNative(ctx, awsClient, infrastructure, infrastructureConfig)).To(Succeed())
+		})
+	})
+})
@@ -28,10 +28,10 @@ import (
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws/client"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/chartrenderer"
-	"github.com/gardener/gardener/pkg/operation/terraformer"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 
 	corev1 "k8s.io/api/core/v1"
@@ -51,19 +51,20 @@ func (a *actuator) reconcile(ctx context.Context, infrastructure *extensionsv1al
 		return err
 	}
 
-	terraformConfig, err := generateTerraformInfraConfig(ctx, infrastructure, infrastructureConfig, providerSecret)
-	if err != nil {
-		return fmt.Errorf("failed to generate Terraform config: %+v", err)
+	if useNativeReconciler(infrastructure, cluster) {
+		if extensionsterraformer.IsPlanRequested(infrastructure) {
+			return fmt.Errorf("the native reconciler cannot plan the changes of infrastructure %s", infrastructure.Name)
+		}
+		return a.reconcileNative(ctx, infrastructure, infrastructureConfig, providerSecret)
 	}
 
-	chartRenderer, err := chartrenderer.NewForConfig(a.restConfig)
+	initializer, err := a.newInitializer(ctx, infrastructure, infrastructureConfig, providerSecret)
 	if err != nil {
-		return fmt.Errorf("could not create chart renderer: %+v", err)
+		return err
 	}
 
-	release, err := chartRenderer.Render(filepath.Join(aws.InternalChartsPath, "aws-infra"), "aws-infra", infrastructure.Namespace, terraformConfig)
-	if err != nil {
-		return fmt.Errorf("could not render Terraform chart: %+v", err)
+	if err := extensionsterraformer.RestoreInfrastructureState(ctx, a.client, infrastructure, aws.TerraformerPurposeInfra); err != nil {
+		return fmt.Errorf("could not restore Terraform state: %+v", err)
 	}
 
 	tf, err := a.newTerraformer(aws.TerraformerPurposeInfra, infrastructure.Namespace, infrastructure.Name)
@@ -71,16 +72,15 @@ func (a *actuator) reconcile(ctx context.Context, infrastructure *extensionsv1al
 		return fmt.Errorf("could not create terraformer object: %+v", err)
 	}
 
-	if err := tf.
+	tf = tf.
 		SetVariablesEnvironment(generateTerraformInfraVariablesEnvironment(providerSecret)).
-		InitializeWith(terraformer.DefaultInitializer(
-			a.client,
-			release.FileContent("main.tf"),
-			release.FileContent("variables.tf"),
-			[]byte(release.FileContent("terraform.tfvars"))),
-		).
-		Apply(); err != nil {
+		InitializeWith(initializer)
 
+	if extensionsterraformer.IsPlanRequested(infrastructure) {
+		return extensionsterraformer.PlanInfrastructure(ctx, a.client, tf, infrastructure)
+	}
+
+	if err := tf.Apply(); err != nil {
 		a.logger.Error(err, "failed to apply the terraform config", "infrastructure", infrastructure.Name)
 		return &controllererrors.RequeueAfterError{
 			Cause:        err,
@@ -88,22 +88,46 @@ func (a *actuator) reconcile(ctx context.Context, infrastructure *extensionsv1al
 		}
 	}
 
+	if err := extensionsterraformer.StoreInfrastructureState(ctx, a.client, tf, infrastructure); err != nil {
+		return err
+	}
+
 	return a.updateProviderStatus(ctx, tf, infrastructure, infrastructureConfig)
 }
 
+// newInitializer renders the Terraform chart for the given infrastructure and returns an initializer for it.
+func (a *actuator) newInitializer(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, infrastructureConfig *awsapi.InfrastructureConfig, providerSecret *corev1.Secret) (extensionsterraformer.Initializer, error) {
+	terraformConfig, err := generateTerraformInfraConfig(ctx, infrastructure, infrastructureConfig, providerSecret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate Terraform config: %+v", err)
+	}
+
+	chartRenderer, err := chartrenderer.NewForConfig(a.restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("could not create chart renderer: %+v", err)
+	}
+
+	release, err := chartRenderer.Render(filepath.Join(aws.InternalChartsPath, "aws-infra"), "aws-infra", infrastructure.Namespace, terraformConfig)
+	if err != nil {
+		return nil, fmt.Errorf("could not render Terraform chart: %+v", err)
+	}
+
+	return a.terraformerFactory.DefaultInitializer(
+		a.client,
+		release.FileContent("main.tf"),
+		release.FileContent("variables.tf"),
+		[]byte(release.FileContent("terraform.tfvars")),
+	), nil
+}
+
 func generateTerraformInfraConfig(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, infrastructureConfig *awsapi.InfrastructureConfig, providerSecret *corev1.Secret) (map[string]interface{}, error) {
 	var (
-		dhcpDomainName    = "ec2.internal"
 		createVPC         = true
 		vpcID             = "${aws_vpc.vpc.id}"
 		vpcCIDR           = ""
 		internetGatewayID = "${aws_internet_gateway.igw.id}"
 	)
 
-	if infrastructure.Spec.Region != "us-east-1" {
-		dhcpDomainName = fmt.Sprintf("%s.compute.internal", infrastructure.Spec.Region)
-	}
-
 	switch {
 	case infrastructureConfig.Networks.VPC.ID != nil:
 		createVPC = false
@@ -142,7 +166,7 @@ func generateTerraformInfraConfig(ctx context.Context, infrastructure *extension
 		"vpc": map[string]interface{}{
 			"id":                vpcID,
 			"cidr":              vpcCIDR,
-			"dhcpDomainName":    dhcpDomainName,
+			"dhcpDomainName":    dhcpDomainName(infrastructure.Spec.Region),
 			"internetGatewayID": internetGatewayID,
 		},
 		"clusterName": infrastructure.Namespace,
@@ -161,7 +185,7 @@ func generateTerraformInfraConfig(ctx context.Context, infrastructure *extension
 	}, nil
 }
 
-func (a *actuator) updateProviderStatus(ctx context.Context, tf *terraformer.Terraformer, infrastructure *extensionsv1alpha1.Infrastructure, infrastructureConfig *awsapi.InfrastructureConfig) error {
+func (a *actuator) updateProviderStatus(ctx context.Context, tf extensionsterraformer.Interface, infrastructure *extensionsv1alpha1.Infrastructure, infrastructureConfig *awsapi.InfrastructureConfig) error {
 	outputVarKeys := []string{
 		aws.VPCIDKey,
 		aws.SSHKeyName,
@@ -180,49 +204,60 @@ func (a *actuator) updateProviderStatus(ctx context.Context, tf *terraformer.Ter
 		return err
 	}
 
-	subnets, err := computeProviderStatusSubnets(infrastructureConfig, output)
+	status, err := computeProviderStatus(infrastructureConfig, output)
 	if err != nil {
 		return err
 	}
 
+	return a.setProviderStatus(ctx, infrastructure, status)
+}
+
+func (a *actuator) setProviderStatus(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, status *awsv1alpha1.InfrastructureStatus) error {
 	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, a.client, infrastructure, func() error {
-		infrastructure.Status.ProviderStatus = &runtime.RawExtension{
-			Object: &awsv1alpha1.InfrastructureStatus{
-				TypeMeta: metav1.TypeMeta{
-					APIVersion: awsv1alpha1.SchemeGroupVersion.String(),
-					Kind:       "InfrastructureStatus",
-				},
-				VPC: awsv1alpha1.VPCStatus{
-					ID:      output[aws.VPCIDKey],
-					Subnets: subnets,
-					SecurityGroups: []awsv1alpha1.SecurityGroup{
-						{
-							Purpose: awsapi.PurposeNodes,
-							ID:      output[aws.SecurityGroupsNodes],
-						},
-					},
+		infrastructure.Status.ProviderStatus = &runtime.RawExtension{Object: status}
+		return nil
+	})
+}
+
+func computeProviderStatus(infrastructureConfig *awsapi.InfrastructureConfig, output map[string]string) (*awsv1alpha1.InfrastructureStatus, error) {
+	subnets, err := computeProviderStatusSubnets(infrastructureConfig, output)
+	if err != nil {
+		return nil, err
+	}
+
+	return &awsv1alpha1.InfrastructureStatus{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: awsv1alpha1.SchemeGroupVersion.String(),
+			Kind:       "InfrastructureStatus",
+		},
+		VPC: awsv1alpha1.VPCStatus{
+			ID:      output[aws.VPCIDKey],
+			Subnets: subnets,
+			SecurityGroups: []awsv1alpha1.SecurityGroup{
+				{
+					Purpose: awsapi.PurposeNodes,
+					ID:      output[aws.SecurityGroupsNodes],
 				},
-				EC2: awsv1alpha1.EC2{
-					KeyName: output[aws.SSHKeyName],
+			},
+		},
+		EC2: awsv1alpha1.EC2{
+			KeyName: output[aws.SSHKeyName],
+		},
+		IAM: awsv1alpha1.IAM{
+			InstanceProfiles: []awsv1alpha1.InstanceProfile{
+				{
+					Purpose: awsapi.PurposeNodes,
+					Name:    output[aws.IAMInstanceProfileNodes],
 				},
-				IAM: awsv1alpha1.IAM{
-					InstanceProfiles: []awsv1alpha1.InstanceProfile{
-						{
-							Purpose: awsapi.PurposeNodes,
-							Name:    output[aws.IAMInstanceProfileNodes],
-						},
-					},
-					Roles: []awsv1alpha1.Role{
-						{
-							Purpose: awsapi.PurposeNodes,
-							ARN:     output[aws.NodesRole],
-						},
-					},
+			},
+			Roles: []awsv1alpha1.Role{
+				{
+					Purpose: awsapi.PurposeNodes,
+					ARN:     output[aws.NodesRole],
 				},
 			},
-		}
-		return nil
-	})
+		},
+	}, nil
 }
 
 func computeProviderStatusSubnets(infrastructure *awsapi.InfrastructureConfig, values map[string]string) ([]awsv1alpha1.Subnet, error) {
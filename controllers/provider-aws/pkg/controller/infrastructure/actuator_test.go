@@ -0,0 +1,106 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure_test
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/install"
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
+	. "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/controller/infrastructure"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	faketerraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer/fake"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+)
+
+var _ = Describe("Actuator", func() {
+	const (
+		namespace = "shoot--foo--bar"
+		name      = "infrastructure"
+	)
+
+	var (
+		ctx = context.TODO()
+
+		scheme             *runtime.Scheme
+		terraformerFactory *faketerraformer.Factory
+		tf                 *faketerraformer.Terraformer
+		actuator           infrastructure.Actuator
+
+		secret *corev1.Secret
+		infra  *extensionsv1alpha1.Infrastructure
+	)
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		Expect(install.AddToScheme(scheme)).To(Succeed())
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		terraformerFactory = faketerraformer.NewFactory()
+		tf = terraformerFactory.Terraformer(aws.TerraformerPurposeInfra, namespace, name)
+
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "cloudprovider"},
+			Data: map[string][]byte{
+				aws.AccessKeyID:     []byte("access-key-id"),
+				aws.SecretAccessKey: []byte("secret-access-key"),
+			},
+		}
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec: extensionsv1alpha1.InfrastructureSpec{
+				Region:    "eu-west-1",
+				SecretRef: corev1.SecretReference{Namespace: namespace, Name: secret.Name},
+			},
+		}
+
+		actuator = NewActuatorWithDeps(log.Log.WithName("test"), terraformerFactory)
+		Expect(inject.ClientInto(fake.NewFakeClientWithScheme(scheme, secret, infra), actuator)).To(BeTrue())
+		Expect(inject.SchemeInto(scheme, actuator)).To(BeTrue())
+	})
+
+	Describe("#Delete", func() {
+		It("should destroy the infrastructure without configuration if the provider config is missing", func() {
+			tf.StateOutputVariables[aws.VPCIDKey] = "vpc-1234"
+
+			Expect(actuator.Delete(ctx, infra, &extensionscontroller.Cluster{})).To(Succeed())
+
+			Expect(tf.Configuration).To(BeNil())
+			Expect(tf.Applications).To(BeEmpty())
+			Expect(tf.Destroyed).To(BeTrue())
+			Expect(tf.VariablesEnvironment).To(Equal(map[string]string{
+				"TF_VAR_ACCESS_KEY_ID":     "access-key-id",
+				"TF_VAR_SECRET_ACCESS_KEY": "secret-access-key",
+			}))
+		})
+
+		It("should not destroy anything if the VPC is not part of the Terraform state", func() {
+			Expect(actuator.Delete(ctx, infra, &extensionscontroller.Cluster{})).To(Succeed())
+
+			Expect(tf.Destroyed).To(BeFalse())
+		})
+	})
+})
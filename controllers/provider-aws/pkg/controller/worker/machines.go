@@ -22,13 +22,16 @@ import (
 	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
 	awsapi "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
 	awsapihelper "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/helper"
+	awsvalidation "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/validation"
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 	"github.com/gardener/gardener-extensions/pkg/util"
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -113,6 +116,11 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 	for _, pool := range w.worker.Spec.Pools {
 		zoneLen := len(pool.Zones)
 
+		workerConfig, err := w.decodeWorkerConfig(pool)
+		if err != nil {
+			return err
+		}
+
 		ami, err := w.findMachineImage(pool.MachineImage.Name, pool.MachineImage.Version, w.worker.Spec.Region)
 		if err != nil {
 			return err
@@ -128,6 +136,20 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 			return err
 		}
 
+		blockDevices := []map[string]interface{}{
+			{
+				"ebs": map[string]interface{}{
+					"volumeSize": volumeSize,
+					"volumeType": pool.Volume.Type,
+				},
+			},
+		}
+		dataVolumes, err := computeDataVolumes(workerConfig.DataVolumes)
+		if err != nil {
+			return err
+		}
+		blockDevices = append(blockDevices, dataVolumes...)
+
 		for zoneIndex, zone := range pool.Zones {
 			nodesSubnet, err := awsapihelper.FindSubnetForPurposeAndZone(infrastructureStatus.VPC.Subnets, awsapi.PurposeNodes, zone)
 			if err != nil {
@@ -146,21 +168,30 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 						"securityGroupIDs": []string{nodesSecurityGroup.ID},
 					},
 				},
-				"tags": map[string]string{
-					fmt.Sprintf("kubernetes.io/cluster/%s", w.worker.Namespace): "1",
-					"kubernetes.io/role/node":                                   "1",
-				},
+				"tags": computeTags(w.worker.Namespace, workerConfig.Tags),
 				"secret": map[string]interface{}{
 					"cloudConfig": string(pool.UserData),
 				},
-				"blockDevices": []map[string]interface{}{
-					{
-						"ebs": map[string]interface{}{
-							"volumeSize": volumeSize,
-							"volumeType": pool.Volume.Type,
-						},
-					},
-				},
+				"blockDevices": blockDevices,
+			}
+
+			if workerConfig.SpotInstance != nil {
+				var spotPrice string
+				if workerConfig.SpotInstance.MaxPrice != nil {
+					spotPrice = *workerConfig.SpotInstance.MaxPrice
+				}
+				machineClassSpec["spotPrice"] = spotPrice
+			}
+
+			if options := workerConfig.InstanceMetadataOptions; options != nil {
+				instanceMetadataOptions := map[string]interface{}{}
+				if options.HTTPTokens != nil {
+					instanceMetadataOptions["httpTokens"] = string(*options.HTTPTokens)
+				}
+				if options.HTTPPutResponseHopLimit != nil {
+					instanceMetadataOptions["httpPutResponseHopLimit"] = *options.HTTPPutResponseHopLimit
+				}
+				machineClassSpec["instanceMetadataOptions"] = instanceMetadataOptions
 			}
 
 			var (
@@ -180,6 +211,8 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 				Labels:         pool.Labels,
 				Annotations:    pool.Annotations,
 				Taints:         pool.Taints,
+
+				MachineConfiguration: computeMachineConfiguration(workerConfig.MachineControllerManagerSettings),
 			})
 
 			machineClassSpec["name"] = className
@@ -199,3 +232,79 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 
 	return nil
 }
+
+func (w *workerDelegate) decodeWorkerConfig(pool extensionsv1alpha1.WorkerPool) (*apisaws.WorkerConfig, error) {
+	workerConfig := &apisaws.WorkerConfig{}
+	if pool.ProviderConfig == nil || pool.ProviderConfig.Raw == nil {
+		return workerConfig, nil
+	}
+
+	if _, _, err := w.decoder.Decode(pool.ProviderConfig.Raw, nil, workerConfig); err != nil {
+		return nil, errors.Wrapf(err, "could not decode provider config of worker pool %q", pool.Name)
+	}
+	if errs := awsvalidation.ValidateWorkerConfig(workerConfig); len(errs) > 0 {
+		return nil, errors.Wrapf(errs.ToAggregate(), "invalid provider config of worker pool %q", pool.Name)
+	}
+	return workerConfig, nil
+}
+
+// computeDataVolumes computes the block devices of the given data volumes. The device names are assigned in order,
+// starting with /dev/sdf as recommended by AWS for EBS volumes.
+func computeDataVolumes(dataVolumes []apisaws.DataVolume) ([]map[string]interface{}, error) {
+	var blockDevices []map[string]interface{}
+
+	for i, dataVolume := range dataVolumes {
+		volumeSize, err := worker.DiskSize(dataVolume.Size)
+		if err != nil {
+			return nil, err
+		}
+
+		ebs := map[string]interface{}{
+			"volumeSize":          volumeSize,
+			"deleteOnTermination": true,
+		}
+		if dataVolume.Type != nil {
+			ebs["volumeType"] = *dataVolume.Type
+		}
+		if dataVolume.IOPS != nil {
+			ebs["iops"] = *dataVolume.IOPS
+		}
+		if dataVolume.Encrypted != nil {
+			ebs["encrypted"] = *dataVolume.Encrypted
+		}
+		if dataVolume.KMSKeyID != nil {
+			ebs["kmsKeyID"] = *dataVolume.KMSKeyID
+		}
+
+		blockDevices = append(blockDevices, map[string]interface{}{
+			"deviceName": fmt.Sprintf("/dev/sd%c", 'f'+i),
+			"ebs":        ebs,
+		})
+	}
+
+	return blockDevices, nil
+}
+
+// computeMachineConfiguration computes the timeouts for the rollout of the machines out of the given settings.
+func computeMachineConfiguration(settings *apisaws.MachineControllerManagerSettings) *worker.MachineConfiguration {
+	if settings == nil {
+		return nil
+	}
+
+	return &worker.MachineConfiguration{
+		DrainTimeout:    settings.MachineDrainTimeout,
+		CreationTimeout: settings.MachineCreationTimeout,
+	}
+}
+
+// computeTags computes the tags of the machines. The tags required by the Kubernetes cloud provider cannot be
+// overwritten by the additional tags.
+func computeTags(namespace string, additionalTags map[string]string) map[string]string {
+	tags := make(map[string]string, len(additionalTags)+2)
+	for key, value := range additionalTags {
+		tags[key] = value
+	}
+	tags[fmt.Sprintf("kubernetes.io/cluster/%s", namespace)] = "1"
+	tags["kubernetes.io/role/node"] = "1"
+	return tags
+}
@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"time"
 
 	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
 	awsv1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/v1alpha1"
@@ -42,6 +43,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -447,6 +449,115 @@ var _ = Describe("Machines", func() {
 				Expect(result).To(Equal(machineDeployments))
 			})
 
+			It("should render the worker config of the pools into the machine classes", func() {
+				expectGetSecretCallToWork(c, awsAccessKeyID, awsSecretAccessKey)
+
+				httpTokens := awsv1alpha1.HTTPTokensRequired
+				w.Spec.Pools[1].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&awsv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: awsv1alpha1.SchemeGroupVersion.String(),
+							Kind:       "WorkerConfig",
+						},
+						DataVolumes: []awsv1alpha1.DataVolume{
+							{
+								Name:      "data",
+								Type:      pointer.StringPtr("io1"),
+								Size:      "100Gi",
+								IOPS:      pointer.Int64Ptr(1000),
+								Encrypted: pointer.BoolPtr(true),
+								KMSKeyID:  pointer.StringPtr("kms-key"),
+							},
+						},
+						SpotInstance: &awsv1alpha1.SpotInstance{},
+						InstanceMetadataOptions: &awsv1alpha1.InstanceMetadataOptions{
+							HTTPTokens: &httpTokens,
+						},
+						Tags: map[string]string{
+							"team": "foo",
+						},
+						MachineControllerManagerSettings: &awsv1alpha1.MachineControllerManagerSettings{
+							MachineDrainTimeout: &metav1.Duration{Duration: time.Hour},
+						},
+					}),
+				}
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToAMIMapping, chartApplier, "", w, cluster)
+
+				var machineClasses []map[string]interface{}
+				chartApplier.
+					EXPECT().
+					ApplyChart(context.TODO(), filepath.Join(aws.InternalChartsPath, "machineclass"), namespace, "machineclass", gomock.Any(), nil).
+					DoAndReturn(func(_ context.Context, _, _, _ string, values map[string]interface{}, _ map[string]interface{}) error {
+						machineClasses = values["machineClasses"].([]map[string]interface{})
+						return nil
+					})
+
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+				Expect(machineClasses).To(HaveLen(4))
+
+				for _, machineClass := range machineClasses[:2] {
+					Expect(machineClass).NotTo(HaveKey("spotPrice"))
+					Expect(machineClass).NotTo(HaveKey("instanceMetadataOptions"))
+					Expect(machineClass["blockDevices"]).To(HaveLen(1))
+				}
+				for _, machineClass := range machineClasses[2:] {
+					Expect(machineClass["spotPrice"]).To(Equal(""))
+					Expect(machineClass["instanceMetadataOptions"]).To(Equal(map[string]interface{}{
+						"httpTokens": "required",
+					}))
+					Expect(machineClass["tags"]).To(Equal(map[string]string{
+						fmt.Sprintf("kubernetes.io/cluster/%s", namespace): "1",
+						"kubernetes.io/role/node":                          "1",
+						"team":                                             "foo",
+					}))
+					Expect(machineClass["blockDevices"]).To(Equal([]map[string]interface{}{
+						{
+							"ebs": map[string]interface{}{
+								"volumeSize": volumeSize,
+								"volumeType": volumeType,
+							},
+						},
+						{
+							"deviceName": "/dev/sdf",
+							"ebs": map[string]interface{}{
+								"volumeSize":          100,
+								"volumeType":          "io1",
+								"iops":                int64(1000),
+								"encrypted":           true,
+								"kmsKeyID":            "kms-key",
+								"deleteOnTermination": true,
+							},
+						},
+					}))
+				}
+
+				machineDeployments, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(machineDeployments[0].MachineConfiguration).To(BeNil())
+				Expect(machineDeployments[2].MachineConfiguration).To(Equal(&worker.MachineConfiguration{
+					DrainTimeout: &metav1.Duration{Duration: time.Hour},
+				}))
+			})
+
+			It("should fail because the worker config is invalid", func() {
+				expectGetSecretCallToWork(c, awsAccessKeyID, awsSecretAccessKey)
+
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&awsv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: awsv1alpha1.SchemeGroupVersion.String(),
+							Kind:       "WorkerConfig",
+						},
+						DataVolumes: []awsv1alpha1.DataVolume{{}},
+					}),
+				}
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToAMIMapping, chartApplier, "", w, cluster)
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).To(HaveOccurred())
+				Expect(result).To(BeNil())
+			})
+
 			It("should fail because the secret cannot be read", func() {
 				c.EXPECT().
 					Get(context.TODO(), gomock.Any(), gomock.AssignableToTypeOf(&corev1.Secret{})).
@@ -0,0 +1,482 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws/client (interfaces: Interface)
+
+// Package client is a generated GoMock package.
+package client
+
+import (
+	context "context"
+	client "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws/client"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockInterface is a mock of Interface interface
+type MockInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockInterfaceMockRecorder
+}
+
+// MockInterfaceMockRecorder is the mock recorder for MockInterface
+type MockInterfaceMockRecorder struct {
+	mock *MockInterface
+}
+
+// NewMockInterface creates a new mock instance
+func NewMockInterface(ctrl *gomock.Controller) *MockInterface {
+	mock := &MockInterface{ctrl: ctrl}
+	mock.recorder = &MockInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockInterface) EXPECT() *MockInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateBucketIfNotExists mocks base method
+func (m *MockInterface) CreateBucketIfNotExists(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBucketIfNotExists", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBucketIfNotExists indicates an expected call of CreateBucketIfNotExists
+func (mr *MockInterfaceMockRecorder) CreateBucketIfNotExists(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBucketIfNotExists", reflect.TypeOf((*MockInterface)(nil).CreateBucketIfNotExists), arg0, arg1, arg2)
+}
+
+// DeleteBucketIfExists mocks base method
+func (m *MockInterface) DeleteBucketIfExists(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBucketIfExists", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBucketIfExists indicates an expected call of DeleteBucketIfExists
+func (mr *MockInterfaceMockRecorder) DeleteBucketIfExists(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBucketIfExists", reflect.TypeOf((*MockInterface)(nil).DeleteBucketIfExists), arg0, arg1)
+}
+
+// DeleteELB mocks base method
+func (m *MockInterface) DeleteELB(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteELB", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteELB indicates an expected call of DeleteELB
+func (mr *MockInterfaceMockRecorder) DeleteELB(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteELB", reflect.TypeOf((*MockInterface)(nil).DeleteELB), arg0, arg1)
+}
+
+// DeleteElasticIPs mocks base method
+func (m *MockInterface) DeleteElasticIPs(arg0 context.Context, arg1 client.Tags) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteElasticIPs", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteElasticIPs indicates an expected call of DeleteElasticIPs
+func (mr *MockInterfaceMockRecorder) DeleteElasticIPs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteElasticIPs", reflect.TypeOf((*MockInterface)(nil).DeleteElasticIPs), arg0, arg1)
+}
+
+// DeleteIAMInstanceProfile mocks base method
+func (m *MockInterface) DeleteIAMInstanceProfile(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIAMInstanceProfile", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIAMInstanceProfile indicates an expected call of DeleteIAMInstanceProfile
+func (mr *MockInterfaceMockRecorder) DeleteIAMInstanceProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIAMInstanceProfile", reflect.TypeOf((*MockInterface)(nil).DeleteIAMInstanceProfile), arg0, arg1)
+}
+
+// DeleteIAMRole mocks base method
+func (m *MockInterface) DeleteIAMRole(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIAMRole", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIAMRole indicates an expected call of DeleteIAMRole
+func (mr *MockInterfaceMockRecorder) DeleteIAMRole(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIAMRole", reflect.TypeOf((*MockInterface)(nil).DeleteIAMRole), arg0, arg1, arg2)
+}
+
+// DeleteInternetGateway mocks base method
+func (m *MockInterface) DeleteInternetGateway(arg0 context.Context, arg1 string, arg2 client.Tags) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteInternetGateway", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteInternetGateway indicates an expected call of DeleteInternetGateway
+func (mr *MockInterfaceMockRecorder) DeleteInternetGateway(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInternetGateway", reflect.TypeOf((*MockInterface)(nil).DeleteInternetGateway), arg0, arg1, arg2)
+}
+
+// DeleteKeyPair mocks base method
+func (m *MockInterface) DeleteKeyPair(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteKeyPair", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteKeyPair indicates an expected call of DeleteKeyPair
+func (mr *MockInterfaceMockRecorder) DeleteKeyPair(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteKeyPair", reflect.TypeOf((*MockInterface)(nil).DeleteKeyPair), arg0, arg1)
+}
+
+// DeleteNATGateways mocks base method
+func (m *MockInterface) DeleteNATGateways(arg0 context.Context, arg1 string, arg2 client.Tags) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNATGateways", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNATGateways indicates an expected call of DeleteNATGateways
+func (mr *MockInterfaceMockRecorder) DeleteNATGateways(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNATGateways", reflect.TypeOf((*MockInterface)(nil).DeleteNATGateways), arg0, arg1, arg2)
+}
+
+// DeleteObjectsWithPrefix mocks base method
+func (m *MockInterface) DeleteObjectsWithPrefix(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteObjectsWithPrefix", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteObjectsWithPrefix indicates an expected call of DeleteObjectsWithPrefix
+func (mr *MockInterfaceMockRecorder) DeleteObjectsWithPrefix(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObjectsWithPrefix", reflect.TypeOf((*MockInterface)(nil).DeleteObjectsWithPrefix), arg0, arg1, arg2)
+}
+
+// DeleteRouteTables mocks base method
+func (m *MockInterface) DeleteRouteTables(arg0 context.Context, arg1 string, arg2 client.Tags) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRouteTables", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRouteTables indicates an expected call of DeleteRouteTables
+func (mr *MockInterfaceMockRecorder) DeleteRouteTables(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRouteTables", reflect.TypeOf((*MockInterface)(nil).DeleteRouteTables), arg0, arg1, arg2)
+}
+
+// DeleteSecurityGroup mocks base method
+func (m *MockInterface) DeleteSecurityGroup(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecurityGroup", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSecurityGroup indicates an expected call of DeleteSecurityGroup
+func (mr *MockInterfaceMockRecorder) DeleteSecurityGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecurityGroup", reflect.TypeOf((*MockInterface)(nil).DeleteSecurityGroup), arg0, arg1)
+}
+
+// DeleteSecurityGroups mocks base method
+func (m *MockInterface) DeleteSecurityGroups(arg0 context.Context, arg1 string, arg2 client.Tags) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecurityGroups", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSecurityGroups indicates an expected call of DeleteSecurityGroups
+func (mr *MockInterfaceMockRecorder) DeleteSecurityGroups(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecurityGroups", reflect.TypeOf((*MockInterface)(nil).DeleteSecurityGroups), arg0, arg1, arg2)
+}
+
+// DeleteSubnets mocks base method
+func (m *MockInterface) DeleteSubnets(arg0 context.Context, arg1 string, arg2 client.Tags) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubnets", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSubnets indicates an expected call of DeleteSubnets
+func (mr *MockInterfaceMockRecorder) DeleteSubnets(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubnets", reflect.TypeOf((*MockInterface)(nil).DeleteSubnets), arg0, arg1, arg2)
+}
+
+// DeleteVPC mocks base method
+func (m *MockInterface) DeleteVPC(arg0 context.Context, arg1 string, arg2 client.Tags) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVPC", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVPC indicates an expected call of DeleteVPC
+func (mr *MockInterfaceMockRecorder) DeleteVPC(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVPC", reflect.TypeOf((*MockInterface)(nil).DeleteVPC), arg0, arg1, arg2)
+}
+
+// EnsureElasticIP mocks base method
+func (m *MockInterface) EnsureElasticIP(arg0 context.Context, arg1 client.Tags) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureElasticIP", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureElasticIP indicates an expected call of EnsureElasticIP
+func (mr *MockInterfaceMockRecorder) EnsureElasticIP(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureElasticIP", reflect.TypeOf((*MockInterface)(nil).EnsureElasticIP), arg0, arg1)
+}
+
+// EnsureIAMInstanceProfile mocks base method
+func (m *MockInterface) EnsureIAMInstanceProfile(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureIAMInstanceProfile", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnsureIAMInstanceProfile indicates an expected call of EnsureIAMInstanceProfile
+func (mr *MockInterfaceMockRecorder) EnsureIAMInstanceProfile(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureIAMInstanceProfile", reflect.TypeOf((*MockInterface)(nil).EnsureIAMInstanceProfile), arg0, arg1, arg2)
+}
+
+// EnsureIAMRole mocks base method
+func (m *MockInterface) EnsureIAMRole(arg0 context.Context, arg1 *client.Role) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureIAMRole", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureIAMRole indicates an expected call of EnsureIAMRole
+func (mr *MockInterfaceMockRecorder) EnsureIAMRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureIAMRole", reflect.TypeOf((*MockInterface)(nil).EnsureIAMRole), arg0, arg1)
+}
+
+// EnsureInternetGateway mocks base method
+func (m *MockInterface) EnsureInternetGateway(arg0 context.Context, arg1 string, arg2 client.Tags) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureInternetGateway", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureInternetGateway indicates an expected call of EnsureInternetGateway
+func (mr *MockInterfaceMockRecorder) EnsureInternetGateway(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureInternetGateway", reflect.TypeOf((*MockInterface)(nil).EnsureInternetGateway), arg0, arg1, arg2)
+}
+
+// EnsureKeyPair mocks base method
+func (m *MockInterface) EnsureKeyPair(arg0 context.Context, arg1 string, arg2 []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureKeyPair", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnsureKeyPair indicates an expected call of EnsureKeyPair
+func (mr *MockInterfaceMockRecorder) EnsureKeyPair(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureKeyPair", reflect.TypeOf((*MockInterface)(nil).EnsureKeyPair), arg0, arg1, arg2)
+}
+
+// EnsureNATGateway mocks base method
+func (m *MockInterface) EnsureNATGateway(arg0 context.Context, arg1, arg2 string, arg3 client.Tags) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureNATGateway", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureNATGateway indicates an expected call of EnsureNATGateway
+func (mr *MockInterfaceMockRecorder) EnsureNATGateway(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureNATGateway", reflect.TypeOf((*MockInterface)(nil).EnsureNATGateway), arg0, arg1, arg2, arg3)
+}
+
+// EnsureRouteTable mocks base method
+func (m *MockInterface) EnsureRouteTable(arg0 context.Context, arg1 *client.RouteTable) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureRouteTable", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureRouteTable indicates an expected call of EnsureRouteTable
+func (mr *MockInterfaceMockRecorder) EnsureRouteTable(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureRouteTable", reflect.TypeOf((*MockInterface)(nil).EnsureRouteTable), arg0, arg1)
+}
+
+// EnsureSecurityGroup mocks base method
+func (m *MockInterface) EnsureSecurityGroup(arg0 context.Context, arg1 *client.SecurityGroup) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureSecurityGroup", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureSecurityGroup indicates an expected call of EnsureSecurityGroup
+func (mr *MockInterfaceMockRecorder) EnsureSecurityGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureSecurityGroup", reflect.TypeOf((*MockInterface)(nil).EnsureSecurityGroup), arg0, arg1)
+}
+
+// EnsureSubnet mocks base method
+func (m *MockInterface) EnsureSubnet(arg0 context.Context, arg1 *client.Subnet) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureSubnet", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureSubnet indicates an expected call of EnsureSubnet
+func (mr *MockInterfaceMockRecorder) EnsureSubnet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureSubnet", reflect.TypeOf((*MockInterface)(nil).EnsureSubnet), arg0, arg1)
+}
+
+// EnsureVPC mocks base method
+func (m *MockInterface) EnsureVPC(arg0 context.Context, arg1 *client.VPC) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureVPC", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureVPC indicates an expected call of EnsureVPC
+func (mr *MockInterfaceMockRecorder) EnsureVPC(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureVPC", reflect.TypeOf((*MockInterface)(nil).EnsureVPC), arg0, arg1)
+}
+
+// FindVPCByTags mocks base method
+func (m *MockInterface) FindVPCByTags(arg0 context.Context, arg1 client.Tags) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindVPCByTags", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindVPCByTags indicates an expected call of FindVPCByTags
+func (mr *MockInterfaceMockRecorder) FindVPCByTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindVPCByTags", reflect.TypeOf((*MockInterface)(nil).FindVPCByTags), arg0, arg1)
+}
+
+// GetAccountID mocks base method
+func (m *MockInterface) GetAccountID(arg0 context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountID", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountID indicates an expected call of GetAccountID
+func (mr *MockInterfaceMockRecorder) GetAccountID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountID", reflect.TypeOf((*MockInterface)(nil).GetAccountID), arg0)
+}
+
+// GetInternetGateway mocks base method
+func (m *MockInterface) GetInternetGateway(arg0 context.Context, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInternetGateway", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInternetGateway indicates an expected call of GetInternetGateway
+func (mr *MockInterfaceMockRecorder) GetInternetGateway(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInternetGateway", reflect.TypeOf((*MockInterface)(nil).GetInternetGateway), arg0, arg1)
+}
+
+// ListKubernetesELBs mocks base method
+func (m *MockInterface) ListKubernetesELBs(arg0 context.Context, arg1, arg2 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListKubernetesELBs", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListKubernetesELBs indicates an expected call of ListKubernetesELBs
+func (mr *MockInterfaceMockRecorder) ListKubernetesELBs(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKubernetesELBs", reflect.TypeOf((*MockInterface)(nil).ListKubernetesELBs), arg0, arg1, arg2)
+}
+
+// ListKubernetesSecurityGroups mocks base method
+func (m *MockInterface) ListKubernetesSecurityGroups(arg0 context.Context, arg1, arg2 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListKubernetesSecurityGroups", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListKubernetesSecurityGroups indicates an expected call of ListKubernetesSecurityGroups
+func (mr *MockInterfaceMockRecorder) ListKubernetesSecurityGroups(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKubernetesSecurityGroups", reflect.TypeOf((*MockInterface)(nil).ListKubernetesSecurityGroups), arg0, arg1, arg2)
+}
+
+// UpdateBucketConfig mocks base method
+func (m *MockInterface) UpdateBucketConfig(arg0 context.Context, arg1 string, arg2 *client.BucketConfig) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBucketConfig", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBucketConfig indicates an expected call of UpdateBucketConfig
+func (mr *MockInterfaceMockRecorder) UpdateBucketConfig(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBucketConfig", reflect.TypeOf((*MockInterface)(nil).UpdateBucketConfig), arg0, arg1, arg2)
+}
@@ -20,19 +20,35 @@ import (
 	"regexp"
 
 	"github.com/coreos/go-systemd/unit"
+	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/helper"
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/install"
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
 	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane"
 	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane/genericmutator"
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	kubeletconfigv1beta1 "k8s.io/kubelet/config/v1beta1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+var decoder runtime.Decoder
+
+func init() {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(install.AddToScheme(scheme))
+	decoder = serializer.NewCodecFactory(scheme).UniversalDecoder()
+}
+
 // NewEnsurer creates a new controlplane ensurer.
 func NewEnsurer(logger logr.Logger) genericmutator.Ensurer {
 	return &ensurer{
@@ -66,11 +82,16 @@ func (e *ensurer) EnsureKubeAPIServerDeployment(ctx context.Context, dep *appsv1
 }
 
 // EnsureKubeControllerManagerDeployment ensures that the kube-controller-manager deployment conforms to the provider requirements.
-func (e *ensurer) EnsureKubeControllerManagerDeployment(ctx context.Context, dep *appsv1.Deployment) error {
+func (e *ensurer) EnsureKubeControllerManagerDeployment(ctx context.Context, dep *appsv1.Deployment, cluster *extensionscontroller.Cluster) error {
+	csiMigrationEnabled, err := isCSIMigrationEnabled(cluster)
+	if err != nil {
+		return err
+	}
+
 	template := &dep.Spec.Template
 	ps := &template.Spec
 	if c := extensionswebhook.ContainerWithName(ps.Containers, "kube-controller-manager"); c != nil {
-		ensureKubeControllerManagerCommandLineArgs(c)
+		ensureKubeControllerManagerCommandLineArgs(c, csiMigrationEnabled)
 		ensureEnvVars(c)
 		ensureVolumeMounts(c)
 	}
@@ -89,11 +110,24 @@ func ensureKubeAPIServerCommandLineArgs(c *corev1.Container) {
 		"PersistentVolumeLabel", ",")
 }
 
-func ensureKubeControllerManagerCommandLineArgs(c *corev1.Container) {
+func ensureKubeControllerManagerCommandLineArgs(c *corev1.Container, csiMigrationEnabled bool) {
 	c.Command = extensionswebhook.EnsureStringWithPrefix(c.Command, "--cloud-provider=", "external")
 	c.Command = extensionswebhook.EnsureStringWithPrefix(c.Command, "--cloud-config=",
 		"/etc/kubernetes/cloudprovider/cloudprovider.conf")
 	c.Command = extensionswebhook.EnsureStringWithPrefix(c.Command, "--external-cloud-volume-plugin=", "aws")
+
+	// Ensure CSI migration feature gates
+	if csiMigrationEnabled {
+		c.Command = extensionswebhook.EnsureStringWithPrefixContains(c.Command, "--feature-gates=",
+			"CSIMigration=true", ",")
+		c.Command = extensionswebhook.EnsureStringWithPrefixContains(c.Command, "--feature-gates=",
+			aws.CSIMigrationFeatureGate+"=true", ",")
+	} else {
+		c.Command = extensionswebhook.EnsureNoStringWithPrefixContains(c.Command, "--feature-gates=",
+			"CSIMigration=true", ",")
+		c.Command = extensionswebhook.EnsureNoStringWithPrefixContains(c.Command, "--feature-gates=",
+			aws.CSIMigrationFeatureGate+"=true", ",")
+	}
 }
 
 func ensureKubeControllerManagerAnnotations(t *corev1.PodTemplateSpec) {
@@ -179,15 +213,49 @@ func ensureKubeletCommandLineArgs(command []string) []string {
 }
 
 // EnsureKubeletConfiguration ensures that the kubelet configuration conforms to the provider requirements.
-func (e *ensurer) EnsureKubeletConfiguration(ctx context.Context, kubeletConfig *kubeletconfigv1beta1.KubeletConfiguration) error {
+func (e *ensurer) EnsureKubeletConfiguration(ctx context.Context, kubeletConfig *kubeletconfigv1beta1.KubeletConfiguration, cluster *extensionscontroller.Cluster) error {
+	csiMigrationEnabled, err := isCSIMigrationEnabled(cluster)
+	if err != nil {
+		return err
+	}
+
 	// Make sure CSI-related feature gates are not enabled
 	// TODO Leaving these enabled shouldn't do any harm, perhaps remove this code when properly tested?
 	delete(kubeletConfig.FeatureGates, "VolumeSnapshotDataSource")
 	delete(kubeletConfig.FeatureGates, "CSINodeInfo")
 	delete(kubeletConfig.FeatureGates, "CSIDriverRegistry")
+
+	// Ensure CSI migration feature gates
+	if csiMigrationEnabled {
+		if kubeletConfig.FeatureGates == nil {
+			kubeletConfig.FeatureGates = make(map[string]bool)
+		}
+		kubeletConfig.FeatureGates["CSIMigration"] = true
+		kubeletConfig.FeatureGates[aws.CSIMigrationFeatureGate] = true
+	} else {
+		delete(kubeletConfig.FeatureGates, "CSIMigration")
+		delete(kubeletConfig.FeatureGates, aws.CSIMigrationFeatureGate)
+	}
 	return nil
 }
 
+// isCSIMigrationEnabled returns whether the migration of the in-tree volume plugin to the CSI driver is enabled in the
+// control plane config of the given cluster's shoot and supported by its Kubernetes version.
+func isCSIMigrationEnabled(cluster *extensionscontroller.Cluster) (bool, error) {
+	if cluster.CoreShoot == nil || cluster.CoreShoot.Spec.Provider.ControlPlaneConfig == nil {
+		return false, nil
+	}
+
+	cpConfig := &apisaws.ControlPlaneConfig{}
+	if _, _, err := decoder.Decode(cluster.CoreShoot.Spec.Provider.ControlPlaneConfig.Raw, nil, cpConfig); err != nil {
+		return false, errors.Wrapf(err, "could not decode controlPlaneConfig of shoot '%s'", cluster.CoreShoot.Name)
+	}
+	if !helper.IsCSIMigrationEnabled(cpConfig) {
+		return false, nil
+	}
+	return extensionscontroller.IsKubernetesVersionAtLeast(cluster, aws.CSIMinimumKubernetesVersion)
+}
+
 var regexFindProperty = regexp.MustCompile("net.ipv4.neigh.default.gc_thresh1[[:space:]]*=[[:space:]]*([[:alnum:]]+)")
 
 // EnsureKubernetesGeneralConfiguration ensures that the kubernetes general configuration conforms to the provider requirements.
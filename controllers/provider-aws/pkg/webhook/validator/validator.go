@@ -0,0 +1,161 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"context"
+	"fmt"
+
+	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	awsvalidation "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/validation"
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsbackupbucket "github.com/gardener/gardener-extensions/pkg/controller/backupbucket"
+	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
+	extensionsvalidator "github.com/gardener/gardener-extensions/pkg/webhook/validator"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NewValidator creates a new validator for the provider configs of the AWS extension resources.
+func NewValidator() extensionswebhook.Validator {
+	return &validator{}
+}
+
+type validator struct {
+	client  client.Client
+	decoder runtime.Decoder
+}
+
+// InjectClient injects the given client into the validator.
+func (v *validator) InjectClient(client client.Client) error {
+	v.client = client
+	return nil
+}
+
+// InjectScheme injects the given scheme into the validator.
+func (v *validator) InjectScheme(scheme *runtime.Scheme) error {
+	v.decoder = serializer.NewCodecFactory(scheme).UniversalDecoder()
+	return nil
+}
+
+// Validate validates the provider configs of the given AWS extension resources.
+func (v *validator) Validate(ctx context.Context, new, old runtime.Object) error {
+	switch x := new.(type) {
+	case *extensionsv1alpha1.Infrastructure:
+		if x.Spec.Type != aws.Type {
+			return nil
+		}
+		return v.validateInfrastructure(ctx, x, old)
+	case *extensionsv1alpha1.ControlPlane:
+		if x.Spec.Type != aws.Type {
+			return nil
+		}
+		return v.validateControlPlane(x)
+	case *extensionsv1alpha1.Worker:
+		if x.Spec.Type != aws.Type {
+			return nil
+		}
+		return v.validateWorker(x)
+	case *extensionsv1alpha1.BackupBucket:
+		if x.Spec.Type != aws.Type {
+			return nil
+		}
+		return v.validateBackupBucket(x)
+	}
+	return nil
+}
+
+func (v *validator) validateInfrastructure(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, old runtime.Object) error {
+	providerConfigPath := field.NewPath("spec", "providerConfig")
+	if infra.Spec.ProviderConfig == nil {
+		return field.ErrorList{field.Required(providerConfigPath, "must provide an infrastructure config")}.ToAggregate()
+	}
+
+	config := &apisaws.InfrastructureConfig{}
+	if _, _, err := v.decoder.Decode(infra.Spec.ProviderConfig.Raw, nil, config); err != nil {
+		return fmt.Errorf("could not decode infrastructure config: %v", err)
+	}
+
+	var nodes, pods, services string
+	cluster, err := extensionscontroller.GetCluster(ctx, v.client, infra.Namespace)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("could not get cluster for infrastructure: %v", err)
+	}
+	if cluster != nil {
+		nodes, pods, services = extensionscontroller.GetNodeNetwork(cluster), extensionscontroller.GetPodNetwork(cluster), extensionscontroller.GetServiceNetwork(cluster)
+	}
+
+	allErrs := extensionsvalidator.PrefixErrors(providerConfigPath, awsvalidation.ValidateInfrastructureConfig(config, nodes, pods, services))
+
+	if oldInfra, ok := old.(*extensionsv1alpha1.Infrastructure); ok && oldInfra.Spec.ProviderConfig != nil {
+		oldConfig := &apisaws.InfrastructureConfig{}
+		if _, _, err := v.decoder.Decode(oldInfra.Spec.ProviderConfig.Raw, nil, oldConfig); err != nil {
+			return fmt.Errorf("could not decode old infrastructure config: %v", err)
+		}
+		allErrs = append(allErrs, extensionsvalidator.PrefixErrors(providerConfigPath, awsvalidation.ValidateInfrastructureConfigUpdate(oldConfig, config))...)
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func (v *validator) validateControlPlane(cp *extensionsv1alpha1.ControlPlane) error {
+	if cp.Spec.ProviderConfig == nil {
+		return nil
+	}
+
+	config := &apisaws.ControlPlaneConfig{}
+	if _, _, err := v.decoder.Decode(cp.Spec.ProviderConfig.Raw, nil, config); err != nil {
+		return fmt.Errorf("could not decode control plane config: %v", err)
+	}
+
+	return extensionsvalidator.PrefixErrors(field.NewPath("spec", "providerConfig"), awsvalidation.ValidateControlPlaneConfig(config)).ToAggregate()
+}
+
+func (v *validator) validateWorker(worker *extensionsv1alpha1.Worker) error {
+	allErrs := field.ErrorList{}
+
+	for i, pool := range worker.Spec.Pools {
+		if pool.ProviderConfig == nil {
+			continue
+		}
+
+		config := &apisaws.WorkerConfig{}
+		if _, _, err := v.decoder.Decode(pool.ProviderConfig.Raw, nil, config); err != nil {
+			return fmt.Errorf("could not decode worker config of pool %s: %v", pool.Name, err)
+		}
+		allErrs = append(allErrs, extensionsvalidator.PrefixErrors(field.NewPath("spec", "pools").Index(i).Child("providerConfig"), awsvalidation.ValidateWorkerConfig(config))...)
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func (v *validator) validateBackupBucket(bb *extensionsv1alpha1.BackupBucket) error {
+	rawConfig := extensionsbackupbucket.GetProviderConfig(bb)
+	if rawConfig == nil {
+		return nil
+	}
+
+	config := &apisaws.BackupBucketConfig{}
+	if _, _, err := v.decoder.Decode(rawConfig, nil, config); err != nil {
+		return fmt.Errorf("could not decode backup bucket config: %v", err)
+	}
+	return extensionsvalidator.PrefixErrors(field.NewPath("metadata", "annotations").Key(extensionsbackupbucket.AnnotationKeyProviderConfig), awsvalidation.ValidateBackupBucketConfig(config)).ToAggregate()
+}
@@ -62,6 +62,43 @@ resource "azurerm_availability_set" "workers" {
 }
 {{- end}}
 
+{{ if .Values.identity.enabled -}}
+#=====================================================================
+#= Managed Identity
+#=====================================================================
+
+{{ if .Values.identity.create -}}
+resource "azurerm_user_assigned_identity" "identity" {
+  name                = "{{ required "identity.name is required" .Values.identity.name }}"
+  resource_group_name = "{{ required "identity.resourceGroup is required" .Values.identity.resourceGroup }}"
+  location            = "{{ required "azure.region is required" .Values.azure.region }}"
+  {{- if .Values.create.resourceGroup }}
+
+  depends_on = ["azurerm_resource_group.rg"]
+  {{- end }}
+}
+{{- else -}}
+data "azurerm_user_assigned_identity" "identity" {
+  name                = "{{ required "identity.name is required" .Values.identity.name }}"
+  resource_group_name = "{{ required "identity.resourceGroup is required" .Values.identity.resourceGroup }}"
+}
+{{- end }}
+{{- if .Values.identity.assignReaderRole }}
+{{- $identity := "azurerm_user_assigned_identity.identity" }}
+{{- if not .Values.identity.create }}
+{{- $identity = "data.azurerm_user_assigned_identity.identity" }}
+{{- end }}
+
+# Allows the identity to read the resources of the cluster. Requires the
+# Microsoft.Authorization/roleAssignments/write permission.
+resource "azurerm_role_assignment" "identity" {
+  scope                = "/subscriptions/{{ required "azure.subscriptionID is required" .Values.azure.subscriptionID }}/resourceGroups/{{ required "resourceGroup.name is required" .Values.resourceGroup.name }}"
+  role_definition_name = "Reader"
+  principal_id         = "${ {{- $identity }}.principal_id}"
+}
+{{- end }}
+{{- end }}
+
 //=====================================================================
 //= Output variables
 //=====================================================================
@@ -94,4 +131,17 @@ output "{{ .Values.outputKeys.availabilitySetID }}" {
 output "{{ .Values.outputKeys.availabilitySetName }}" {
   value = "${azurerm_availability_set.workers.name}"
 }
+{{- end}}
+{{ if .Values.identity.enabled -}}
+{{- $identity := "azurerm_user_assigned_identity.identity" }}
+{{- if not .Values.identity.create }}
+{{- $identity = "data.azurerm_user_assigned_identity.identity" }}
+{{- end }}
+output "{{ .Values.outputKeys.identityID }}" {
+  value = "${ {{- $identity }}.id}"
+}
+
+output "{{ .Values.outputKeys.identityClientID }}" {
+  value = "${ {{- $identity }}.client_id}"
+}
 {{- end}}
\ No newline at end of file
@@ -4,3 +4,10 @@ aadClientSecret: "{{ .Values.aadClientSecret }}"
 tenantId: "{{ .Values.tenantId }}"
 subscriptionId: "{{ .Values.subscriptionId }}"
 {{- end }}
+
+{{- define "azure-managed-identity"}}
+useManagedIdentityExtension: true
+userAssignedIdentityID: "{{ .Values.identityClientID }}"
+tenantId: "{{ .Values.tenantId }}"
+subscriptionId: "{{ .Values.subscriptionId }}"
+{{- end }}
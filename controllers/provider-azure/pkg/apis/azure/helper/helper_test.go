@@ -90,6 +90,29 @@ var _ = Describe("Helper", func() {
 		Entry("entry not found (no version)", []azure.MachineImage{{Name: "bar", Version: "1.2.3", Publisher: "abc", Offer: "bcd", SKU: "cde"}}, "foo", "1.2.4", nil, true),
 		Entry("entry exists", []azure.MachineImage{{Name: "bar", Version: "1.2.3", Publisher: "abc", Offer: "bcd", SKU: "cde", URN: &urn}}, "bar", "1.2.3", &azure.MachineImage{Name: "bar", Version: "1.2.3", Publisher: "abc", Offer: "bcd", SKU: "cde", URN: &urn}, false),
 	)
+
+	DescribeTable("#IsCSIDriverEnabled",
+		func(config *azure.ControlPlaneConfig, expected bool) {
+			Expect(IsCSIDriverEnabled(config)).To(Equal(expected))
+		},
+
+		Entry("config is nil", nil, false),
+		Entry("storage is nil", &azure.ControlPlaneConfig{}, false),
+		Entry("driver is disabled", &azure.ControlPlaneConfig{Storage: &azure.Storage{CSIMigration: true}}, false),
+		Entry("driver is enabled", &azure.ControlPlaneConfig{Storage: &azure.Storage{CSIDriver: true}}, true),
+	)
+
+	DescribeTable("#IsCSIMigrationEnabled",
+		func(config *azure.ControlPlaneConfig, expected bool) {
+			Expect(IsCSIMigrationEnabled(config)).To(Equal(expected))
+		},
+
+		Entry("config is nil", nil, false),
+		Entry("storage is nil", &azure.ControlPlaneConfig{}, false),
+		Entry("driver is disabled", &azure.ControlPlaneConfig{Storage: &azure.Storage{CSIMigration: true}}, false),
+		Entry("migration is disabled", &azure.ControlPlaneConfig{Storage: &azure.Storage{CSIDriver: true}}, false),
+		Entry("migration is enabled", &azure.ControlPlaneConfig{Storage: &azure.Storage{CSIDriver: true, CSIMigration: true}}, true),
+	)
 })
 
 func expectResults(result, expected interface{}, err error, expectErr bool) {
@@ -27,6 +27,10 @@ type ControlPlaneConfig struct {
 	// CloudControllerManager contains configuration settings for the cloud-controller-manager.
 	// +optional
 	CloudControllerManager *CloudControllerManagerConfig
+
+	// Storage contains configuration settings for the storage of the shoot cluster.
+	// +optional
+	Storage *Storage
 }
 
 // CloudControllerManagerConfig contains configuration settings for the cloud-controller-manager.
@@ -34,3 +38,12 @@ type CloudControllerManagerConfig struct {
 	// FeatureGates contains information about enabled feature gates.
 	FeatureGates map[string]bool
 }
+
+// Storage contains configuration settings for the storage of the shoot cluster.
+type Storage struct {
+	// CSIDriver specifies whether the Azure Disk CSI driver is deployed.
+	CSIDriver bool
+	// CSIMigration specifies whether the in-tree Azure Disk volume plugin is migrated to the CSI driver. It requires the
+	// CSI driver to be deployed.
+	CSIMigration bool
+}
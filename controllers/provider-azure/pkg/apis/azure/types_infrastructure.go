@@ -29,6 +29,21 @@ type InfrastructureConfig struct {
 	Networks NetworkConfig
 	// Zoned indicates whether the cluster uses zones
 	Zoned bool
+	// Identity contains configuration for the user-assigned managed identity of the worker nodes. If it is set,
+	// the identity is attached to the worker VMs and used by the kubelets instead of the service principal.
+	Identity *IdentityConfig
+}
+
+// IdentityConfig contains configuration for the user-assigned managed identity.
+type IdentityConfig struct {
+	// Name is the name of an existing user-assigned managed identity. If it is not set, a new identity is created
+	// in the resource group of the cluster.
+	Name *string
+	// ResourceGroup is the resource group of the existing user-assigned managed identity.
+	ResourceGroup *string
+	// AssignReaderRole indicates whether the identity is assigned the Reader role on the resource group of the
+	// cluster. This requires the Microsoft.Authorization/roleAssignments/write permission for the service principal.
+	AssignReaderRole bool
 }
 
 // ResourceGroup is azure resource group
@@ -64,6 +79,16 @@ type InfrastructureStatus struct {
 	SecurityGroups []SecurityGroup
 	// Zoned indicates whether the cluster uses zones
 	Zoned bool
+	// Identity is the user-assigned managed identity of the worker nodes.
+	Identity *IdentityStatus
+}
+
+// IdentityStatus contains information about the user-assigned managed identity.
+type IdentityStatus struct {
+	// ID is the Azure resource ID of the identity.
+	ID string
+	// ClientID is the client ID of the identity.
+	ClientID string
 }
 
 // NetworkStatus is the current status of the infrastructure networks.
@@ -20,6 +20,26 @@ import (
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// WorkerConfig contains configuration settings for the worker nodes.
+type WorkerConfig struct {
+	metav1.TypeMeta
+
+	// MachineControllerManagerSettings contains configuration for the rollout of the machines of the worker pool.
+	MachineControllerManagerSettings *MachineControllerManagerSettings
+}
+
+// MachineControllerManagerSettings contains configuration for the rollout of the machines of a worker pool. The
+// machine-controller-manager only supports these timeouts for all machines of a shoot, hence the longest timeouts of
+// all worker pools apply to the machines of all worker pools.
+type MachineControllerManagerSettings struct {
+	// MachineDrainTimeout is the maximum duration for draining a machine that is replaced.
+	MachineDrainTimeout *metav1.Duration
+	// MachineCreationTimeout is the maximum duration for creating a machine until its node has joined the cluster.
+	MachineCreationTimeout *metav1.Duration
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // WorkerStatus contains information about created worker resources.
 type WorkerStatus struct {
 	metav1.TypeMeta
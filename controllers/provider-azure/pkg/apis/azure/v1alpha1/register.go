@@ -40,9 +40,12 @@ var (
 func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&CloudProfileConfig{},
+		&BackupBucketConfig{},
+		&BackupBucketStatus{},
 		&InfrastructureConfig{},
 		&InfrastructureStatus{},
 		&ControlPlaneConfig{},
+		&WorkerConfig{},
 		&WorkerStatus{},
 	)
 	return nil
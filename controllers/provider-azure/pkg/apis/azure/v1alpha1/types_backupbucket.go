@@ -0,0 +1,81 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BackupBucketConfig contains configuration settings for the backup bucket.
+type BackupBucketConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Encryption contains the encryption settings of the storage account of the bucket.
+	// +optional
+	Encryption *BucketEncryption `json:"encryption,omitempty"`
+	// Immutability contains the time-based retention policy of the blob container.
+	// +optional
+	Immutability *BucketImmutability `json:"immutability,omitempty"`
+	// BlockPublicAccess indicates whether public access to the blob container is disabled.
+	// +optional
+	BlockPublicAccess bool `json:"blockPublicAccess,omitempty"`
+	// Lifecycle contains the lifecycle rules of the storage account of the bucket.
+	// +optional
+	Lifecycle *BucketLifecycle `json:"lifecycle,omitempty"`
+}
+
+// BucketEncryption contains the encryption settings of a storage account.
+type BucketEncryption struct {
+	// KeyVaultURI is the URI of the key vault that contains the customer-managed key.
+	KeyVaultURI string `json:"keyVaultURI"`
+	// KeyName is the name of the customer-managed key in the key vault.
+	KeyName string `json:"keyName"`
+	// KeyVersion is the version of the customer-managed key. If it is not set, the current version is used.
+	// +optional
+	KeyVersion *string `json:"keyVersion,omitempty"`
+}
+
+// BucketImmutability contains the time-based retention policy of a blob container.
+type BucketImmutability struct {
+	// RetentionDays is the number of days after creation during which blobs can neither be modified nor deleted.
+	RetentionDays int32 `json:"retentionDays"`
+}
+
+// BucketLifecycle contains the lifecycle rules of a storage account.
+type BucketLifecycle struct {
+	// ExpirationDays is the number of days after the last modification at which blobs are deleted.
+	ExpirationDays int32 `json:"expirationDays"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BackupBucketStatus contains information about the settings applied to the backup bucket.
+type BackupBucketStatus struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Encryption contains the encryption settings applied to the storage account of the bucket.
+	// +optional
+	Encryption *BucketEncryption `json:"encryption,omitempty"`
+	// Immutability contains the time-based retention policy applied to the blob container.
+	// +optional
+	Immutability *BucketImmutability `json:"immutability,omitempty"`
+	// PublicAccessBlocked indicates whether public access to the blob container is disabled.
+	PublicAccessBlocked bool `json:"publicAccessBlocked"`
+	// Lifecycle contains the lifecycle rules applied to the storage account of the bucket.
+	// +optional
+	Lifecycle *BucketLifecycle `json:"lifecycle,omitempty"`
+}
@@ -31,6 +31,25 @@ type InfrastructureConfig struct {
 	// Zoned indicates whether the cluster uses zones
 	// +optional
 	Zoned bool `json:"zoned,omitempty"`
+	// Identity contains configuration for the user-assigned managed identity of the worker nodes. If it is set,
+	// the identity is attached to the worker VMs and used by the kubelets instead of the service principal.
+	// +optional
+	Identity *IdentityConfig `json:"identity,omitempty"`
+}
+
+// IdentityConfig contains configuration for the user-assigned managed identity.
+type IdentityConfig struct {
+	// Name is the name of an existing user-assigned managed identity. If it is not set, a new identity is created
+	// in the resource group of the cluster.
+	// +optional
+	Name *string `json:"name,omitempty"`
+	// ResourceGroup is the resource group of the existing user-assigned managed identity.
+	// +optional
+	ResourceGroup *string `json:"resourceGroup,omitempty"`
+	// AssignReaderRole indicates whether the identity is assigned the Reader role on the resource group of the
+	// cluster. This requires the Microsoft.Authorization/roleAssignments/write permission for the service principal.
+	// +optional
+	AssignReaderRole bool `json:"assignReaderRole,omitempty"`
 }
 
 // ResourceGroup is azure resource group
@@ -68,6 +87,17 @@ type InfrastructureStatus struct {
 	// Zoned indicates whether the cluster uses zones
 	// +optional
 	Zoned bool `json:"zoned,omitempty"`
+	// Identity is the user-assigned managed identity of the worker nodes.
+	// +optional
+	Identity *IdentityStatus `json:"identity,omitempty"`
+}
+
+// IdentityStatus contains information about the user-assigned managed identity.
+type IdentityStatus struct {
+	// ID is the Azure resource ID of the identity.
+	ID string `json:"id"`
+	// ClientID is the client ID of the identity.
+	ClientID string `json:"clientID"`
 }
 
 // NetworkStatus is the current status of the infrastructure networks.
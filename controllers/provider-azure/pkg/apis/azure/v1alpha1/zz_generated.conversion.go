@@ -24,6 +24,7 @@ import (
 	unsafe "unsafe"
 
 	azure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -45,6 +46,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BackupBucketConfig)(nil), (*azure.BackupBucketConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BackupBucketConfig_To_azure_BackupBucketConfig(a.(*BackupBucketConfig), b.(*azure.BackupBucketConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.BackupBucketConfig)(nil), (*BackupBucketConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(a.(*azure.BackupBucketConfig), b.(*BackupBucketConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BackupBucketStatus)(nil), (*azure.BackupBucketStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BackupBucketStatus_To_azure_BackupBucketStatus(a.(*BackupBucketStatus), b.(*azure.BackupBucketStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.BackupBucketStatus)(nil), (*BackupBucketStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_BackupBucketStatus_To_v1alpha1_BackupBucketStatus(a.(*azure.BackupBucketStatus), b.(*BackupBucketStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BucketEncryption)(nil), (*azure.BucketEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BucketEncryption_To_azure_BucketEncryption(a.(*BucketEncryption), b.(*azure.BucketEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.BucketEncryption)(nil), (*BucketEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_BucketEncryption_To_v1alpha1_BucketEncryption(a.(*azure.BucketEncryption), b.(*BucketEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BucketImmutability)(nil), (*azure.BucketImmutability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BucketImmutability_To_azure_BucketImmutability(a.(*BucketImmutability), b.(*azure.BucketImmutability), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.BucketImmutability)(nil), (*BucketImmutability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_BucketImmutability_To_v1alpha1_BucketImmutability(a.(*azure.BucketImmutability), b.(*BucketImmutability), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BucketLifecycle)(nil), (*azure.BucketLifecycle)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BucketLifecycle_To_azure_BucketLifecycle(a.(*BucketLifecycle), b.(*azure.BucketLifecycle), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.BucketLifecycle)(nil), (*BucketLifecycle)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_BucketLifecycle_To_v1alpha1_BucketLifecycle(a.(*azure.BucketLifecycle), b.(*BucketLifecycle), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudControllerManagerConfig)(nil), (*azure.CloudControllerManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CloudControllerManagerConfig_To_azure_CloudControllerManagerConfig(a.(*CloudControllerManagerConfig), b.(*azure.CloudControllerManagerConfig), scope)
 	}); err != nil {
@@ -85,6 +136,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*IdentityConfig)(nil), (*azure.IdentityConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IdentityConfig_To_azure_IdentityConfig(a.(*IdentityConfig), b.(*azure.IdentityConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.IdentityConfig)(nil), (*IdentityConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_IdentityConfig_To_v1alpha1_IdentityConfig(a.(*azure.IdentityConfig), b.(*IdentityConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*IdentityStatus)(nil), (*azure.IdentityStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IdentityStatus_To_azure_IdentityStatus(a.(*IdentityStatus), b.(*azure.IdentityStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.IdentityStatus)(nil), (*IdentityStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_IdentityStatus_To_v1alpha1_IdentityStatus(a.(*azure.IdentityStatus), b.(*IdentityStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*InfrastructureConfig)(nil), (*azure.InfrastructureConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_InfrastructureConfig_To_azure_InfrastructureConfig(a.(*InfrastructureConfig), b.(*azure.InfrastructureConfig), scope)
 	}); err != nil {
@@ -105,6 +176,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MachineControllerManagerSettings)(nil), (*azure.MachineControllerManagerSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineControllerManagerSettings_To_azure_MachineControllerManagerSettings(a.(*MachineControllerManagerSettings), b.(*azure.MachineControllerManagerSettings), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.MachineControllerManagerSettings)(nil), (*MachineControllerManagerSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_MachineControllerManagerSettings_To_v1alpha1_MachineControllerManagerSettings(a.(*azure.MachineControllerManagerSettings), b.(*MachineControllerManagerSettings), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MachineImage)(nil), (*azure.MachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_MachineImage_To_azure_MachineImage(a.(*MachineImage), b.(*azure.MachineImage), scope)
 	}); err != nil {
@@ -185,6 +266,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Storage)(nil), (*azure.Storage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Storage_To_azure_Storage(a.(*Storage), b.(*azure.Storage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.Storage)(nil), (*Storage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_Storage_To_v1alpha1_Storage(a.(*azure.Storage), b.(*Storage), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Subnet)(nil), (*azure.Subnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Subnet_To_azure_Subnet(a.(*Subnet), b.(*azure.Subnet), scope)
 	}); err != nil {
@@ -215,6 +306,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WorkerConfig)(nil), (*azure.WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerConfig_To_azure_WorkerConfig(a.(*WorkerConfig), b.(*azure.WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.WorkerConfig)(nil), (*WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_WorkerConfig_To_v1alpha1_WorkerConfig(a.(*azure.WorkerConfig), b.(*WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*WorkerStatus)(nil), (*azure.WorkerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_WorkerStatus_To_azure_WorkerStatus(a.(*WorkerStatus), b.(*azure.WorkerStatus), scope)
 	}); err != nil {
@@ -252,6 +353,122 @@ func Convert_azure_AvailabilitySet_To_v1alpha1_AvailabilitySet(in *azure.Availab
 	return autoConvert_azure_AvailabilitySet_To_v1alpha1_AvailabilitySet(in, out, s)
 }
 
+func autoConvert_v1alpha1_BackupBucketConfig_To_azure_BackupBucketConfig(in *BackupBucketConfig, out *azure.BackupBucketConfig, s conversion.Scope) error {
+	out.Encryption = (*azure.BucketEncryption)(unsafe.Pointer(in.Encryption))
+	out.Immutability = (*azure.BucketImmutability)(unsafe.Pointer(in.Immutability))
+	out.BlockPublicAccess = in.BlockPublicAccess
+	out.Lifecycle = (*azure.BucketLifecycle)(unsafe.Pointer(in.Lifecycle))
+	return nil
+}
+
+// Convert_v1alpha1_BackupBucketConfig_To_azure_BackupBucketConfig is an autogenerated conversion function.
+func Convert_v1alpha1_BackupBucketConfig_To_azure_BackupBucketConfig(in *BackupBucketConfig, out *azure.BackupBucketConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BackupBucketConfig_To_azure_BackupBucketConfig(in, out, s)
+}
+
+func autoConvert_azure_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *azure.BackupBucketConfig, out *BackupBucketConfig, s conversion.Scope) error {
+	out.Encryption = (*BucketEncryption)(unsafe.Pointer(in.Encryption))
+	out.Immutability = (*BucketImmutability)(unsafe.Pointer(in.Immutability))
+	out.BlockPublicAccess = in.BlockPublicAccess
+	out.Lifecycle = (*BucketLifecycle)(unsafe.Pointer(in.Lifecycle))
+	return nil
+}
+
+// Convert_azure_BackupBucketConfig_To_v1alpha1_BackupBucketConfig is an autogenerated conversion function.
+func Convert_azure_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *azure.BackupBucketConfig, out *BackupBucketConfig, s conversion.Scope) error {
+	return autoConvert_azure_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_BackupBucketStatus_To_azure_BackupBucketStatus(in *BackupBucketStatus, out *azure.BackupBucketStatus, s conversion.Scope) error {
+	out.Encryption = (*azure.BucketEncryption)(unsafe.Pointer(in.Encryption))
+	out.Immutability = (*azure.BucketImmutability)(unsafe.Pointer(in.Immutability))
+	out.PublicAccessBlocked = in.PublicAccessBlocked
+	out.Lifecycle = (*azure.BucketLifecycle)(unsafe.Pointer(in.Lifecycle))
+	return nil
+}
+
+// Convert_v1alpha1_BackupBucketStatus_To_azure_BackupBucketStatus is an autogenerated conversion function.
+func Convert_v1alpha1_BackupBucketStatus_To_azure_BackupBucketStatus(in *BackupBucketStatus, out *azure.BackupBucketStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BackupBucketStatus_To_azure_BackupBucketStatus(in, out, s)
+}
+
+func autoConvert_azure_BackupBucketStatus_To_v1alpha1_BackupBucketStatus(in *azure.BackupBucketStatus, out *BackupBucketStatus, s conversion.Scope) error {
+	out.Encryption = (*BucketEncryption)(unsafe.Pointer(in.Encryption))
+	out.Immutability = (*BucketImmutability)(unsafe.Pointer(in.Immutability))
+	out.PublicAccessBlocked = in.PublicAccessBlocked
+	out.Lifecycle = (*BucketLifecycle)(unsafe.Pointer(in.Lifecycle))
+	return nil
+}
+
+// Convert_azure_BackupBucketStatus_To_v1alpha1_BackupBucketStatus is an autogenerated conversion function.
+func Convert_azure_BackupBucketStatus_To_v1alpha1_BackupBucketStatus(in *azure.BackupBucketStatus, out *BackupBucketStatus, s conversion.Scope) error {
+	return autoConvert_azure_BackupBucketStatus_To_v1alpha1_BackupBucketStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_BucketEncryption_To_azure_BucketEncryption(in *BucketEncryption, out *azure.BucketEncryption, s conversion.Scope) error {
+	out.KeyVaultURI = in.KeyVaultURI
+	out.KeyName = in.KeyName
+	out.KeyVersion = (*string)(unsafe.Pointer(in.KeyVersion))
+	return nil
+}
+
+// Convert_v1alpha1_BucketEncryption_To_azure_BucketEncryption is an autogenerated conversion function.
+func Convert_v1alpha1_BucketEncryption_To_azure_BucketEncryption(in *BucketEncryption, out *azure.BucketEncryption, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BucketEncryption_To_azure_BucketEncryption(in, out, s)
+}
+
+func autoConvert_azure_BucketEncryption_To_v1alpha1_BucketEncryption(in *azure.BucketEncryption, out *BucketEncryption, s conversion.Scope) error {
+	out.KeyVaultURI = in.KeyVaultURI
+	out.KeyName = in.KeyName
+	out.KeyVersion = (*string)(unsafe.Pointer(in.KeyVersion))
+	return nil
+}
+
+// Convert_azure_BucketEncryption_To_v1alpha1_BucketEncryption is an autogenerated conversion function.
+func Convert_azure_BucketEncryption_To_v1alpha1_BucketEncryption(in *azure.BucketEncryption, out *BucketEncryption, s conversion.Scope) error {
+	return autoConvert_azure_BucketEncryption_To_v1alpha1_BucketEncryption(in, out, s)
+}
+
+func autoConvert_v1alpha1_BucketImmutability_To_azure_BucketImmutability(in *BucketImmutability, out *azure.BucketImmutability, s conversion.Scope) error {
+	out.RetentionDays = in.RetentionDays
+	return nil
+}
+
+// Convert_v1alpha1_BucketImmutability_To_azure_BucketImmutability is an autogenerated conversion function.
+func Convert_v1alpha1_BucketImmutability_To_azure_BucketImmutability(in *BucketImmutability, out *azure.BucketImmutability, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BucketImmutability_To_azure_BucketImmutability(in, out, s)
+}
+
+func autoConvert_azure_BucketImmutability_To_v1alpha1_BucketImmutability(in *azure.BucketImmutability, out *BucketImmutability, s conversion.Scope) error {
+	out.RetentionDays = in.RetentionDays
+	return nil
+}
+
+// Convert_azure_BucketImmutability_To_v1alpha1_BucketImmutability is an autogenerated conversion function.
+func Convert_azure_BucketImmutability_To_v1alpha1_BucketImmutability(in *azure.BucketImmutability, out *BucketImmutability, s conversion.Scope) error {
+	return autoConvert_azure_BucketImmutability_To_v1alpha1_BucketImmutability(in, out, s)
+}
+
+func autoConvert_v1alpha1_BucketLifecycle_To_azure_BucketLifecycle(in *BucketLifecycle, out *azure.BucketLifecycle, s conversion.Scope) error {
+	out.ExpirationDays = in.ExpirationDays
+	return nil
+}
+
+// Convert_v1alpha1_BucketLifecycle_To_azure_BucketLifecycle is an autogenerated conversion function.
+func Convert_v1alpha1_BucketLifecycle_To_azure_BucketLifecycle(in *BucketLifecycle, out *azure.BucketLifecycle, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BucketLifecycle_To_azure_BucketLifecycle(in, out, s)
+}
+
+func autoConvert_azure_BucketLifecycle_To_v1alpha1_BucketLifecycle(in *azure.BucketLifecycle, out *BucketLifecycle, s conversion.Scope) error {
+	out.ExpirationDays = in.ExpirationDays
+	return nil
+}
+
+// Convert_azure_BucketLifecycle_To_v1alpha1_BucketLifecycle is an autogenerated conversion function.
+func Convert_azure_BucketLifecycle_To_v1alpha1_BucketLifecycle(in *azure.BucketLifecycle, out *BucketLifecycle, s conversion.Scope) error {
+	return autoConvert_azure_BucketLifecycle_To_v1alpha1_BucketLifecycle(in, out, s)
+}
+
 func autoConvert_v1alpha1_CloudControllerManagerConfig_To_azure_CloudControllerManagerConfig(in *CloudControllerManagerConfig, out *azure.CloudControllerManagerConfig, s conversion.Scope) error {
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
 	return nil
@@ -298,6 +515,7 @@ func Convert_azure_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in *azure.C
 
 func autoConvert_v1alpha1_ControlPlaneConfig_To_azure_ControlPlaneConfig(in *ControlPlaneConfig, out *azure.ControlPlaneConfig, s conversion.Scope) error {
 	out.CloudControllerManager = (*azure.CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.Storage = (*azure.Storage)(unsafe.Pointer(in.Storage))
 	return nil
 }
 
@@ -308,6 +526,7 @@ func Convert_v1alpha1_ControlPlaneConfig_To_azure_ControlPlaneConfig(in *Control
 
 func autoConvert_azure_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *azure.ControlPlaneConfig, out *ControlPlaneConfig, s conversion.Scope) error {
 	out.CloudControllerManager = (*CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.Storage = (*Storage)(unsafe.Pointer(in.Storage))
 	return nil
 }
 
@@ -338,12 +557,59 @@ func Convert_azure_DomainCount_To_v1alpha1_DomainCount(in *azure.DomainCount, ou
 	return autoConvert_azure_DomainCount_To_v1alpha1_DomainCount(in, out, s)
 }
 
+func autoConvert_v1alpha1_IdentityConfig_To_azure_IdentityConfig(in *IdentityConfig, out *azure.IdentityConfig, s conversion.Scope) error {
+	out.Name = (*string)(unsafe.Pointer(in.Name))
+	out.ResourceGroup = (*string)(unsafe.Pointer(in.ResourceGroup))
+	out.AssignReaderRole = in.AssignReaderRole
+	return nil
+}
+
+// Convert_v1alpha1_IdentityConfig_To_azure_IdentityConfig is an autogenerated conversion function.
+func Convert_v1alpha1_IdentityConfig_To_azure_IdentityConfig(in *IdentityConfig, out *azure.IdentityConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IdentityConfig_To_azure_IdentityConfig(in, out, s)
+}
+
+func autoConvert_azure_IdentityConfig_To_v1alpha1_IdentityConfig(in *azure.IdentityConfig, out *IdentityConfig, s conversion.Scope) error {
+	out.Name = (*string)(unsafe.Pointer(in.Name))
+	out.ResourceGroup = (*string)(unsafe.Pointer(in.ResourceGroup))
+	out.AssignReaderRole = in.AssignReaderRole
+	return nil
+}
+
+// Convert_azure_IdentityConfig_To_v1alpha1_IdentityConfig is an autogenerated conversion function.
+func Convert_azure_IdentityConfig_To_v1alpha1_IdentityConfig(in *azure.IdentityConfig, out *IdentityConfig, s conversion.Scope) error {
+	return autoConvert_azure_IdentityConfig_To_v1alpha1_IdentityConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_IdentityStatus_To_azure_IdentityStatus(in *IdentityStatus, out *azure.IdentityStatus, s conversion.Scope) error {
+	out.ID = in.ID
+	out.ClientID = in.ClientID
+	return nil
+}
+
+// Convert_v1alpha1_IdentityStatus_To_azure_IdentityStatus is an autogenerated conversion function.
+func Convert_v1alpha1_IdentityStatus_To_azure_IdentityStatus(in *IdentityStatus, out *azure.IdentityStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IdentityStatus_To_azure_IdentityStatus(in, out, s)
+}
+
+func autoConvert_azure_IdentityStatus_To_v1alpha1_IdentityStatus(in *azure.IdentityStatus, out *IdentityStatus, s conversion.Scope) error {
+	out.ID = in.ID
+	out.ClientID = in.ClientID
+	return nil
+}
+
+// Convert_azure_IdentityStatus_To_v1alpha1_IdentityStatus is an autogenerated conversion function.
+func Convert_azure_IdentityStatus_To_v1alpha1_IdentityStatus(in *azure.IdentityStatus, out *IdentityStatus, s conversion.Scope) error {
+	return autoConvert_azure_IdentityStatus_To_v1alpha1_IdentityStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_InfrastructureConfig_To_azure_InfrastructureConfig(in *InfrastructureConfig, out *azure.InfrastructureConfig, s conversion.Scope) error {
 	out.ResourceGroup = (*azure.ResourceGroup)(unsafe.Pointer(in.ResourceGroup))
 	if err := Convert_v1alpha1_NetworkConfig_To_azure_NetworkConfig(&in.Networks, &out.Networks, s); err != nil {
 		return err
 	}
 	out.Zoned = in.Zoned
+	out.Identity = (*azure.IdentityConfig)(unsafe.Pointer(in.Identity))
 	return nil
 }
 
@@ -358,6 +624,7 @@ func autoConvert_azure_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(in
 		return err
 	}
 	out.Zoned = in.Zoned
+	out.Identity = (*IdentityConfig)(unsafe.Pointer(in.Identity))
 	return nil
 }
 
@@ -377,6 +644,7 @@ func autoConvert_v1alpha1_InfrastructureStatus_To_azure_InfrastructureStatus(in
 	out.RouteTables = *(*[]azure.RouteTable)(unsafe.Pointer(&in.RouteTables))
 	out.SecurityGroups = *(*[]azure.SecurityGroup)(unsafe.Pointer(&in.SecurityGroups))
 	out.Zoned = in.Zoned
+	out.Identity = (*azure.IdentityStatus)(unsafe.Pointer(in.Identity))
 	return nil
 }
 
@@ -396,6 +664,7 @@ func autoConvert_azure_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in
 	out.RouteTables = *(*[]RouteTable)(unsafe.Pointer(&in.RouteTables))
 	out.SecurityGroups = *(*[]SecurityGroup)(unsafe.Pointer(&in.SecurityGroups))
 	out.Zoned = in.Zoned
+	out.Identity = (*IdentityStatus)(unsafe.Pointer(in.Identity))
 	return nil
 }
 
@@ -404,6 +673,28 @@ func Convert_azure_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in *azu
 	return autoConvert_azure_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_MachineControllerManagerSettings_To_azure_MachineControllerManagerSettings(in *MachineControllerManagerSettings, out *azure.MachineControllerManagerSettings, s conversion.Scope) error {
+	out.MachineDrainTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineDrainTimeout))
+	out.MachineCreationTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineCreationTimeout))
+	return nil
+}
+
+// Convert_v1alpha1_MachineControllerManagerSettings_To_azure_MachineControllerManagerSettings is an autogenerated conversion function.
+func Convert_v1alpha1_MachineControllerManagerSettings_To_azure_MachineControllerManagerSettings(in *MachineControllerManagerSettings, out *azure.MachineControllerManagerSettings, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineControllerManagerSettings_To_azure_MachineControllerManagerSettings(in, out, s)
+}
+
+func autoConvert_azure_MachineControllerManagerSettings_To_v1alpha1_MachineControllerManagerSettings(in *azure.MachineControllerManagerSettings, out *MachineControllerManagerSettings, s conversion.Scope) error {
+	out.MachineDrainTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineDrainTimeout))
+	out.MachineCreationTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineCreationTimeout))
+	return nil
+}
+
+// Convert_azure_MachineControllerManagerSettings_To_v1alpha1_MachineControllerManagerSettings is an autogenerated conversion function.
+func Convert_azure_MachineControllerManagerSettings_To_v1alpha1_MachineControllerManagerSettings(in *azure.MachineControllerManagerSettings, out *MachineControllerManagerSettings, s conversion.Scope) error {
+	return autoConvert_azure_MachineControllerManagerSettings_To_v1alpha1_MachineControllerManagerSettings(in, out, s)
+}
+
 func autoConvert_v1alpha1_MachineImage_To_azure_MachineImage(in *MachineImage, out *azure.MachineImage, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Version = in.Version
@@ -596,6 +887,28 @@ func Convert_azure_SecurityGroup_To_v1alpha1_SecurityGroup(in *azure.SecurityGro
 	return autoConvert_azure_SecurityGroup_To_v1alpha1_SecurityGroup(in, out, s)
 }
 
+func autoConvert_v1alpha1_Storage_To_azure_Storage(in *Storage, out *azure.Storage, s conversion.Scope) error {
+	out.CSIDriver = in.CSIDriver
+	out.CSIMigration = in.CSIMigration
+	return nil
+}
+
+// Convert_v1alpha1_Storage_To_azure_Storage is an autogenerated conversion function.
+func Convert_v1alpha1_Storage_To_azure_Storage(in *Storage, out *azure.Storage, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Storage_To_azure_Storage(in, out, s)
+}
+
+func autoConvert_azure_Storage_To_v1alpha1_Storage(in *azure.Storage, out *Storage, s conversion.Scope) error {
+	out.CSIDriver = in.CSIDriver
+	out.CSIMigration = in.CSIMigration
+	return nil
+}
+
+// Convert_azure_Storage_To_v1alpha1_Storage is an autogenerated conversion function.
+func Convert_azure_Storage_To_v1alpha1_Storage(in *azure.Storage, out *Storage, s conversion.Scope) error {
+	return autoConvert_azure_Storage_To_v1alpha1_Storage(in, out, s)
+}
+
 func autoConvert_v1alpha1_Subnet_To_azure_Subnet(in *Subnet, out *azure.Subnet, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Purpose = azure.Purpose(in.Purpose)
@@ -660,6 +973,26 @@ func Convert_azure_VNetStatus_To_v1alpha1_VNetStatus(in *azure.VNetStatus, out *
 	return autoConvert_azure_VNetStatus_To_v1alpha1_VNetStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_WorkerConfig_To_azure_WorkerConfig(in *WorkerConfig, out *azure.WorkerConfig, s conversion.Scope) error {
+	out.MachineControllerManagerSettings = (*azure.MachineControllerManagerSettings)(unsafe.Pointer(in.MachineControllerManagerSettings))
+	return nil
+}
+
+// Convert_v1alpha1_WorkerConfig_To_azure_WorkerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerConfig_To_azure_WorkerConfig(in *WorkerConfig, out *azure.WorkerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerConfig_To_azure_WorkerConfig(in, out, s)
+}
+
+func autoConvert_azure_WorkerConfig_To_v1alpha1_WorkerConfig(in *azure.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	out.MachineControllerManagerSettings = (*MachineControllerManagerSettings)(unsafe.Pointer(in.MachineControllerManagerSettings))
+	return nil
+}
+
+// Convert_azure_WorkerConfig_To_v1alpha1_WorkerConfig is an autogenerated conversion function.
+func Convert_azure_WorkerConfig_To_v1alpha1_WorkerConfig(in *azure.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	return autoConvert_azure_WorkerConfig_To_v1alpha1_WorkerConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_WorkerStatus_To_azure_WorkerStatus(in *WorkerStatus, out *azure.WorkerStatus, s conversion.Scope) error {
 	out.MachineImages = *(*[]azure.MachineImage)(unsafe.Pointer(&in.MachineImages))
 	return nil
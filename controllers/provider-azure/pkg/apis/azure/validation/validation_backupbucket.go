@@ -0,0 +1,56 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"net/url"
+
+	apisazure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// MaxImmutabilityRetentionDays is the maximum number of days of a time-based retention policy of a blob container.
+const MaxImmutabilityRetentionDays = 146000
+
+// ValidateBackupBucketConfig validates a BackupBucketConfig object.
+func ValidateBackupBucketConfig(config *apisazure.BackupBucketConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if encryption := config.Encryption; encryption != nil {
+		encryptionPath := field.NewPath("encryption")
+		if len(encryption.KeyVaultURI) == 0 {
+			allErrs = append(allErrs, field.Required(encryptionPath.Child("keyVaultURI"), "must provide the uri of a key vault"))
+		} else if u, err := url.Parse(encryption.KeyVaultURI); err != nil || u.Scheme != "https" || len(u.Host) == 0 {
+			allErrs = append(allErrs, field.Invalid(encryptionPath.Child("keyVaultURI"), encryption.KeyVaultURI, "must be a valid https url"))
+		}
+		if len(encryption.KeyName) == 0 {
+			allErrs = append(allErrs, field.Required(encryptionPath.Child("keyName"), "must provide the name of a key"))
+		}
+		if encryption.KeyVersion != nil && len(*encryption.KeyVersion) == 0 {
+			allErrs = append(allErrs, field.Required(encryptionPath.Child("keyVersion"), "must not provide an empty key version"))
+		}
+	}
+
+	if immutability := config.Immutability; immutability != nil && (immutability.RetentionDays <= 0 || immutability.RetentionDays > MaxImmutabilityRetentionDays) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("immutability", "retentionDays"), immutability.RetentionDays, "retention days must be between 1 and 146000"))
+	}
+
+	if lifecycle := config.Lifecycle; lifecycle != nil && lifecycle.ExpirationDays <= 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("lifecycle", "expirationDays"), lifecycle.ExpirationDays, "expiration days must be positive"))
+	}
+
+	return allErrs
+}
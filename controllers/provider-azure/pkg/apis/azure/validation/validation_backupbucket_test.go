@@ -0,0 +1,92 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisazure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
+	. "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("BackupBucketConfig validation", func() {
+	Describe("#ValidateBackupBucketConfig", func() {
+		var config *apisazure.BackupBucketConfig
+
+		BeforeEach(func() {
+			config = &apisazure.BackupBucketConfig{
+				Encryption: &apisazure.BucketEncryption{
+					KeyVaultURI: "https://foo.vault.azure.net",
+					KeyName:     "bar",
+				},
+				Immutability: &apisazure.BucketImmutability{
+					RetentionDays: 7,
+				},
+				BlockPublicAccess: true,
+				Lifecycle: &apisazure.BucketLifecycle{
+					ExpirationDays: 30,
+				},
+			}
+		})
+
+		It("should allow a valid configuration", func() {
+			Expect(ValidateBackupBucketConfig(config)).To(BeEmpty())
+		})
+
+		It("should forbid incomplete encryption settings", func() {
+			config.Encryption = &apisazure.BucketEncryption{KeyVersion: pointer.StringPtr("")}
+
+			Expect(ValidateBackupBucketConfig(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("encryption.keyVaultURI"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("encryption.keyName"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("encryption.keyVersion"),
+				})),
+			))
+		})
+
+		It("should forbid an invalid key vault uri, retention and expiration days", func() {
+			config.Encryption.KeyVaultURI = "http://foo.vault.azure.net"
+			config.Immutability.RetentionDays = MaxImmutabilityRetentionDays + 1
+			config.Lifecycle.ExpirationDays = 0
+
+			Expect(ValidateBackupBucketConfig(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("encryption.keyVaultURI"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("immutability.retentionDays"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("lifecycle.expirationDays"),
+				})),
+			))
+		})
+	})
+})
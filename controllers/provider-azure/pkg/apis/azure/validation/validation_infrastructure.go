@@ -0,0 +1,109 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	apisazure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
+	cidrvalidation "github.com/gardener/gardener-extensions/pkg/util/validation/cidr"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateInfrastructureConfig validates a InfrastructureConfig object. The subnets are validated against the node,
+// pod and service CIDRs of the shoot, empty or invalid CIDRs of the shoot are ignored.
+func ValidateInfrastructureConfig(infra *apisazure.InfrastructureConfig, nodesCIDR, podsCIDR, servicesCIDR string) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var (
+		networksPath = field.NewPath("networks")
+		vnetPath     = networksPath.Child("vnet")
+
+		nodes    = cidrvalidation.NewCIDR(nodesCIDR, field.NewPath("nodes"))
+		pods     = cidrvalidation.NewCIDR(podsCIDR, field.NewPath("pods"))
+		services = cidrvalidation.NewCIDR(servicesCIDR, field.NewPath("services"))
+		workers  = cidrvalidation.NewCIDR(infra.Networks.Workers, networksPath.Child("workers"))
+	)
+
+	if infra.ResourceGroup != nil && len(infra.ResourceGroup.Name) == 0 {
+		allErrs = append(allErrs, field.Required(field.NewPath("resourceGroup", "name"), "must provide the name of an existing resource group"))
+	}
+
+	if infra.Networks.VNet.Name != nil && len(*infra.Networks.VNet.Name) == 0 {
+		allErrs = append(allErrs, field.Required(vnetPath.Child("name"), "must provide the name of an existing vnet"))
+	}
+	if infra.Networks.VNet.CIDR != nil {
+		vnet := cidrvalidation.NewCIDR(*infra.Networks.VNet.CIDR, vnetPath.Child("cidr"))
+		allErrs = append(allErrs, vnet.ValidateParse()...)
+		allErrs = append(allErrs, vnet.ValidateSubset(workers)...)
+	}
+
+	allErrs = append(allErrs, workers.ValidateParse()...)
+	allErrs = append(allErrs, nodes.ValidateSubset(workers)...)
+	allErrs = append(allErrs, pods.ValidateNotOverlap(workers)...)
+	allErrs = append(allErrs, services.ValidateNotOverlap(workers)...)
+
+	for i, serviceEndpoint := range infra.Networks.ServiceEndpoints {
+		if len(serviceEndpoint) == 0 {
+			allErrs = append(allErrs, field.Required(networksPath.Child("serviceEndpoints").Index(i), "must provide the name of a service endpoint"))
+		}
+	}
+
+	if infra.Identity != nil {
+		allErrs = append(allErrs, validateIdentity(infra.Identity, field.NewPath("identity"))...)
+	}
+
+	return allErrs
+}
+
+func validateIdentity(identity *apisazure.IdentityConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if identity.Name == nil && identity.ResourceGroup == nil {
+		return allErrs
+	}
+
+	if identity.Name == nil || len(*identity.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "must provide the name of an existing identity"))
+	}
+	if identity.ResourceGroup == nil || len(*identity.ResourceGroup) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("resourceGroup"), "must provide the resource group of an existing identity"))
+	}
+
+	return allErrs
+}
+
+// ValidateInfrastructureConfigUpdate validates a InfrastructureConfig object before an update. The resource group,
+// the vnet, the worker subnet and whether the cluster is zoned cannot be changed.
+func ValidateInfrastructureConfigUpdate(oldConfig, newConfig *apisazure.InfrastructureConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	networksPath := field.NewPath("networks")
+
+	if !apiequality.Semantic.DeepEqual(newConfig.ResourceGroup, oldConfig.ResourceGroup) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("resourceGroup"), newConfig.ResourceGroup, "field is immutable"))
+	}
+	if !apiequality.Semantic.DeepEqual(newConfig.Networks.VNet, oldConfig.Networks.VNet) {
+		allErrs = append(allErrs, field.Invalid(networksPath.Child("vnet"), newConfig.Networks.VNet, "field is immutable"))
+	}
+	if newConfig.Networks.Workers != oldConfig.Networks.Workers {
+		allErrs = append(allErrs, field.Invalid(networksPath.Child("workers"), newConfig.Networks.Workers, "field is immutable"))
+	}
+	if newConfig.Zoned != oldConfig.Zoned {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("zoned"), newConfig.Zoned, "field is immutable"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisazure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
+	. "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("InfrastructureConfig validation", func() {
+	var (
+		infrastructureConfig *apisazure.InfrastructureConfig
+
+		nodes    = "10.250.0.0/16"
+		pods     = "100.96.0.0/11"
+		services = "100.64.0.0/13"
+	)
+
+	BeforeEach(func() {
+		infrastructureConfig = &apisazure.InfrastructureConfig{
+			Networks: apisazure.NetworkConfig{
+				VNet: apisazure.VNet{
+					CIDR: pointer.StringPtr("10.250.0.0/16"),
+				},
+				Workers: "10.250.0.0/19",
+			},
+		}
+	})
+
+	Describe("#ValidateInfrastructureConfig", func() {
+		It("should accept a valid configuration", func() {
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, pods, services)).To(BeEmpty())
+		})
+
+		It("should require the name of an existing resource group", func() {
+			infrastructureConfig.ResourceGroup = &apisazure.ResourceGroup{}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, pods, services)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("resourceGroup.name"),
+			}))))
+		})
+
+		It("should forbid an invalid worker subnet", func() {
+			infrastructureConfig.Networks.Workers = "10.250.0.0"
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, pods, services)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.workers"),
+			}))))
+		})
+
+		It("should forbid a worker subnet outside of the vnet and the node network", func() {
+			infrastructureConfig.Networks.Workers = "10.251.0.0/19"
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, pods, services)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.workers"),
+					"Detail": ContainSubstring("networks.vnet.cidr"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.workers"),
+					"Detail": ContainSubstring("nodes"),
+				})),
+			))
+		})
+
+		It("should forbid a worker subnet overlapping with the pod network", func() {
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, "10.250.0.0/24", services)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("networks.workers"),
+				"Detail": ContainSubstring("pods"),
+			}))))
+		})
+
+		It("should accept an identity that is created", func() {
+			infrastructureConfig.Identity = &apisazure.IdentityConfig{}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, pods, services)).To(BeEmpty())
+		})
+
+		It("should accept an existing identity", func() {
+			infrastructureConfig.Identity = &apisazure.IdentityConfig{
+				Name:          pointer.StringPtr("identity"),
+				ResourceGroup: pointer.StringPtr("identity-group"),
+			}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, pods, services)).To(BeEmpty())
+		})
+
+		It("should require the name and resource group of an existing identity", func() {
+			infrastructureConfig.Identity = &apisazure.IdentityConfig{
+				Name: pointer.StringPtr(""),
+			}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, pods, services)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("identity.name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("identity.resourceGroup"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateInfrastructureConfigUpdate", func() {
+		It("should allow unchanged configurations", func() {
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, infrastructureConfig.DeepCopy())).To(BeEmpty())
+		})
+
+		It("should forbid changing immutable fields", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.ResourceGroup = &apisazure.ResourceGroup{Name: "foo"}
+			newInfrastructureConfig.Networks.VNet.CIDR = pointer.StringPtr("10.0.0.0/8")
+			newInfrastructureConfig.Networks.Workers = "10.250.32.0/19"
+			newInfrastructureConfig.Zoned = true
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("resourceGroup")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("networks.vnet")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("networks.workers")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("zoned")})),
+			))
+		})
+	})
+})
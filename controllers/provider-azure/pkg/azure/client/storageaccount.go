@@ -0,0 +1,271 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-04-01/storage"
+	"github.com/Azure/go-autorest/autorest/azure/auth"
+	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal"
+	"github.com/gardener/gardener-extensions/pkg/util"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// keyVaultAPIVersion is the API version of the Microsoft.KeyVault resource provider.
+const keyVaultAPIVersion = "2018-02-14"
+
+type storageAccounts struct {
+	accounts           storage.AccountsClient
+	blobContainers     storage.BlobContainersClient
+	managementPolicies storage.ManagementPoliciesClient
+	resources          resources.Client
+}
+
+// NewStorageAccountsFromSubscriptionSecretRef creates a new StorageAccounts client using the subscription details
+// from the secretRef.
+func NewStorageAccountsFromSubscriptionSecretRef(ctx context.Context, c client.Client, secretRef *corev1.SecretReference) (StorageAccounts, error) {
+	clientAuth, err := internal.GetClientAuthData(ctx, c, *secretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	clientCredConfig := auth.NewClientCredentialsConfig(clientAuth.ClientID, clientAuth.ClientSecret, clientAuth.TenantID)
+	authorizer, err := clientCredConfig.Authorizer()
+	if err != nil {
+		return nil, err
+	}
+
+	s := &storageAccounts{
+		accounts:           storage.NewAccountsClient(clientAuth.SubscriptionID),
+		blobContainers:     storage.NewBlobContainersClient(clientAuth.SubscriptionID),
+		managementPolicies: storage.NewManagementPoliciesClient(clientAuth.SubscriptionID),
+		resources:          resources.NewClient(clientAuth.SubscriptionID),
+	}
+	s.accounts.Authorizer = authorizer
+	s.blobContainers.Authorizer = authorizer
+	s.managementPolicies.Authorizer = authorizer
+	s.resources.Authorizer = authorizer
+	return s, nil
+}
+
+// UpdateStorageAccountConfig converges the encryption and lifecycle configuration of the storage account
+// <accountName> and the retention policy and public access of its blob container <container> to the given <config>.
+// A storage account can only use a customer-managed key once its identity has been granted access to the key vault,
+// hence the identity is assigned and granted access before the encryption is updated. Public access to the blob
+// container is only blocked if requested, but never enabled. The retention policy is never locked. It returns whether
+// public access to the blob container is blocked.
+func UpdateStorageAccountConfig(ctx context.Context, accounts StorageAccounts, resourceGroupName, accountName, container string, config *StorageAccountConfig) (bool, error) {
+	encryption := &storage.Encryption{
+		Services:  &storage.EncryptionServices{Blob: &storage.EncryptionService{Enabled: util.BoolPtr(true)}},
+		KeySource: storage.MicrosoftStorage,
+	}
+	if len(config.KeyVaultURI) > 0 {
+		principalID, tenantID, err := accounts.EnableSystemAssignedIdentity(ctx, resourceGroupName, accountName)
+		if err != nil {
+			return false, err
+		}
+		if err := accounts.GrantKeyVaultAccess(ctx, config.KeyVaultURI, tenantID, principalID); err != nil {
+			return false, err
+		}
+
+		encryption.KeySource = storage.MicrosoftKeyvault
+		encryption.KeyVaultProperties = &storage.KeyVaultProperties{
+			KeyVaultURI: util.StringPtr(config.KeyVaultURI),
+			KeyName:     util.StringPtr(config.KeyName),
+			KeyVersion:  util.StringPtr(config.KeyVersion),
+		}
+	}
+	if err := accounts.UpdateEncryption(ctx, resourceGroupName, accountName, encryption); err != nil {
+		return false, err
+	}
+
+	blobContainer, err := accounts.GetContainer(ctx, resourceGroupName, accountName, container)
+	if err != nil {
+		return false, err
+	}
+
+	publicAccessBlocked := blobContainer.ContainerProperties == nil || blobContainer.PublicAccess == storage.PublicAccessNone || len(blobContainer.PublicAccess) == 0
+	if config.BlockPublicAccess && !publicAccessBlocked {
+		if err := accounts.BlockContainerPublicAccess(ctx, resourceGroupName, accountName, container); err != nil {
+			return false, err
+		}
+		publicAccessBlocked = true
+	}
+
+	if config.ImmutabilityDays > 0 {
+		if err := accounts.SetImmutabilityPolicy(ctx, resourceGroupName, accountName, container, config.ImmutabilityDays); err != nil {
+			return false, err
+		}
+	} else if blobContainer.ContainerProperties != nil && blobContainer.HasImmutabilityPolicy != nil && *blobContainer.HasImmutabilityPolicy {
+		if err := accounts.DeleteImmutabilityPolicy(ctx, resourceGroupName, accountName, container); err != nil {
+			return false, err
+		}
+	}
+
+	if config.ExpirationDays > 0 {
+		return publicAccessBlocked, accounts.SetExpiration(ctx, resourceGroupName, accountName, config.ExpirationDays)
+	}
+	return publicAccessBlocked, accounts.DeleteExpiration(ctx, resourceGroupName, accountName)
+}
+
+func (s *storageAccounts) EnableSystemAssignedIdentity(ctx context.Context, resourceGroupName, accountName string) (string, string, error) {
+	account, err := s.accounts.Update(ctx, resourceGroupName, accountName, storage.AccountUpdateParameters{
+		Identity: &storage.Identity{Type: util.StringPtr("SystemAssigned")},
+	})
+	if err != nil {
+		return "", "", err
+	}
+	if account.Identity == nil || account.Identity.PrincipalID == nil || account.Identity.TenantID == nil {
+		return "", "", fmt.Errorf("storage account %s has no system-assigned identity", accountName)
+	}
+	return *account.Identity.PrincipalID, *account.Identity.TenantID, nil
+}
+
+// GrantKeyVaultAccess adds an access policy to the key vault that allows the given principal to use its keys for
+// the encryption of a storage account. The key vault must be part of the same subscription.
+func (s *storageAccounts) GrantKeyVaultAccess(ctx context.Context, keyVaultURI, tenantID, principalID string) error {
+	u, err := url.Parse(keyVaultURI)
+	if err != nil {
+		return err
+	}
+	keyVaultName := strings.Split(u.Hostname(), ".")[0]
+
+	vaults, err := s.resources.ListComplete(ctx, fmt.Sprintf("resourceType eq 'Microsoft.KeyVault/vaults' and name eq '%s'", keyVaultName), "", nil)
+	if err != nil {
+		return err
+	}
+	if !vaults.NotDone() || vaults.Value().ID == nil {
+		return fmt.Errorf("key vault %s not found", keyVaultName)
+	}
+	body, err := json.Marshal(map[string]interface{}{
+		"properties": map[string]interface{}{
+			"accessPolicies": []interface{}{
+				map[string]interface{}{
+					"tenantId": tenantID,
+					"objectId": principalID,
+					"permissions": map[string]interface{}{
+						"keys": []string{"get", "wrapKey", "unwrapKey"},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// The vendored SDK has no client for the Microsoft.KeyVault resource provider, hence the request is sent with the
+	// authorizer of the generic resources client.
+	req, err := http.NewRequest(http.MethodPut, fmt.Sprintf("%s%s/accessPolicies/add?api-version=%s", strings.TrimSuffix(s.resources.BaseURI, "/"), *vaults.Value().ID, keyVaultAPIVersion), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	resp, err := s.resources.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("could not grant access to key vault %s: %s", keyVaultName, resp.Status)
+	}
+	return nil
+}
+
+func (s *storageAccounts) UpdateEncryption(ctx context.Context, resourceGroupName, accountName string, encryption *storage.Encryption) error {
+	_, err := s.accounts.Update(ctx, resourceGroupName, accountName, storage.AccountUpdateParameters{
+		AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{Encryption: encryption},
+	})
+	return err
+}
+
+func (s *storageAccounts) GetContainer(ctx context.Context, resourceGroupName, accountName, container string) (*storage.BlobContainer, error) {
+	blobContainer, err := s.blobContainers.Get(ctx, resourceGroupName, accountName, container)
+	if err != nil {
+		return nil, err
+	}
+	return &blobContainer, nil
+}
+
+func (s *storageAccounts) BlockContainerPublicAccess(ctx context.Context, resourceGroupName, accountName, container string) error {
+	_, err := s.blobContainers.Update(ctx, resourceGroupName, accountName, container, storage.BlobContainer{
+		ContainerProperties: &storage.ContainerProperties{PublicAccess: storage.PublicAccessNone},
+	})
+	return err
+}
+
+func (s *storageAccounts) SetImmutabilityPolicy(ctx context.Context, resourceGroupName, accountName, container string, days int32) error {
+	_, err := s.blobContainers.CreateOrUpdateImmutabilityPolicy(ctx, resourceGroupName, accountName, container, &storage.ImmutabilityPolicy{
+		ImmutabilityPolicyProperty: &storage.ImmutabilityPolicyProperty{
+			ImmutabilityPeriodSinceCreationInDays: util.Int32Ptr(days),
+		},
+	}, "")
+	return err
+}
+
+func (s *storageAccounts) DeleteImmutabilityPolicy(ctx context.Context, resourceGroupName, accountName, container string) error {
+	policy, err := s.blobContainers.GetImmutabilityPolicy(ctx, resourceGroupName, accountName, container, "")
+	if err != nil {
+		return err
+	}
+	if policy.Etag == nil {
+		return fmt.Errorf("immutability policy of blob container %s has no etag", container)
+	}
+	_, err = s.blobContainers.DeleteImmutabilityPolicy(ctx, resourceGroupName, accountName, container, *policy.Etag)
+	return err
+}
+
+func (s *storageAccounts) SetExpiration(ctx context.Context, resourceGroupName, accountName string, days int32) error {
+	daysAfter := float64(days)
+	_, err := s.managementPolicies.CreateOrUpdate(ctx, resourceGroupName, accountName, storage.ManagementPolicy{
+		ManagementPolicyProperties: &storage.ManagementPolicyProperties{
+			Policy: &storage.ManagementPolicySchema{
+				Rules: &[]storage.ManagementPolicyRule{
+					{
+						Enabled: util.BoolPtr(true),
+						Name:    util.StringPtr("expiration"),
+						Type:    util.StringPtr("Lifecycle"),
+						Definition: &storage.ManagementPolicyDefinition{
+							Actions: &storage.ManagementPolicyAction{
+								BaseBlob: &storage.ManagementPolicyBaseBlob{Delete: &storage.DateAfterModification{DaysAfterModificationGreaterThan: &daysAfter}},
+								Snapshot: &storage.ManagementPolicySnapShot{Delete: &storage.DateAfterCreation{DaysAfterCreationGreaterThan: &daysAfter}},
+							},
+							Filters: &storage.ManagementPolicyFilter{BlobTypes: &[]string{"blockBlob"}},
+						},
+					},
+				},
+			},
+		},
+	})
+	return err
+}
+
+func (s *storageAccounts) DeleteExpiration(ctx context.Context, resourceGroupName, accountName string) error {
+	if result, err := s.managementPolicies.Delete(ctx, resourceGroupName, accountName); err != nil && (result.Response == nil || result.StatusCode != http.StatusNotFound) {
+		return err
+	}
+	return nil
+}
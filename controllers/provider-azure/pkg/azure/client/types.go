@@ -17,6 +17,7 @@ package client
 import (
 	"context"
 
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-04-01/storage"
 	"github.com/Azure/azure-storage-blob-go/azblob"
 )
 
@@ -28,6 +29,25 @@ type StorageAuth struct {
 	StorageKey []byte
 }
 
+// StorageAccountConfig contains the desired configuration of a storage account and its blob container.
+type StorageAccountConfig struct {
+	// KeyVaultURI is the URI of the key vault that contains the customer-managed key used to encrypt the storage
+	// account. If it is empty, Microsoft-managed keys are used.
+	KeyVaultURI string
+	// KeyName is the name of the customer-managed key in the key vault.
+	KeyName string
+	// KeyVersion is the version of the customer-managed key. If it is empty, the current version is used.
+	KeyVersion string
+	// ImmutabilityDays is the number of days of the time-based retention policy of the blob container. Zero means
+	// that the blob container has no retention policy.
+	ImmutabilityDays int32
+	// BlockPublicAccess indicates whether public access to the blob container is disabled.
+	BlockPublicAccess bool
+	// ExpirationDays is the number of days after the last modification at which blobs are deleted. Zero means that
+	// blobs are never deleted.
+	ExpirationDays int32
+}
+
 // StorageClient represents a Azure storage client.
 type StorageClient struct {
 	// serviceURL is azure storage serviceURL object configured with storage credentials and pipeline.
@@ -40,3 +60,26 @@ type Storage interface {
 	CreateContainerIfNotExists(ctx context.Context, container string) error
 	DeleteContainerIfExists(ctx context.Context, container string) error
 }
+
+// StorageAccounts is the interface to the management of storage accounts and their blob containers.
+type StorageAccounts interface {
+	// EnableSystemAssignedIdentity assigns a system-assigned identity to the storage account and returns the
+	// principal and tenant ID of the identity.
+	EnableSystemAssignedIdentity(ctx context.Context, resourceGroupName, accountName string) (principalID, tenantID string, err error)
+	// GrantKeyVaultAccess grants the given principal access to the keys of the key vault with the given URI.
+	GrantKeyVaultAccess(ctx context.Context, keyVaultURI, tenantID, principalID string) error
+	// UpdateEncryption updates the encryption settings of the storage account.
+	UpdateEncryption(ctx context.Context, resourceGroupName, accountName string, encryption *storage.Encryption) error
+	// GetContainer returns the blob container of the storage account.
+	GetContainer(ctx context.Context, resourceGroupName, accountName, container string) (*storage.BlobContainer, error)
+	// BlockContainerPublicAccess disables public access to the blob container.
+	BlockContainerPublicAccess(ctx context.Context, resourceGroupName, accountName, container string) error
+	// SetImmutabilityPolicy sets the time-based retention policy of the blob container.
+	SetImmutabilityPolicy(ctx context.Context, resourceGroupName, accountName, container string, days int32) error
+	// DeleteImmutabilityPolicy deletes the time-based retention policy of the blob container.
+	DeleteImmutabilityPolicy(ctx context.Context, resourceGroupName, accountName, container string) error
+	// SetExpiration sets the lifecycle rule that deletes blobs of the storage account after the given number of days.
+	SetExpiration(ctx context.Context, resourceGroupName, accountName string, days int32) error
+	// DeleteExpiration deletes the lifecycle rules of the storage account if they exist.
+	DeleteExpiration(ctx context.Context, resourceGroupName, accountName string) error
+}
@@ -28,6 +28,22 @@ const (
 	MachineControllerManagerImageName = "machine-controller-manager"
 	// ETCDBackupRestoreImageName is the name of the etcd backup and restore image.
 	ETCDBackupRestoreImageName = "etcd-backup-restore"
+	// CSIAttacherImageName is the name of the CSI attacher image.
+	CSIAttacherImageName = "csi-attacher"
+	// CSINodeDriverRegistrarImageName is the name of the CSI driver registrar image.
+	CSINodeDriverRegistrarImageName = "csi-node-driver-registrar"
+	// CSIProvisionerImageName is the name of the CSI provisioner image.
+	CSIProvisionerImageName = "csi-provisioner"
+	// CSISnapshotterImageName is the name of the CSI snapshotter image.
+	CSISnapshotterImageName = "csi-snapshotter"
+	// CSIDriverImageName is the name of the Azure Disk CSI driver image.
+	CSIDriverImageName = "azuredisk-csi-driver"
+
+	// CSIMinimumKubernetesVersion is the minimum Kubernetes version for which the Azure Disk CSI driver can be deployed
+	// and the in-tree volume plugin can be migrated to it, see the storage section of the ControlPlaneConfig.
+	CSIMinimumKubernetesVersion = "1.15"
+	// CSIMigrationFeatureGate is the name of the feature gate that migrates the in-tree Azure Disk volume plugin to CSI.
+	CSIMigrationFeatureGate = "CSIMigrationAzureDisk"
 
 	// MachineControllerManagerName is a constant for the name of the machine-controller-manager.
 	MachineControllerManagerName = "machine-controller-manager"
@@ -18,6 +18,8 @@ import (
 	"context"
 	"fmt"
 
+	apisazure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
+	azurev1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/v1alpha1"
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/azure"
 	azureclient "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/azure/client"
 	extensioncontroller "github.com/gardener/gardener-extensions/pkg/controller"
@@ -29,6 +31,8 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -36,13 +40,17 @@ import (
 
 type actuator struct {
 	backupbucket.Actuator
-	client client.Client
-	logger logr.Logger
+	client  client.Client
+	decoder runtime.Decoder
+	logger  logr.Logger
+
+	newStorageAccounts func(ctx context.Context, c client.Client, secretRef *corev1.SecretReference) (azureclient.StorageAccounts, error)
 }
 
 func newActuator() backupbucket.Actuator {
 	return &actuator{
-		logger: log.Log.WithName("azure-backupbucket-actuator"),
+		logger:             log.Log.WithName("azure-backupbucket-actuator"),
+		newStorageAccounts: azureclient.NewStorageAccountsFromSubscriptionSecretRef,
 	}
 }
 
@@ -51,13 +59,52 @@ func (a *actuator) InjectClient(client client.Client) error {
 	return nil
 }
 
+func (a *actuator) InjectScheme(scheme *runtime.Scheme) error {
+	a.decoder = serializer.NewCodecFactory(scheme).UniversalDecoder()
+	return nil
+}
+
 func (a *actuator) Reconcile(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
 	azureClient, err := a.getAzureClient(ctx, bb)
 	if err != nil {
 		return err
 	}
 
-	return azureClient.CreateContainerIfNotExists(ctx, bb.Name)
+	if err := azureClient.CreateContainerIfNotExists(ctx, bb.Name); err != nil {
+		return err
+	}
+
+	return a.reconcileConfig(ctx, bb)
+}
+
+// reconcileConfig converges the storage account and the blob container of the given BackupBucket to its provider
+// config and updates its provider status.
+func (a *actuator) reconcileConfig(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
+	// Buckets that never had a provider config are left untouched, whereas buckets whose provider config has been
+	// removed are reset to the defaults.
+	rawConfig := backupbucket.GetProviderConfig(bb)
+	if rawConfig == nil && len(bb.Status.State) == 0 {
+		return nil
+	}
+
+	config := &apisazure.BackupBucketConfig{}
+	if rawConfig != nil {
+		if _, _, err := a.decoder.Decode(rawConfig, nil, config); err != nil {
+			return fmt.Errorf("could not decode provider config: %+v", err)
+		}
+	}
+
+	storageAccounts, err := a.newStorageAccounts(ctx, a.client, &bb.Spec.SecretRef)
+	if err != nil {
+		return err
+	}
+
+	publicAccessBlocked, err := azureclient.UpdateStorageAccountConfig(ctx, storageAccounts, bb.Name, generateStorageAccountName(bb.Name), bb.Name, computeStorageAccountConfig(config))
+	if err != nil {
+		return err
+	}
+
+	return backupbucket.UpdateProviderStatus(ctx, a.client, bb, computeProviderStatus(config, publicAccessBlocked))
 }
 
 func (a *actuator) Delete(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
@@ -77,9 +124,7 @@ func (a *actuator) getAzureClient(ctx context.Context, bb *extensionsv1alpha1.Ba
 	if bb.Status.GeneratedSecretRef != nil {
 		return azureclient.NewStorageClientFromSecretRef(ctx, a.client, bb.Status.GeneratedSecretRef)
 	}
-	backupBucketNameSha := utils.ComputeSHA1Hex([]byte(bb.Name))
-	storageAccountName := fmt.Sprintf("bkp%s", backupBucketNameSha[:15])
-	storageAuth, err := azureclient.NewStorageClientAuthFromSubscriptionSecretRef(ctx, a.client, &bb.Spec.SecretRef, bb.Name, storageAccountName, bb.Spec.Region)
+	storageAuth, err := azureclient.NewStorageClientAuthFromSubscriptionSecretRef(ctx, a.client, &bb.Spec.SecretRef, bb.Name, generateStorageAccountName(bb.Name), bb.Spec.Region)
 	if err != nil {
 		return nil, err
 	}
@@ -113,6 +158,11 @@ func (a *actuator) getAzureClient(ctx context.Context, bb *extensionsv1alpha1.Ba
 	return azureclient.NewStorageClientFromStorageAuth(storageAuth)
 }
 
+func generateStorageAccountName(backupBucketName string) string {
+	backupBucketNameSha := utils.ComputeSHA1Hex([]byte(backupBucketName))
+	return fmt.Sprintf("bkp%s", backupBucketNameSha[:15])
+}
+
 func generateGeneratedBackupBucketSecretName(backupBucketName string) string {
 	return fmt.Sprintf("generated-bucket-%s", backupBucketName)
 }
@@ -133,3 +183,59 @@ func (a *actuator) deleteGenerateBackupBucketSecret(ctx context.Context, bb *ext
 	}
 	return nil
 }
+
+func computeStorageAccountConfig(config *apisazure.BackupBucketConfig) *azureclient.StorageAccountConfig {
+	storageAccountConfig := &azureclient.StorageAccountConfig{
+		BlockPublicAccess: config.BlockPublicAccess,
+	}
+
+	if config.Encryption != nil {
+		storageAccountConfig.KeyVaultURI = config.Encryption.KeyVaultURI
+		storageAccountConfig.KeyName = config.Encryption.KeyName
+		if config.Encryption.KeyVersion != nil {
+			storageAccountConfig.KeyVersion = *config.Encryption.KeyVersion
+		}
+	}
+
+	if config.Immutability != nil {
+		storageAccountConfig.ImmutabilityDays = config.Immutability.RetentionDays
+	}
+
+	if config.Lifecycle != nil {
+		storageAccountConfig.ExpirationDays = config.Lifecycle.ExpirationDays
+	}
+
+	return storageAccountConfig
+}
+
+func computeProviderStatus(config *apisazure.BackupBucketConfig, publicAccessBlocked bool) *azurev1alpha1.BackupBucketStatus {
+	status := &azurev1alpha1.BackupBucketStatus{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: azurev1alpha1.SchemeGroupVersion.String(),
+			Kind:       "BackupBucketStatus",
+		},
+		PublicAccessBlocked: publicAccessBlocked,
+	}
+
+	if config.Encryption != nil {
+		status.Encryption = &azurev1alpha1.BucketEncryption{
+			KeyVaultURI: config.Encryption.KeyVaultURI,
+			KeyName:     config.Encryption.KeyName,
+			KeyVersion:  config.Encryption.KeyVersion,
+		}
+	}
+
+	if config.Immutability != nil {
+		status.Immutability = &azurev1alpha1.BucketImmutability{
+			RetentionDays: config.Immutability.RetentionDays,
+		}
+	}
+
+	if config.Lifecycle != nil {
+		status.Lifecycle = &azurev1alpha1.BucketLifecycle{
+			ExpirationDays: config.Lifecycle.ExpirationDays,
+		}
+	}
+
+	return status
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupbucket
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-04-01/storage"
+	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/install"
+	azurev1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/v1alpha1"
+	azureclient "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/azure/client"
+	mockazureclient "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/mock/provider-azure/azure/client"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket"
+	"github.com/gardener/gardener-extensions/pkg/util"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var _ = Describe("Actuator", func() {
+	const (
+		name        = "bucket"
+		keyVaultURI = "https://vault.vault.azure.net"
+		principalID = "principal"
+		tenantID    = "tenant"
+	)
+
+	var (
+		storageAccount = generateStorageAccountName(name)
+		errFake        = errors.New("fake")
+	)
+
+	var (
+		ctx  context.Context
+		ctrl *gomock.Controller
+
+		s               *runtime.Scheme
+		storageAccounts *mockazureclient.MockStorageAccounts
+		a               *actuator
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		ctrl = gomock.NewController(GinkgoT())
+		storageAccounts = mockazureclient.NewMockStorageAccounts(ctrl)
+
+		s = runtime.NewScheme()
+		Expect(scheme.AddToScheme(s)).To(Succeed())
+		Expect(extensionsv1alpha1.AddToScheme(s)).To(Succeed())
+		install.Install(s)
+
+		a = &actuator{
+			logger: log.Log.WithName("test"),
+			newStorageAccounts: func(context.Context, client.Client, *corev1.SecretReference) (azureclient.StorageAccounts, error) {
+				return storageAccounts, nil
+			},
+		}
+		Expect(a.InjectScheme(s)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	newBackupBucket := func(config *azurev1alpha1.BackupBucketConfig, state string) *extensionsv1alpha1.BackupBucket {
+		bb := &extensionsv1alpha1.BackupBucket{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: extensionsv1alpha1.BackupBucketStatus{
+				DefaultStatus: extensionsv1alpha1.DefaultStatus{State: state},
+			},
+		}
+		if config != nil {
+			config.TypeMeta = metav1.TypeMeta{APIVersion: azurev1alpha1.SchemeGroupVersion.String(), Kind: "BackupBucketConfig"}
+			data, err := json.Marshal(config)
+			Expect(err).NotTo(HaveOccurred())
+			bb.Annotations = map[string]string{backupbucket.AnnotationKeyProviderConfig: string(data)}
+		}
+		Expect(a.InjectClient(fake.NewFakeClientWithScheme(s, bb.DeepCopy()))).To(Succeed())
+		return bb
+	}
+
+	getProviderStatus := func() *azurev1alpha1.BackupBucketStatus {
+		bb := &extensionsv1alpha1.BackupBucket{}
+		Expect(a.client.Get(ctx, client.ObjectKey{Name: name}, bb)).To(Succeed())
+		status := &azurev1alpha1.BackupBucketStatus{}
+		Expect(json.Unmarshal([]byte(bb.Status.State), status)).To(Succeed())
+		return status
+	}
+
+	Describe("#reconcileConfig", func() {
+		It("should not touch a bucket that never had a provider config", func() {
+			bb := newBackupBucket(nil, "")
+
+			Expect(a.reconcileConfig(ctx, bb)).To(Succeed())
+		})
+
+		It("should grant the storage account access to the key vault before using the customer-managed key", func() {
+			bb := newBackupBucket(&azurev1alpha1.BackupBucketConfig{
+				Encryption:        &azurev1alpha1.BucketEncryption{KeyVaultURI: keyVaultURI, KeyName: "key"},
+				Immutability:      &azurev1alpha1.BucketImmutability{RetentionDays: 7},
+				Lifecycle:         &azurev1alpha1.BucketLifecycle{ExpirationDays: 30},
+				BlockPublicAccess: true,
+			}, "")
+
+			gomock.InOrder(
+				storageAccounts.EXPECT().EnableSystemAssignedIdentity(ctx, name, storageAccount).Return(principalID, tenantID, nil),
+				storageAccounts.EXPECT().GrantKeyVaultAccess(ctx, keyVaultURI, tenantID, principalID),
+				storageAccounts.EXPECT().UpdateEncryption(ctx, name, storageAccount, &storage.Encryption{
+					Services:  &storage.EncryptionServices{Blob: &storage.EncryptionService{Enabled: util.BoolPtr(true)}},
+					KeySource: storage.MicrosoftKeyvault,
+					KeyVaultProperties: &storage.KeyVaultProperties{
+						KeyVaultURI: util.StringPtr(keyVaultURI),
+						KeyName:     util.StringPtr("key"),
+						KeyVersion:  util.StringPtr(""),
+					},
+				}),
+				storageAccounts.EXPECT().GetContainer(ctx, name, storageAccount, name).Return(&storage.BlobContainer{
+					ContainerProperties: &storage.ContainerProperties{PublicAccess: storage.PublicAccessBlob},
+				}, nil),
+				storageAccounts.EXPECT().BlockContainerPublicAccess(ctx, name, storageAccount, name),
+				storageAccounts.EXPECT().SetImmutabilityPolicy(ctx, name, storageAccount, name, int32(7)),
+				storageAccounts.EXPECT().SetExpiration(ctx, name, storageAccount, int32(30)),
+			)
+
+			Expect(a.reconcileConfig(ctx, bb)).To(Succeed())
+
+			status := getProviderStatus()
+			Expect(status.PublicAccessBlocked).To(BeTrue())
+			Expect(status.Encryption).To(Equal(&azurev1alpha1.BucketEncryption{KeyVaultURI: keyVaultURI, KeyName: "key"}))
+		})
+
+		It("should not grant key vault access if assigning the identity fails", func() {
+			bb := newBackupBucket(&azurev1alpha1.BackupBucketConfig{
+				Encryption: &azurev1alpha1.BucketEncryption{KeyVaultURI: keyVaultURI, KeyName: "key"},
+			}, "")
+
+			storageAccounts.EXPECT().EnableSystemAssignedIdentity(ctx, name, storageAccount).Return("", "", errFake)
+
+			Expect(a.reconcileConfig(ctx, bb)).To(Equal(errFake))
+		})
+
+		It("should leave public access unchanged and report it if blocking public access is not requested", func() {
+			bb := newBackupBucket(&azurev1alpha1.BackupBucketConfig{}, "")
+
+			storageAccounts.EXPECT().UpdateEncryption(ctx, name, storageAccount, gomock.Any())
+			storageAccounts.EXPECT().GetContainer(ctx, name, storageAccount, name).Return(&storage.BlobContainer{
+				ContainerProperties: &storage.ContainerProperties{PublicAccess: storage.PublicAccessContainer},
+			}, nil)
+			storageAccounts.EXPECT().DeleteExpiration(ctx, name, storageAccount)
+
+			Expect(a.reconcileConfig(ctx, bb)).To(Succeed())
+			Expect(getProviderStatus().PublicAccessBlocked).To(BeFalse())
+		})
+
+		It("should reset a bucket whose provider config has been removed to the defaults", func() {
+			bb := newBackupBucket(nil, `{"publicAccessBlocked":true,"immutability":{"retentionDays":7}}`)
+
+			storageAccounts.EXPECT().UpdateEncryption(ctx, name, storageAccount, &storage.Encryption{
+				Services:  &storage.EncryptionServices{Blob: &storage.EncryptionService{Enabled: util.BoolPtr(true)}},
+				KeySource: storage.MicrosoftStorage,
+			})
+			storageAccounts.EXPECT().GetContainer(ctx, name, storageAccount, name).Return(&storage.BlobContainer{
+				ContainerProperties: &storage.ContainerProperties{PublicAccess: storage.PublicAccessNone, HasImmutabilityPolicy: util.BoolPtr(true)},
+			}, nil)
+			storageAccounts.EXPECT().DeleteImmutabilityPolicy(ctx, name, storageAccount, name)
+			storageAccounts.EXPECT().DeleteExpiration(ctx, name, storageAccount)
+
+			Expect(a.reconcileConfig(ctx, bb)).To(Succeed())
+
+			status := getProviderStatus()
+			Expect(status.PublicAccessBlocked).To(BeTrue())
+			Expect(status.Immutability).To(BeNil())
+		})
+	})
+})
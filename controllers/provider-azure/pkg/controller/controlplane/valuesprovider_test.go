@@ -401,6 +401,22 @@ var _ = Describe("ValuesProvider", func() {
 			},
 		}
 
+		csiCP = &extensionsv1alpha1.ControlPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "control-plane",
+				Namespace: namespace,
+			},
+			Spec: extensionsv1alpha1.ControlPlaneSpec{
+				ProviderConfig: &runtime.RawExtension{
+					Raw: encode(&apisazure.ControlPlaneConfig{
+						Storage: &apisazure.Storage{
+							CSIDriver: true,
+						},
+					}),
+				},
+			},
+		}
+
 		cidr    = "10.250.0.0/19"
 		cluster = &extensionscontroller.Cluster{
 			CoreShoot: &gardencorev1alpha1.Shoot{
@@ -415,6 +431,16 @@ var _ = Describe("ValuesProvider", func() {
 			},
 		}
 
+		csiCluster = &extensionscontroller.Cluster{
+			CoreShoot: &gardencorev1alpha1.Shoot{
+				Spec: gardencorev1alpha1.ShootSpec{
+					Kubernetes: gardencorev1alpha1.Kubernetes{
+						Version: "1.15.2",
+					},
+				},
+			},
+		}
+
 		cpSecretKey = client.ObjectKey{Namespace: namespace, Name: v1alpha1constants.SecretNameCloudProvider}
 		cpSecret    = &corev1.Secret{
 			ObjectMeta: metav1.ObjectMeta{
@@ -445,6 +471,10 @@ var _ = Describe("ValuesProvider", func() {
 			azure.CloudProviderConfigName:             "08a7bc7fe8f59b055f173145e211760a83f02cf89635cef26ebb351378635606",
 			"cloud-controller-manager":                "3d791b164a808638da9a8df03924be2a41e34cd664e42231c00fe369e3588272",
 			"cloud-controller-manager-server":         "6dff2a2e6f14444b66d8e4a351c049f7e89ee24ba3eaab95dbec40ba6bdebb52",
+
+			"csi-attacher":    "2da58ad61c401a2af779a909d22fb42eed93a1524cbfdab974ceedb413fcb914",
+			"csi-provisioner": "f75b42d40ab501428c383dfb2336cb1fc892bbee1fc1d739675171e4acc4d911",
+			"csi-snapshotter": "bf417a5f8a1f9a7e2fe2ee7d3cc4d7a3f6cd8d0e3f1bbbd5cb1c36d7c6e2ee0c",
 		}
 
 		configNonZonedClusterChartValues = map[string]interface{}{
@@ -478,19 +508,37 @@ var _ = Describe("ValuesProvider", func() {
 			"kubernetesVersion": "1.13.4",
 		}
 
-		ccmChartValues = map[string]interface{}{
-			"replicas":          1,
-			"clusterName":       namespace,
-			"kubernetesVersion": "1.13.4",
-			"podNetwork":        cidr,
-			"podAnnotations": map[string]interface{}{
-				"checksum/secret-cloud-controller-manager":        "3d791b164a808638da9a8df03924be2a41e34cd664e42231c00fe369e3588272",
-				"checksum/secret-cloud-controller-manager-server": "6dff2a2e6f14444b66d8e4a351c049f7e89ee24ba3eaab95dbec40ba6bdebb52",
-				"checksum/secret-cloudprovider":                   "8bafb35ff1ac60275d62e1cbd495aceb511fb354f74a20f7d06ecb48b3a68432",
-				"checksum/configmap-cloud-provider-config":        "08a7bc7fe8f59b055f173145e211760a83f02cf89635cef26ebb351378635606",
+		controlPlaneChartValues = map[string]interface{}{
+			"cloud-controller-manager": map[string]interface{}{
+				"replicas":          1,
+				"clusterName":       namespace,
+				"kubernetesVersion": "1.13.4",
+				"podNetwork":        cidr,
+				"podAnnotations": map[string]interface{}{
+					"checksum/secret-cloud-controller-manager":        "3d791b164a808638da9a8df03924be2a41e34cd664e42231c00fe369e3588272",
+					"checksum/secret-cloud-controller-manager-server": "6dff2a2e6f14444b66d8e4a351c049f7e89ee24ba3eaab95dbec40ba6bdebb52",
+					"checksum/secret-cloudprovider":                   "8bafb35ff1ac60275d62e1cbd495aceb511fb354f74a20f7d06ecb48b3a68432",
+					"checksum/configmap-cloud-provider-config":        "08a7bc7fe8f59b055f173145e211760a83f02cf89635cef26ebb351378635606",
+				},
+				"featureGates": map[string]bool{
+					"CustomResourceValidation": true,
+				},
+			},
+			"csi-driver-controller": map[string]interface{}{
+				"enabled":  false,
+				"replicas": 1,
+				"podAnnotations": map[string]interface{}{
+					"checksum/secret-csi-attacher":             "2da58ad61c401a2af779a909d22fb42eed93a1524cbfdab974ceedb413fcb914",
+					"checksum/secret-csi-provisioner":          "f75b42d40ab501428c383dfb2336cb1fc892bbee1fc1d739675171e4acc4d911",
+					"checksum/secret-csi-snapshotter":          "bf417a5f8a1f9a7e2fe2ee7d3cc4d7a3f6cd8d0e3f1bbbd5cb1c36d7c6e2ee0c",
+					"checksum/configmap-cloud-provider-config": "08a7bc7fe8f59b055f173145e211760a83f02cf89635cef26ebb351378635606",
+				},
 			},
-			"featureGates": map[string]bool{
-				"CustomResourceValidation": true,
+		}
+
+		controlPlaneShootChartValues = map[string]interface{}{
+			"csi-driver-node": map[string]interface{}{
+				"enabled": false,
 			},
 		}
 
@@ -547,6 +595,40 @@ var _ = Describe("ValuesProvider", func() {
 		Expect(values).To(Equal(configZonedClusterChartValues))
 	})
 
+	It("should return correct config chart values for cluster with managed identity", func() {
+		// Create mock client
+		client := mockclient.NewMockClient(ctrl)
+		client.EXPECT().Get(context.TODO(), cloudProviderConfigKey, &corev1.ConfigMap{}).DoAndReturn(clientGet(cloudProviderConfigMap))
+		client.EXPECT().Get(context.TODO(), cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
+
+		// Create valuesProvider
+		vp := NewValuesProvider(logger)
+		err := vp.(inject.Scheme).InjectScheme(scheme)
+		Expect(err).NotTo(HaveOccurred())
+		err = vp.(inject.Client).InjectClient(client)
+		Expect(err).NotTo(HaveOccurred())
+
+		// Attach a managed identity to the infrastructure status
+		infraStatus := &apisazure.InfrastructureStatus{}
+		Expect(json.Unmarshal(cpZoned.Spec.InfrastructureProviderStatus.Raw, infraStatus)).To(Succeed())
+		infraStatus.Identity = &apisazure.IdentityStatus{
+			ID:       "/my/identity/id",
+			ClientID: "identity-client-id",
+		}
+		cpIdentity := cpZoned.DeepCopy()
+		cpIdentity.Spec.InfrastructureProviderStatus = &runtime.RawExtension{Raw: encode(infraStatus)}
+
+		// Call GetConfigChartValues method and check the result
+		values, err := vp.GetConfigChartValues(context.TODO(), cpIdentity, cluster)
+
+		expectedValues := map[string]interface{}{"identityClientID": "identity-client-id"}
+		for k, v := range configZonedClusterChartValues {
+			expectedValues[k] = v
+		}
+		Expect(err).NotTo(HaveOccurred())
+		Expect(values).To(Equal(expectedValues))
+	})
+
 	Describe("#GetConfigChartValuesNoSubnet", func() {
 		It("should return error, missing subnet", func() {
 			// Create mock client
@@ -641,7 +723,81 @@ var _ = Describe("ValuesProvider", func() {
 			// Call GetControlPlaneChartValues method and check the result
 			values, err := vp.GetControlPlaneChartValues(context.TODO(), cp, cluster, checksums, false)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(values).To(Equal(ccmChartValues))
+			Expect(values).To(Equal(controlPlaneChartValues))
+		})
+	})
+
+	Describe("#GetControlPlaneShootChartValues", func() {
+		It("should return correct control plane shoot chart values", func() {
+			// Create valuesProvider
+			vp := NewValuesProvider(logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetControlPlaneShootChartValues method and check the result
+			values, err := vp.GetControlPlaneShootChartValues(context.TODO(), cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(controlPlaneShootChartValues))
+		})
+
+		It("should enable the CSI node plugin if the CSI driver is enabled", func() {
+			// Create valuesProvider
+			vp := NewValuesProvider(logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetControlPlaneShootChartValues method and check the result
+			values, err := vp.GetControlPlaneShootChartValues(context.TODO(), csiCP, csiCluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"csi-driver-node": map[string]interface{}{
+					"enabled": true,
+				},
+			}))
+		})
+	})
+
+	Describe("#GetStorageClassesChartValues", func() {
+		It("should return correct storage classes chart values", func() {
+			// Create valuesProvider
+			vp := NewValuesProvider(logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetStorageClassesChartValues method and check the result
+			values, err := vp.GetStorageClassesChartValues(context.TODO(), cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"csiEnabled": false,
+			}))
+		})
+
+		It("should not enable the CSI storage classes if the CSI driver is not enabled", func() {
+			// Create valuesProvider
+			vp := NewValuesProvider(logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetStorageClassesChartValues method and check the result
+			values, err := vp.GetStorageClassesChartValues(context.TODO(), cp, csiCluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"csiEnabled": false,
+			}))
+		})
+
+		It("should enable the CSI storage classes if the CSI driver is enabled", func() {
+			// Create valuesProvider
+			vp := NewValuesProvider(logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetStorageClassesChartValues method and check the result
+			values, err := vp.GetStorageClassesChartValues(context.TODO(), csiCP, csiCluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"csiEnabled": true,
+			}))
 		})
 	})
 
@@ -20,13 +20,14 @@ import (
 	azurev1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/v1alpha1"
 	infrainternal "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal/infrastructure"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
 	"github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 
 	"github.com/go-logr/logr"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/chartrenderer"
-	"github.com/gardener/gardener/pkg/operation/terraformer"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
@@ -36,16 +37,26 @@ import (
 )
 
 type actuator struct {
-	logger        logr.Logger
-	client        client.Client
-	restConfig    *rest.Config
-	chartRenderer chartrenderer.Interface
+	logger             logr.Logger
+	client             client.Client
+	restConfig         *rest.Config
+	chartRenderer      chartrenderer.Interface
+	terraformerFactory extensionsterraformer.Factory
 }
 
 // NewActuator creates a new infrastructure.Actuator.
 func NewActuator() infrastructure.Actuator {
+	return NewActuatorWithDeps(
+		log.Log.WithName("infrastructure-actuator"),
+		extensionsterraformer.DefaultFactory(),
+	)
+}
+
+// NewActuatorWithDeps creates a new infrastructure.Actuator with the given dependencies.
+func NewActuatorWithDeps(logger logr.Logger, terraformerFactory extensionsterraformer.Factory) infrastructure.Actuator {
 	return &actuator{
-		logger: log.Log.WithName("infrastructure-actuator"),
+		logger:             logger,
+		terraformerFactory: terraformerFactory,
 	}
 }
 
@@ -68,9 +79,25 @@ func (a *actuator) InjectConfig(config *rest.Config) error {
 	return nil
 }
 
+// errorMatchers classify the errors of the Azure API and of the Terraform jobs.
+var errorMatchers = []controllererrors.Matcher{
+	controllererrors.TerraformMatcher(controllererrors.AzureMatcher),
+	controllererrors.AzureMatcher,
+}
+
+// Reconcile implements infrastructure.Actuator.
+func (a *actuator) Reconcile(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	return controllererrors.Classify(a.reconcile(ctx, infra, cluster), errorMatchers...)
+}
+
+// Delete implements infrastructure.Actuator.
+func (a *actuator) Delete(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	return controllererrors.Classify(a.delete(ctx, infra, cluster), errorMatchers...)
+}
+
 func (a *actuator) updateProviderStatus(
 	ctx context.Context,
-	tf *terraformer.Terraformer,
+	tf extensionsterraformer.Interface,
 	infra *extensionsv1alpha1.Infrastructure,
 	config *azurev1alpha1.InfrastructureConfig,
 ) error {
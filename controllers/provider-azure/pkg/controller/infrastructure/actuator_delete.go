@@ -20,20 +20,44 @@ import (
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal"
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal/infrastructure"
 	"github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 )
 
-// Delete implements infrastructure.Actuator.
-func (a *actuator) Delete(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *controller.Cluster) error {
+func (a *actuator) delete(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *controller.Cluster) error {
 	clientAuth, err := internal.GetClientAuthData(ctx, a.client, infra.Spec.SecretRef)
 	if err != nil {
 		return err
 	}
 
-	tf, err := internal.NewTerraformer(a.restConfig, clientAuth, infrastructure.TerraformerPurpose, infra.Namespace, infra.Name)
+	if err := extensionsterraformer.RestoreInfrastructureState(ctx, a.client, infra, infrastructure.TerraformerPurpose); err != nil {
+		return err
+	}
+
+	tf, err := internal.NewTerraformer(a.terraformerFactory, a.restConfig, clientAuth, infrastructure.TerraformerPurpose, infra.Namespace, infra.Name)
+	if err != nil {
+		return err
+	}
+
+	configExists, err := tf.ConfigExists()
 	if err != nil {
 		return err
 	}
 
+	// The Terraform configuration is missing if the state has been restored from the status, e.g. after the seed
+	// namespace has been recreated. It has to be rendered again so that the infrastructure can be destroyed.
+	if !configExists && len(infra.Status.State) > 0 {
+		config, err := internal.InfrastructureConfigFromInfrastructure(infra)
+		if err != nil {
+			return err
+		}
+
+		terraformFiles, err := infrastructure.RenderTerraformerChart(a.chartRenderer, infra, clientAuth, config, cluster)
+		if err != nil {
+			return err
+		}
+		tf.InitializeWith(a.terraformerFactory.DefaultInitializer(a.client, terraformFiles.Main, terraformFiles.Variables, terraformFiles.TFVars))
+	}
+
 	return tf.Destroy()
 }
@@ -22,13 +22,16 @@ import (
 	apisazure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
 	azureapi "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
 	azureapihelper "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/helper"
+	azurevalidation "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/validation"
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/azure"
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -119,6 +122,11 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 	}
 
 	for _, pool := range w.worker.Spec.Pools {
+		workerConfig, err := w.decodeWorkerConfig(pool)
+		if err != nil {
+			return err
+		}
+
 		publisher, sku, offer, urn, err := w.findMachineImage(pool.MachineImage.Name, pool.MachineImage.Version)
 		if err != nil {
 			return err
@@ -157,6 +165,8 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 					Labels:         pool.Labels,
 					Annotations:    pool.Annotations,
 					Taints:         pool.Taints,
+
+					MachineConfiguration: computeMachineConfiguration(workerConfig.MachineControllerManagerSettings),
 				}
 
 				machineClassSpec = map[string]interface{}{
@@ -189,6 +199,9 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 			if availabilitySetID != nil {
 				machineClassSpec["availabilitySetID"] = *availabilitySetID
 			}
+			if infrastructureStatus.Identity != nil {
+				machineClassSpec["identityID"] = infrastructureStatus.Identity.ID
+			}
 
 			var (
 				machineClassSpecHash = worker.MachineClassHash(machineClassSpec, shootVersionMajorMinor)
@@ -242,3 +255,30 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 
 	return nil
 }
+
+func (w *workerDelegate) decodeWorkerConfig(pool extensionsv1alpha1.WorkerPool) (*apisazure.WorkerConfig, error) {
+	workerConfig := &apisazure.WorkerConfig{}
+	if pool.ProviderConfig == nil || pool.ProviderConfig.Raw == nil {
+		return workerConfig, nil
+	}
+
+	if _, _, err := w.decoder.Decode(pool.ProviderConfig.Raw, nil, workerConfig); err != nil {
+		return nil, errors.Wrapf(err, "could not decode provider config of worker pool %q", pool.Name)
+	}
+	if errs := azurevalidation.ValidateWorkerConfig(workerConfig); len(errs) > 0 {
+		return nil, errors.Wrapf(errs.ToAggregate(), "invalid provider config of worker pool %q", pool.Name)
+	}
+	return workerConfig, nil
+}
+
+// computeMachineConfiguration computes the timeouts for the rollout of the machines out of the given settings.
+func computeMachineConfiguration(settings *apisazure.MachineControllerManagerSettings) *worker.MachineConfiguration {
+	if settings == nil {
+		return nil
+	}
+
+	return &worker.MachineConfiguration{
+		DrainTimeout:    settings.MachineDrainTimeout,
+		CreationTimeout: settings.MachineCreationTimeout,
+	}
+}
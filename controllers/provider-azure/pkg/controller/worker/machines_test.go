@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"time"
 
 	apisazure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
 	azurev1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/v1alpha1"
@@ -377,6 +378,101 @@ var _ = Describe("Machines", func() {
 				Expect(result).To(Equal(machineDeployments))
 			})
 
+			It("should add the identity ID to the machine classes", func() {
+				identityID := "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity"
+				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{
+					Raw: encode(&apisazure.InfrastructureStatus{
+						ResourceGroup: apisazure.ResourceGroup{
+							Name: resourceGroupName,
+						},
+						Networks: apisazure.NetworkStatus{
+							VNet: apisazure.VNetStatus{
+								Name: vnetName,
+							},
+							Subnets: []apisazure.Subnet{
+								{
+									Purpose: apisazure.PurposeNodes,
+									Name:    subnetName,
+								},
+							},
+						},
+						AvailabilitySets: []apisazure.AvailabilitySet{
+							{
+								Purpose: apisazure.PurposeNodes,
+								ID:      availabilitySetID,
+							},
+						},
+						Identity: &apisazure.IdentityStatus{
+							ID:       identityID,
+							ClientID: "client-id",
+						},
+					}),
+				}
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImages, chartApplier, "", w, cluster)
+
+				expectGetSecretCallToWork(c, azureClientID, azureClientSecret, azureSubscriptionID, azureTenantID)
+				chartApplier.
+					EXPECT().
+					ApplyChart(context.TODO(), filepath.Join(azure.InternalChartsPath, "machineclass"), namespace, "machineclass", gomock.Any(), nil).
+					DoAndReturn(func(_ context.Context, _, _, _ string, values map[string]interface{}, _ map[string]interface{}) error {
+						machineClasses := values["machineClasses"].([]map[string]interface{})
+						Expect(machineClasses).To(HaveLen(2))
+						for _, machineClass := range machineClasses {
+							Expect(machineClass).To(HaveKeyWithValue("identityID", identityID))
+						}
+						return nil
+					})
+
+				err := workerDelegate.DeployMachineClasses(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should set the machine configuration of the pools with machine controller manager settings", func() {
+				expectGetSecretCallToWork(c, azureClientID, azureClientSecret, azureSubscriptionID, azureTenantID)
+
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&azurev1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: azurev1alpha1.SchemeGroupVersion.String(),
+							Kind:       "WorkerConfig",
+						},
+						MachineControllerManagerSettings: &azurev1alpha1.MachineControllerManagerSettings{
+							MachineDrainTimeout: &metav1.Duration{Duration: time.Hour},
+						},
+					}),
+				}
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImages, chartApplier, "", w, cluster)
+
+				machineDeployments, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(machineDeployments).To(HaveLen(2))
+				Expect(machineDeployments[0].MachineConfiguration).To(Equal(&worker.MachineConfiguration{
+					DrainTimeout: &metav1.Duration{Duration: time.Hour},
+				}))
+				Expect(machineDeployments[1].MachineConfiguration).To(BeNil())
+			})
+
+			It("should fail because the worker config is invalid", func() {
+				expectGetSecretCallToWork(c, azureClientID, azureClientSecret, azureSubscriptionID, azureTenantID)
+
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&azurev1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: azurev1alpha1.SchemeGroupVersion.String(),
+							Kind:       "WorkerConfig",
+						},
+						MachineControllerManagerSettings: &azurev1alpha1.MachineControllerManagerSettings{
+							MachineCreationTimeout: &metav1.Duration{},
+						},
+					}),
+				}
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImages, chartApplier, "", w, cluster)
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).To(HaveOccurred())
+				Expect(result).To(BeNil())
+			})
+
 			It("should fail because the secret cannot be read", func() {
 				c.EXPECT().
 					Get(context.TODO(), gomock.Any(), gomock.AssignableToTypeOf(&corev1.Secret{})).
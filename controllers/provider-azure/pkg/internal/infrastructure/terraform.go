@@ -22,11 +22,11 @@ import (
 	azurev1alpha1helper "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/v1alpha1/helper"
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal"
 	"github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/chartrenderer"
-	"github.com/gardener/gardener/pkg/operation/terraformer"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -49,6 +49,10 @@ const (
 	TerraformerOutputKeyRouteTableName = "routeTableName"
 	// TerraformerOutputKeySecurityGroupName is the key for the securityGroupName output
 	TerraformerOutputKeySecurityGroupName = "securityGroupName"
+	// TerraformerOutputKeyIdentityID is the key for the identityID output
+	TerraformerOutputKeyIdentityID = "identityID"
+	// TerraformerOutputKeyIdentityClientID is the key for the identityClientID output
+	TerraformerOutputKeyIdentityClientID = "identityClientID"
 )
 
 var (
@@ -112,6 +116,26 @@ func ComputeTerraformerChartValues(infra *extensionsv1alpha1.Infrastructure, cli
 		vnetCIDR = *config.Networks.VNet.CIDR
 	}
 
+	identity := map[string]interface{}{
+		"enabled": config.Identity != nil,
+	}
+	if config.Identity != nil {
+		outputKeys["identityID"] = TerraformerOutputKeyIdentityID
+		outputKeys["identityClientID"] = TerraformerOutputKeyIdentityClientID
+		identity["assignReaderRole"] = config.Identity.AssignReaderRole
+
+		// check if we should use an existing identity or create a new one
+		if config.Identity.Name != nil && config.Identity.ResourceGroup != nil {
+			identity["create"] = false
+			identity["name"] = *config.Identity.Name
+			identity["resourceGroup"] = *config.Identity.ResourceGroup
+		} else {
+			identity["create"] = true
+			identity["name"] = fmt.Sprintf("%s-identity", infra.Namespace)
+			identity["resourceGroup"] = resourceGroupName
+		}
+	}
+
 	// If the cluster is zoned, then we don't need to create an AvailabilitySet.
 	if !config.Zoned {
 		createAvailabilitySet = true
@@ -167,6 +191,7 @@ func ComputeTerraformerChartValues(infra *extensionsv1alpha1.Infrastructure, cli
 				"serviceEndpoints": config.Networks.ServiceEndpoints,
 			},
 		},
+		"identity":    identity,
 		"clusterName": infra.Namespace,
 		"networks": map[string]interface{}{
 			"worker": config.Networks.Workers,
@@ -218,10 +243,14 @@ type TerraformState struct {
 	RouteTableName string
 	// SecurityGroupName is the name of the security group.
 	SecurityGroupName string
+	// IdentityID is the ID of the user-assigned managed identity.
+	IdentityID string
+	// IdentityClientID is the client ID of the user-assigned managed identity.
+	IdentityClientID string
 }
 
 // ExtractTerraformState extracts the TerraformState from the given Terraformer.
-func ExtractTerraformState(tf *terraformer.Terraformer, config *azurev1alpha1.InfrastructureConfig) (*TerraformState, error) {
+func ExtractTerraformState(tf extensionsterraformer.Interface, config *azurev1alpha1.InfrastructureConfig) (*TerraformState, error) {
 	var outputKeys = []string{
 		TerraformerOutputKeyResourceGroupName,
 		TerraformerOutputKeyRouteTableName,
@@ -233,6 +262,9 @@ func ExtractTerraformState(tf *terraformer.Terraformer, config *azurev1alpha1.In
 	if !config.Zoned {
 		outputKeys = append(outputKeys, TerraformerOutputKeyAvailabilitySetID, TerraformerOutputKeyAvailabilitySetName)
 	}
+	if config.Identity != nil {
+		outputKeys = append(outputKeys, TerraformerOutputKeyIdentityID, TerraformerOutputKeyIdentityClientID)
+	}
 
 	vars, err := tf.GetStateOutputVariables(outputKeys...)
 	if err != nil {
@@ -251,6 +283,10 @@ func ExtractTerraformState(tf *terraformer.Terraformer, config *azurev1alpha1.In
 		tfState.AvailabilitySetID = vars[TerraformerOutputKeyAvailabilitySetID]
 		tfState.AvailabilitySetName = vars[TerraformerOutputKeyAvailabilitySetName]
 	}
+	if config.Identity != nil {
+		tfState.IdentityID = vars[TerraformerOutputKeyIdentityID]
+		tfState.IdentityClientID = vars[TerraformerOutputKeyIdentityClientID]
+	}
 	return &tfState, nil
 }
 
@@ -293,11 +329,18 @@ func StatusFromTerraformState(state *TerraformState) *azurev1alpha1.Infrastructu
 		})
 	}
 
+	if state.IdentityID != "" {
+		tfState.Identity = &azurev1alpha1.IdentityStatus{
+			ID:       state.IdentityID,
+			ClientID: state.IdentityClientID,
+		}
+	}
+
 	return &tfState
 }
 
 // ComputeStatus computes the status based on the Terraformer and the given InfrastructureConfig.
-func ComputeStatus(tf *terraformer.Terraformer, config *azurev1alpha1.InfrastructureConfig) (*azurev1alpha1.InfrastructureStatus, error) {
+func ComputeStatus(tf extensionsterraformer.Interface, config *azurev1alpha1.InfrastructureConfig) (*azurev1alpha1.InfrastructureStatus, error) {
 	state, err := ExtractTerraformState(tf, config)
 	if err != nil {
 		return nil, err
@@ -20,6 +20,7 @@ import (
 	azurev1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/v1alpha1"
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal"
 	"github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/util"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -147,6 +148,9 @@ var _ = Describe("Terraform", func() {
 						"serviceEndpoints": []string{testServiceEndpoint},
 					},
 				},
+				"identity": map[string]interface{}{
+					"enabled": false,
+				},
 				"clusterName": infra.Namespace,
 				"networks": map[string]interface{}{
 					"worker": config.Networks.Workers,
@@ -190,6 +194,9 @@ var _ = Describe("Terraform", func() {
 						"serviceEndpoints": []string{testServiceEndpoint},
 					},
 				},
+				"identity": map[string]interface{}{
+					"enabled": false,
+				},
 				"clusterName": infra.Namespace,
 				"networks": map[string]interface{}{
 					"worker": config.Networks.Workers,
@@ -206,6 +213,38 @@ var _ = Describe("Terraform", func() {
 			}
 			Expect(values).To(BeEquivalentTo(expectedValues))
 		})
+
+		It("should correctly compute the terraformer chart values for a created identity", func() {
+			config.Identity = &azurev1alpha1.IdentityConfig{}
+			values, err := ComputeTerraformerChartValues(infra, clientAuth, config, cluster)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(values).To(HaveKeyWithValue("identity", map[string]interface{}{
+				"enabled":          true,
+				"create":           true,
+				"name":             infra.Namespace + "-identity",
+				"resourceGroup":    infra.Namespace,
+				"assignReaderRole": false,
+			}))
+			Expect(values["outputKeys"]).To(HaveKeyWithValue("identityID", TerraformerOutputKeyIdentityID))
+			Expect(values["outputKeys"]).To(HaveKeyWithValue("identityClientID", TerraformerOutputKeyIdentityClientID))
+		})
+
+		It("should correctly compute the terraformer chart values for an existing identity", func() {
+			config.Identity = &azurev1alpha1.IdentityConfig{
+				Name:             util.StringPtr("identity"),
+				ResourceGroup:    util.StringPtr("identity-group"),
+				AssignReaderRole: true,
+			}
+			values, err := ComputeTerraformerChartValues(infra, clientAuth, config, cluster)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(values).To(HaveKeyWithValue("identity", map[string]interface{}{
+				"enabled":          true,
+				"create":           false,
+				"name":             "identity",
+				"resourceGroup":    "identity-group",
+				"assignReaderRole": true,
+			}))
+		})
 	})
 
 	Describe("#StatusFromTerraformState", func() {
@@ -294,5 +333,14 @@ var _ = Describe("Terraform", func() {
 			}))
 		})
 
+		It("should correctly compute the status with an identity", func() {
+			state.IdentityID = "identity_id"
+			state.IdentityClientID = "identity_client_id"
+			status := StatusFromTerraformState(state)
+			Expect(status.Identity).To(Equal(&azurev1alpha1.IdentityStatus{
+				ID:       "identity_id",
+				ClientID: "identity_client_id",
+			}))
+		})
 	})
 })
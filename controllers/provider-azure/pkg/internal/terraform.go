@@ -18,8 +18,8 @@ import (
 	"time"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal/imagevector"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 	"github.com/gardener/gardener/pkg/logger"
-	"github.com/gardener/gardener/pkg/operation/terraformer"
 	"k8s.io/client-go/rest"
 )
 
@@ -41,13 +41,14 @@ func TerraformVariablesEnvironmentFromClientAuth(auth *ClientAuth) (map[string]s
 
 // NewTerraformer initializes a new Terraformer that has the azure auth credentials.
 func NewTerraformer(
+	factory extensionsterraformer.Factory,
 	restConfig *rest.Config,
 	clientAuth *ClientAuth,
 	purpose,
 	namespace,
 	name string,
-) (*terraformer.Terraformer, error) {
-	tf, err := terraformer.NewForConfig(logger.NewLogger("info"), restConfig, purpose, namespace, name, imagevector.TerraformerImage())
+) (extensionsterraformer.Interface, error) {
+	tf, err := factory.NewForConfig(logger.NewLogger("info"), restConfig, purpose, namespace, name, imagevector.TerraformerImage())
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,164 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/azure/client (interfaces: StorageAccounts)
+
+// Package client is a generated GoMock package.
+package client
+
+import (
+	context "context"
+	storage "github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-04-01/storage"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockStorageAccounts is a mock of StorageAccounts interface
+type MockStorageAccounts struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageAccountsMockRecorder
+}
+
+// MockStorageAccountsMockRecorder is the mock recorder for MockStorageAccounts
+type MockStorageAccountsMockRecorder struct {
+	mock *MockStorageAccounts
+}
+
+// NewMockStorageAccounts creates a new mock instance
+func NewMockStorageAccounts(ctrl *gomock.Controller) *MockStorageAccounts {
+	mock := &MockStorageAccounts{ctrl: ctrl}
+	mock.recorder = &MockStorageAccountsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockStorageAccounts) EXPECT() *MockStorageAccountsMockRecorder {
+	return m.recorder
+}
+
+// BlockContainerPublicAccess mocks base method
+func (m *MockStorageAccounts) BlockContainerPublicAccess(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockContainerPublicAccess", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockContainerPublicAccess indicates an expected call of BlockContainerPublicAccess
+func (mr *MockStorageAccountsMockRecorder) BlockContainerPublicAccess(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockContainerPublicAccess", reflect.TypeOf((*MockStorageAccounts)(nil).BlockContainerPublicAccess), arg0, arg1, arg2, arg3)
+}
+
+// DeleteExpiration mocks base method
+func (m *MockStorageAccounts) DeleteExpiration(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiration", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExpiration indicates an expected call of DeleteExpiration
+func (mr *MockStorageAccountsMockRecorder) DeleteExpiration(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiration", reflect.TypeOf((*MockStorageAccounts)(nil).DeleteExpiration), arg0, arg1, arg2)
+}
+
+// DeleteImmutabilityPolicy mocks base method
+func (m *MockStorageAccounts) DeleteImmutabilityPolicy(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteImmutabilityPolicy", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteImmutabilityPolicy indicates an expected call of DeleteImmutabilityPolicy
+func (mr *MockStorageAccountsMockRecorder) DeleteImmutabilityPolicy(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImmutabilityPolicy", reflect.TypeOf((*MockStorageAccounts)(nil).DeleteImmutabilityPolicy), arg0, arg1, arg2, arg3)
+}
+
+// EnableSystemAssignedIdentity mocks base method
+func (m *MockStorageAccounts) EnableSystemAssignedIdentity(arg0 context.Context, arg1, arg2 string) (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableSystemAssignedIdentity", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// EnableSystemAssignedIdentity indicates an expected call of EnableSystemAssignedIdentity
+func (mr *MockStorageAccountsMockRecorder) EnableSystemAssignedIdentity(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableSystemAssignedIdentity", reflect.TypeOf((*MockStorageAccounts)(nil).EnableSystemAssignedIdentity), arg0, arg1, arg2)
+}
+
+// GetContainer mocks base method
+func (m *MockStorageAccounts) GetContainer(arg0 context.Context, arg1, arg2, arg3 string) (*storage.BlobContainer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContainer", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*storage.BlobContainer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContainer indicates an expected call of GetContainer
+func (mr *MockStorageAccountsMockRecorder) GetContainer(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContainer", reflect.TypeOf((*MockStorageAccounts)(nil).GetContainer), arg0, arg1, arg2, arg3)
+}
+
+// GrantKeyVaultAccess mocks base method
+func (m *MockStorageAccounts) GrantKeyVaultAccess(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantKeyVaultAccess", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GrantKeyVaultAccess indicates an expected call of GrantKeyVaultAccess
+func (mr *MockStorageAccountsMockRecorder) GrantKeyVaultAccess(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantKeyVaultAccess", reflect.TypeOf((*MockStorageAccounts)(nil).GrantKeyVaultAccess), arg0, arg1, arg2, arg3)
+}
+
+// SetExpiration mocks base method
+func (m *MockStorageAccounts) SetExpiration(arg0 context.Context, arg1, arg2 string, arg3 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetExpiration", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetExpiration indicates an expected call of SetExpiration
+func (mr *MockStorageAccountsMockRecorder) SetExpiration(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetExpiration", reflect.TypeOf((*MockStorageAccounts)(nil).SetExpiration), arg0, arg1, arg2, arg3)
+}
+
+// SetImmutabilityPolicy mocks base method
+func (m *MockStorageAccounts) SetImmutabilityPolicy(arg0 context.Context, arg1, arg2, arg3 string, arg4 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetImmutabilityPolicy", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetImmutabilityPolicy indicates an expected call of SetImmutabilityPolicy
+func (mr *MockStorageAccountsMockRecorder) SetImmutabilityPolicy(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetImmutabilityPolicy", reflect.TypeOf((*MockStorageAccounts)(nil).SetImmutabilityPolicy), arg0, arg1, arg2, arg3, arg4)
+}
+
+// UpdateEncryption mocks base method
+func (m *MockStorageAccounts) UpdateEncryption(arg0 context.Context, arg1, arg2 string, arg3 *storage.Encryption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEncryption", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEncryption indicates an expected call of UpdateEncryption
+func (mr *MockStorageAccountsMockRecorder) UpdateEncryption(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEncryption", reflect.TypeOf((*MockStorageAccounts)(nil).UpdateEncryption), arg0, arg1, arg2, arg3)
+}
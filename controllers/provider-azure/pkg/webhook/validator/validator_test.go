@@ -0,0 +1,155 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator_test
+
+import (
+	"context"
+
+	azureinstall "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/install"
+	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/azure"
+	. "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/webhook/validator"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsbackupbucket "github.com/gardener/gardener-extensions/pkg/controller/backupbucket"
+	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+)
+
+var _ = Describe("Validator", func() {
+	const (
+		validInfrastructureConfig   = `{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","networks":{"vnet":{"cidr":"10.250.0.0/16"},"workers":"10.250.0.0/19"}}`
+		otherInfrastructureConfig   = `{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","networks":{"vnet":{"cidr":"10.250.0.0/16"},"workers":"10.250.32.0/19"}}`
+		invalidInfrastructureConfig = `{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","networks":{"vnet":{"cidr":"10.250.0.0/16"},"workers":"10.0.0.0/19"}}`
+	)
+
+	var (
+		ctx       = context.TODO()
+		validator extensionswebhook.Validator
+
+		infrastructure = func(providerConfig string) *extensionsv1alpha1.Infrastructure {
+			return &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar", Name: "bar"},
+				Spec: extensionsv1alpha1.InfrastructureSpec{
+					DefaultSpec:    extensionsv1alpha1.DefaultSpec{Type: azure.Type},
+					ProviderConfig: &runtime.RawExtension{Raw: []byte(providerConfig)},
+				},
+			}
+		}
+	)
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(extensionscontroller.AddToScheme(scheme)).To(Succeed())
+		Expect(azureinstall.AddToScheme(scheme)).To(Succeed())
+
+		validator = NewValidator()
+		Expect(validator.(inject.Scheme).InjectScheme(scheme)).To(Succeed())
+		Expect(validator.(inject.Client).InjectClient(fake.NewFakeClientWithScheme(scheme))).To(Succeed())
+	})
+
+	Describe("#Validate", func() {
+		It("should accept a valid infrastructure config", func() {
+			Expect(validator.Validate(ctx, infrastructure(validInfrastructureConfig), nil)).To(Succeed())
+		})
+
+		It("should reject an invalid infrastructure config", func() {
+			err := validator.Validate(ctx, infrastructure(invalidInfrastructureConfig), nil)
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.providerConfig.networks.workers"))
+		})
+
+		It("should reject an infrastructure without config", func() {
+			infra := infrastructure(validInfrastructureConfig)
+			infra.Spec.ProviderConfig = nil
+
+			Expect(validator.Validate(ctx, infra, nil)).To(HaveOccurred())
+		})
+
+		It("should reject changes of immutable fields of the infrastructure config", func() {
+			err := validator.Validate(ctx, infrastructure(otherInfrastructureConfig), infrastructure(validInfrastructureConfig))
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.providerConfig.networks.workers"))
+		})
+
+		It("should ignore infrastructures of other providers", func() {
+			infra := infrastructure(invalidInfrastructureConfig)
+			infra.Spec.Type = "gcp"
+
+			Expect(validator.Validate(ctx, infra, nil)).To(Succeed())
+		})
+
+		It("should reject an invalid control plane config", func() {
+			cp := &extensionsv1alpha1.ControlPlane{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar", Name: "bar"},
+				Spec: extensionsv1alpha1.ControlPlaneSpec{
+					DefaultSpec:    extensionsv1alpha1.DefaultSpec{Type: azure.Type},
+					ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"ControlPlaneConfig","storage":{"csiMigration":true}}`)},
+				},
+			}
+
+			err := validator.Validate(ctx, cp, nil)
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.providerConfig.storage.csiMigration"))
+		})
+
+		It("should reject an invalid backup bucket config", func() {
+			bb := &extensionsv1alpha1.BackupBucket{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "bucket",
+					Annotations: map[string]string{
+						extensionsbackupbucket.AnnotationKeyProviderConfig: `{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"BackupBucketConfig","lifecycle":{"expirationDays":0}}`,
+					},
+				},
+				Spec: extensionsv1alpha1.BackupBucketSpec{
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: azure.Type},
+				},
+			}
+
+			err := validator.Validate(ctx, bb, nil)
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("lifecycle.expirationDays"))
+		})
+
+		It("should reject an invalid worker config", func() {
+			worker := &extensionsv1alpha1.Worker{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar", Name: "bar"},
+				Spec: extensionsv1alpha1.WorkerSpec{
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: azure.Type},
+					Pools: []extensionsv1alpha1.WorkerPool{
+						{
+							Name:           "pool",
+							ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","machineControllerManagerSettings":{"machineDrainTimeout":"0s"}}`)},
+						},
+					},
+				},
+			}
+
+			err := validator.Validate(ctx, worker, nil)
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.pools[0].providerConfig.machineControllerManagerSettings.machineDrainTimeout"))
+		})
+	})
+})
@@ -3,6 +3,16 @@ provider "google" {
   project     = "{{ required "google.project is required" .Values.google.project }}"
   region      = "{{ required "google.region is required" .Values.google.region }}"
 }
+{{- if .Values.hostProject.enabled }}
+
+// The network resources of a shared VPC are managed in the host project.
+provider "google" {
+  alias       = "host"
+  credentials = "${var.HOST_SERVICEACCOUNT}"
+  project     = "{{ required "hostProject.id is required" .Values.hostProject.id }}"
+  region      = "{{ required "google.region is required" .Values.google.region }}"
+}
+{{- end }}
 
 //=====================================================================
 //= Service Account
@@ -25,6 +35,9 @@ resource "google_compute_network" "network" {
 {{- end}}
 
 resource "google_compute_subnetwork" "subnetwork-nodes" {
+  {{- if .Values.hostProject.enabled }}
+  provider      = "google.host"
+  {{- end }}
   name          = "{{ required "clusterName is required" .Values.clusterName }}-nodes"
   ip_cidr_range = "{{ required "networks.worker is required" .Values.networks.worker }}"
   network       = "{{ required "vpc.name is required" .Values.vpc.name }}"
@@ -33,18 +46,72 @@ resource "google_compute_subnetwork" "subnetwork-nodes" {
 
 {{ if .Values.networks.internal -}}
 resource "google_compute_subnetwork" "subnetwork-internal" {
+  {{- if .Values.hostProject.enabled }}
+  provider      = "google.host"
+  {{- end }}
   name          = "{{ required "clusterName is required" .Values.clusterName }}-internal"
   ip_cidr_range = "{{ required "networks.internal is required" .Values.networks.internal }}"
   network       = "{{ required "vpc.name is required" .Values.vpc.name }}"
   region        = "{{ required "google.region is required" .Values.google.region }}"
 }
 {{- end}}
+
+{{ if .Values.cloudNAT.enabled -}}
+//=====================================================================
+//= Cloud Router and Cloud NAT
+//=====================================================================
+
+resource "google_compute_router" "router" {
+  {{- if .Values.hostProject.enabled }}
+  provider = "google.host"
+  {{- end }}
+  name     = "{{ required "clusterName is required" .Values.clusterName }}-cloud-router"
+  region   = "{{ required "google.region is required" .Values.google.region }}"
+  network  = "{{ required "vpc.name is required" .Values.vpc.name }}"
+}
+{{ range $i, $name := .Values.cloudNAT.natIPNames }}
+data "google_compute_address" "nat-ip-{{ $i }}" {
+  {{- if $.Values.hostProject.enabled }}
+  provider = "google.host"
+  {{- end }}
+  name     = "{{ $name }}"
+  region   = "{{ required "google.region is required" $.Values.google.region }}"
+}
+{{ end }}
+resource "google_compute_router_nat" "nat" {
+  {{- if .Values.hostProject.enabled }}
+  provider                           = "google.host"
+  {{- end }}
+  name                               = "{{ required "clusterName is required" .Values.clusterName }}-cloud-nat"
+  router                             = "${google_compute_router.router.name}"
+  region                             = "${google_compute_router.router.region}"
+  {{- if .Values.cloudNAT.natIPNames }}
+  nat_ip_allocate_option             = "MANUAL_ONLY"
+  nat_ips                            = [{{ range $i, $name := .Values.cloudNAT.natIPNames }}{{ if $i }}, {{ end }}"${data.google_compute_address.nat-ip-{{ $i }}.self_link}"{{ end }}]
+  {{- else }}
+  nat_ip_allocate_option             = "AUTO_ONLY"
+  {{- end }}
+  {{- if .Values.cloudNAT.minPortsPerVM }}
+  min_ports_per_vm                   = "{{ .Values.cloudNAT.minPortsPerVM }}"
+  {{- end }}
+  source_subnetwork_ip_ranges_to_nat = "LIST_OF_SUBNETWORKS"
+
+  subnetwork {
+    name                    = "${google_compute_subnetwork.subnetwork-nodes.self_link}"
+    source_ip_ranges_to_nat = ["ALL_IP_RANGES"]
+  }
+}
+{{- end }}
+
 //=====================================================================
 //= Firewall
 //=====================================================================
 
 // Allow traffic within internal network range.
 resource "google_compute_firewall" "rule-allow-internal-access" {
+  {{- if .Values.hostProject.enabled }}
+  provider      = "google.host"
+  {{- end }}
   name          = "{{ required "clusterName is required" .Values.clusterName }}-allow-internal-access"
   network       = "{{ required "vpc.name is required" .Values.vpc.name }}"
   source_ranges = ["10.0.0.0/8"]
@@ -69,6 +136,9 @@ resource "google_compute_firewall" "rule-allow-internal-access" {
 }
 
 resource "google_compute_firewall" "rule-allow-external-access" {
+  {{- if .Values.hostProject.enabled }}
+  provider      = "google.host"
+  {{- end }}
   name          = "{{ required "clusterName is required" .Values.clusterName }}-allow-external-access"
   network       = "{{ required "vpc.name is required" .Values.vpc.name }}"
   source_ranges = ["0.0.0.0/0"]
@@ -83,6 +153,9 @@ resource "google_compute_firewall" "rule-allow-external-access" {
 // https://cloud.google.com/compute/docs/load-balancing/internal/
 // https://cloud.google.com/compute/docs/load-balancing/network/
 resource "google_compute_firewall" "rule-allow-health-checks" {
+  {{- if .Values.hostProject.enabled }}
+  provider      = "google.host"
+  {{- end }}
   name          = "{{ required "clusterName is required" .Values.clusterName }}-allow-health-checks"
   network       = "{{ required "vpc.name is required" .Values.vpc.name }}"
   source_ranges = [
@@ -133,3 +206,18 @@ output "{{ .Values.outputKeys.subnetInternal }}" {
   value = "${google_compute_subnetwork.subnetwork-internal.name}"
 }
 {{- end}}
+{{ if .Values.cloudNAT.enabled -}}
+output "{{ .Values.outputKeys.cloudRouter }}" {
+  value = "${google_compute_router.router.name}"
+}
+
+output "{{ .Values.outputKeys.cloudNAT }}" {
+  value = "${google_compute_router_nat.nat.name}"
+}
+{{- if .Values.cloudNAT.natIPNames }}
+
+output "{{ .Values.outputKeys.natIPs }}" {
+  value = "{{ range $i, $name := .Values.cloudNAT.natIPNames }}{{ if $i }},{{ end }}${data.google_compute_address.nat-ip-{{ $i }}.address}{{ end }}"
+}
+{{- end }}
+{{- end }}
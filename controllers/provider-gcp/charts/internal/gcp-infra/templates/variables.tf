@@ -2,3 +2,10 @@ variable "SERVICEACCOUNT" {
   description = "ServiceAccount"
   type        = "string"
 }
+{{- if .Values.hostProject.enabled }}
+
+variable "HOST_SERVICEACCOUNT" {
+  description = "ServiceAccount for the host project of the shared VPC"
+  type        = "string"
+}
+{{- end }}
@@ -43,3 +43,14 @@ func FindMachineImage(machineImages []gcp.MachineImage, name, version string) (*
 	}
 	return nil, fmt.Errorf("no machine image with name %q, version %q found", name, version)
 }
+
+// IsCSIDriverEnabled returns whether the deployment of the CSI driver is enabled in the given control plane config.
+func IsCSIDriverEnabled(config *gcp.ControlPlaneConfig) bool {
+	return config != nil && config.Storage != nil && config.Storage.CSIDriver
+}
+
+// IsCSIMigrationEnabled returns whether the migration of the in-tree volume plugin to the CSI driver is enabled in the
+// given control plane config. The migration is only enabled if the CSI driver is enabled as well.
+func IsCSIMigrationEnabled(config *gcp.ControlPlaneConfig) bool {
+	return IsCSIDriverEnabled(config) && config.Storage.CSIMigration
+}
@@ -53,6 +53,29 @@ var _ = Describe("Helper", func() {
 		Entry("entry not found (no version)", []gcp.MachineImage{{Name: "bar", Version: "1.2.3", Image: "image123"}}, "foo", "1.2.4", nil, true),
 		Entry("entry exists", []gcp.MachineImage{{Name: "bar", Version: "1.2.3", Image: "image123"}}, "bar", "1.2.3", &gcp.MachineImage{Name: "bar", Version: "1.2.3", Image: "image123"}, false),
 	)
+
+	DescribeTable("#IsCSIDriverEnabled",
+		func(config *gcp.ControlPlaneConfig, expected bool) {
+			Expect(IsCSIDriverEnabled(config)).To(Equal(expected))
+		},
+
+		Entry("config is nil", nil, false),
+		Entry("storage is nil", &gcp.ControlPlaneConfig{}, false),
+		Entry("driver is disabled", &gcp.ControlPlaneConfig{Storage: &gcp.Storage{CSIMigration: true}}, false),
+		Entry("driver is enabled", &gcp.ControlPlaneConfig{Storage: &gcp.Storage{CSIDriver: true}}, true),
+	)
+
+	DescribeTable("#IsCSIMigrationEnabled",
+		func(config *gcp.ControlPlaneConfig, expected bool) {
+			Expect(IsCSIMigrationEnabled(config)).To(Equal(expected))
+		},
+
+		Entry("config is nil", nil, false),
+		Entry("storage is nil", &gcp.ControlPlaneConfig{}, false),
+		Entry("driver is disabled", &gcp.ControlPlaneConfig{Storage: &gcp.Storage{CSIMigration: true}}, false),
+		Entry("migration is disabled", &gcp.ControlPlaneConfig{Storage: &gcp.Storage{CSIDriver: true}}, false),
+		Entry("migration is enabled", &gcp.ControlPlaneConfig{Storage: &gcp.Storage{CSIDriver: true, CSIMigration: true}}, true),
+	)
 })
 
 func expectResults(result, expected interface{}, err error, expectErr bool) {
@@ -0,0 +1,65 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcp
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BackupBucketConfig contains configuration settings for the backup bucket.
+type BackupBucketConfig struct {
+	metav1.TypeMeta
+
+	// Encryption contains the default encryption settings of the bucket.
+	Encryption *BucketEncryption
+	// Versioning indicates whether object versioning is enabled for the bucket.
+	Versioning bool
+	// BlockPublicAccess indicates whether uniform bucket-level access is enforced, i.e. whether object ACLs that
+	// could grant public access to single objects are disabled.
+	BlockPublicAccess bool
+	// Lifecycle contains the lifecycle rules of the bucket.
+	Lifecycle *BucketLifecycle
+}
+
+// BucketEncryption contains the default encryption settings of a bucket.
+type BucketEncryption struct {
+	// KMSKeyName is the name of the customer-managed Cloud KMS key used to encrypt the objects, in the form
+	// `projects/<project>/locations/<location>/keyRings/<key-ring>/cryptoKeys/<key>`.
+	KMSKeyName string
+}
+
+// BucketLifecycle contains the lifecycle rules of a bucket.
+type BucketLifecycle struct {
+	// ExpirationDays is the number of days after which objects and noncurrent object versions are deleted.
+	ExpirationDays int32
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BackupBucketStatus contains information about the settings applied to the backup bucket.
+type BackupBucketStatus struct {
+	metav1.TypeMeta
+
+	// Encryption contains the default encryption settings applied to the bucket.
+	Encryption *BucketEncryption
+	// Versioning indicates whether object versioning is enabled for the bucket.
+	Versioning bool
+	// PublicAccessBlocked indicates whether uniform bucket-level access is enforced for the bucket.
+	PublicAccessBlocked bool
+	// Lifecycle contains the lifecycle rules applied to the bucket.
+	Lifecycle *BucketLifecycle
+}
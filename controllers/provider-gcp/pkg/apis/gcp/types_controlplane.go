@@ -29,6 +29,9 @@ type ControlPlaneConfig struct {
 
 	// CloudControllerManager contains configuration settings for the cloud-controller-manager.
 	CloudControllerManager *CloudControllerManagerConfig
+
+	// Storage contains configuration settings for the storage of the shoot cluster.
+	Storage *Storage
 }
 
 // CloudControllerManagerConfig contains configuration settings for the cloud-controller-manager.
@@ -36,3 +39,12 @@ type CloudControllerManagerConfig struct {
 	// FeatureGates contains information about enabled feature gates.
 	FeatureGates map[string]bool
 }
+
+// Storage contains configuration settings for the storage of the shoot cluster.
+type Storage struct {
+	// CSIDriver specifies whether the GCE PD CSI driver is deployed.
+	CSIDriver bool
+	// CSIMigration specifies whether the in-tree GCE PD volume plugin is migrated to the CSI driver. It requires the
+	// CSI driver to be deployed.
+	CSIMigration bool
+}
@@ -30,6 +30,10 @@ type ControlPlaneConfig struct {
 	// CloudControllerManager contains configuration settings for the cloud-controller-manager.
 	// +optional
 	CloudControllerManager *CloudControllerManagerConfig `json:"cloudControllerManager,omitempty"`
+
+	// Storage contains configuration settings for the storage of the shoot cluster.
+	// +optional
+	Storage *Storage `json:"storage,omitempty"`
 }
 
 // CloudControllerManagerConfig contains configuration settings for the cloud-controller-manager.
@@ -38,3 +42,14 @@ type CloudControllerManagerConfig struct {
 	// +optional
 	FeatureGates map[string]bool `json:"featureGates,omitempty"`
 }
+
+// Storage contains configuration settings for the storage of the shoot cluster.
+type Storage struct {
+	// CSIDriver specifies whether the GCE PD CSI driver is deployed.
+	// +optional
+	CSIDriver bool `json:"csiDriver,omitempty"`
+	// CSIMigration specifies whether the in-tree GCE PD volume plugin is migrated to the CSI driver. It requires the
+	// CSI driver to be deployed.
+	// +optional
+	CSIMigration bool `json:"csiMigration,omitempty"`
+}
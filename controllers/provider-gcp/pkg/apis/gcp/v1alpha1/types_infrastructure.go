@@ -15,6 +15,7 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -33,11 +34,48 @@ type NetworkConfig struct {
 	// VPC indicates whether to use an existing VPC or create a new one.
 	// +optional
 	VPC *VPC `json:"vpc,omitempty"`
+	// HostProject contains information about the host project of a shared VPC. If it is set, the VPC is
+	// looked up in the host project and the subnets and firewall rules are created there.
+	// +optional
+	HostProject *HostProject `json:"hostProject,omitempty"`
 	// Internal is a private subnet (used for internal load balancers).
 	// +optional
 	Internal *string `json:"internal,omitempty"`
 	// Workers is the worker subnet range to create (used for the VMs).
 	Worker string `json:"worker"`
+	// CloudNAT contains configuration about the Cloud NAT. If it is set, a Cloud Router and a Cloud NAT
+	// are created for the worker subnet.
+	// +optional
+	CloudNAT *CloudNAT `json:"cloudNAT,omitempty"`
+}
+
+// HostProject contains information about the host project of a shared VPC.
+type HostProject struct {
+	// ID is the ID of the host project.
+	ID string `json:"id"`
+	// SecretRef references a secret containing the credentials of a service account that is allowed to
+	// manage the network resources in the host project. The secret is always looked up in the namespace of the
+	// infrastructure, hence only its name may be set. If it is not set, the shoot's credentials are used.
+	// +optional
+	SecretRef *corev1.SecretReference `json:"secretRef,omitempty"`
+}
+
+// CloudNAT contains configuration about the Cloud NAT resource.
+type CloudNAT struct {
+	// MinPortsPerVM is the minimum number of ports allocated to a VM in the NAT config.
+	// The default value is 64 ports.
+	// +optional
+	MinPortsPerVM *int32 `json:"minPortsPerVM,omitempty"`
+	// NatIPNames is a list of names of reserved static IP addresses used by the Cloud NAT. If it is empty,
+	// the IP addresses are allocated automatically.
+	// +optional
+	NatIPNames []NatIPName `json:"natIPNames,omitempty"`
+}
+
+// NatIPName is the name of a reserved static IP address.
+type NatIPName struct {
+	// Name is the name of the reserved static IP address.
+	Name string `json:"name"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -58,8 +96,42 @@ type NetworkStatus struct {
 	// VPC states the name of the infrastructure VPC.
 	VPC VPC `json:"vpc"`
 
+	// HostProjectID is the ID of the host project of a shared VPC.
+	// +optional
+	HostProjectID *string `json:"hostProjectID,omitempty"`
+
 	// Subnets are the subnets that have been created.
 	Subnets []Subnet `json:"subnets"`
+
+	// CloudRouter is the Cloud Router that has been created for the Cloud NAT.
+	// +optional
+	CloudRouter *CloudRouter `json:"cloudRouter,omitempty"`
+
+	// CloudNAT is the Cloud NAT that has been created for the worker subnet.
+	// +optional
+	CloudNAT *CloudNATStatus `json:"cloudNAT,omitempty"`
+}
+
+// CloudRouter contains information about the Cloud Router.
+type CloudRouter struct {
+	// Name is the Cloud Router name.
+	Name string `json:"name"`
+}
+
+// CloudNATStatus contains information about the Cloud NAT.
+type CloudNATStatus struct {
+	// Name is the Cloud NAT name.
+	Name string `json:"name"`
+	// NatIPs are the reserved static IP addresses used by the Cloud NAT. It is empty if the IP
+	// addresses are allocated automatically.
+	// +optional
+	NatIPs []NatIP `json:"natIPs,omitempty"`
+}
+
+// NatIP is an external IP address used by the Cloud NAT.
+type NatIP struct {
+	// IP is the external IP address.
+	IP string `json:"ip"`
 }
 
 // SubnetPurpose is a purpose of a subnet.
@@ -24,6 +24,8 @@ import (
 	unsafe "unsafe"
 
 	gcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -35,6 +37,46 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*BackupBucketConfig)(nil), (*gcp.BackupBucketConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BackupBucketConfig_To_gcp_BackupBucketConfig(a.(*BackupBucketConfig), b.(*gcp.BackupBucketConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.BackupBucketConfig)(nil), (*BackupBucketConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(a.(*gcp.BackupBucketConfig), b.(*BackupBucketConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BackupBucketStatus)(nil), (*gcp.BackupBucketStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BackupBucketStatus_To_gcp_BackupBucketStatus(a.(*BackupBucketStatus), b.(*gcp.BackupBucketStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.BackupBucketStatus)(nil), (*BackupBucketStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_BackupBucketStatus_To_v1alpha1_BackupBucketStatus(a.(*gcp.BackupBucketStatus), b.(*BackupBucketStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BucketEncryption)(nil), (*gcp.BucketEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BucketEncryption_To_gcp_BucketEncryption(a.(*BucketEncryption), b.(*gcp.BucketEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.BucketEncryption)(nil), (*BucketEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_BucketEncryption_To_v1alpha1_BucketEncryption(a.(*gcp.BucketEncryption), b.(*BucketEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BucketLifecycle)(nil), (*gcp.BucketLifecycle)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BucketLifecycle_To_gcp_BucketLifecycle(a.(*BucketLifecycle), b.(*gcp.BucketLifecycle), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.BucketLifecycle)(nil), (*BucketLifecycle)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_BucketLifecycle_To_v1alpha1_BucketLifecycle(a.(*gcp.BucketLifecycle), b.(*BucketLifecycle), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudControllerManagerConfig)(nil), (*gcp.CloudControllerManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CloudControllerManagerConfig_To_gcp_CloudControllerManagerConfig(a.(*CloudControllerManagerConfig), b.(*gcp.CloudControllerManagerConfig), scope)
 	}); err != nil {
@@ -45,6 +87,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CloudNAT)(nil), (*gcp.CloudNAT)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CloudNAT_To_gcp_CloudNAT(a.(*CloudNAT), b.(*gcp.CloudNAT), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.CloudNAT)(nil), (*CloudNAT)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_CloudNAT_To_v1alpha1_CloudNAT(a.(*gcp.CloudNAT), b.(*CloudNAT), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CloudNATStatus)(nil), (*gcp.CloudNATStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CloudNATStatus_To_gcp_CloudNATStatus(a.(*CloudNATStatus), b.(*gcp.CloudNATStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.CloudNATStatus)(nil), (*CloudNATStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_CloudNATStatus_To_v1alpha1_CloudNATStatus(a.(*gcp.CloudNATStatus), b.(*CloudNATStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudProfileConfig)(nil), (*gcp.CloudProfileConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CloudProfileConfig_To_gcp_CloudProfileConfig(a.(*CloudProfileConfig), b.(*gcp.CloudProfileConfig), scope)
 	}); err != nil {
@@ -55,6 +117,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CloudRouter)(nil), (*gcp.CloudRouter)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CloudRouter_To_gcp_CloudRouter(a.(*CloudRouter), b.(*gcp.CloudRouter), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.CloudRouter)(nil), (*CloudRouter)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_CloudRouter_To_v1alpha1_CloudRouter(a.(*gcp.CloudRouter), b.(*CloudRouter), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControlPlaneConfig)(nil), (*gcp.ControlPlaneConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ControlPlaneConfig_To_gcp_ControlPlaneConfig(a.(*ControlPlaneConfig), b.(*gcp.ControlPlaneConfig), scope)
 	}); err != nil {
@@ -65,6 +137,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HostProject)(nil), (*gcp.HostProject)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HostProject_To_gcp_HostProject(a.(*HostProject), b.(*gcp.HostProject), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.HostProject)(nil), (*HostProject)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_HostProject_To_v1alpha1_HostProject(a.(*gcp.HostProject), b.(*HostProject), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*InfrastructureConfig)(nil), (*gcp.InfrastructureConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_InfrastructureConfig_To_gcp_InfrastructureConfig(a.(*InfrastructureConfig), b.(*gcp.InfrastructureConfig), scope)
 	}); err != nil {
@@ -85,6 +167,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MachineControllerManagerSettings)(nil), (*gcp.MachineControllerManagerSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineControllerManagerSettings_To_gcp_MachineControllerManagerSettings(a.(*MachineControllerManagerSettings), b.(*gcp.MachineControllerManagerSettings), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.MachineControllerManagerSettings)(nil), (*MachineControllerManagerSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_MachineControllerManagerSettings_To_v1alpha1_MachineControllerManagerSettings(a.(*gcp.MachineControllerManagerSettings), b.(*MachineControllerManagerSettings), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MachineImage)(nil), (*gcp.MachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_MachineImage_To_gcp_MachineImage(a.(*MachineImage), b.(*gcp.MachineImage), scope)
 	}); err != nil {
@@ -115,6 +207,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NatIP)(nil), (*gcp.NatIP)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NatIP_To_gcp_NatIP(a.(*NatIP), b.(*gcp.NatIP), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.NatIP)(nil), (*NatIP)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_NatIP_To_v1alpha1_NatIP(a.(*gcp.NatIP), b.(*NatIP), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NatIPName)(nil), (*gcp.NatIPName)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NatIPName_To_gcp_NatIPName(a.(*NatIPName), b.(*gcp.NatIPName), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.NatIPName)(nil), (*NatIPName)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_NatIPName_To_v1alpha1_NatIPName(a.(*gcp.NatIPName), b.(*NatIPName), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NetworkConfig)(nil), (*gcp.NetworkConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NetworkConfig_To_gcp_NetworkConfig(a.(*NetworkConfig), b.(*gcp.NetworkConfig), scope)
 	}); err != nil {
@@ -135,6 +247,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Storage)(nil), (*gcp.Storage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Storage_To_gcp_Storage(a.(*Storage), b.(*gcp.Storage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.Storage)(nil), (*Storage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_Storage_To_v1alpha1_Storage(a.(*gcp.Storage), b.(*Storage), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Subnet)(nil), (*gcp.Subnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Subnet_To_gcp_Subnet(a.(*Subnet), b.(*gcp.Subnet), scope)
 	}); err != nil {
@@ -155,6 +277,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WorkerConfig)(nil), (*gcp.WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerConfig_To_gcp_WorkerConfig(a.(*WorkerConfig), b.(*gcp.WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.WorkerConfig)(nil), (*WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_WorkerConfig_To_v1alpha1_WorkerConfig(a.(*gcp.WorkerConfig), b.(*WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*WorkerStatus)(nil), (*gcp.WorkerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_WorkerStatus_To_gcp_WorkerStatus(a.(*WorkerStatus), b.(*gcp.WorkerStatus), scope)
 	}); err != nil {
@@ -168,6 +300,98 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1alpha1_BackupBucketConfig_To_gcp_BackupBucketConfig(in *BackupBucketConfig, out *gcp.BackupBucketConfig, s conversion.Scope) error {
+	out.Encryption = (*gcp.BucketEncryption)(unsafe.Pointer(in.Encryption))
+	out.Versioning = in.Versioning
+	out.BlockPublicAccess = in.BlockPublicAccess
+	out.Lifecycle = (*gcp.BucketLifecycle)(unsafe.Pointer(in.Lifecycle))
+	return nil
+}
+
+// Convert_v1alpha1_BackupBucketConfig_To_gcp_BackupBucketConfig is an autogenerated conversion function.
+func Convert_v1alpha1_BackupBucketConfig_To_gcp_BackupBucketConfig(in *BackupBucketConfig, out *gcp.BackupBucketConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BackupBucketConfig_To_gcp_BackupBucketConfig(in, out, s)
+}
+
+func autoConvert_gcp_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *gcp.BackupBucketConfig, out *BackupBucketConfig, s conversion.Scope) error {
+	out.Encryption = (*BucketEncryption)(unsafe.Pointer(in.Encryption))
+	out.Versioning = in.Versioning
+	out.BlockPublicAccess = in.BlockPublicAccess
+	out.Lifecycle = (*BucketLifecycle)(unsafe.Pointer(in.Lifecycle))
+	return nil
+}
+
+// Convert_gcp_BackupBucketConfig_To_v1alpha1_BackupBucketConfig is an autogenerated conversion function.
+func Convert_gcp_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *gcp.BackupBucketConfig, out *BackupBucketConfig, s conversion.Scope) error {
+	return autoConvert_gcp_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_BackupBucketStatus_To_gcp_BackupBucketStatus(in *BackupBucketStatus, out *gcp.BackupBucketStatus, s conversion.Scope) error {
+	out.Encryption = (*gcp.BucketEncryption)(unsafe.Pointer(in.Encryption))
+	out.Versioning = in.Versioning
+	out.PublicAccessBlocked = in.PublicAccessBlocked
+	out.Lifecycle = (*gcp.BucketLifecycle)(unsafe.Pointer(in.Lifecycle))
+	return nil
+}
+
+// Convert_v1alpha1_BackupBucketStatus_To_gcp_BackupBucketStatus is an autogenerated conversion function.
+func Convert_v1alpha1_BackupBucketStatus_To_gcp_BackupBucketStatus(in *BackupBucketStatus, out *gcp.BackupBucketStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BackupBucketStatus_To_gcp_BackupBucketStatus(in, out, s)
+}
+
+func autoConvert_gcp_BackupBucketStatus_To_v1alpha1_BackupBucketStatus(in *gcp.BackupBucketStatus, out *BackupBucketStatus, s conversion.Scope) error {
+	out.Encryption = (*BucketEncryption)(unsafe.Pointer(in.Encryption))
+	out.Versioning = in.Versioning
+	out.PublicAccessBlocked = in.PublicAccessBlocked
+	out.Lifecycle = (*BucketLifecycle)(unsafe.Pointer(in.Lifecycle))
+	return nil
+}
+
+// Convert_gcp_BackupBucketStatus_To_v1alpha1_BackupBucketStatus is an autogenerated conversion function.
+func Convert_gcp_BackupBucketStatus_To_v1alpha1_BackupBucketStatus(in *gcp.BackupBucketStatus, out *BackupBucketStatus, s conversion.Scope) error {
+	return autoConvert_gcp_BackupBucketStatus_To_v1alpha1_BackupBucketStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_BucketEncryption_To_gcp_BucketEncryption(in *BucketEncryption, out *gcp.BucketEncryption, s conversion.Scope) error {
+	out.KMSKeyName = in.KMSKeyName
+	return nil
+}
+
+// Convert_v1alpha1_BucketEncryption_To_gcp_BucketEncryption is an autogenerated conversion function.
+func Convert_v1alpha1_BucketEncryption_To_gcp_BucketEncryption(in *BucketEncryption, out *gcp.BucketEncryption, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BucketEncryption_To_gcp_BucketEncryption(in, out, s)
+}
+
+func autoConvert_gcp_BucketEncryption_To_v1alpha1_BucketEncryption(in *gcp.BucketEncryption, out *BucketEncryption, s conversion.Scope) error {
+	out.KMSKeyName = in.KMSKeyName
+	return nil
+}
+
+// Convert_gcp_BucketEncryption_To_v1alpha1_BucketEncryption is an autogenerated conversion function.
+func Convert_gcp_BucketEncryption_To_v1alpha1_BucketEncryption(in *gcp.BucketEncryption, out *BucketEncryption, s conversion.Scope) error {
+	return autoConvert_gcp_BucketEncryption_To_v1alpha1_BucketEncryption(in, out, s)
+}
+
+func autoConvert_v1alpha1_BucketLifecycle_To_gcp_BucketLifecycle(in *BucketLifecycle, out *gcp.BucketLifecycle, s conversion.Scope) error {
+	out.ExpirationDays = in.ExpirationDays
+	return nil
+}
+
+// Convert_v1alpha1_BucketLifecycle_To_gcp_BucketLifecycle is an autogenerated conversion function.
+func Convert_v1alpha1_BucketLifecycle_To_gcp_BucketLifecycle(in *BucketLifecycle, out *gcp.BucketLifecycle, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BucketLifecycle_To_gcp_BucketLifecycle(in, out, s)
+}
+
+func autoConvert_gcp_BucketLifecycle_To_v1alpha1_BucketLifecycle(in *gcp.BucketLifecycle, out *BucketLifecycle, s conversion.Scope) error {
+	out.ExpirationDays = in.ExpirationDays
+	return nil
+}
+
+// Convert_gcp_BucketLifecycle_To_v1alpha1_BucketLifecycle is an autogenerated conversion function.
+func Convert_gcp_BucketLifecycle_To_v1alpha1_BucketLifecycle(in *gcp.BucketLifecycle, out *BucketLifecycle, s conversion.Scope) error {
+	return autoConvert_gcp_BucketLifecycle_To_v1alpha1_BucketLifecycle(in, out, s)
+}
+
 func autoConvert_v1alpha1_CloudControllerManagerConfig_To_gcp_CloudControllerManagerConfig(in *CloudControllerManagerConfig, out *gcp.CloudControllerManagerConfig, s conversion.Scope) error {
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
 	return nil
@@ -188,6 +412,50 @@ func Convert_gcp_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManager
 	return autoConvert_gcp_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_CloudNAT_To_gcp_CloudNAT(in *CloudNAT, out *gcp.CloudNAT, s conversion.Scope) error {
+	out.MinPortsPerVM = (*int32)(unsafe.Pointer(in.MinPortsPerVM))
+	out.NatIPNames = *(*[]gcp.NatIPName)(unsafe.Pointer(&in.NatIPNames))
+	return nil
+}
+
+// Convert_v1alpha1_CloudNAT_To_gcp_CloudNAT is an autogenerated conversion function.
+func Convert_v1alpha1_CloudNAT_To_gcp_CloudNAT(in *CloudNAT, out *gcp.CloudNAT, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloudNAT_To_gcp_CloudNAT(in, out, s)
+}
+
+func autoConvert_gcp_CloudNAT_To_v1alpha1_CloudNAT(in *gcp.CloudNAT, out *CloudNAT, s conversion.Scope) error {
+	out.MinPortsPerVM = (*int32)(unsafe.Pointer(in.MinPortsPerVM))
+	out.NatIPNames = *(*[]NatIPName)(unsafe.Pointer(&in.NatIPNames))
+	return nil
+}
+
+// Convert_gcp_CloudNAT_To_v1alpha1_CloudNAT is an autogenerated conversion function.
+func Convert_gcp_CloudNAT_To_v1alpha1_CloudNAT(in *gcp.CloudNAT, out *CloudNAT, s conversion.Scope) error {
+	return autoConvert_gcp_CloudNAT_To_v1alpha1_CloudNAT(in, out, s)
+}
+
+func autoConvert_v1alpha1_CloudNATStatus_To_gcp_CloudNATStatus(in *CloudNATStatus, out *gcp.CloudNATStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.NatIPs = *(*[]gcp.NatIP)(unsafe.Pointer(&in.NatIPs))
+	return nil
+}
+
+// Convert_v1alpha1_CloudNATStatus_To_gcp_CloudNATStatus is an autogenerated conversion function.
+func Convert_v1alpha1_CloudNATStatus_To_gcp_CloudNATStatus(in *CloudNATStatus, out *gcp.CloudNATStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloudNATStatus_To_gcp_CloudNATStatus(in, out, s)
+}
+
+func autoConvert_gcp_CloudNATStatus_To_v1alpha1_CloudNATStatus(in *gcp.CloudNATStatus, out *CloudNATStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.NatIPs = *(*[]NatIP)(unsafe.Pointer(&in.NatIPs))
+	return nil
+}
+
+// Convert_gcp_CloudNATStatus_To_v1alpha1_CloudNATStatus is an autogenerated conversion function.
+func Convert_gcp_CloudNATStatus_To_v1alpha1_CloudNATStatus(in *gcp.CloudNATStatus, out *CloudNATStatus, s conversion.Scope) error {
+	return autoConvert_gcp_CloudNATStatus_To_v1alpha1_CloudNATStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_CloudProfileConfig_To_gcp_CloudProfileConfig(in *CloudProfileConfig, out *gcp.CloudProfileConfig, s conversion.Scope) error {
 	out.MachineImages = *(*[]gcp.MachineImages)(unsafe.Pointer(&in.MachineImages))
 	return nil
@@ -208,9 +476,30 @@ func Convert_gcp_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in *gcp.Cloud
 	return autoConvert_gcp_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_CloudRouter_To_gcp_CloudRouter(in *CloudRouter, out *gcp.CloudRouter, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha1_CloudRouter_To_gcp_CloudRouter is an autogenerated conversion function.
+func Convert_v1alpha1_CloudRouter_To_gcp_CloudRouter(in *CloudRouter, out *gcp.CloudRouter, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloudRouter_To_gcp_CloudRouter(in, out, s)
+}
+
+func autoConvert_gcp_CloudRouter_To_v1alpha1_CloudRouter(in *gcp.CloudRouter, out *CloudRouter, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_gcp_CloudRouter_To_v1alpha1_CloudRouter is an autogenerated conversion function.
+func Convert_gcp_CloudRouter_To_v1alpha1_CloudRouter(in *gcp.CloudRouter, out *CloudRouter, s conversion.Scope) error {
+	return autoConvert_gcp_CloudRouter_To_v1alpha1_CloudRouter(in, out, s)
+}
+
 func autoConvert_v1alpha1_ControlPlaneConfig_To_gcp_ControlPlaneConfig(in *ControlPlaneConfig, out *gcp.ControlPlaneConfig, s conversion.Scope) error {
 	out.Zone = in.Zone
 	out.CloudControllerManager = (*gcp.CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.Storage = (*gcp.Storage)(unsafe.Pointer(in.Storage))
 	return nil
 }
 
@@ -222,6 +511,7 @@ func Convert_v1alpha1_ControlPlaneConfig_To_gcp_ControlPlaneConfig(in *ControlPl
 func autoConvert_gcp_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *gcp.ControlPlaneConfig, out *ControlPlaneConfig, s conversion.Scope) error {
 	out.Zone = in.Zone
 	out.CloudControllerManager = (*CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.Storage = (*Storage)(unsafe.Pointer(in.Storage))
 	return nil
 }
 
@@ -230,6 +520,28 @@ func Convert_gcp_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *gcp.Contr
 	return autoConvert_gcp_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_HostProject_To_gcp_HostProject(in *HostProject, out *gcp.HostProject, s conversion.Scope) error {
+	out.ID = in.ID
+	out.SecretRef = (*v1.SecretReference)(unsafe.Pointer(in.SecretRef))
+	return nil
+}
+
+// Convert_v1alpha1_HostProject_To_gcp_HostProject is an autogenerated conversion function.
+func Convert_v1alpha1_HostProject_To_gcp_HostProject(in *HostProject, out *gcp.HostProject, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HostProject_To_gcp_HostProject(in, out, s)
+}
+
+func autoConvert_gcp_HostProject_To_v1alpha1_HostProject(in *gcp.HostProject, out *HostProject, s conversion.Scope) error {
+	out.ID = in.ID
+	out.SecretRef = (*v1.SecretReference)(unsafe.Pointer(in.SecretRef))
+	return nil
+}
+
+// Convert_gcp_HostProject_To_v1alpha1_HostProject is an autogenerated conversion function.
+func Convert_gcp_HostProject_To_v1alpha1_HostProject(in *gcp.HostProject, out *HostProject, s conversion.Scope) error {
+	return autoConvert_gcp_HostProject_To_v1alpha1_HostProject(in, out, s)
+}
+
 func autoConvert_v1alpha1_InfrastructureConfig_To_gcp_InfrastructureConfig(in *InfrastructureConfig, out *gcp.InfrastructureConfig, s conversion.Scope) error {
 	if err := Convert_v1alpha1_NetworkConfig_To_gcp_NetworkConfig(&in.Networks, &out.Networks, s); err != nil {
 		return err
@@ -280,6 +592,28 @@ func Convert_gcp_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in *gcp.I
 	return autoConvert_gcp_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_MachineControllerManagerSettings_To_gcp_MachineControllerManagerSettings(in *MachineControllerManagerSettings, out *gcp.MachineControllerManagerSettings, s conversion.Scope) error {
+	out.MachineDrainTimeout = (*metav1.Duration)(unsafe.Pointer(in.MachineDrainTimeout))
+	out.MachineCreationTimeout = (*metav1.Duration)(unsafe.Pointer(in.MachineCreationTimeout))
+	return nil
+}
+
+// Convert_v1alpha1_MachineControllerManagerSettings_To_gcp_MachineControllerManagerSettings is an autogenerated conversion function.
+func Convert_v1alpha1_MachineControllerManagerSettings_To_gcp_MachineControllerManagerSettings(in *MachineControllerManagerSettings, out *gcp.MachineControllerManagerSettings, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineControllerManagerSettings_To_gcp_MachineControllerManagerSettings(in, out, s)
+}
+
+func autoConvert_gcp_MachineControllerManagerSettings_To_v1alpha1_MachineControllerManagerSettings(in *gcp.MachineControllerManagerSettings, out *MachineControllerManagerSettings, s conversion.Scope) error {
+	out.MachineDrainTimeout = (*metav1.Duration)(unsafe.Pointer(in.MachineDrainTimeout))
+	out.MachineCreationTimeout = (*metav1.Duration)(unsafe.Pointer(in.MachineCreationTimeout))
+	return nil
+}
+
+// Convert_gcp_MachineControllerManagerSettings_To_v1alpha1_MachineControllerManagerSettings is an autogenerated conversion function.
+func Convert_gcp_MachineControllerManagerSettings_To_v1alpha1_MachineControllerManagerSettings(in *gcp.MachineControllerManagerSettings, out *MachineControllerManagerSettings, s conversion.Scope) error {
+	return autoConvert_gcp_MachineControllerManagerSettings_To_v1alpha1_MachineControllerManagerSettings(in, out, s)
+}
+
 func autoConvert_v1alpha1_MachineImage_To_gcp_MachineImage(in *MachineImage, out *gcp.MachineImage, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Version = in.Version
@@ -348,10 +682,52 @@ func Convert_gcp_MachineImages_To_v1alpha1_MachineImages(in *gcp.MachineImages,
 	return autoConvert_gcp_MachineImages_To_v1alpha1_MachineImages(in, out, s)
 }
 
+func autoConvert_v1alpha1_NatIP_To_gcp_NatIP(in *NatIP, out *gcp.NatIP, s conversion.Scope) error {
+	out.IP = in.IP
+	return nil
+}
+
+// Convert_v1alpha1_NatIP_To_gcp_NatIP is an autogenerated conversion function.
+func Convert_v1alpha1_NatIP_To_gcp_NatIP(in *NatIP, out *gcp.NatIP, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NatIP_To_gcp_NatIP(in, out, s)
+}
+
+func autoConvert_gcp_NatIP_To_v1alpha1_NatIP(in *gcp.NatIP, out *NatIP, s conversion.Scope) error {
+	out.IP = in.IP
+	return nil
+}
+
+// Convert_gcp_NatIP_To_v1alpha1_NatIP is an autogenerated conversion function.
+func Convert_gcp_NatIP_To_v1alpha1_NatIP(in *gcp.NatIP, out *NatIP, s conversion.Scope) error {
+	return autoConvert_gcp_NatIP_To_v1alpha1_NatIP(in, out, s)
+}
+
+func autoConvert_v1alpha1_NatIPName_To_gcp_NatIPName(in *NatIPName, out *gcp.NatIPName, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha1_NatIPName_To_gcp_NatIPName is an autogenerated conversion function.
+func Convert_v1alpha1_NatIPName_To_gcp_NatIPName(in *NatIPName, out *gcp.NatIPName, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NatIPName_To_gcp_NatIPName(in, out, s)
+}
+
+func autoConvert_gcp_NatIPName_To_v1alpha1_NatIPName(in *gcp.NatIPName, out *NatIPName, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_gcp_NatIPName_To_v1alpha1_NatIPName is an autogenerated conversion function.
+func Convert_gcp_NatIPName_To_v1alpha1_NatIPName(in *gcp.NatIPName, out *NatIPName, s conversion.Scope) error {
+	return autoConvert_gcp_NatIPName_To_v1alpha1_NatIPName(in, out, s)
+}
+
 func autoConvert_v1alpha1_NetworkConfig_To_gcp_NetworkConfig(in *NetworkConfig, out *gcp.NetworkConfig, s conversion.Scope) error {
 	out.VPC = (*gcp.VPC)(unsafe.Pointer(in.VPC))
+	out.HostProject = (*gcp.HostProject)(unsafe.Pointer(in.HostProject))
 	out.Internal = (*string)(unsafe.Pointer(in.Internal))
 	out.Worker = in.Worker
+	out.CloudNAT = (*gcp.CloudNAT)(unsafe.Pointer(in.CloudNAT))
 	return nil
 }
 
@@ -362,8 +738,10 @@ func Convert_v1alpha1_NetworkConfig_To_gcp_NetworkConfig(in *NetworkConfig, out
 
 func autoConvert_gcp_NetworkConfig_To_v1alpha1_NetworkConfig(in *gcp.NetworkConfig, out *NetworkConfig, s conversion.Scope) error {
 	out.VPC = (*VPC)(unsafe.Pointer(in.VPC))
+	out.HostProject = (*HostProject)(unsafe.Pointer(in.HostProject))
 	out.Internal = (*string)(unsafe.Pointer(in.Internal))
 	out.Worker = in.Worker
+	out.CloudNAT = (*CloudNAT)(unsafe.Pointer(in.CloudNAT))
 	return nil
 }
 
@@ -376,7 +754,10 @@ func autoConvert_v1alpha1_NetworkStatus_To_gcp_NetworkStatus(in *NetworkStatus,
 	if err := Convert_v1alpha1_VPC_To_gcp_VPC(&in.VPC, &out.VPC, s); err != nil {
 		return err
 	}
+	out.HostProjectID = (*string)(unsafe.Pointer(in.HostProjectID))
 	out.Subnets = *(*[]gcp.Subnet)(unsafe.Pointer(&in.Subnets))
+	out.CloudRouter = (*gcp.CloudRouter)(unsafe.Pointer(in.CloudRouter))
+	out.CloudNAT = (*gcp.CloudNATStatus)(unsafe.Pointer(in.CloudNAT))
 	return nil
 }
 
@@ -389,7 +770,10 @@ func autoConvert_gcp_NetworkStatus_To_v1alpha1_NetworkStatus(in *gcp.NetworkStat
 	if err := Convert_gcp_VPC_To_v1alpha1_VPC(&in.VPC, &out.VPC, s); err != nil {
 		return err
 	}
+	out.HostProjectID = (*string)(unsafe.Pointer(in.HostProjectID))
 	out.Subnets = *(*[]Subnet)(unsafe.Pointer(&in.Subnets))
+	out.CloudRouter = (*CloudRouter)(unsafe.Pointer(in.CloudRouter))
+	out.CloudNAT = (*CloudNATStatus)(unsafe.Pointer(in.CloudNAT))
 	return nil
 }
 
@@ -398,6 +782,28 @@ func Convert_gcp_NetworkStatus_To_v1alpha1_NetworkStatus(in *gcp.NetworkStatus,
 	return autoConvert_gcp_NetworkStatus_To_v1alpha1_NetworkStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_Storage_To_gcp_Storage(in *Storage, out *gcp.Storage, s conversion.Scope) error {
+	out.CSIDriver = in.CSIDriver
+	out.CSIMigration = in.CSIMigration
+	return nil
+}
+
+// Convert_v1alpha1_Storage_To_gcp_Storage is an autogenerated conversion function.
+func Convert_v1alpha1_Storage_To_gcp_Storage(in *Storage, out *gcp.Storage, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Storage_To_gcp_Storage(in, out, s)
+}
+
+func autoConvert_gcp_Storage_To_v1alpha1_Storage(in *gcp.Storage, out *Storage, s conversion.Scope) error {
+	out.CSIDriver = in.CSIDriver
+	out.CSIMigration = in.CSIMigration
+	return nil
+}
+
+// Convert_gcp_Storage_To_v1alpha1_Storage is an autogenerated conversion function.
+func Convert_gcp_Storage_To_v1alpha1_Storage(in *gcp.Storage, out *Storage, s conversion.Scope) error {
+	return autoConvert_gcp_Storage_To_v1alpha1_Storage(in, out, s)
+}
+
 func autoConvert_v1alpha1_Subnet_To_gcp_Subnet(in *Subnet, out *gcp.Subnet, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Purpose = gcp.SubnetPurpose(in.Purpose)
@@ -440,6 +846,26 @@ func Convert_gcp_VPC_To_v1alpha1_VPC(in *gcp.VPC, out *VPC, s conversion.Scope)
 	return autoConvert_gcp_VPC_To_v1alpha1_VPC(in, out, s)
 }
 
+func autoConvert_v1alpha1_WorkerConfig_To_gcp_WorkerConfig(in *WorkerConfig, out *gcp.WorkerConfig, s conversion.Scope) error {
+	out.MachineControllerManagerSettings = (*gcp.MachineControllerManagerSettings)(unsafe.Pointer(in.MachineControllerManagerSettings))
+	return nil
+}
+
+// Convert_v1alpha1_WorkerConfig_To_gcp_WorkerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerConfig_To_gcp_WorkerConfig(in *WorkerConfig, out *gcp.WorkerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerConfig_To_gcp_WorkerConfig(in, out, s)
+}
+
+func autoConvert_gcp_WorkerConfig_To_v1alpha1_WorkerConfig(in *gcp.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	out.MachineControllerManagerSettings = (*MachineControllerManagerSettings)(unsafe.Pointer(in.MachineControllerManagerSettings))
+	return nil
+}
+
+// Convert_gcp_WorkerConfig_To_v1alpha1_WorkerConfig is an autogenerated conversion function.
+func Convert_gcp_WorkerConfig_To_v1alpha1_WorkerConfig(in *gcp.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	return autoConvert_gcp_WorkerConfig_To_v1alpha1_WorkerConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_WorkerStatus_To_gcp_WorkerStatus(in *WorkerStatus, out *gcp.WorkerStatus, s conversion.Scope) error {
 	out.MachineImages = *(*[]gcp.MachineImage)(unsafe.Pointer(&in.MachineImages))
 	return nil
@@ -0,0 +1,73 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisgcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
+	. "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("BackupBucketConfig validation", func() {
+	Describe("#ValidateBackupBucketConfig", func() {
+		var config *apisgcp.BackupBucketConfig
+
+		BeforeEach(func() {
+			config = &apisgcp.BackupBucketConfig{
+				Encryption: &apisgcp.BucketEncryption{
+					KMSKeyName: "projects/foo/locations/europe-west1/keyRings/bar/cryptoKeys/baz",
+				},
+				Versioning:        true,
+				BlockPublicAccess: true,
+				Lifecycle: &apisgcp.BucketLifecycle{
+					ExpirationDays: 30,
+				},
+			}
+		})
+
+		It("should allow a valid configuration", func() {
+			Expect(ValidateBackupBucketConfig(config)).To(BeEmpty())
+		})
+
+		It("should forbid an empty kms key name", func() {
+			config.Encryption.KMSKeyName = ""
+
+			Expect(ValidateBackupBucketConfig(config)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("encryption.kmsKeyName"),
+			}))))
+		})
+
+		It("should forbid a malformed kms key name and non-positive expiration days", func() {
+			config.Encryption.KMSKeyName = "projects/foo/keyRings/bar"
+			config.Lifecycle.ExpirationDays = -1
+
+			Expect(ValidateBackupBucketConfig(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("encryption.kmsKeyName"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("lifecycle.expirationDays"),
+				})),
+			))
+		})
+	})
+})
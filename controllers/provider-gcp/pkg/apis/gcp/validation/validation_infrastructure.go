@@ -0,0 +1,144 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"regexp"
+
+	apisgcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
+	cidrvalidation "github.com/gardener/gardener-extensions/pkg/util/validation/cidr"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// projectIDRegex matches GCP project IDs, see https://cloud.google.com/resource-manager/reference/rest/v1/projects.
+var projectIDRegex = regexp.MustCompile(`^[a-z][a-z0-9-]{4,28}[a-z0-9]$`)
+
+// ValidateInfrastructureConfig validates a InfrastructureConfig object. The subnets are validated against the node,
+// pod and service CIDRs of the shoot, empty or invalid CIDRs of the shoot are ignored.
+func ValidateInfrastructureConfig(infra *apisgcp.InfrastructureConfig, nodesCIDR, podsCIDR, servicesCIDR string) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var (
+		networksPath = field.NewPath("networks")
+
+		nodes    = cidrvalidation.NewCIDR(nodesCIDR, field.NewPath("nodes"))
+		pods     = cidrvalidation.NewCIDR(podsCIDR, field.NewPath("pods"))
+		services = cidrvalidation.NewCIDR(servicesCIDR, field.NewPath("services"))
+		worker   = cidrvalidation.NewCIDR(infra.Networks.Worker, networksPath.Child("worker"))
+		subnets  = []*cidrvalidation.CIDR{worker}
+	)
+
+	if infra.Networks.VPC != nil && len(infra.Networks.VPC.Name) == 0 {
+		allErrs = append(allErrs, field.Required(networksPath.Child("vpc", "name"), "must provide the name of an existing VPC"))
+	}
+
+	if infra.Networks.HostProject != nil {
+		if infra.Networks.VPC == nil {
+			allErrs = append(allErrs, field.Required(networksPath.Child("vpc"), "must provide the existing VPC of the host project"))
+		}
+		allErrs = append(allErrs, validateHostProject(infra.Networks.HostProject, networksPath.Child("hostProject"))...)
+	}
+
+	allErrs = append(allErrs, worker.ValidateParse()...)
+	if infra.Networks.Internal != nil {
+		internal := cidrvalidation.NewCIDR(*infra.Networks.Internal, networksPath.Child("internal"))
+		allErrs = append(allErrs, internal.ValidateParse()...)
+		allErrs = append(allErrs, worker.ValidateNotOverlap(internal)...)
+		subnets = append(subnets, internal)
+	}
+
+	allErrs = append(allErrs, nodes.ValidateSubset(worker)...)
+	allErrs = append(allErrs, pods.ValidateNotOverlap(subnets...)...)
+	allErrs = append(allErrs, services.ValidateNotOverlap(subnets...)...)
+
+	if infra.Networks.CloudNAT != nil {
+		allErrs = append(allErrs, validateCloudNAT(infra.Networks.CloudNAT, networksPath.Child("cloudNAT"))...)
+	}
+
+	return allErrs
+}
+
+func validateHostProject(hostProject *apisgcp.HostProject, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(hostProject.ID) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("id"), "must provide the ID of the host project"))
+	} else if !projectIDRegex.MatchString(hostProject.ID) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("id"), hostProject.ID, "must be a valid GCP project ID"))
+	}
+
+	if hostProject.SecretRef != nil {
+		if len(hostProject.SecretRef.Name) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("secretRef", "name"), "must provide the name of the secret"))
+		}
+		if len(hostProject.SecretRef.Namespace) != 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("secretRef", "namespace"), "the secret is always looked up in the namespace of the infrastructure"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateCloudNAT(cloudNAT *apisgcp.CloudNAT, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cloudNAT.MinPortsPerVM != nil && (*cloudNAT.MinPortsPerVM < 2 || *cloudNAT.MinPortsPerVM > 65536) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minPortsPerVM"), *cloudNAT.MinPortsPerVM, "must be between 2 and 65536"))
+	}
+
+	natIPNames := sets.NewString()
+	for i, natIPName := range cloudNAT.NatIPNames {
+		namePath := fldPath.Child("natIPNames").Index(i).Child("name")
+		switch {
+		case len(natIPName.Name) == 0:
+			allErrs = append(allErrs, field.Required(namePath, "must provide the name of a reserved static IP address"))
+		case natIPNames.Has(natIPName.Name):
+			allErrs = append(allErrs, field.Duplicate(namePath, natIPName.Name))
+		}
+		natIPNames.Insert(natIPName.Name)
+	}
+
+	return allErrs
+}
+
+// ValidateInfrastructureConfigUpdate validates a InfrastructureConfig object before an update. The VPC, the
+// host project and the worker subnet cannot be changed.
+func ValidateInfrastructureConfigUpdate(oldConfig, newConfig *apisgcp.InfrastructureConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	networksPath := field.NewPath("networks")
+
+	if !apiequality.Semantic.DeepEqual(newConfig.Networks.VPC, oldConfig.Networks.VPC) {
+		allErrs = append(allErrs, field.Invalid(networksPath.Child("vpc"), newConfig.Networks.VPC, "field is immutable"))
+	}
+	if hostProjectID(newConfig) != hostProjectID(oldConfig) {
+		allErrs = append(allErrs, field.Invalid(networksPath.Child("hostProject", "id"), hostProjectID(newConfig), "field is immutable"))
+	}
+	if newConfig.Networks.Worker != oldConfig.Networks.Worker {
+		allErrs = append(allErrs, field.Invalid(networksPath.Child("worker"), newConfig.Networks.Worker, "field is immutable"))
+	}
+
+	return allErrs
+}
+
+func hostProjectID(config *apisgcp.InfrastructureConfig) string {
+	if config.Networks.HostProject == nil {
+		return ""
+	}
+	return config.Networks.HostProject.ID
+}
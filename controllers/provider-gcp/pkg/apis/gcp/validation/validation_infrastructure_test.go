@@ -0,0 +1,227 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisgcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
+	. "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("InfrastructureConfig validation", func() {
+	var (
+		infrastructureConfig *apisgcp.InfrastructureConfig
+
+		nodes    = "10.250.0.0/16"
+		pods     = "100.96.0.0/11"
+		services = "100.64.0.0/13"
+	)
+
+	BeforeEach(func() {
+		infrastructureConfig = &apisgcp.InfrastructureConfig{
+			Networks: apisgcp.NetworkConfig{
+				Worker:   "10.250.0.0/19",
+				Internal: pointer.StringPtr("10.250.112.0/22"),
+			},
+		}
+	})
+
+	Describe("#ValidateInfrastructureConfig", func() {
+		It("should accept a valid configuration", func() {
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, pods, services)).To(BeEmpty())
+		})
+
+		It("should require the name of an existing VPC", func() {
+			infrastructureConfig.Networks.VPC = &apisgcp.VPC{}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, pods, services)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("networks.vpc.name"),
+			}))))
+		})
+
+		It("should forbid an invalid worker subnet", func() {
+			infrastructureConfig.Networks.Worker = "10.250.0.0"
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, pods, services)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.worker"),
+			}))))
+		})
+
+		It("should forbid an internal subnet overlapping with the worker subnet", func() {
+			infrastructureConfig.Networks.Internal = pointer.StringPtr("10.250.0.0/22")
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, pods, services)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.internal"),
+			}))))
+		})
+
+		It("should forbid a worker subnet outside of the node network", func() {
+			infrastructureConfig.Networks.Worker = "10.251.0.0/19"
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, pods, services)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("networks.worker"),
+				"Detail": ContainSubstring("nodes"),
+			}))))
+		})
+
+		It("should forbid subnets overlapping with the pod and service networks", func() {
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, "10.250.0.0/24", "10.250.112.0/24")).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.worker"),
+					"Detail": ContainSubstring("pods"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.internal"),
+					"Detail": ContainSubstring("services"),
+				})),
+			))
+		})
+
+		It("should accept a valid Cloud NAT configuration", func() {
+			infrastructureConfig.Networks.CloudNAT = &apisgcp.CloudNAT{
+				MinPortsPerVM: pointer.Int32Ptr(2048),
+				NatIPNames:    []apisgcp.NatIPName{{Name: "ip-a"}, {Name: "ip-b"}},
+			}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, pods, services)).To(BeEmpty())
+		})
+
+		It("should forbid an invalid Cloud NAT configuration", func() {
+			infrastructureConfig.Networks.CloudNAT = &apisgcp.CloudNAT{
+				MinPortsPerVM: pointer.Int32Ptr(1),
+				NatIPNames:    []apisgcp.NatIPName{{Name: "ip-a"}, {}, {Name: "ip-a"}},
+			}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, pods, services)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.cloudNAT.minPortsPerVM"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.cloudNAT.natIPNames[1].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.cloudNAT.natIPNames[2].name"),
+				})),
+			))
+		})
+
+		It("should accept a valid host project", func() {
+			infrastructureConfig.Networks.VPC = &apisgcp.VPC{Name: "shared-vpc"}
+			infrastructureConfig.Networks.HostProject = &apisgcp.HostProject{
+				ID:        "host-project",
+				SecretRef: &corev1.SecretReference{Name: "host-project"},
+			}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, pods, services)).To(BeEmpty())
+		})
+
+		It("should forbid an invalid host project", func() {
+			infrastructureConfig.Networks.HostProject = &apisgcp.HostProject{
+				ID:        "Host_Project",
+				SecretRef: &corev1.SecretReference{},
+			}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, pods, services)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.vpc"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.hostProject.id"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.hostProject.secretRef.name"),
+				})),
+			))
+		})
+
+		It("should forbid referencing a secret in another namespace", func() {
+			infrastructureConfig.Networks.VPC = &apisgcp.VPC{Name: "shared-vpc"}
+			infrastructureConfig.Networks.HostProject = &apisgcp.HostProject{
+				ID:        "host-project",
+				SecretRef: &corev1.SecretReference{Namespace: "garden", Name: "host-project"},
+			}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, pods, services)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("networks.hostProject.secretRef.namespace"),
+			}))))
+		})
+
+		It("should require the ID of the host project", func() {
+			infrastructureConfig.Networks.VPC = &apisgcp.VPC{Name: "shared-vpc"}
+			infrastructureConfig.Networks.HostProject = &apisgcp.HostProject{}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, pods, services)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("networks.hostProject.id"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateInfrastructureConfigUpdate", func() {
+		It("should allow changing the internal subnet", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Internal = pointer.StringPtr("10.250.116.0/22")
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)).To(BeEmpty())
+		})
+
+		It("should allow changing the secret of the host project", func() {
+			infrastructureConfig.Networks.HostProject = &apisgcp.HostProject{ID: "host-project"}
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.HostProject.SecretRef = &corev1.SecretReference{Name: "host-project"}
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)).To(BeEmpty())
+		})
+
+		It("should forbid changing the host project", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.HostProject = &apisgcp.HostProject{ID: "host-project"}
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("networks.hostProject.id")})),
+			))
+		})
+
+		It("should forbid changing the VPC and the worker subnet", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.VPC = &apisgcp.VPC{Name: "foo"}
+			newInfrastructureConfig.Networks.Worker = "10.250.32.0/19"
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("networks.vpc")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("networks.worker")})),
+			))
+		})
+	})
+})
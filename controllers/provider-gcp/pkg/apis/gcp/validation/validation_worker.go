@@ -0,0 +1,38 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	apisgcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateWorkerConfig validates a WorkerConfig object.
+func ValidateWorkerConfig(workerConfig *apisgcp.WorkerConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if settings := workerConfig.MachineControllerManagerSettings; settings != nil {
+		settingsPath := field.NewPath("machineControllerManagerSettings")
+		if settings.MachineDrainTimeout != nil && settings.MachineDrainTimeout.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(settingsPath.Child("machineDrainTimeout"), settings.MachineDrainTimeout.Duration.String(), "timeout must be positive"))
+		}
+		if settings.MachineCreationTimeout != nil && settings.MachineCreationTimeout.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(settingsPath.Child("machineCreationTimeout"), settings.MachineCreationTimeout.Duration.String(), "timeout must be positive"))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	"time"
+
+	apisgcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
+	. "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("WorkerConfig validation", func() {
+	var workerConfig *apisgcp.WorkerConfig
+
+	BeforeEach(func() {
+		workerConfig = &apisgcp.WorkerConfig{
+			MachineControllerManagerSettings: &apisgcp.MachineControllerManagerSettings{
+				MachineDrainTimeout:    &metav1.Duration{Duration: time.Hour},
+				MachineCreationTimeout: &metav1.Duration{Duration: 20 * time.Minute},
+			},
+		}
+	})
+
+	Describe("#ValidateWorkerConfig", func() {
+		It("should accept a valid configuration", func() {
+			Expect(ValidateWorkerConfig(workerConfig)).To(BeEmpty())
+		})
+
+		It("should accept an empty configuration", func() {
+			Expect(ValidateWorkerConfig(&apisgcp.WorkerConfig{})).To(BeEmpty())
+		})
+
+		It("should forbid non-positive machine controller manager timeouts", func() {
+			workerConfig.MachineControllerManagerSettings.MachineDrainTimeout = &metav1.Duration{Duration: -time.Minute}
+			workerConfig.MachineControllerManagerSettings.MachineCreationTimeout = &metav1.Duration{}
+
+			Expect(ValidateWorkerConfig(workerConfig)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("machineControllerManagerSettings.machineDrainTimeout")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("machineControllerManagerSettings.machineCreationTimeout")})),
+			))
+		})
+	})
+})
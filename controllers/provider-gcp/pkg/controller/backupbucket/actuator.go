@@ -16,20 +16,27 @@ package backupbucket
 
 import (
 	"context"
+	"fmt"
 
+	apisgcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
+	gcpv1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp/v1alpha1"
 	gcpclient "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/gcp/client"
 	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
 type actuator struct {
 	backupbucket.Actuator
-	client client.Client
-	logger logr.Logger
+	client  client.Client
+	decoder runtime.Decoder
+	logger  logr.Logger
 }
 
 func newActuator() backupbucket.Actuator {
@@ -43,13 +50,40 @@ func (a *actuator) InjectClient(client client.Client) error {
 	return nil
 }
 
+func (a *actuator) InjectScheme(scheme *runtime.Scheme) error {
+	a.decoder = serializer.NewCodecFactory(scheme).UniversalDecoder()
+	return nil
+}
+
 func (a *actuator) Reconcile(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
 	storageClient, err := gcpclient.NewStorageClientFromSecretRef(ctx, a.client, bb.Spec.SecretRef)
 	if err != nil {
 		return err
 	}
 
-	return storageClient.CreateBucketIfNotExists(ctx, bb.Name, bb.Spec.Region)
+	if err := storageClient.CreateBucketIfNotExists(ctx, bb.Name, bb.Spec.Region); err != nil {
+		return err
+	}
+
+	// Buckets that never had a provider config are left untouched, whereas buckets whose provider config has been
+	// removed are reset to the defaults.
+	rawConfig := backupbucket.GetProviderConfig(bb)
+	if rawConfig == nil && len(bb.Status.State) == 0 {
+		return nil
+	}
+
+	config := &apisgcp.BackupBucketConfig{}
+	if rawConfig != nil {
+		if _, _, err := a.decoder.Decode(rawConfig, nil, config); err != nil {
+			return fmt.Errorf("could not decode provider config: %+v", err)
+		}
+	}
+
+	if err := storageClient.UpdateBucketConfig(ctx, bb.Name, computeBucketConfig(config)); err != nil {
+		return err
+	}
+
+	return backupbucket.UpdateProviderStatus(ctx, a.client, bb, computeProviderStatus(config))
 }
 
 func (a *actuator) Delete(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
@@ -60,3 +94,45 @@ func (a *actuator) Delete(ctx context.Context, bb *extensionsv1alpha1.BackupBuck
 
 	return storageClient.DeleteBucketIfExists(ctx, bb.Name)
 }
+
+func computeBucketConfig(config *apisgcp.BackupBucketConfig) *gcpclient.BucketConfig {
+	bucketConfig := &gcpclient.BucketConfig{
+		Versioning:        config.Versioning,
+		BlockPublicAccess: config.BlockPublicAccess,
+	}
+
+	if config.Encryption != nil {
+		bucketConfig.KMSKeyName = config.Encryption.KMSKeyName
+	}
+
+	if config.Lifecycle != nil {
+		bucketConfig.ExpirationDays = int64(config.Lifecycle.ExpirationDays)
+	}
+
+	return bucketConfig
+}
+
+func computeProviderStatus(config *apisgcp.BackupBucketConfig) *gcpv1alpha1.BackupBucketStatus {
+	status := &gcpv1alpha1.BackupBucketStatus{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gcpv1alpha1.SchemeGroupVersion.String(),
+			Kind:       "BackupBucketStatus",
+		},
+		Versioning:          config.Versioning,
+		PublicAccessBlocked: config.BlockPublicAccess,
+	}
+
+	if config.Encryption != nil {
+		status.Encryption = &gcpv1alpha1.BucketEncryption{
+			KMSKeyName: config.Encryption.KMSKeyName,
+		}
+	}
+
+	if config.Lifecycle != nil {
+		status.Lifecycle = &gcpv1alpha1.BucketLifecycle{
+			ExpirationDays: config.Lifecycle.ExpirationDays,
+		}
+	}
+
+	return status
+}
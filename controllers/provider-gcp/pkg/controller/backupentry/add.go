@@ -15,9 +15,12 @@
 package backupentry
 
 import (
+	"time"
+
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/gcp"
 	"github.com/gardener/gardener-extensions/pkg/controller/backupentry"
 	"github.com/gardener/gardener-extensions/pkg/controller/backupentry/genericactuator"
+	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
 
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -37,15 +40,19 @@ type AddOptions struct {
 	Controller controller.Options
 	// IgnoreOperationAnnotation specifies whether to ignore the operation annotation or not.
 	IgnoreOperationAnnotation bool
+	// DeletionGracePeriod is the period for which the backup data of a deleted backupentry is retained.
+	DeletionGracePeriod time.Duration
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
 // The opts.Reconciler is being set with a newly instantiated actuator.
 func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) error {
 	return backupentry.Add(mgr, backupentry.AddArgs{
-		Actuator:          genericactuator.NewActuator(newActuator(), logger),
-		ControllerOptions: opts.Controller,
-		Predicates:        backupentry.DefaultPredicates(gcp.Type, opts.IgnoreOperationAnnotation),
+		Actuator:            genericactuator.NewActuator(newActuator(), logger),
+		ControllerOptions:   opts.Controller,
+		Predicates:          backupentry.DefaultPredicates(gcp.Type, opts.IgnoreOperationAnnotation),
+		DeletionGracePeriod: opts.DeletionGracePeriod,
+		ErrorMatchers:       []controllererrors.Matcher{controllererrors.GCPMatcher},
 	})
 }
 
@@ -23,6 +23,7 @@ import (
 	gcpclient "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal/client"
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal/infrastructure"
 	"github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/operation/terraformer"
 	"github.com/gardener/gardener/pkg/utils/flow"
@@ -32,8 +33,8 @@ func (a *actuator) cleanupKubernetesFirewallRules(
 	ctx context.Context,
 	config *gcpv1alpha1.InfrastructureConfig,
 	client gcpclient.Interface,
-	tf *terraformer.Terraformer,
-	account *internal.ServiceAccount,
+	tf extensionsterraformer.Interface,
+	projectID string,
 	shootSeedNamespace string,
 ) error {
 	state, err := infrastructure.ExtractTerraformState(tf, config)
@@ -44,15 +45,15 @@ func (a *actuator) cleanupKubernetesFirewallRules(
 		return err
 	}
 
-	return infrastructure.CleanupKubernetesFirewalls(ctx, client, account.ProjectID, state.VPCName, shootSeedNamespace)
+	return infrastructure.CleanupKubernetesFirewalls(ctx, client, projectID, state.VPCName, shootSeedNamespace)
 }
 
 func (a *actuator) cleanupKubernetesRoutes(
 	ctx context.Context,
 	config *gcpv1alpha1.InfrastructureConfig,
 	client gcpclient.Interface,
-	tf *terraformer.Terraformer,
-	account *internal.ServiceAccount,
+	tf extensionsterraformer.Interface,
+	projectID string,
 	shootSeedNamespace string,
 ) error {
 	state, err := infrastructure.ExtractTerraformState(tf, config)
@@ -63,11 +64,10 @@ func (a *actuator) cleanupKubernetesRoutes(
 		return err
 	}
 
-	return infrastructure.CleanupKubernetesRoutes(ctx, client, account.ProjectID, state.VPCName, shootSeedNamespace)
+	return infrastructure.CleanupKubernetesRoutes(ctx, client, projectID, state.VPCName, shootSeedNamespace)
 }
 
-// Delete implements infrastructure.Actuator.
-func (a *actuator) Delete(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *controller.Cluster) error {
+func (a *actuator) delete(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *controller.Cluster) error {
 	config, err := internal.InfrastructureConfigFromInfrastructure(infra)
 	if err != nil {
 		return err
@@ -78,12 +78,28 @@ func (a *actuator) Delete(ctx context.Context, infra *extensionsv1alpha1.Infrast
 		return err
 	}
 
-	gcpClient, err := gcpclient.NewFromServiceAccount(ctx, serviceAccount.Raw)
+	hostServiceAccount, err := infrastructure.GetHostServiceAccountFromInfrastructure(ctx, a.client, infra, config)
 	if err != nil {
 		return err
 	}
 
-	tf, err := internal.NewTerraformer(a.restConfig, serviceAccount, infrastructure.TerraformerPurpose, infra.Namespace, infra.Name)
+	// The Kubernetes firewall rules and routes belong to the network, i.e. they live in the host project of a
+	// shared VPC.
+	networkProjectID, networkServiceAccount := serviceAccount.ProjectID, serviceAccount
+	if hostServiceAccount != nil {
+		networkProjectID, networkServiceAccount = config.Networks.HostProject.ID, hostServiceAccount
+	}
+
+	gcpClient, err := gcpclient.NewFromServiceAccount(ctx, networkServiceAccount.Raw)
+	if err != nil {
+		return err
+	}
+
+	if err := extensionsterraformer.RestoreInfrastructureState(ctx, a.client, infra, infrastructure.TerraformerPurpose); err != nil {
+		return err
+	}
+
+	tf, err := internal.NewTerraformer(a.terraformerFactory, a.restConfig, serviceAccount, hostServiceAccount, infrastructure.TerraformerPurpose, infra.Namespace, infra.Name)
 	if err != nil {
 		return err
 	}
@@ -93,12 +109,26 @@ func (a *actuator) Delete(ctx context.Context, infra *extensionsv1alpha1.Infrast
 		return err
 	}
 
+	// The Terraform configuration is missing if the state has been restored from the status, e.g. after the seed
+	// namespace has been recreated. It has to be rendered again so that the infrastructure can be destroyed.
+	if !configExists && len(infra.Status.State) > 0 {
+		terraformFiles, err := infrastructure.RenderTerraformerChart(a.chartRenderer, infra, serviceAccount, config, cluster)
+		if err != nil {
+			return err
+		}
+		tf.InitializeWith(a.terraformerFactory.DefaultInitializer(a.client, terraformFiles.Main, terraformFiles.Variables, terraformFiles.TFVars))
+
+		if configExists, err = tf.ConfigExists(); err != nil {
+			return err
+		}
+	}
+
 	var (
 		g                              = flow.NewGraph("GCP infrastructure destruction")
 		destroyKubernetesFirewallRules = g.Add(flow.Task{
 			Name: "Destroying Kubernetes firewall rules",
 			Fn: flow.TaskFn(func(ctx context.Context) error {
-				return a.cleanupKubernetesFirewallRules(ctx, config, gcpClient, tf, serviceAccount, infra.Namespace)
+				return a.cleanupKubernetesFirewallRules(ctx, config, gcpClient, tf, networkProjectID, infra.Namespace)
 			}).
 				RetryUntilTimeout(10*time.Second, 5*time.Minute).
 				DoIf(configExists),
@@ -107,15 +137,17 @@ func (a *actuator) Delete(ctx context.Context, infra *extensionsv1alpha1.Infrast
 		destroyKubernetesRoutes = g.Add(flow.Task{
 			Name: "Destroying Kubernetes route entries",
 			Fn: flow.TaskFn(func(ctx context.Context) error {
-				return a.cleanupKubernetesRoutes(ctx, config, gcpClient, tf, serviceAccount, infra.Namespace)
+				return a.cleanupKubernetesRoutes(ctx, config, gcpClient, tf, networkProjectID, infra.Namespace)
 			}).
 				RetryUntilTimeout(10*time.Second, 5*time.Minute).
 				DoIf(configExists),
 		})
 
 		_ = g.Add(flow.Task{
-			Name:         "Destroying Shoot infrastructure",
-			Fn:           flow.SimpleTaskFn(tf.Destroy),
+			Name: "Destroying Shoot infrastructure",
+			Fn: flow.SimpleTaskFn(func() error {
+				return tf.Destroy()
+			}),
 			Dependencies: flow.NewTaskIDs(destroyKubernetesFirewallRules, destroyKubernetesRoutes),
 		})
 
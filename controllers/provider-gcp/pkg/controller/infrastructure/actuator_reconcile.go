@@ -22,13 +22,12 @@ import (
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal/infrastructure"
 	"github.com/gardener/gardener-extensions/pkg/controller"
 	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
-	"github.com/gardener/gardener/pkg/operation/terraformer"
 )
 
-// Reconcile implements infrastructure.Actuator.
-func (a *actuator) Reconcile(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *controller.Cluster) error {
+func (a *actuator) reconcile(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *controller.Cluster) error {
 	config, err := internal.InfrastructureConfigFromInfrastructure(infra)
 	if err != nil {
 		return err
@@ -39,20 +38,32 @@ func (a *actuator) Reconcile(ctx context.Context, infra *extensionsv1alpha1.Infr
 		return err
 	}
 
+	hostServiceAccount, err := infrastructure.GetHostServiceAccountFromInfrastructure(ctx, a.client, infra, config)
+	if err != nil {
+		return err
+	}
+
 	terraformFiles, err := infrastructure.RenderTerraformerChart(a.chartRenderer, infra, serviceAccount, config, cluster)
 	if err != nil {
 		return err
 	}
 
-	tf, err := internal.NewTerraformer(a.restConfig, serviceAccount, infrastructure.TerraformerPurpose, infra.Namespace, infra.Name)
+	if err := extensionsterraformer.RestoreInfrastructureState(ctx, a.client, infra, infrastructure.TerraformerPurpose); err != nil {
+		return err
+	}
+
+	tf, err := internal.NewTerraformer(a.terraformerFactory, a.restConfig, serviceAccount, hostServiceAccount, infrastructure.TerraformerPurpose, infra.Namespace, infra.Name)
 	if err != nil {
 		return err
 	}
 
-	if err := tf.
-		InitializeWith(terraformer.DefaultInitializer(a.client, terraformFiles.Main, terraformFiles.Variables, terraformFiles.TFVars)).
-		Apply(); err != nil {
+	tf = tf.InitializeWith(a.terraformerFactory.DefaultInitializer(a.client, terraformFiles.Main, terraformFiles.Variables, terraformFiles.TFVars))
+
+	if extensionsterraformer.IsPlanRequested(infra) {
+		return extensionsterraformer.PlanInfrastructure(ctx, a.client, tf, infra)
+	}
 
+	if err := tf.Apply(); err != nil {
 		a.logger.Error(err, "failed to apply the terraform config", "infrastructure", infra.Name)
 		return &controllererrors.RequeueAfterError{
 			Cause:        err,
@@ -60,5 +71,9 @@ func (a *actuator) Reconcile(ctx context.Context, infra *extensionsv1alpha1.Infr
 		}
 	}
 
+	if err := extensionsterraformer.StoreInfrastructureState(ctx, a.client, tf, infra); err != nil {
+		return err
+	}
+
 	return a.updateProviderStatus(ctx, tf, infra, config)
 }
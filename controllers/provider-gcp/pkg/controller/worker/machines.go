@@ -22,13 +22,16 @@ import (
 	apisgcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
 	gcpapi "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
 	gcpapihelper "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp/helper"
+	gcpvalidation "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp/validation"
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/gcp"
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -100,7 +103,18 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 		return err
 	}
 
+	// Subnets of a shared VPC live in the host project and must therefore be referenced by their full path.
+	subnetwork := nodesSubnet.Name
+	if infrastructureStatus.Networks.HostProjectID != nil {
+		subnetwork = fmt.Sprintf("projects/%s/regions/%s/subnetworks/%s", *infrastructureStatus.Networks.HostProjectID, w.worker.Spec.Region, nodesSubnet.Name)
+	}
+
 	for _, pool := range w.worker.Spec.Pools {
+		workerConfig, err := w.decodeWorkerConfig(pool)
+		if err != nil {
+			return err
+		}
+
 		zoneLen := len(pool.Zones)
 
 		machineImage, err := w.findMachineImage(pool.MachineImage.Name, pool.MachineImage.Version)
@@ -143,7 +157,7 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 				"machineType": pool.MachineType,
 				"networkInterfaces": []map[string]interface{}{
 					{
-						"subnetwork": nodesSubnet.Name,
+						"subnetwork": subnetwork,
 					},
 				},
 				"scheduling": map[string]interface{}{
@@ -186,6 +200,8 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 				Labels:         pool.Labels,
 				Annotations:    pool.Annotations,
 				Taints:         pool.Taints,
+
+				MachineConfiguration: computeMachineConfiguration(workerConfig.MachineControllerManagerSettings),
 			})
 
 			machineClassSpec["name"] = className
@@ -201,3 +217,30 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 
 	return nil
 }
+
+func (w *workerDelegate) decodeWorkerConfig(pool extensionsv1alpha1.WorkerPool) (*apisgcp.WorkerConfig, error) {
+	workerConfig := &apisgcp.WorkerConfig{}
+	if pool.ProviderConfig == nil || pool.ProviderConfig.Raw == nil {
+		return workerConfig, nil
+	}
+
+	if _, _, err := w.decoder.Decode(pool.ProviderConfig.Raw, nil, workerConfig); err != nil {
+		return nil, errors.Wrapf(err, "could not decode provider config of worker pool %q", pool.Name)
+	}
+	if errs := gcpvalidation.ValidateWorkerConfig(workerConfig); len(errs) > 0 {
+		return nil, errors.Wrapf(errs.ToAggregate(), "invalid provider config of worker pool %q", pool.Name)
+	}
+	return workerConfig, nil
+}
+
+// computeMachineConfiguration computes the timeouts for the rollout of the machines out of the given settings.
+func computeMachineConfiguration(settings *apisgcp.MachineControllerManagerSettings) *worker.MachineConfiguration {
+	if settings == nil {
+		return nil
+	}
+
+	return &worker.MachineConfiguration{
+		DrainTimeout:    settings.MachineDrainTimeout,
+		CreationTimeout: settings.MachineCreationTimeout,
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"time"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/config"
 	apisgcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
@@ -417,6 +418,92 @@ var _ = Describe("Machines", func() {
 				Expect(result).To(Equal(machineDeployments))
 			})
 
+			It("should reference the nodes subnet in the host project of a shared VPC", func() {
+				expectGetSecretCallToWork(c, serviceAccountJSON)
+
+				hostProjectID := "host-project"
+				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{
+					Raw: encode(&apisgcp.InfrastructureStatus{
+						ServiceAccountEmail: serviceAccountEmail,
+						Networks: apisgcp.NetworkStatus{
+							HostProjectID: &hostProjectID,
+							Subnets: []apisgcp.Subnet{
+								{
+									Name:    subnetName,
+									Purpose: apisgcp.PurposeNodes,
+								},
+							},
+						},
+					}),
+				}
+
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImages, chartApplier, "", w, cluster)
+
+				chartApplier.
+					EXPECT().
+					ApplyChart(context.TODO(), filepath.Join(gcp.InternalChartsPath, "machineclass"), namespace, "machineclass", gomock.Any(), nil).
+					DoAndReturn(func(_ context.Context, _, _, _ string, values, _ map[string]interface{}) error {
+						machineClasses := values["machineClasses"].([]map[string]interface{})
+						Expect(machineClasses).To(HaveLen(4))
+						for _, machineClass := range machineClasses {
+							Expect(machineClass["networkInterfaces"]).To(Equal([]map[string]interface{}{
+								{
+									"subnetwork": fmt.Sprintf("projects/%s/regions/%s/subnetworks/%s", hostProjectID, region, subnetName),
+								},
+							}))
+						}
+						return nil
+					})
+
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+			})
+
+			It("should set the machine configuration of the pools with machine controller manager settings", func() {
+				expectGetSecretCallToWork(c, serviceAccountJSON)
+
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&gcpv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: gcpv1alpha1.SchemeGroupVersion.String(),
+							Kind:       "WorkerConfig",
+						},
+						MachineControllerManagerSettings: &gcpv1alpha1.MachineControllerManagerSettings{
+							MachineDrainTimeout: &metav1.Duration{Duration: time.Hour},
+						},
+					}),
+				}
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImages, chartApplier, "", w, cluster)
+
+				machineDeployments, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(machineDeployments).To(HaveLen(4))
+				Expect(machineDeployments[0].MachineConfiguration).To(Equal(&worker.MachineConfiguration{
+					DrainTimeout: &metav1.Duration{Duration: time.Hour},
+				}))
+				Expect(machineDeployments[2].MachineConfiguration).To(BeNil())
+			})
+
+			It("should fail because the worker config is invalid", func() {
+				expectGetSecretCallToWork(c, serviceAccountJSON)
+
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&gcpv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: gcpv1alpha1.SchemeGroupVersion.String(),
+							Kind:       "WorkerConfig",
+						},
+						MachineControllerManagerSettings: &gcpv1alpha1.MachineControllerManagerSettings{
+							MachineCreationTimeout: &metav1.Duration{},
+						},
+					}),
+				}
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImages, chartApplier, "", w, cluster)
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).To(HaveOccurred())
+				Expect(result).To(BeNil())
+			})
+
 			It("should fail because the secret cannot be read", func() {
 				c.EXPECT().
 					Get(context.TODO(), gomock.Any(), gomock.AssignableToTypeOf(&corev1.Secret{})).
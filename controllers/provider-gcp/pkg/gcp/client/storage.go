@@ -30,6 +30,11 @@ import (
 
 const (
 	errCodeBucketAlreadyOwnedByYou = 409
+	errCodeBucketNotEmpty          = 409
+
+	// maxDeleteBucketAttempts is the maximum number of attempts to empty and delete a bucket. Objects that are
+	// written concurrently may prevent the deletion of a bucket.
+	maxDeleteBucketAttempts = 3
 )
 
 // StorageClient is an interface which must be implemented by GCS clients.
@@ -38,6 +43,21 @@ type StorageClient interface {
 	CreateBucketIfNotExists(ctx context.Context, bucketName, region string) error
 	DeleteBucketIfExists(ctx context.Context, bucketName string) error
 	DeleteObjectsWithPrefix(ctx context.Context, bucketName, prefix string) error
+	UpdateBucketConfig(ctx context.Context, bucketName string, config *BucketConfig) error
+}
+
+// BucketConfig contains the desired configuration of a GCS bucket.
+type BucketConfig struct {
+	// KMSKeyName is the name of the Cloud KMS key used for the default encryption. If it is empty, Google-managed
+	// keys are used.
+	KMSKeyName string
+	// Versioning indicates whether object versioning is enabled for the bucket.
+	Versioning bool
+	// BlockPublicAccess indicates whether uniform bucket-level access is enforced for the bucket.
+	BlockPublicAccess bool
+	// ExpirationDays is the number of days after which objects and noncurrent object versions are deleted. Zero
+	// means that objects are never deleted.
+	ExpirationDays int64
 }
 
 type storageClient struct {
@@ -80,11 +100,66 @@ func (s *storageClient) CreateBucketIfNotExists(ctx context.Context, bucketName,
 	return nil
 }
 
+// DeleteBucketIfExists deletes the bucket with name <bucketName>. If it does not exist, no error is returned. A bucket
+// that is not empty is emptied including all noncurrent object versions before its deletion is retried.
 func (s *storageClient) DeleteBucketIfExists(ctx context.Context, bucketName string) error {
-	if err := s.client.Bucket(bucketName).Delete(ctx); err != nil && err != storage.ErrBucketNotExist {
-		return err
+	for attempt := 1; ; attempt++ {
+		err := s.client.Bucket(bucketName).Delete(ctx)
+		if err == nil || err == storage.ErrBucketNotExist {
+			return nil
+		}
+
+		if gerr, ok := err.(*googleapi.Error); !ok || gerr.Code != errCodeBucketNotEmpty || attempt >= maxDeleteBucketAttempts {
+			return err
+		}
+		if err := s.deleteObjectVersions(ctx, bucketName); err != nil {
+			return err
+		}
 	}
-	return nil
+}
+
+// deleteObjectVersions deletes all objects of the bucket with name <bucketName> including all their noncurrent
+// versions.
+func (s *storageClient) deleteObjectVersions(ctx context.Context, bucketName string) error {
+	bucketHandle := s.client.Bucket(bucketName)
+	itr := bucketHandle.Objects(ctx, &storage.Query{Versions: true})
+	for {
+		attr, err := itr.Next()
+		if err != nil {
+			if err == iterator.Done {
+				return nil
+			}
+			return err
+		}
+		if err := bucketHandle.Object(attr.Name).Generation(attr.Generation).Delete(ctx); err != nil && err != storage.ErrObjectNotExist {
+			return err
+		}
+	}
+}
+
+// UpdateBucketConfig converges the default encryption, versioning, uniform bucket-level access and lifecycle
+// configuration of the bucket with name <bucketName> to the given <config>. The storage library cannot remove an
+// existing lifecycle configuration, hence it is left untouched if no expiration is configured.
+func (s *storageClient) UpdateBucketConfig(ctx context.Context, bucketName string, config *BucketConfig) error {
+	attrs := storage.BucketAttrsToUpdate{
+		VersioningEnabled: config.Versioning,
+		BucketPolicyOnly:  &storage.BucketPolicyOnly{Enabled: config.BlockPublicAccess},
+		Encryption:        &storage.BucketEncryption{DefaultKMSKeyName: config.KMSKeyName},
+	}
+
+	if config.ExpirationDays > 0 {
+		attrs.Lifecycle = &storage.Lifecycle{
+			Rules: []storage.LifecycleRule{
+				{
+					Action:    storage.LifecycleAction{Type: storage.DeleteAction},
+					Condition: storage.LifecycleCondition{AgeInDays: config.ExpirationDays, Liveness: storage.LiveAndArchived},
+				},
+			},
+		}
+	}
+
+	_, err := s.client.Bucket(bucketName).Update(ctx, attrs)
+	return err
 }
 
 func (s *storageClient) DeleteObjectsWithPrefix(ctx context.Context, bucketName, prefix string) error {
@@ -28,6 +28,22 @@ const (
 	MachineControllerManagerImageName = "machine-controller-manager"
 	// ETCDBackupRestoreImageName is the name of the etcd backup and restore image.
 	ETCDBackupRestoreImageName = "etcd-backup-restore"
+	// CSIAttacherImageName is the name of the CSI attacher image.
+	CSIAttacherImageName = "csi-attacher"
+	// CSINodeDriverRegistrarImageName is the name of the CSI driver registrar image.
+	CSINodeDriverRegistrarImageName = "csi-node-driver-registrar"
+	// CSIProvisionerImageName is the name of the CSI provisioner image.
+	CSIProvisionerImageName = "csi-provisioner"
+	// CSISnapshotterImageName is the name of the CSI snapshotter image.
+	CSISnapshotterImageName = "csi-snapshotter"
+	// CSIDriverImageName is the name of the GCE PD CSI driver image.
+	CSIDriverImageName = "gcp-compute-persistent-disk-csi-driver"
+
+	// CSIMinimumKubernetesVersion is the minimum Kubernetes version for which the GCE PD CSI driver can be deployed
+	// and the in-tree volume plugin can be migrated to it, see the storage section of the ControlPlaneConfig.
+	CSIMinimumKubernetesVersion = "1.14"
+	// CSIMigrationFeatureGate is the name of the feature gate that migrates the in-tree GCE PD volume plugin to CSI.
+	CSIMigrationFeatureGate = "CSIMigrationGCE"
 
 	// ServiceAccountJSONField is the field in a secret where the service account JSON is stored at.
 	ServiceAccountJSONField = "serviceaccount.json"
@@ -18,10 +18,12 @@ import (
 	"context"
 	"strings"
 
+	gcpv1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp/v1alpha1"
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal"
 	gcpclient "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal/client"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"google.golang.org/api/compute/v1"
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -128,3 +130,18 @@ func CleanupKubernetesRoutes(ctx context.Context, client gcpclient.Interface, pr
 func GetServiceAccountFromInfrastructure(ctx context.Context, c client.Client, config *extensionsv1alpha1.Infrastructure) (*internal.ServiceAccount, error) {
 	return internal.GetServiceAccount(ctx, c, config.Spec.SecretRef)
 }
+
+// GetHostServiceAccountFromInfrastructure retrieves the ServiceAccount for the host project of a shared VPC. It returns
+// nil if the given InfrastructureConfig does not configure a host project. The Secret referenced by the host project is
+// always looked up in the namespace of the given Infrastructure. If the host project does not reference a Secret, the
+// ServiceAccount from the Secret referenced in the given Infrastructure is used.
+func GetHostServiceAccountFromInfrastructure(ctx context.Context, c client.Client, infra *extensionsv1alpha1.Infrastructure, config *gcpv1alpha1.InfrastructureConfig) (*internal.ServiceAccount, error) {
+	hostProject := config.Networks.HostProject
+	if hostProject == nil {
+		return nil, nil
+	}
+	if hostProject.SecretRef == nil {
+		return internal.GetServiceAccount(ctx, c, infra.Spec.SecretRef)
+	}
+	return internal.GetServiceAccount(ctx, c, corev1.SecretReference{Namespace: infra.Namespace, Name: hostProject.SecretRef.Name})
+}
@@ -18,12 +18,18 @@ import (
 	"context"
 	"fmt"
 
+	gcpv1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp/v1alpha1"
+	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/gcp"
 	mockgcpclient "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal/mock/client"
 
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"google.golang.org/api/compute/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 var _ = Describe("Infrastructure", func() {
@@ -181,4 +187,38 @@ var _ = Describe("Infrastructure", func() {
 			Expect(DeleteRoutes(ctx, client, projectID, routeNames)).To(Succeed())
 		})
 	})
+
+	Describe("#GetHostServiceAccountFromInfrastructure", func() {
+		It("should always look up the secret of the host project in the namespace of the infrastructure", func() {
+			var (
+				ctx       = context.TODO()
+				namespace = "shoot--foobar--gcp"
+
+				newSecret = func(namespace, projectID string) *corev1.Secret {
+					return &corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "host-project"},
+						Data: map[string][]byte{
+							gcp.ServiceAccountJSONField: []byte(fmt.Sprintf(`{"project_id":"%s"}`, projectID)),
+						},
+					}
+				}
+
+				c      = fake.NewFakeClient(newSecret(namespace, "host-project"), newSecret("garden", "other-project"))
+				infra  = &extensionsv1alpha1.Infrastructure{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "infra"}}
+				config = &gcpv1alpha1.InfrastructureConfig{
+					Networks: gcpv1alpha1.NetworkConfig{
+						HostProject: &gcpv1alpha1.HostProject{
+							ID:        "host-project",
+							SecretRef: &corev1.SecretReference{Namespace: "garden", Name: "host-project"},
+						},
+					},
+				}
+			)
+
+			serviceAccount, err := GetHostServiceAccountFromInfrastructure(ctx, c, infra, config)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(serviceAccount.ProjectID).To(Equal("host-project"))
+		})
+	})
 })
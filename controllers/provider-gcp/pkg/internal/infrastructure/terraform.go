@@ -16,14 +16,15 @@ package infrastructure
 
 import (
 	"path/filepath"
+	"strings"
 
 	gcpv1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp/v1alpha1"
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/chartrenderer"
-	"github.com/gardener/gardener/pkg/operation/terraformer"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -43,6 +44,12 @@ const (
 	TerraformerOutputKeySubnetNodes = "subnet_nodes"
 	// TerraformerOutputKeySubnetInternal is the name of the subnet_internal terraform output variable.
 	TerraformerOutputKeySubnetInternal = "subnet_internal"
+	// TerraformerOutputKeyCloudRouter is the name of the cloud_router terraform output variable.
+	TerraformerOutputKeyCloudRouter = "cloud_router"
+	// TerraformerOutputKeyCloudNAT is the name of the cloud_nat terraform output variable.
+	TerraformerOutputKeyCloudNAT = "cloud_nat"
+	// TerraformerOutputKeyNatIPs is the name of the nat_ips terraform output variable.
+	TerraformerOutputKeyNatIPs = "nat_ips"
 )
 
 var (
@@ -70,6 +77,28 @@ func ComputeTerraformerChartValues(
 		vpcName = config.Networks.VPC.Name
 	}
 
+	hostProject := map[string]interface{}{
+		"enabled": config.Networks.HostProject != nil,
+	}
+	if config.Networks.HostProject != nil {
+		hostProject["id"] = config.Networks.HostProject.ID
+	}
+
+	cloudNAT := map[string]interface{}{
+		"enabled": config.Networks.CloudNAT != nil,
+	}
+	if config.Networks.CloudNAT != nil {
+		natIPNames := make([]string, 0, len(config.Networks.CloudNAT.NatIPNames))
+		for _, natIPName := range config.Networks.CloudNAT.NatIPNames {
+			natIPNames = append(natIPNames, natIPName.Name)
+		}
+
+		cloudNAT["natIPNames"] = natIPNames
+		if config.Networks.CloudNAT.MinPortsPerVM != nil {
+			cloudNAT["minPortsPerVM"] = *config.Networks.CloudNAT.MinPortsPerVM
+		}
+	}
+
 	return map[string]interface{}{
 		"google": map[string]interface{}{
 			"region":  infra.Spec.Region,
@@ -81,6 +110,7 @@ func ComputeTerraformerChartValues(
 		"vpc": map[string]interface{}{
 			"name": vpcName,
 		},
+		"hostProject": hostProject,
 		"clusterName": infra.Namespace,
 		"networks": map[string]interface{}{
 			"pods":     extensionscontroller.GetPodNetwork(cluster),
@@ -88,11 +118,15 @@ func ComputeTerraformerChartValues(
 			"worker":   config.Networks.Worker,
 			"internal": config.Networks.Internal,
 		},
+		"cloudNAT": cloudNAT,
 		"outputKeys": map[string]interface{}{
 			"vpcName":             TerraformerOutputKeyVPCName,
 			"serviceAccountEmail": TerraformerOutputKeyServiceAccountEmail,
 			"subnetNodes":         TerraformerOutputKeySubnetNodes,
 			"subnetInternal":      TerraformerOutputKeySubnetInternal,
+			"cloudRouter":         TerraformerOutputKeyCloudRouter,
+			"cloudNAT":            TerraformerOutputKeyCloudNAT,
+			"natIPs":              TerraformerOutputKeyNatIPs,
 		},
 	}
 }
@@ -130,16 +164,24 @@ type TerraformFiles struct {
 type TerraformState struct {
 	// VPCName is the name of the VPC created for an infrastructure.
 	VPCName string
+	// HostProjectID is the ID of the host project of the shared VPC of an infrastructure.
+	HostProjectID *string
 	// ServiceAccountEmail is the service account email for a network.
 	ServiceAccountEmail string
 	// SubnetNodes is the CIDR of the nodes subnet of an infrastructure.
 	SubnetNodes string
 	// SubnetInternal is the CIDR of the internal subnet of an infrastructure.
 	SubnetInternal *string
+	// CloudRouterName is the name of the Cloud Router created for an infrastructure.
+	CloudRouterName *string
+	// CloudNATName is the name of the Cloud NAT created for an infrastructure.
+	CloudNATName *string
+	// NatIPs are the reserved static IP addresses used by the Cloud NAT of an infrastructure.
+	NatIPs []string
 }
 
 // ExtractTerraformState extracts the TerraformState from the given Terraformer.
-func ExtractTerraformState(tf *terraformer.Terraformer, config *gcpv1alpha1.InfrastructureConfig) (*TerraformState, error) {
+func ExtractTerraformState(tf extensionsterraformer.Interface, config *gcpv1alpha1.InfrastructureConfig) (*TerraformState, error) {
 	outputKeys := []string{
 		TerraformerOutputKeyVPCName,
 		TerraformerOutputKeySubnetNodes,
@@ -151,6 +193,15 @@ func ExtractTerraformState(tf *terraformer.Terraformer, config *gcpv1alpha1.Infr
 		outputKeys = append(outputKeys, TerraformerOutputKeySubnetInternal)
 	}
 
+	hasCloudNAT := config.Networks.CloudNAT != nil
+	hasNatIPs := hasCloudNAT && len(config.Networks.CloudNAT.NatIPNames) > 0
+	if hasCloudNAT {
+		outputKeys = append(outputKeys, TerraformerOutputKeyCloudRouter, TerraformerOutputKeyCloudNAT)
+	}
+	if hasNatIPs {
+		outputKeys = append(outputKeys, TerraformerOutputKeyNatIPs)
+	}
+
 	vars, err := tf.GetStateOutputVariables(outputKeys...)
 	if err != nil {
 		return nil, err
@@ -161,10 +212,22 @@ func ExtractTerraformState(tf *terraformer.Terraformer, config *gcpv1alpha1.Infr
 		SubnetNodes:         vars[TerraformerOutputKeySubnetNodes],
 		ServiceAccountEmail: vars[TerraformerOutputKeyServiceAccountEmail],
 	}
+	if config.Networks.HostProject != nil {
+		hostProjectID := config.Networks.HostProject.ID
+		state.HostProjectID = &hostProjectID
+	}
 	if hasInternal {
 		subnetInternal := vars[TerraformerOutputKeySubnetInternal]
 		state.SubnetInternal = &subnetInternal
 	}
+	if hasCloudNAT {
+		cloudRouterName, cloudNATName := vars[TerraformerOutputKeyCloudRouter], vars[TerraformerOutputKeyCloudNAT]
+		state.CloudRouterName = &cloudRouterName
+		state.CloudNATName = &cloudNATName
+	}
+	if hasNatIPs {
+		state.NatIPs = strings.Split(vars[TerraformerOutputKeyNatIPs], ",")
+	}
 	return state, nil
 }
 
@@ -178,6 +241,7 @@ func StatusFromTerraformState(state *TerraformState) *gcpv1alpha1.Infrastructure
 				VPC: gcpv1alpha1.VPC{
 					Name: state.VPCName,
 				},
+				HostProjectID: state.HostProjectID,
 				Subnets: []gcpv1alpha1.Subnet{
 					{
 						Purpose: gcpv1alpha1.PurposeNodes,
@@ -195,11 +259,25 @@ func StatusFromTerraformState(state *TerraformState) *gcpv1alpha1.Infrastructure
 			Name:    *state.SubnetInternal,
 		})
 	}
+
+	if state.CloudRouterName != nil {
+		status.Networks.CloudRouter = &gcpv1alpha1.CloudRouter{
+			Name: *state.CloudRouterName,
+		}
+	}
+	if state.CloudNATName != nil {
+		status.Networks.CloudNAT = &gcpv1alpha1.CloudNATStatus{
+			Name: *state.CloudNATName,
+		}
+		for _, ip := range state.NatIPs {
+			status.Networks.CloudNAT.NatIPs = append(status.Networks.CloudNAT.NatIPs, gcpv1alpha1.NatIP{IP: ip})
+		}
+	}
 	return status
 }
 
 // ComputeStatus computes the status based on the Terraformer and the given InfrastructureConfig.
-func ComputeStatus(tf *terraformer.Terraformer, config *gcpv1alpha1.InfrastructureConfig) (*gcpv1alpha1.InfrastructureStatus, error) {
+func ComputeStatus(tf extensionsterraformer.Interface, config *gcpv1alpha1.InfrastructureConfig) (*gcpv1alpha1.InfrastructureStatus, error) {
 	state, err := ExtractTerraformState(tf, config)
 	if err != nil {
 		return nil, err
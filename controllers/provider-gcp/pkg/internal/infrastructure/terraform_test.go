@@ -20,6 +20,7 @@ import (
 	gcpv1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp/v1alpha1"
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal"
 	"github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/util"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -105,6 +106,9 @@ var _ = Describe("Terraform", func() {
 				"vpc": map[string]interface{}{
 					"name": config.Networks.VPC.Name,
 				},
+				"hostProject": map[string]interface{}{
+					"enabled": false,
+				},
 				"clusterName": infra.Namespace,
 				"networks": map[string]interface{}{
 					"pods":     podsCIDR,
@@ -112,11 +116,17 @@ var _ = Describe("Terraform", func() {
 					"worker":   config.Networks.Worker,
 					"internal": config.Networks.Internal,
 				},
+				"cloudNAT": map[string]interface{}{
+					"enabled": false,
+				},
 				"outputKeys": map[string]interface{}{
 					"vpcName":             TerraformerOutputKeyVPCName,
 					"serviceAccountEmail": TerraformerOutputKeyServiceAccountEmail,
 					"subnetNodes":         TerraformerOutputKeySubnetNodes,
 					"subnetInternal":      TerraformerOutputKeySubnetInternal,
+					"cloudRouter":         TerraformerOutputKeyCloudRouter,
+					"cloudNAT":            TerraformerOutputKeyCloudNAT,
+					"natIPs":              TerraformerOutputKeyNatIPs,
 				},
 			}))
 		})
@@ -136,6 +146,9 @@ var _ = Describe("Terraform", func() {
 				"vpc": map[string]interface{}{
 					"name": DefaultVPCName,
 				},
+				"hostProject": map[string]interface{}{
+					"enabled": false,
+				},
 				"clusterName": infra.Namespace,
 				"networks": map[string]interface{}{
 					"pods":     podsCIDR,
@@ -143,16 +156,60 @@ var _ = Describe("Terraform", func() {
 					"worker":   config.Networks.Worker,
 					"internal": config.Networks.Internal,
 				},
+				"cloudNAT": map[string]interface{}{
+					"enabled": false,
+				},
 				"outputKeys": map[string]interface{}{
 					"vpcName":             TerraformerOutputKeyVPCName,
 					"serviceAccountEmail": TerraformerOutputKeyServiceAccountEmail,
 					"subnetNodes":         TerraformerOutputKeySubnetNodes,
 					"subnetInternal":      TerraformerOutputKeySubnetInternal,
+					"cloudRouter":         TerraformerOutputKeyCloudRouter,
+					"cloudNAT":            TerraformerOutputKeyCloudNAT,
+					"natIPs":              TerraformerOutputKeyNatIPs,
 				},
 			}))
 		})
 	})
 
+	Describe("#ComputeTerraformerChartValues with shared VPC", func() {
+		It("should correctly compute the host project values", func() {
+			config.Networks.HostProject = &gcpv1alpha1.HostProject{ID: "host-project"}
+			values := ComputeTerraformerChartValues(infra, serviceAccount, config, cluster)
+
+			Expect(values).To(HaveKeyWithValue("hostProject", map[string]interface{}{
+				"enabled": true,
+				"id":      "host-project",
+			}))
+		})
+	})
+
+	Describe("#ComputeTerraformerChartValues with Cloud NAT", func() {
+		It("should correctly compute the Cloud NAT values", func() {
+			config.Networks.CloudNAT = &gcpv1alpha1.CloudNAT{
+				MinPortsPerVM: util.Int32Ptr(2048),
+				NatIPNames:    []gcpv1alpha1.NatIPName{{Name: "ip-a"}, {Name: "ip-b"}},
+			}
+			values := ComputeTerraformerChartValues(infra, serviceAccount, config, cluster)
+
+			Expect(values).To(HaveKeyWithValue("cloudNAT", map[string]interface{}{
+				"enabled":       true,
+				"minPortsPerVM": int32(2048),
+				"natIPNames":    []string{"ip-a", "ip-b"},
+			}))
+		})
+
+		It("should not set the minimum ports per VM if it is not configured", func() {
+			config.Networks.CloudNAT = &gcpv1alpha1.CloudNAT{}
+			values := ComputeTerraformerChartValues(infra, serviceAccount, config, cluster)
+
+			Expect(values).To(HaveKeyWithValue("cloudNAT", map[string]interface{}{
+				"enabled":    true,
+				"natIPNames": []string{},
+			}))
+		})
+	})
+
 	Describe("#StatusFromTerraformState", func() {
 		var (
 			serviceAccountEmail string
@@ -201,6 +258,27 @@ var _ = Describe("Terraform", func() {
 			}))
 		})
 
+		It("should correctly compute the status with shared VPC", func() {
+			state.HostProjectID = util.StringPtr("host-project")
+			status := StatusFromTerraformState(state)
+
+			Expect(status.Networks.HostProjectID).To(Equal(util.StringPtr("host-project")))
+		})
+
+		It("should correctly compute the status with Cloud NAT", func() {
+			state.SubnetInternal = nil
+			state.CloudRouterName = util.StringPtr("router")
+			state.CloudNATName = util.StringPtr("nat")
+			state.NatIPs = []string{"1.2.3.4", "5.6.7.8"}
+			status := StatusFromTerraformState(state)
+
+			Expect(status.Networks.CloudRouter).To(Equal(&gcpv1alpha1.CloudRouter{Name: "router"}))
+			Expect(status.Networks.CloudNAT).To(Equal(&gcpv1alpha1.CloudNATStatus{
+				Name:   "nat",
+				NatIPs: []gcpv1alpha1.NatIP{{IP: "1.2.3.4"}, {IP: "5.6.7.8"}},
+			}))
+		})
+
 		It("should correctly compute the status without internal subnet", func() {
 			state.SubnetInternal = nil
 			status := StatusFromTerraformState(state)
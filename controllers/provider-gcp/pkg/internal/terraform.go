@@ -20,14 +20,16 @@ import (
 	"time"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal/imagevector"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 	"github.com/gardener/gardener/pkg/logger"
-	"github.com/gardener/gardener/pkg/operation/terraformer"
 	"k8s.io/client-go/rest"
 )
 
 const (
 	// TerraformVarServiceAccount is the name of the terraform service account environment variable.
 	TerraformVarServiceAccount = "TF_VAR_SERVICEACCOUNT"
+	// TerraformVarHostServiceAccount is the name of the terraform host project service account environment variable.
+	TerraformVarHostServiceAccount = "TF_VAR_HOST_SERVICEACCOUNT"
 )
 
 // TerraformerVariablesEnvironmentFromServiceAccount computes the Terraformer variables environment from the
@@ -43,20 +45,42 @@ func TerraformerVariablesEnvironmentFromServiceAccount(account *ServiceAccount)
 	}, nil
 }
 
-// NewTerraformer initializes a new Terraformer that has the ServiceAccount credentials.
+// TerraformerVariablesEnvironmentFromServiceAccounts computes the Terraformer variables environment from the
+// given ServiceAccount and the optional ServiceAccount for the host project of a shared VPC.
+func TerraformerVariablesEnvironmentFromServiceAccounts(account, hostAccount *ServiceAccount) (map[string]string, error) {
+	variables, err := TerraformerVariablesEnvironmentFromServiceAccount(account)
+	if err != nil {
+		return nil, err
+	}
+	if hostAccount == nil {
+		return variables, nil
+	}
+
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, hostAccount.Raw); err != nil {
+		return nil, err
+	}
+	variables[TerraformVarHostServiceAccount] = buf.String()
+	return variables, nil
+}
+
+// NewTerraformer initializes a new Terraformer that has the ServiceAccount credentials. The host ServiceAccount
+// is optional and only required for infrastructures in a shared VPC.
 func NewTerraformer(
+	factory extensionsterraformer.Factory,
 	restConfig *rest.Config,
 	serviceAccount *ServiceAccount,
+	hostServiceAccount *ServiceAccount,
 	purpose,
 	namespace,
 	name string,
-) (*terraformer.Terraformer, error) {
-	tf, err := terraformer.NewForConfig(logger.NewLogger("info"), restConfig, purpose, namespace, name, imagevector.TerraformerImage())
+) (extensionsterraformer.Interface, error) {
+	tf, err := factory.NewForConfig(logger.NewLogger("info"), restConfig, purpose, namespace, name, imagevector.TerraformerImage())
 	if err != nil {
 		return nil, err
 	}
 
-	variables, err := TerraformerVariablesEnvironmentFromServiceAccount(serviceAccount)
+	variables, err := TerraformerVariablesEnvironmentFromServiceAccounts(serviceAccount, hostServiceAccount)
 	if err != nil {
 		return nil, err
 	}
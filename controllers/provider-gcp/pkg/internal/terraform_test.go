@@ -43,4 +43,26 @@ var _ = Describe("Terraform", func() {
 			}))
 		})
 	})
+
+	Describe("#TerraformerVariablesEnvironmentFromServiceAccounts", func() {
+		It("should not add the host service account if it is not given", func() {
+			variables, err := TerraformerVariablesEnvironmentFromServiceAccounts(serviceAccount, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(variables).To(Equal(map[string]string{
+				TerraformVarServiceAccount: fmt.Sprintf(`{"project_id":"%s"}`, projectID),
+			}))
+		})
+
+		It("should add the host service account", func() {
+			hostServiceAccount := &ServiceAccount{ProjectID: "host", Raw: []byte(`{"project_id": "host"}`)}
+			variables, err := TerraformerVariablesEnvironmentFromServiceAccounts(serviceAccount, hostServiceAccount)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(variables).To(Equal(map[string]string{
+				TerraformVarServiceAccount:     fmt.Sprintf(`{"project_id":"%s"}`, projectID),
+				TerraformVarHostServiceAccount: `{"project_id":"host"}`,
+			}))
+		})
+	})
 })
@@ -0,0 +1,133 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator_test
+
+import (
+	"context"
+
+	gcpinstall "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp/install"
+	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/gcp"
+	. "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/webhook/validator"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+)
+
+var _ = Describe("Validator", func() {
+	const (
+		validInfrastructureConfig   = `{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","networks":{"worker":"10.250.0.0/19","internal":"10.250.112.0/22"}}`
+		otherInfrastructureConfig   = `{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","networks":{"worker":"10.250.32.0/19","internal":"10.250.112.0/22"}}`
+		invalidInfrastructureConfig = `{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","networks":{"worker":"10.250.0.0/19","internal":"10.250.0.0/22"}}`
+	)
+
+	var (
+		ctx       = context.TODO()
+		validator extensionswebhook.Validator
+
+		infrastructure = func(providerConfig string) *extensionsv1alpha1.Infrastructure {
+			return &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar", Name: "bar"},
+				Spec: extensionsv1alpha1.InfrastructureSpec{
+					DefaultSpec:    extensionsv1alpha1.DefaultSpec{Type: gcp.Type},
+					ProviderConfig: &runtime.RawExtension{Raw: []byte(providerConfig)},
+				},
+			}
+		}
+		controlPlane = func(zone string) *extensionsv1alpha1.ControlPlane {
+			return &extensionsv1alpha1.ControlPlane{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar", Name: "bar"},
+				Spec: extensionsv1alpha1.ControlPlaneSpec{
+					DefaultSpec:    extensionsv1alpha1.DefaultSpec{Type: gcp.Type},
+					ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"ControlPlaneConfig","zone":"` + zone + `"}`)},
+				},
+			}
+		}
+	)
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(extensionscontroller.AddToScheme(scheme)).To(Succeed())
+		Expect(gcpinstall.AddToScheme(scheme)).To(Succeed())
+
+		validator = NewValidator()
+		Expect(validator.(inject.Scheme).InjectScheme(scheme)).To(Succeed())
+		Expect(validator.(inject.Client).InjectClient(fake.NewFakeClientWithScheme(scheme))).To(Succeed())
+	})
+
+	Describe("#Validate", func() {
+		It("should accept a valid infrastructure config", func() {
+			Expect(validator.Validate(ctx, infrastructure(validInfrastructureConfig), nil)).To(Succeed())
+		})
+
+		It("should reject an invalid infrastructure config", func() {
+			err := validator.Validate(ctx, infrastructure(invalidInfrastructureConfig), nil)
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.providerConfig.networks.internal"))
+		})
+
+		It("should reject changes of immutable fields of the infrastructure config", func() {
+			err := validator.Validate(ctx, infrastructure(otherInfrastructureConfig), infrastructure(validInfrastructureConfig))
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.providerConfig.networks.worker"))
+		})
+
+		It("should accept a valid control plane config", func() {
+			Expect(validator.Validate(ctx, controlPlane("europe-west1-b"), nil)).To(Succeed())
+		})
+
+		It("should reject a control plane config without zone", func() {
+			err := validator.Validate(ctx, controlPlane(""), nil)
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.providerConfig.zone"))
+		})
+
+		It("should reject changing the zone of the control plane config", func() {
+			err := validator.Validate(ctx, controlPlane("europe-west1-c"), controlPlane("europe-west1-b"))
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.providerConfig.zone"))
+		})
+
+		It("should reject an invalid worker config", func() {
+			worker := &extensionsv1alpha1.Worker{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar", Name: "bar"},
+				Spec: extensionsv1alpha1.WorkerSpec{
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: gcp.Type},
+					Pools: []extensionsv1alpha1.WorkerPool{
+						{
+							Name:           "pool",
+							ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","machineControllerManagerSettings":{"machineDrainTimeout":"0s"}}`)},
+						},
+					},
+				},
+			}
+
+			err := validator.Validate(ctx, worker, nil)
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.pools[0].providerConfig.machineControllerManagerSettings.machineDrainTimeout"))
+		})
+	})
+})
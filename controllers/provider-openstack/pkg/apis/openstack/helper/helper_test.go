@@ -65,6 +65,29 @@ var _ = Describe("Helper", func() {
 		Entry("entry not found (no version)", []openstack.MachineImage{{Name: "bar", Version: "1.2.3"}}, "foo", "1.2.3", nil, true),
 		Entry("entry exists", []openstack.MachineImage{{Name: "bar", Version: "1.2.3"}}, "bar", "1.2.3", &openstack.MachineImage{Name: "bar", Version: "1.2.3"}, false),
 	)
+
+	DescribeTable("#IsCSIDriverEnabled",
+		func(config *openstack.ControlPlaneConfig, expected bool) {
+			Expect(IsCSIDriverEnabled(config)).To(Equal(expected))
+		},
+
+		Entry("config is nil", nil, false),
+		Entry("storage is nil", &openstack.ControlPlaneConfig{}, false),
+		Entry("driver is disabled", &openstack.ControlPlaneConfig{Storage: &openstack.Storage{CSIMigration: true}}, false),
+		Entry("driver is enabled", &openstack.ControlPlaneConfig{Storage: &openstack.Storage{CSIDriver: true}}, true),
+	)
+
+	DescribeTable("#IsCSIMigrationEnabled",
+		func(config *openstack.ControlPlaneConfig, expected bool) {
+			Expect(IsCSIMigrationEnabled(config)).To(Equal(expected))
+		},
+
+		Entry("config is nil", nil, false),
+		Entry("storage is nil", &openstack.ControlPlaneConfig{}, false),
+		Entry("driver is disabled", &openstack.ControlPlaneConfig{Storage: &openstack.Storage{CSIMigration: true}}, false),
+		Entry("migration is disabled", &openstack.ControlPlaneConfig{Storage: &openstack.Storage{CSIDriver: true}}, false),
+		Entry("migration is enabled", &openstack.ControlPlaneConfig{Storage: &openstack.Storage{CSIDriver: true, CSIMigration: true}}, true),
+	)
 })
 
 func expectResults(result, expected interface{}, err error, expectErr bool) {
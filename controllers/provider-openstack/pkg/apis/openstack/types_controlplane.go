@@ -34,6 +34,9 @@ type ControlPlaneConfig struct {
 
 	// CloudControllerManager contains configuration settings for the cloud-controller-manager.
 	CloudControllerManager *CloudControllerManagerConfig
+
+	// Storage contains configuration settings for the storage of the shoot cluster.
+	Storage *Storage
 }
 
 const (
@@ -50,3 +53,12 @@ type CloudControllerManagerConfig struct {
 	// FeatureGates contains information about enabled feature gates.
 	FeatureGates map[string]bool
 }
+
+// Storage contains configuration settings for the storage of the shoot cluster.
+type Storage struct {
+	// CSIDriver specifies whether the Cinder CSI driver is deployed.
+	CSIDriver bool
+	// CSIMigration specifies whether the in-tree Cinder volume plugin is migrated to the CSI driver. It requires the
+	// CSI driver to be deployed.
+	CSIMigration bool
+}
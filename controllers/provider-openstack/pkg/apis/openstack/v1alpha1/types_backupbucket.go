@@ -0,0 +1,48 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BackupBucketConfig contains configuration settings for the backup bucket. Swift does not support default
+// encryption with customer-managed keys or lifecycle rules on container level, hence only versioning and the
+// access to the container can be configured.
+type BackupBucketConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Versioning indicates whether object versioning is enabled for the container. Old versions of the objects
+	// are kept in a separate container with the suffix `-versions`.
+	// +optional
+	Versioning bool `json:"versioning,omitempty"`
+	// BlockPublicAccess indicates whether the read ACL of the container is removed.
+	// +optional
+	BlockPublicAccess bool `json:"blockPublicAccess,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BackupBucketStatus contains information about the settings applied to the backup bucket.
+type BackupBucketStatus struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Versioning indicates whether object versioning is enabled for the container.
+	Versioning bool `json:"versioning"`
+	// PublicAccessBlocked indicates whether the read ACL of the container is removed.
+	PublicAccessBlocked bool `json:"publicAccessBlocked"`
+}
@@ -20,6 +20,48 @@ import (
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// WorkerConfig contains configuration settings for the worker nodes.
+type WorkerConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// ServerGroup contains configuration for the server group the machines of the worker pool are placed into.
+	// +optional
+	ServerGroup *ServerGroup `json:"serverGroup,omitempty"`
+	// MachineControllerManagerSettings contains configuration for the rollout of the machines of the worker pool.
+	// +optional
+	MachineControllerManagerSettings *MachineControllerManagerSettings `json:"machineControllerManagerSettings,omitempty"`
+}
+
+// ServerGroup contains configuration for the server group the machines of a worker pool are placed into.
+type ServerGroup struct {
+	// Policy is the scheduling policy of the server group, i.e., "anti-affinity" or "soft-anti-affinity".
+	Policy ServerGroupPolicy `json:"policy"`
+}
+
+// ServerGroupPolicy is a scheduling policy of a server group.
+type ServerGroupPolicy string
+
+const (
+	// ServerGroupPolicyAntiAffinity requires that the machines of a server group are placed on different hypervisors.
+	ServerGroupPolicyAntiAffinity ServerGroupPolicy = "anti-affinity"
+	// ServerGroupPolicySoftAntiAffinity places the machines of a server group on different hypervisors if possible.
+	ServerGroupPolicySoftAntiAffinity ServerGroupPolicy = "soft-anti-affinity"
+)
+
+// MachineControllerManagerSettings contains configuration for the rollout of the machines of a worker pool. The
+// machine-controller-manager only supports these timeouts for all machines of a shoot, hence the longest timeouts of
+// all worker pools apply to the machines of all worker pools.
+type MachineControllerManagerSettings struct {
+	// MachineDrainTimeout is the maximum duration for draining a machine that is replaced.
+	// +optional
+	MachineDrainTimeout *metav1.Duration `json:"machineDrainTimeout,omitempty"`
+	// MachineCreationTimeout is the maximum duration for creating a machine until its node has joined the cluster.
+	// +optional
+	MachineCreationTimeout *metav1.Duration `json:"machineCreationTimeout,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // WorkerStatus contains information about created worker resources.
 type WorkerStatus struct {
 	metav1.TypeMeta `json:",inline"`
@@ -31,6 +73,9 @@ type WorkerStatus struct {
 	// reconciliation is possible.
 	// +optional
 	MachineImages []MachineImage `json:"machineImages,omitempty"`
+	// ServerGroupDependencies is a list of server groups that have been created for the worker pools of this worker.
+	// +optional
+	ServerGroupDependencies []ServerGroupDependency `json:"serverGroupDependencies,omitempty"`
 }
 
 // MachineImage is a mapping from logical names and versions to provider-specific machine image data.
@@ -42,3 +87,13 @@ type MachineImage struct {
 	// Image is the name of the image.
 	Image string `json:"image"`
 }
+
+// ServerGroupDependency is a server group that has been created for a worker pool.
+type ServerGroupDependency struct {
+	// PoolName is the name of the worker pool the server group has been created for.
+	PoolName string `json:"poolName"`
+	// ID is the ID of the server group.
+	ID string `json:"id"`
+	// Name is the name of the server group.
+	Name string `json:"name"`
+}
@@ -24,6 +24,7 @@ import (
 	unsafe "unsafe"
 
 	openstack "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -35,6 +36,26 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*BackupBucketConfig)(nil), (*openstack.BackupBucketConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BackupBucketConfig_To_openstack_BackupBucketConfig(a.(*BackupBucketConfig), b.(*openstack.BackupBucketConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.BackupBucketConfig)(nil), (*BackupBucketConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(a.(*openstack.BackupBucketConfig), b.(*BackupBucketConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BackupBucketStatus)(nil), (*openstack.BackupBucketStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BackupBucketStatus_To_openstack_BackupBucketStatus(a.(*BackupBucketStatus), b.(*openstack.BackupBucketStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.BackupBucketStatus)(nil), (*BackupBucketStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_BackupBucketStatus_To_v1alpha1_BackupBucketStatus(a.(*openstack.BackupBucketStatus), b.(*BackupBucketStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudControllerManagerConfig)(nil), (*openstack.CloudControllerManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CloudControllerManagerConfig_To_openstack_CloudControllerManagerConfig(a.(*CloudControllerManagerConfig), b.(*openstack.CloudControllerManagerConfig), scope)
 	}); err != nil {
@@ -135,6 +156,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MachineControllerManagerSettings)(nil), (*openstack.MachineControllerManagerSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineControllerManagerSettings_To_openstack_MachineControllerManagerSettings(a.(*MachineControllerManagerSettings), b.(*openstack.MachineControllerManagerSettings), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.MachineControllerManagerSettings)(nil), (*MachineControllerManagerSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_MachineControllerManagerSettings_To_v1alpha1_MachineControllerManagerSettings(a.(*openstack.MachineControllerManagerSettings), b.(*MachineControllerManagerSettings), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MachineImage)(nil), (*openstack.MachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_MachineImage_To_openstack_MachineImage(a.(*MachineImage), b.(*openstack.MachineImage), scope)
 	}); err != nil {
@@ -225,6 +256,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ServerGroup)(nil), (*openstack.ServerGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ServerGroup_To_openstack_ServerGroup(a.(*ServerGroup), b.(*openstack.ServerGroup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.ServerGroup)(nil), (*ServerGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_ServerGroup_To_v1alpha1_ServerGroup(a.(*openstack.ServerGroup), b.(*ServerGroup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ServerGroupDependency)(nil), (*openstack.ServerGroupDependency)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ServerGroupDependency_To_openstack_ServerGroupDependency(a.(*ServerGroupDependency), b.(*openstack.ServerGroupDependency), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.ServerGroupDependency)(nil), (*ServerGroupDependency)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_ServerGroupDependency_To_v1alpha1_ServerGroupDependency(a.(*openstack.ServerGroupDependency), b.(*ServerGroupDependency), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Storage)(nil), (*openstack.Storage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Storage_To_openstack_Storage(a.(*Storage), b.(*openstack.Storage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.Storage)(nil), (*Storage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_Storage_To_v1alpha1_Storage(a.(*openstack.Storage), b.(*Storage), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Subnet)(nil), (*openstack.Subnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Subnet_To_openstack_Subnet(a.(*Subnet), b.(*openstack.Subnet), scope)
 	}); err != nil {
@@ -235,6 +296,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WorkerConfig)(nil), (*openstack.WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerConfig_To_openstack_WorkerConfig(a.(*WorkerConfig), b.(*openstack.WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.WorkerConfig)(nil), (*WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_WorkerConfig_To_v1alpha1_WorkerConfig(a.(*openstack.WorkerConfig), b.(*WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*WorkerStatus)(nil), (*openstack.WorkerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_WorkerStatus_To_openstack_WorkerStatus(a.(*WorkerStatus), b.(*openstack.WorkerStatus), scope)
 	}); err != nil {
@@ -248,6 +319,50 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1alpha1_BackupBucketConfig_To_openstack_BackupBucketConfig(in *BackupBucketConfig, out *openstack.BackupBucketConfig, s conversion.Scope) error {
+	out.Versioning = in.Versioning
+	out.BlockPublicAccess = in.BlockPublicAccess
+	return nil
+}
+
+// Convert_v1alpha1_BackupBucketConfig_To_openstack_BackupBucketConfig is an autogenerated conversion function.
+func Convert_v1alpha1_BackupBucketConfig_To_openstack_BackupBucketConfig(in *BackupBucketConfig, out *openstack.BackupBucketConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BackupBucketConfig_To_openstack_BackupBucketConfig(in, out, s)
+}
+
+func autoConvert_openstack_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *openstack.BackupBucketConfig, out *BackupBucketConfig, s conversion.Scope) error {
+	out.Versioning = in.Versioning
+	out.BlockPublicAccess = in.BlockPublicAccess
+	return nil
+}
+
+// Convert_openstack_BackupBucketConfig_To_v1alpha1_BackupBucketConfig is an autogenerated conversion function.
+func Convert_openstack_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *openstack.BackupBucketConfig, out *BackupBucketConfig, s conversion.Scope) error {
+	return autoConvert_openstack_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_BackupBucketStatus_To_openstack_BackupBucketStatus(in *BackupBucketStatus, out *openstack.BackupBucketStatus, s conversion.Scope) error {
+	out.Versioning = in.Versioning
+	out.PublicAccessBlocked = in.PublicAccessBlocked
+	return nil
+}
+
+// Convert_v1alpha1_BackupBucketStatus_To_openstack_BackupBucketStatus is an autogenerated conversion function.
+func Convert_v1alpha1_BackupBucketStatus_To_openstack_BackupBucketStatus(in *BackupBucketStatus, out *openstack.BackupBucketStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BackupBucketStatus_To_openstack_BackupBucketStatus(in, out, s)
+}
+
+func autoConvert_openstack_BackupBucketStatus_To_v1alpha1_BackupBucketStatus(in *openstack.BackupBucketStatus, out *BackupBucketStatus, s conversion.Scope) error {
+	out.Versioning = in.Versioning
+	out.PublicAccessBlocked = in.PublicAccessBlocked
+	return nil
+}
+
+// Convert_openstack_BackupBucketStatus_To_v1alpha1_BackupBucketStatus is an autogenerated conversion function.
+func Convert_openstack_BackupBucketStatus_To_v1alpha1_BackupBucketStatus(in *openstack.BackupBucketStatus, out *BackupBucketStatus, s conversion.Scope) error {
+	return autoConvert_openstack_BackupBucketStatus_To_v1alpha1_BackupBucketStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_CloudControllerManagerConfig_To_openstack_CloudControllerManagerConfig(in *CloudControllerManagerConfig, out *openstack.CloudControllerManagerConfig, s conversion.Scope) error {
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
 	return nil
@@ -329,6 +444,7 @@ func autoConvert_v1alpha1_ControlPlaneConfig_To_openstack_ControlPlaneConfig(in
 	out.Zone = in.Zone
 	out.LoadBalancerClasses = *(*[]openstack.LoadBalancerClass)(unsafe.Pointer(&in.LoadBalancerClasses))
 	out.CloudControllerManager = (*openstack.CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.Storage = (*openstack.Storage)(unsafe.Pointer(in.Storage))
 	return nil
 }
 
@@ -342,6 +458,7 @@ func autoConvert_openstack_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in
 	out.Zone = in.Zone
 	out.LoadBalancerClasses = *(*[]LoadBalancerClass)(unsafe.Pointer(&in.LoadBalancerClasses))
 	out.CloudControllerManager = (*CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.Storage = (*Storage)(unsafe.Pointer(in.Storage))
 	return nil
 }
 
@@ -498,6 +615,28 @@ func Convert_openstack_LoadBalancerProvider_To_v1alpha1_LoadBalancerProvider(in
 	return autoConvert_openstack_LoadBalancerProvider_To_v1alpha1_LoadBalancerProvider(in, out, s)
 }
 
+func autoConvert_v1alpha1_MachineControllerManagerSettings_To_openstack_MachineControllerManagerSettings(in *MachineControllerManagerSettings, out *openstack.MachineControllerManagerSettings, s conversion.Scope) error {
+	out.MachineDrainTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineDrainTimeout))
+	out.MachineCreationTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineCreationTimeout))
+	return nil
+}
+
+// Convert_v1alpha1_MachineControllerManagerSettings_To_openstack_MachineControllerManagerSettings is an autogenerated conversion function.
+func Convert_v1alpha1_MachineControllerManagerSettings_To_openstack_MachineControllerManagerSettings(in *MachineControllerManagerSettings, out *openstack.MachineControllerManagerSettings, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineControllerManagerSettings_To_openstack_MachineControllerManagerSettings(in, out, s)
+}
+
+func autoConvert_openstack_MachineControllerManagerSettings_To_v1alpha1_MachineControllerManagerSettings(in *openstack.MachineControllerManagerSettings, out *MachineControllerManagerSettings, s conversion.Scope) error {
+	out.MachineDrainTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineDrainTimeout))
+	out.MachineCreationTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineCreationTimeout))
+	return nil
+}
+
+// Convert_openstack_MachineControllerManagerSettings_To_v1alpha1_MachineControllerManagerSettings is an autogenerated conversion function.
+func Convert_openstack_MachineControllerManagerSettings_To_v1alpha1_MachineControllerManagerSettings(in *openstack.MachineControllerManagerSettings, out *MachineControllerManagerSettings, s conversion.Scope) error {
+	return autoConvert_openstack_MachineControllerManagerSettings_To_v1alpha1_MachineControllerManagerSettings(in, out, s)
+}
+
 func autoConvert_v1alpha1_MachineImage_To_openstack_MachineImage(in *MachineImage, out *openstack.MachineImage, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Version = in.Version
@@ -706,6 +845,72 @@ func Convert_openstack_SecurityGroup_To_v1alpha1_SecurityGroup(in *openstack.Sec
 	return autoConvert_openstack_SecurityGroup_To_v1alpha1_SecurityGroup(in, out, s)
 }
 
+func autoConvert_v1alpha1_ServerGroup_To_openstack_ServerGroup(in *ServerGroup, out *openstack.ServerGroup, s conversion.Scope) error {
+	out.Policy = openstack.ServerGroupPolicy(in.Policy)
+	return nil
+}
+
+// Convert_v1alpha1_ServerGroup_To_openstack_ServerGroup is an autogenerated conversion function.
+func Convert_v1alpha1_ServerGroup_To_openstack_ServerGroup(in *ServerGroup, out *openstack.ServerGroup, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ServerGroup_To_openstack_ServerGroup(in, out, s)
+}
+
+func autoConvert_openstack_ServerGroup_To_v1alpha1_ServerGroup(in *openstack.ServerGroup, out *ServerGroup, s conversion.Scope) error {
+	out.Policy = ServerGroupPolicy(in.Policy)
+	return nil
+}
+
+// Convert_openstack_ServerGroup_To_v1alpha1_ServerGroup is an autogenerated conversion function.
+func Convert_openstack_ServerGroup_To_v1alpha1_ServerGroup(in *openstack.ServerGroup, out *ServerGroup, s conversion.Scope) error {
+	return autoConvert_openstack_ServerGroup_To_v1alpha1_ServerGroup(in, out, s)
+}
+
+func autoConvert_v1alpha1_ServerGroupDependency_To_openstack_ServerGroupDependency(in *ServerGroupDependency, out *openstack.ServerGroupDependency, s conversion.Scope) error {
+	out.PoolName = in.PoolName
+	out.ID = in.ID
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha1_ServerGroupDependency_To_openstack_ServerGroupDependency is an autogenerated conversion function.
+func Convert_v1alpha1_ServerGroupDependency_To_openstack_ServerGroupDependency(in *ServerGroupDependency, out *openstack.ServerGroupDependency, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ServerGroupDependency_To_openstack_ServerGroupDependency(in, out, s)
+}
+
+func autoConvert_openstack_ServerGroupDependency_To_v1alpha1_ServerGroupDependency(in *openstack.ServerGroupDependency, out *ServerGroupDependency, s conversion.Scope) error {
+	out.PoolName = in.PoolName
+	out.ID = in.ID
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_openstack_ServerGroupDependency_To_v1alpha1_ServerGroupDependency is an autogenerated conversion function.
+func Convert_openstack_ServerGroupDependency_To_v1alpha1_ServerGroupDependency(in *openstack.ServerGroupDependency, out *ServerGroupDependency, s conversion.Scope) error {
+	return autoConvert_openstack_ServerGroupDependency_To_v1alpha1_ServerGroupDependency(in, out, s)
+}
+
+func autoConvert_v1alpha1_Storage_To_openstack_Storage(in *Storage, out *openstack.Storage, s conversion.Scope) error {
+	out.CSIDriver = in.CSIDriver
+	out.CSIMigration = in.CSIMigration
+	return nil
+}
+
+// Convert_v1alpha1_Storage_To_openstack_Storage is an autogenerated conversion function.
+func Convert_v1alpha1_Storage_To_openstack_Storage(in *Storage, out *openstack.Storage, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Storage_To_openstack_Storage(in, out, s)
+}
+
+func autoConvert_openstack_Storage_To_v1alpha1_Storage(in *openstack.Storage, out *Storage, s conversion.Scope) error {
+	out.CSIDriver = in.CSIDriver
+	out.CSIMigration = in.CSIMigration
+	return nil
+}
+
+// Convert_openstack_Storage_To_v1alpha1_Storage is an autogenerated conversion function.
+func Convert_openstack_Storage_To_v1alpha1_Storage(in *openstack.Storage, out *Storage, s conversion.Scope) error {
+	return autoConvert_openstack_Storage_To_v1alpha1_Storage(in, out, s)
+}
+
 func autoConvert_v1alpha1_Subnet_To_openstack_Subnet(in *Subnet, out *openstack.Subnet, s conversion.Scope) error {
 	out.Purpose = openstack.Purpose(in.Purpose)
 	out.ID = in.ID
@@ -728,8 +933,31 @@ func Convert_openstack_Subnet_To_v1alpha1_Subnet(in *openstack.Subnet, out *Subn
 	return autoConvert_openstack_Subnet_To_v1alpha1_Subnet(in, out, s)
 }
 
+func autoConvert_v1alpha1_WorkerConfig_To_openstack_WorkerConfig(in *WorkerConfig, out *openstack.WorkerConfig, s conversion.Scope) error {
+	out.ServerGroup = (*openstack.ServerGroup)(unsafe.Pointer(in.ServerGroup))
+	out.MachineControllerManagerSettings = (*openstack.MachineControllerManagerSettings)(unsafe.Pointer(in.MachineControllerManagerSettings))
+	return nil
+}
+
+// Convert_v1alpha1_WorkerConfig_To_openstack_WorkerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerConfig_To_openstack_WorkerConfig(in *WorkerConfig, out *openstack.WorkerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerConfig_To_openstack_WorkerConfig(in, out, s)
+}
+
+func autoConvert_openstack_WorkerConfig_To_v1alpha1_WorkerConfig(in *openstack.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	out.ServerGroup = (*ServerGroup)(unsafe.Pointer(in.ServerGroup))
+	out.MachineControllerManagerSettings = (*MachineControllerManagerSettings)(unsafe.Pointer(in.MachineControllerManagerSettings))
+	return nil
+}
+
+// Convert_openstack_WorkerConfig_To_v1alpha1_WorkerConfig is an autogenerated conversion function.
+func Convert_openstack_WorkerConfig_To_v1alpha1_WorkerConfig(in *openstack.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	return autoConvert_openstack_WorkerConfig_To_v1alpha1_WorkerConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_WorkerStatus_To_openstack_WorkerStatus(in *WorkerStatus, out *openstack.WorkerStatus, s conversion.Scope) error {
 	out.MachineImages = *(*[]openstack.MachineImage)(unsafe.Pointer(&in.MachineImages))
+	out.ServerGroupDependencies = *(*[]openstack.ServerGroupDependency)(unsafe.Pointer(&in.ServerGroupDependencies))
 	return nil
 }
 
@@ -740,6 +968,7 @@ func Convert_v1alpha1_WorkerStatus_To_openstack_WorkerStatus(in *WorkerStatus, o
 
 func autoConvert_openstack_WorkerStatus_To_v1alpha1_WorkerStatus(in *openstack.WorkerStatus, out *WorkerStatus, s conversion.Scope) error {
 	out.MachineImages = *(*[]MachineImage)(unsafe.Pointer(&in.MachineImages))
+	out.ServerGroupDependencies = *(*[]ServerGroupDependency)(unsafe.Pointer(&in.ServerGroupDependencies))
 	return nil
 }
 
@@ -0,0 +1,62 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	apisopenstack "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateControlPlaneConfig validates a ControlPlaneConfig object.
+func ValidateControlPlaneConfig(controlPlaneConfig *apisopenstack.ControlPlaneConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(controlPlaneConfig.LoadBalancerProvider) == 0 {
+		allErrs = append(allErrs, field.Required(field.NewPath("loadBalancerProvider"), "must provide the name of a load balancer provider"))
+	}
+	if len(controlPlaneConfig.Zone) == 0 {
+		allErrs = append(allErrs, field.Required(field.NewPath("zone"), "must provide the name of a zone"))
+	}
+
+	names := sets.NewString()
+	for i, class := range controlPlaneConfig.LoadBalancerClasses {
+		namePath := field.NewPath("loadBalancerClasses").Index(i).Child("name")
+		if len(class.Name) == 0 {
+			allErrs = append(allErrs, field.Required(namePath, "must provide the name of the load balancer class"))
+		} else if names.Has(class.Name) {
+			allErrs = append(allErrs, field.Duplicate(namePath, class.Name))
+		}
+		names.Insert(class.Name)
+	}
+
+	if storage := controlPlaneConfig.Storage; storage != nil && storage.CSIMigration && !storage.CSIDriver {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("storage", "csiMigration"), "requires the CSI driver to be enabled"))
+	}
+
+	return allErrs
+}
+
+// ValidateControlPlaneConfigUpdate validates a ControlPlaneConfig object before an update. The zone cannot be changed.
+func ValidateControlPlaneConfigUpdate(oldConfig, newConfig *apisopenstack.ControlPlaneConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if newConfig.Zone != oldConfig.Zone {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("zone"), newConfig.Zone, "field is immutable"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	apisopenstack "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
+	cidrvalidation "github.com/gardener/gardener-extensions/pkg/util/validation/cidr"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateInfrastructureConfig validates a InfrastructureConfig object. The worker subnet is validated against the
+// node, pod and service CIDRs of the shoot, empty or invalid CIDRs of the shoot are ignored.
+func ValidateInfrastructureConfig(infra *apisopenstack.InfrastructureConfig, nodesCIDR, podsCIDR, servicesCIDR string) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var (
+		networksPath = field.NewPath("networks")
+
+		nodes    = cidrvalidation.NewCIDR(nodesCIDR, field.NewPath("nodes"))
+		pods     = cidrvalidation.NewCIDR(podsCIDR, field.NewPath("pods"))
+		services = cidrvalidation.NewCIDR(servicesCIDR, field.NewPath("services"))
+		worker   = cidrvalidation.NewCIDR(infra.Networks.Worker, networksPath.Child("worker"))
+	)
+
+	if len(infra.FloatingPoolName) == 0 {
+		allErrs = append(allErrs, field.Required(field.NewPath("floatingPoolName"), "must provide the name of a floating pool"))
+	}
+
+	if infra.Networks.Router != nil && len(infra.Networks.Router.ID) == 0 {
+		allErrs = append(allErrs, field.Required(networksPath.Child("router", "id"), "must provide the id of an existing router"))
+	}
+
+	allErrs = append(allErrs, worker.ValidateParse()...)
+	allErrs = append(allErrs, nodes.ValidateSubset(worker)...)
+	allErrs = append(allErrs, pods.ValidateNotOverlap(worker)...)
+	allErrs = append(allErrs, services.ValidateNotOverlap(worker)...)
+
+	return allErrs
+}
+
+// ValidateInfrastructureConfigUpdate validates a InfrastructureConfig object before an update. The floating pool,
+// the router and the worker subnet cannot be changed.
+func ValidateInfrastructureConfigUpdate(oldConfig, newConfig *apisopenstack.InfrastructureConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	networksPath := field.NewPath("networks")
+
+	if newConfig.FloatingPoolName != oldConfig.FloatingPoolName {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("floatingPoolName"), newConfig.FloatingPoolName, "field is immutable"))
+	}
+	if !apiequality.Semantic.DeepEqual(newConfig.Networks.Router, oldConfig.Networks.Router) {
+		allErrs = append(allErrs, field.Invalid(networksPath.Child("router"), newConfig.Networks.Router, "field is immutable"))
+	}
+	if newConfig.Networks.Worker != oldConfig.Networks.Worker {
+		allErrs = append(allErrs, field.Invalid(networksPath.Child("worker"), newConfig.Networks.Worker, "field is immutable"))
+	}
+
+	return allErrs
+}
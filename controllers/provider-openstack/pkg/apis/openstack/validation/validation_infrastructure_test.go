@@ -0,0 +1,93 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisopenstack "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
+	. "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("InfrastructureConfig validation", func() {
+	var (
+		infrastructureConfig *apisopenstack.InfrastructureConfig
+
+		nodes    = "10.250.0.0/16"
+		pods     = "100.96.0.0/11"
+		services = "100.64.0.0/13"
+	)
+
+	BeforeEach(func() {
+		infrastructureConfig = &apisopenstack.InfrastructureConfig{
+			FloatingPoolName: "fip",
+			Networks: apisopenstack.Networks{
+				Worker: "10.250.0.0/19",
+			},
+		}
+	})
+
+	Describe("#ValidateInfrastructureConfig", func() {
+		It("should accept a valid configuration", func() {
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, pods, services)).To(BeEmpty())
+		})
+
+		It("should require the floating pool and the id of an existing router", func() {
+			infrastructureConfig.FloatingPoolName = ""
+			infrastructureConfig.Networks.Router = &apisopenstack.Router{}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, pods, services)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("floatingPoolName")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("networks.router.id")})),
+			))
+		})
+
+		It("should forbid a worker subnet outside of the node network", func() {
+			infrastructureConfig.Networks.Worker = "10.251.0.0/19"
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, pods, services)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("networks.worker"),
+				"Detail": ContainSubstring("nodes"),
+			}))))
+		})
+
+		It("should forbid a worker subnet overlapping with the service network", func() {
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nodes, pods, "10.250.0.0/24")).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("networks.worker"),
+				"Detail": ContainSubstring("services"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateInfrastructureConfigUpdate", func() {
+		It("should forbid changing immutable fields", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.FloatingPoolName = "other"
+			newInfrastructureConfig.Networks.Router = &apisopenstack.Router{ID: "router"}
+			newInfrastructureConfig.Networks.Worker = "10.250.32.0/19"
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("floatingPoolName")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("networks.router")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("networks.worker")})),
+			))
+		})
+	})
+})
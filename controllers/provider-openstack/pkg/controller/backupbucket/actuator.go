@@ -16,20 +16,27 @@ package backupbucket
 
 import (
 	"context"
+	"fmt"
 
+	apisopenstack "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
+	openstackv1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack/v1alpha1"
 	openstackclient "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/openstack/client"
 	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
 type actuator struct {
 	backupbucket.Actuator
-	client client.Client
-	logger logr.Logger
+	client  client.Client
+	decoder runtime.Decoder
+	logger  logr.Logger
 }
 
 func newActuator() backupbucket.Actuator {
@@ -43,13 +50,50 @@ func (a *actuator) InjectClient(client client.Client) error {
 	return nil
 }
 
+func (a *actuator) InjectScheme(scheme *runtime.Scheme) error {
+	a.decoder = serializer.NewCodecFactory(scheme).UniversalDecoder()
+	return nil
+}
+
 func (a *actuator) Reconcile(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
 	openstackClient, err := openstackclient.NewStorageClientFromSecretRef(ctx, a.client, bb.Spec.SecretRef, bb.Spec.Region)
 	if err != nil {
 		return err
 	}
 
-	return openstackClient.CreateContainerIfNotExists(ctx, bb.Name)
+	if err := openstackClient.CreateContainerIfNotExists(ctx, bb.Name); err != nil {
+		return err
+	}
+
+	// Buckets that never had a provider config are left untouched, whereas buckets whose provider config has been
+	// removed are reset to the defaults.
+	rawConfig := backupbucket.GetProviderConfig(bb)
+	if rawConfig == nil && len(bb.Status.State) == 0 {
+		return nil
+	}
+
+	config := &apisopenstack.BackupBucketConfig{}
+	if rawConfig != nil {
+		if _, _, err := a.decoder.Decode(rawConfig, nil, config); err != nil {
+			return fmt.Errorf("could not decode provider config: %+v", err)
+		}
+	}
+
+	if err := openstackClient.UpdateContainerConfig(ctx, bb.Name, &openstackclient.ContainerConfig{
+		Versioning:        config.Versioning,
+		BlockPublicAccess: config.BlockPublicAccess,
+	}); err != nil {
+		return err
+	}
+
+	return backupbucket.UpdateProviderStatus(ctx, a.client, bb, &openstackv1alpha1.BackupBucketStatus{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: openstackv1alpha1.SchemeGroupVersion.String(),
+			Kind:       "BackupBucketStatus",
+		},
+		Versioning:          config.Versioning,
+		PublicAccessBlocked: config.BlockPublicAccess,
+	})
 }
 
 func (a *actuator) Delete(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
@@ -58,5 +102,10 @@ func (a *actuator) Delete(ctx context.Context, bb *extensionsv1alpha1.BackupBuck
 		return err
 	}
 
-	return openstackClient.DeleteContainerIfExists(ctx, bb.Name)
+	if err := openstackClient.DeleteContainerIfExists(ctx, bb.Name); err != nil {
+		return err
+	}
+
+	// Deleting the objects of a versioned container archives them in the versions container, hence it is deleted last.
+	return openstackClient.DeleteContainerIfExists(ctx, openstackclient.VersionsContainerName(bb.Name))
 }
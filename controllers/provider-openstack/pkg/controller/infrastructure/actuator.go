@@ -22,10 +22,11 @@ import (
 	openstackv1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack/v1alpha1"
 	infrainternal "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal/infrastructure"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
 	"github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
-	"github.com/gardener/gardener/pkg/operation/terraformer"
 
 	"github.com/go-logr/logr"
 
@@ -40,6 +41,8 @@ import (
 type actuator struct {
 	logger logr.Logger
 
+	terraformerFactory extensionsterraformer.Factory
+
 	restConfig *rest.Config
 
 	client  client.Client
@@ -51,8 +54,17 @@ type actuator struct {
 
 // NewActuator creates a new Actuator that updates the status of the handled Infrastructure resources.
 func NewActuator() infrastructure.Actuator {
+	return NewActuatorWithDeps(
+		log.Log.WithName("infrastructure-actuator"),
+		extensionsterraformer.DefaultFactory(),
+	)
+}
+
+// NewActuatorWithDeps creates a new infrastructure.Actuator with the given dependencies.
+func NewActuatorWithDeps(logger logr.Logger, terraformerFactory extensionsterraformer.Factory) infrastructure.Actuator {
 	return &actuator{
-		logger: log.Log.WithName("infrastructure-actuator"),
+		logger:             logger,
+		terraformerFactory: terraformerFactory,
 	}
 }
 
@@ -80,19 +92,33 @@ func (a *actuator) InjectConfig(config *rest.Config) error {
 	return nil
 }
 
+// errorMatchers classify the errors of the OpenStack API and of the Terraform jobs.
+var errorMatchers = []controllererrors.Matcher{
+	controllererrors.TerraformMatcher(controllererrors.OpenStackMatcher),
+	controllererrors.OpenStackMatcher,
+}
+
 func (a *actuator) Reconcile(ctx context.Context, config *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
-	return a.reconcile(ctx, config, cluster)
+	return controllererrors.Classify(a.reconcile(ctx, config, cluster), errorMatchers...)
 }
 
 func (a *actuator) Delete(ctx context.Context, config *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
-	return a.delete(ctx, config, cluster)
+	return controllererrors.Classify(a.delete(ctx, config, cluster), errorMatchers...)
+}
+
+func (a *actuator) Migrate(ctx context.Context, config *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	return a.migrate(ctx, config, cluster)
+}
+
+func (a *actuator) Restore(ctx context.Context, config *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	return a.restore(ctx, config, cluster)
 }
 
 // Helper functions
 
 func (a *actuator) updateProviderStatus(
 	ctx context.Context,
-	tf *terraformer.Terraformer,
+	tf extensionsterraformer.Interface,
 	infra *extensionsv1alpha1.Infrastructure,
 	config *openstackv1alpha1.InfrastructureConfig,
 ) error {
@@ -17,9 +17,12 @@ package infrastructure
 import (
 	"context"
 	"fmt"
+
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal"
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal/infrastructure"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
+
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 )
 
@@ -29,11 +32,35 @@ func (a *actuator) delete(ctx context.Context, infra *extensionsv1alpha1.Infrast
 		return err
 	}
 
-	tf, err := internal.NewTerraformer(a.restConfig, creds, infrastructure.TerraformerPurpose, infra.Namespace, infra.Name)
+	if err := extensionsterraformer.RestoreInfrastructureState(ctx, a.client, infra, infrastructure.TerraformerPurpose); err != nil {
+		return fmt.Errorf("could not restore Terraform state: %+v", err)
+	}
+
+	tf, err := internal.NewTerraformer(a.terraformerFactory, a.restConfig, creds, infrastructure.TerraformerPurpose, infra.Namespace, infra.Name)
 	if err != nil {
 		return fmt.Errorf("could not create the Terraformer: %+v", err)
 	}
 
+	configExists, err := tf.ConfigExists()
+	if err != nil {
+		return fmt.Errorf("terraform configuration was not found: %+v", err)
+	}
+
+	// The Terraform configuration is missing if the state has been restored from the status, e.g. after the seed
+	// namespace has been recreated. It has to be rendered again so that the infrastructure can be destroyed.
+	if !configExists && len(infra.Status.State) > 0 {
+		config, err := internal.InfrastructureConfigFromInfrastructure(infra)
+		if err != nil {
+			return err
+		}
+
+		terraformFiles, err := infrastructure.RenderTerraformerChart(a.chartRenderer, infra, creds, config, cluster)
+		if err != nil {
+			return err
+		}
+		tf.InitializeWith(a.terraformerFactory.DefaultInitializer(a.client, terraformFiles.Main, terraformFiles.Variables, terraformFiles.TFVars))
+	}
+
 	return tf.
 		SetVariablesEnvironment(internal.TerraformerVariablesEnvironmentFromCredentials(creds)).
 		Destroy()
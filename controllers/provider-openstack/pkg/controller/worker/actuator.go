@@ -21,6 +21,7 @@ import (
 	apisopenstack "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/imagevector"
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/openstack"
+	openstackclient "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/openstack/client"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 	"github.com/gardener/gardener-extensions/pkg/controller/worker/genericactuator"
@@ -47,6 +48,7 @@ type delegateFactory struct {
 	decoder runtime.Decoder
 
 	machineImageToCloudProfilesMapping []config.MachineImage
+	openstackClientFactory             openstackclient.Factory
 }
 
 // NewActuator creates a new Actuator that updates the status of the handled WorkerPoolConfigs.
@@ -54,6 +56,7 @@ func NewActuator(machineImageToCloudProfilesMapping []config.MachineImage) worke
 	delegateFactory := &delegateFactory{
 		logger:                             log.Log.WithName("worker-actuator"),
 		machineImageToCloudProfilesMapping: machineImageToCloudProfilesMapping,
+		openstackClientFactory:             openstackclient.DefaultFactory(),
 	}
 
 	return genericactuator.NewActuator(
@@ -107,6 +110,7 @@ func (d *delegateFactory) WorkerDelegate(ctx context.Context, worker *extensions
 		d.machineImageToCloudProfilesMapping,
 		seedChartApplier,
 		serverVersion.GitVersion,
+		d.openstackClientFactory,
 
 		worker,
 		cluster,
@@ -121,13 +125,18 @@ type workerDelegate struct {
 	machineImageToCloudProfilesMapping []config.MachineImage
 	seedChartApplier                   gardener.ChartApplier
 	serverVersion                      string
+	openstackClientFactory             openstackclient.Factory
 
 	cluster *extensionscontroller.Cluster
 	worker  *extensionsv1alpha1.Worker
 
-	machineClasses     []map[string]interface{}
-	machineDeployments worker.MachineDeployments
-	machineImages      []apisopenstack.MachineImage
+	computeClient openstackclient.Compute
+
+	machineClasses                  []map[string]interface{}
+	machineDeployments              worker.MachineDeployments
+	machineImages                   []apisopenstack.MachineImage
+	serverGroupDependencies         []apisopenstack.ServerGroupDependency
+	obsoleteServerGroupDependencies []apisopenstack.ServerGroupDependency
 }
 
 // NewWorkerDelegate creates a new context for a worker reconciliation.
@@ -139,6 +148,7 @@ func NewWorkerDelegate(
 	machineImageToCloudProfilesMapping []config.MachineImage,
 	seedChartApplier gardener.ChartApplier,
 	serverVersion string,
+	openstackClientFactory openstackclient.Factory,
 
 	worker *extensionsv1alpha1.Worker,
 	cluster *extensionscontroller.Cluster,
@@ -151,6 +161,7 @@ func NewWorkerDelegate(
 		machineImageToCloudProfilesMapping: machineImageToCloudProfilesMapping,
 		seedChartApplier:                   seedChartApplier,
 		serverVersion:                      serverVersion,
+		openstackClientFactory:             openstackClientFactory,
 
 		cluster: cluster,
 		worker:  worker,
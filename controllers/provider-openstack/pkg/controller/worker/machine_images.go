@@ -37,13 +37,23 @@ func (w *workerDelegate) GetMachineImages(ctx context.Context) (runtime.Object,
 		}
 	}
 
+	// Obsolete server groups are kept in the status until they have been deleted by the post reconcile hook.
+	var serverGroupDependencies []apisopenstack.ServerGroupDependency
+	serverGroupDependencies = append(serverGroupDependencies, w.serverGroupDependencies...)
+	serverGroupDependencies = append(serverGroupDependencies, w.obsoleteServerGroupDependencies...)
+
+	return w.computeWorkerStatus(serverGroupDependencies)
+}
+
+func (w *workerDelegate) computeWorkerStatus(serverGroupDependencies []apisopenstack.ServerGroupDependency) (*openstackv1alpha1.WorkerStatus, error) {
 	var (
 		workerStatus = &apisopenstack.WorkerStatus{
 			TypeMeta: metav1.TypeMeta{
 				APIVersion: apisopenstack.SchemeGroupVersion.String(),
 				Kind:       "WorkerStatus",
 			},
-			MachineImages: w.machineImages,
+			MachineImages:           w.machineImages,
+			ServerGroupDependencies: serverGroupDependencies,
 		}
 
 		workerStatusV1alpha1 = &openstackv1alpha1.WorkerStatus{
@@ -22,13 +22,16 @@ import (
 	apisopenstack "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
 	openstackapi "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
 	openstackapihelper "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack/helper"
+	openstackvalidation "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack/validation"
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal"
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/openstack"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -88,6 +91,9 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 		machineDeployments = worker.MachineDeployments{}
 		machineClasses     []map[string]interface{}
 		machineImages      []apisopenstack.MachineImage
+
+		serverGroupDependencies         []apisopenstack.ServerGroupDependency
+		obsoleteServerGroupDependencies []apisopenstack.ServerGroupDependency
 	)
 
 	machineClassSecretData, err := w.generateMachineClassSecretData(ctx)
@@ -117,9 +123,27 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 		cloudProfileName = w.cluster.CoreCloudProfile.Name
 	}
 
+	workerStatus, err := w.decodeWorkerStatus()
+	if err != nil {
+		return err
+	}
+
 	for _, pool := range w.worker.Spec.Pools {
 		zoneLen := len(pool.Zones)
 
+		workerConfig, err := w.decodeWorkerConfig(pool)
+		if err != nil {
+			return err
+		}
+
+		var serverGroupDependency *apisopenstack.ServerGroupDependency
+		if workerConfig.ServerGroup != nil {
+			if serverGroupDependency, err = w.ensureServerGroup(ctx, pool.Name, workerConfig.ServerGroup.Policy, workerStatus.ServerGroupDependencies); err != nil {
+				return err
+			}
+			serverGroupDependencies = append(serverGroupDependencies, *serverGroupDependency)
+		}
+
 		machineImage, err := w.findMachineImage(pool.MachineImage.Name, pool.MachineImage.Version, cloudProfileName)
 		if err != nil {
 			return err
@@ -149,6 +173,10 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 				},
 			}
 
+			if serverGroupDependency != nil {
+				machineClassSpec["serverGroupID"] = serverGroupDependency.ID
+			}
+
 			var (
 				machineClassSpecHash = worker.MachineClassHash(machineClassSpec, shootVersionMajorMinor)
 				deploymentName       = fmt.Sprintf("%s-%s-z%d", w.worker.Namespace, pool.Name, zoneIndex+1)
@@ -166,6 +194,8 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 				Labels:         pool.Labels,
 				Annotations:    pool.Annotations,
 				Taints:         pool.Taints,
+
+				MachineConfiguration: computeMachineConfiguration(workerConfig.MachineControllerManagerSettings),
 			})
 
 			machineClassSpec["name"] = className
@@ -179,9 +209,55 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 		}
 	}
 
+	// Server groups that are recorded in the worker status but are no longer used by any worker pool are deleted by the
+	// post reconcile hook once the machines have been rolled.
+	for _, dependency := range workerStatus.ServerGroupDependencies {
+		if !containsServerGroupDependency(serverGroupDependencies, dependency.ID) {
+			obsoleteServerGroupDependencies = append(obsoleteServerGroupDependencies, dependency)
+		}
+	}
+
 	w.machineDeployments = machineDeployments
 	w.machineClasses = machineClasses
 	w.machineImages = machineImages
+	w.serverGroupDependencies = serverGroupDependencies
+	w.obsoleteServerGroupDependencies = obsoleteServerGroupDependencies
 
 	return nil
 }
+
+func (w *workerDelegate) decodeWorkerConfig(pool extensionsv1alpha1.WorkerPool) (*apisopenstack.WorkerConfig, error) {
+	workerConfig := &apisopenstack.WorkerConfig{}
+	if pool.ProviderConfig == nil || pool.ProviderConfig.Raw == nil {
+		return workerConfig, nil
+	}
+
+	if _, _, err := w.decoder.Decode(pool.ProviderConfig.Raw, nil, workerConfig); err != nil {
+		return nil, errors.Wrapf(err, "could not decode provider config of worker pool %q", pool.Name)
+	}
+	if errs := openstackvalidation.ValidateWorkerConfig(workerConfig); len(errs) > 0 {
+		return nil, errors.Wrapf(errs.ToAggregate(), "invalid provider config of worker pool %q", pool.Name)
+	}
+	return workerConfig, nil
+}
+
+// computeMachineConfiguration computes the timeouts for the rollout of the machines out of the given settings.
+func computeMachineConfiguration(settings *apisopenstack.MachineControllerManagerSettings) *worker.MachineConfiguration {
+	if settings == nil {
+		return nil
+	}
+
+	return &worker.MachineConfiguration{
+		DrainTimeout:    settings.MachineDrainTimeout,
+		CreationTimeout: settings.MachineCreationTimeout,
+	}
+}
+
+func containsServerGroupDependency(dependencies []apisopenstack.ServerGroupDependency, id string) bool {
+	for _, dependency := range dependencies {
+		if dependency.ID == id {
+			return true
+		}
+	}
+	return false
+}
@@ -19,14 +19,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"time"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/config"
 	apisopenstack "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
 	openstackv1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack/v1alpha1"
 	. "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/controller/worker"
+	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal"
+	mockopenstackclient "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/mock/provider-openstack/openstack/client"
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/openstack"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/controller/worker"
+	"github.com/gardener/gardener-extensions/pkg/controller/worker/genericactuator"
 	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
 	mockkubernetes "github.com/gardener/gardener-extensions/pkg/mock/gardener/client/kubernetes"
 
@@ -34,6 +38,7 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	"github.com/golang/mock/gomock"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/servergroups"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
@@ -46,16 +51,22 @@ import (
 
 var _ = Describe("Machines", func() {
 	var (
-		ctrl         *gomock.Controller
-		c            *mockclient.MockClient
-		chartApplier *mockkubernetes.MockChartApplier
+		ctrl                   *gomock.Controller
+		c                      *mockclient.MockClient
+		statusWriter           *mockclient.MockStatusWriter
+		chartApplier           *mockkubernetes.MockChartApplier
+		openstackClientFactory *mockopenstackclient.MockFactory
+		computeClient          *mockopenstackclient.MockCompute
 	)
 
 	BeforeEach(func() {
 		ctrl = gomock.NewController(GinkgoT())
 
 		c = mockclient.NewMockClient(ctrl)
+		statusWriter = mockclient.NewMockStatusWriter(ctrl)
 		chartApplier = mockkubernetes.NewMockChartApplier(ctrl)
+		openstackClientFactory = mockopenstackclient.NewMockFactory(ctrl)
+		computeClient = mockopenstackclient.NewMockCompute(ctrl)
 	})
 
 	AfterEach(func() {
@@ -63,7 +74,7 @@ var _ = Describe("Machines", func() {
 	})
 
 	Context("workerDelegate", func() {
-		workerDelegate := NewWorkerDelegate(nil, nil, nil, nil, nil, "", nil, nil)
+		workerDelegate := NewWorkerDelegate(nil, nil, nil, nil, nil, "", nil, nil, nil)
 
 		Describe("#MachineClassKind", func() {
 			It("should return the correct kind of the machine class", func() {
@@ -278,7 +289,7 @@ var _ = Describe("Machines", func() {
 				_ = openstackv1alpha1.AddToScheme(scheme)
 				decoder = serializer.NewCodecFactory(scheme).UniversalDecoder()
 
-				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToCloudProfilesMapping, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToCloudProfilesMapping, chartApplier, "", openstackClientFactory, w, cluster)
 			})
 
 			It("should return the expected machine deployments", func() {
@@ -425,7 +436,7 @@ var _ = Describe("Machines", func() {
 				expectGetSecretCallToWork(c, openstackDomainName, openstackTenantName, openstackUserName, openstackPassword)
 
 				cluster.CoreShoot.Spec.Kubernetes.Version = "invalid"
-				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToCloudProfilesMapping, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToCloudProfilesMapping, chartApplier, "", openstackClientFactory, w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -437,7 +448,7 @@ var _ = Describe("Machines", func() {
 
 				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{}
 
-				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToCloudProfilesMapping, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToCloudProfilesMapping, chartApplier, "", openstackClientFactory, w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -451,7 +462,7 @@ var _ = Describe("Machines", func() {
 					Raw: encode(&apisopenstack.InfrastructureStatus{}),
 				}
 
-				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToCloudProfilesMapping, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToCloudProfilesMapping, chartApplier, "", openstackClientFactory, w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -463,12 +474,204 @@ var _ = Describe("Machines", func() {
 
 				cluster.CoreCloudProfile.Name = "another-cloud-profile"
 
-				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToCloudProfilesMapping, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToCloudProfilesMapping, chartApplier, "", openstackClientFactory, w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
 				Expect(result).To(BeNil())
 			})
+
+			It("should set the machine configuration of the pools with machine controller manager settings", func() {
+				expectGetSecretCallToWork(c, openstackDomainName, openstackTenantName, openstackUserName, openstackPassword)
+
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&openstackv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: openstackv1alpha1.SchemeGroupVersion.String(),
+							Kind:       "WorkerConfig",
+						},
+						MachineControllerManagerSettings: &openstackv1alpha1.MachineControllerManagerSettings{
+							MachineDrainTimeout: &metav1.Duration{Duration: time.Hour},
+						},
+					}),
+				}
+
+				machineDeployments, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(machineDeployments).To(HaveLen(4))
+				Expect(machineDeployments[0].MachineConfiguration).To(Equal(&worker.MachineConfiguration{
+					DrainTimeout: &metav1.Duration{Duration: time.Hour},
+				}))
+				Expect(machineDeployments[2].MachineConfiguration).To(BeNil())
+			})
+
+			Context("server groups", func() {
+				var (
+					serverGroupID   string
+					serverGroupName string
+
+					expectNewComputeClient = func() {
+						expectGetSecretCallToWork(c, openstackDomainName, openstackTenantName, openstackUserName, openstackPassword)
+						openstackClientFactory.EXPECT().
+							NewCompute(&internal.Credentials{
+								DomainName: openstackDomainName,
+								TenantName: openstackTenantName,
+								Username:   openstackUserName,
+								Password:   openstackPassword,
+								AuthURL:    openstackAuthURL,
+							}, region).
+							Return(computeClient, nil)
+					}
+				)
+
+				BeforeEach(func() {
+					serverGroupID = "server-group-id"
+					serverGroupName = fmt.Sprintf("%s-%s-anti-affinity", namespace, namePool1)
+
+					w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+						Raw: encode(&openstackv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								APIVersion: openstackv1alpha1.SchemeGroupVersion.String(),
+								Kind:       "WorkerConfig",
+							},
+							ServerGroup: &openstackv1alpha1.ServerGroup{
+								Policy: openstackv1alpha1.ServerGroupPolicyAntiAffinity,
+							},
+						}),
+					}
+				})
+
+				It("should create a server group and reference it in the machine classes of the pool", func() {
+					expectGetSecretCallToWork(c, openstackDomainName, openstackTenantName, openstackUserName, openstackPassword)
+					expectNewComputeClient()
+					computeClient.EXPECT().FindServerGroupByName(context.TODO(), serverGroupName).Return(nil, nil)
+					computeClient.EXPECT().
+						CreateServerGroup(context.TODO(), serverGroupName, string(apisopenstack.ServerGroupPolicyAntiAffinity)).
+						Return(&servergroups.ServerGroup{ID: serverGroupID, Name: serverGroupName}, nil)
+
+					var machineClasses []map[string]interface{}
+					chartApplier.
+						EXPECT().
+						ApplyChart(context.TODO(), filepath.Join(openstack.InternalChartsPath, "machineclass"), namespace, "machineclass", gomock.Any(), nil).
+						DoAndReturn(func(_ context.Context, _, _, _ string, values, _ map[string]interface{}) error {
+							machineClasses = values["machineClasses"].([]map[string]interface{})
+							return nil
+						})
+
+					Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+					Expect(machineClasses).To(HaveLen(4))
+					Expect(machineClasses[0]).To(HaveKeyWithValue("serverGroupID", serverGroupID))
+					Expect(machineClasses[1]).To(HaveKeyWithValue("serverGroupID", serverGroupID))
+					Expect(machineClasses[2]).NotTo(HaveKey("serverGroupID"))
+					Expect(machineClasses[3]).NotTo(HaveKey("serverGroupID"))
+
+					workerStatus, err := workerDelegate.GetMachineImages(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(workerStatus.(*openstackv1alpha1.WorkerStatus).ServerGroupDependencies).To(Equal([]openstackv1alpha1.ServerGroupDependency{
+						{PoolName: namePool1, ID: serverGroupID, Name: serverGroupName},
+					}))
+				})
+
+				It("should reuse an existing server group with the same name", func() {
+					expectGetSecretCallToWork(c, openstackDomainName, openstackTenantName, openstackUserName, openstackPassword)
+					expectNewComputeClient()
+					computeClient.EXPECT().
+						FindServerGroupByName(context.TODO(), serverGroupName).
+						Return(&servergroups.ServerGroup{ID: serverGroupID, Name: serverGroupName}, nil)
+
+					_, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+
+					workerStatus, err := workerDelegate.GetMachineImages(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(workerStatus.(*openstackv1alpha1.WorkerStatus).ServerGroupDependencies).To(Equal([]openstackv1alpha1.ServerGroupDependency{
+						{PoolName: namePool1, ID: serverGroupID, Name: serverGroupName},
+					}))
+				})
+
+				It("should reuse the server groups of the worker status and delete obsolete ones in the post reconcile hook", func() {
+					obsoleteServerGroup := openstackv1alpha1.ServerGroupDependency{PoolName: namePool2, ID: "obsolete-id", Name: "obsolete-name"}
+					w.Status.ProviderStatus = &runtime.RawExtension{
+						Raw: encode(&openstackv1alpha1.WorkerStatus{
+							TypeMeta: metav1.TypeMeta{
+								APIVersion: openstackv1alpha1.SchemeGroupVersion.String(),
+								Kind:       "WorkerStatus",
+							},
+							ServerGroupDependencies: []openstackv1alpha1.ServerGroupDependency{
+								{PoolName: namePool1, ID: serverGroupID, Name: serverGroupName},
+								obsoleteServerGroup,
+							},
+						}),
+					}
+
+					expectGetSecretCallToWork(c, openstackDomainName, openstackTenantName, openstackUserName, openstackPassword)
+
+					_, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+
+					workerStatus, err := workerDelegate.GetMachineImages(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(workerStatus.(*openstackv1alpha1.WorkerStatus).ServerGroupDependencies).To(Equal([]openstackv1alpha1.ServerGroupDependency{
+						{PoolName: namePool1, ID: serverGroupID, Name: serverGroupName},
+						obsoleteServerGroup,
+					}))
+
+					expectNewComputeClient()
+					computeClient.EXPECT().DeleteServerGroupIfExists(context.TODO(), obsoleteServerGroup.ID).Return(nil)
+					c.EXPECT().Get(context.TODO(), gomock.Any(), w).Return(nil)
+					c.EXPECT().Status().Return(statusWriter)
+					statusWriter.EXPECT().Update(context.TODO(), w).DoAndReturn(func(_ context.Context, obj runtime.Object, _ ...client.UpdateOptionFunc) error {
+						workerStatus := obj.(*extensionsv1alpha1.Worker).Status.ProviderStatus.Object.(*openstackv1alpha1.WorkerStatus)
+						Expect(workerStatus.ServerGroupDependencies).To(Equal([]openstackv1alpha1.ServerGroupDependency{
+							{PoolName: namePool1, ID: serverGroupID, Name: serverGroupName},
+						}))
+						return nil
+					})
+
+					Expect(workerDelegate.(genericactuator.WorkerDelegateHooks).PostReconcileHook(context.TODO())).To(Succeed())
+				})
+
+				It("should delete all server groups of the worker status in the post delete hook", func() {
+					w.Status.ProviderStatus = &runtime.RawExtension{
+						Raw: encode(&openstackv1alpha1.WorkerStatus{
+							TypeMeta: metav1.TypeMeta{
+								APIVersion: openstackv1alpha1.SchemeGroupVersion.String(),
+								Kind:       "WorkerStatus",
+							},
+							ServerGroupDependencies: []openstackv1alpha1.ServerGroupDependency{
+								{PoolName: namePool1, ID: serverGroupID, Name: serverGroupName},
+								{PoolName: namePool2, ID: "other-id", Name: "other-name"},
+							},
+						}),
+					}
+
+					expectNewComputeClient()
+					computeClient.EXPECT().DeleteServerGroupIfExists(context.TODO(), serverGroupID).Return(nil)
+					computeClient.EXPECT().DeleteServerGroupIfExists(context.TODO(), "other-id").Return(nil)
+
+					Expect(workerDelegate.(genericactuator.WorkerDelegateHooks).PostDeleteHook(context.TODO())).To(Succeed())
+				})
+
+				It("should fail because the worker config is invalid", func() {
+					expectGetSecretCallToWork(c, openstackDomainName, openstackTenantName, openstackUserName, openstackPassword)
+
+					w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+						Raw: encode(&openstackv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								APIVersion: openstackv1alpha1.SchemeGroupVersion.String(),
+								Kind:       "WorkerConfig",
+							},
+							ServerGroup: &openstackv1alpha1.ServerGroup{
+								Policy: "affinity",
+							},
+						}),
+					}
+
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).To(HaveOccurred())
+					Expect(result).To(BeNil())
+				})
+			})
 		})
 	})
 })
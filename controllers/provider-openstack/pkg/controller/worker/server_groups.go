@@ -0,0 +1,166 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	apisopenstack "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal"
+	openstackclient "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/openstack/client"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/util"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+)
+
+// PostReconcileHook deletes the server groups that are no longer used by any worker pool and removes them from the
+// worker status.
+func (w *workerDelegate) PostReconcileHook(ctx context.Context) error {
+	if w.machineClasses == nil {
+		if err := w.generateMachineConfig(ctx); err != nil {
+			return err
+		}
+	}
+
+	if len(w.obsoleteServerGroupDependencies) == 0 {
+		return nil
+	}
+
+	if err := w.deleteServerGroups(ctx, w.obsoleteServerGroupDependencies); err != nil {
+		return err
+	}
+
+	workerStatus, err := w.computeWorkerStatus(w.serverGroupDependencies)
+	if err != nil {
+		return err
+	}
+	if err := extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, w.client, w.worker, func() error {
+		w.worker.Status.ProviderStatus = &runtime.RawExtension{Object: workerStatus}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	w.obsoleteServerGroupDependencies = nil
+	return nil
+}
+
+// PostDeleteHook deletes all server groups that have been created for the worker pools.
+func (w *workerDelegate) PostDeleteHook(ctx context.Context) error {
+	workerStatus, err := w.decodeWorkerStatus()
+	if err != nil {
+		return err
+	}
+
+	return w.deleteServerGroups(ctx, workerStatus.ServerGroupDependencies)
+}
+
+// ensureServerGroup ensures that a server group with the given policy exists for the worker pool <poolName>. Server
+// groups that are already recorded in <existingDependencies> are reused without contacting OpenStack.
+func (w *workerDelegate) ensureServerGroup(ctx context.Context, poolName string, policy apisopenstack.ServerGroupPolicy, existingDependencies []apisopenstack.ServerGroupDependency) (*apisopenstack.ServerGroupDependency, error) {
+	name := serverGroupName(w.worker.Namespace, poolName, policy)
+
+	for _, dependency := range existingDependencies {
+		if dependency.PoolName == poolName && dependency.Name == name {
+			return &dependency, nil
+		}
+	}
+
+	computeClient, err := w.getComputeClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	serverGroup, err := computeClient.FindServerGroupByName(ctx, name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not look up server group %q", name)
+	}
+	if serverGroup == nil {
+		if serverGroup, err = computeClient.CreateServerGroup(ctx, name, string(policy)); err != nil {
+			return nil, errors.Wrapf(err, "could not create server group %q", name)
+		}
+	}
+
+	return &apisopenstack.ServerGroupDependency{
+		PoolName: poolName,
+		ID:       serverGroup.ID,
+		Name:     serverGroup.Name,
+	}, nil
+}
+
+func (w *workerDelegate) deleteServerGroups(ctx context.Context, dependencies []apisopenstack.ServerGroupDependency) error {
+	if len(dependencies) == 0 {
+		return nil
+	}
+
+	computeClient, err := w.getComputeClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, dependency := range dependencies {
+		if err := computeClient.DeleteServerGroupIfExists(ctx, dependency.ID); err != nil {
+			return errors.Wrapf(err, "could not delete server group %q of worker pool %q", dependency.Name, dependency.PoolName)
+		}
+	}
+	return nil
+}
+
+func (w *workerDelegate) getComputeClient(ctx context.Context) (openstackclient.Compute, error) {
+	if w.computeClient != nil {
+		return w.computeClient, nil
+	}
+
+	credentials, err := internal.GetCredentials(ctx, w.client, w.worker.Spec.SecretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	cloudProfileConfig, err := internal.CloudProfileConfigFromCloudProfile(w.cluster.CoreCloudProfile)
+	if err != nil {
+		return nil, err
+	}
+	credentials.AuthURL = cloudProfileConfig.KeyStoneURL
+
+	computeClient, err := w.openstackClientFactory.NewCompute(credentials, w.worker.Spec.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	w.computeClient = computeClient
+	return computeClient, nil
+}
+
+func (w *workerDelegate) decodeWorkerStatus() (*apisopenstack.WorkerStatus, error) {
+	workerStatus := &apisopenstack.WorkerStatus{}
+	if w.worker.Status.ProviderStatus == nil || w.worker.Status.ProviderStatus.Raw == nil {
+		return workerStatus, nil
+	}
+
+	if _, _, err := w.decoder.Decode(w.worker.Status.ProviderStatus.Raw, nil, workerStatus); err != nil {
+		return nil, errors.Wrapf(err, "could not decode worker status of worker '%s'", util.ObjectName(w.worker))
+	}
+	return workerStatus, nil
+}
+
+// serverGroupName computes the name of the server group of a worker pool. The policy is part of the name as the
+// policy of an existing server group cannot be changed.
+func serverGroupName(namespace, poolName string, policy apisopenstack.ServerGroupPolicy) string {
+	return fmt.Sprintf("%s-%s-%s", namespace, poolName, policy)
+}
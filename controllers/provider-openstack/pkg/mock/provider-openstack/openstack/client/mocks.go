@@ -0,0 +1,119 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/openstack/client (interfaces: Compute,Factory)
+
+// Package client is a generated GoMock package.
+package client
+
+import (
+	context "context"
+	internal "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal"
+	client "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/openstack/client"
+	gomock "github.com/golang/mock/gomock"
+	servergroups "github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/servergroups"
+	reflect "reflect"
+)
+
+// MockCompute is a mock of Compute interface
+type MockCompute struct {
+	ctrl     *gomock.Controller
+	recorder *MockComputeMockRecorder
+}
+
+// MockComputeMockRecorder is the mock recorder for MockCompute
+type MockComputeMockRecorder struct {
+	mock *MockCompute
+}
+
+// NewMockCompute creates a new mock instance
+func NewMockCompute(ctrl *gomock.Controller) *MockCompute {
+	mock := &MockCompute{ctrl: ctrl}
+	mock.recorder = &MockComputeMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockCompute) EXPECT() *MockComputeMockRecorder {
+	return m.recorder
+}
+
+// CreateServerGroup mocks base method
+func (m *MockCompute) CreateServerGroup(arg0 context.Context, arg1, arg2 string) (*servergroups.ServerGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateServerGroup", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*servergroups.ServerGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateServerGroup indicates an expected call of CreateServerGroup
+func (mr *MockComputeMockRecorder) CreateServerGroup(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServerGroup", reflect.TypeOf((*MockCompute)(nil).CreateServerGroup), arg0, arg1, arg2)
+}
+
+// DeleteServerGroupIfExists mocks base method
+func (m *MockCompute) DeleteServerGroupIfExists(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteServerGroupIfExists", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteServerGroupIfExists indicates an expected call of DeleteServerGroupIfExists
+func (mr *MockComputeMockRecorder) DeleteServerGroupIfExists(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServerGroupIfExists", reflect.TypeOf((*MockCompute)(nil).DeleteServerGroupIfExists), arg0, arg1)
+}
+
+// FindServerGroupByName mocks base method
+func (m *MockCompute) FindServerGroupByName(arg0 context.Context, arg1 string) (*servergroups.ServerGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindServerGroupByName", arg0, arg1)
+	ret0, _ := ret[0].(*servergroups.ServerGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindServerGroupByName indicates an expected call of FindServerGroupByName
+func (mr *MockComputeMockRecorder) FindServerGroupByName(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindServerGroupByName", reflect.TypeOf((*MockCompute)(nil).FindServerGroupByName), arg0, arg1)
+}
+
+// MockFactory is a mock of Factory interface
+type MockFactory struct {
+	ctrl     *gomock.Controller
+	recorder *MockFactoryMockRecorder
+}
+
+// MockFactoryMockRecorder is the mock recorder for MockFactory
+type MockFactoryMockRecorder struct {
+	mock *MockFactory
+}
+
+// NewMockFactory creates a new mock instance
+func NewMockFactory(ctrl *gomock.Controller) *MockFactory {
+	mock := &MockFactory{ctrl: ctrl}
+	mock.recorder = &MockFactoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockFactory) EXPECT() *MockFactoryMockRecorder {
+	return m.recorder
+}
+
+// NewCompute mocks base method
+func (m *MockFactory) NewCompute(arg0 *internal.Credentials, arg1 string) (client.Compute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCompute", arg0, arg1)
+	ret0, _ := ret[0].(client.Compute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewCompute indicates an expected call of NewCompute
+func (mr *MockFactoryMockRecorder) NewCompute(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCompute", reflect.TypeOf((*MockFactory)(nil).NewCompute), arg0, arg1)
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal"
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/utils/openstack/clientconfig"
+)
+
+// newProviderClient creates an authenticated openstack provider client from credentials.
+func newProviderClient(credentials *internal.Credentials, region string) (*gophercloud.ProviderClient, error) {
+	opts := &clientconfig.ClientOpts{
+		AuthInfo: &clientconfig.AuthInfo{
+			AuthURL:     credentials.AuthURL,
+			Username:    credentials.Username,
+			Password:    credentials.Password,
+			ProjectName: credentials.TenantName,
+			DomainName:  credentials.DomainName,
+		},
+		RegionName: region,
+	}
+	authOpts, err := clientconfig.AuthOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// AllowReauth should be set to true if you grant permission for Gophercloud to
+	// cache your credentials in memory, and to allow Gophercloud to attempt to
+	// re-authenticate automatically if/when your token expires.
+	authOpts.AllowReauth = true
+
+	return openstack.AuthenticatedClient(*authOpts)
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal"
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/servergroups"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// computeMicroversion is the compute API microversion that is required for the soft-anti-affinity server group policy.
+const computeMicroversion = "2.15"
+
+// FactoryFunc is a function that implements the Factory interface. Used for consuming the
+// `NewComputeClientFromCredentials` function.
+type FactoryFunc func(credentials *internal.Credentials, region string) (*ComputeClient, error)
+
+// NewCompute implements Factory.
+func (f FactoryFunc) NewCompute(credentials *internal.Credentials, region string) (Compute, error) {
+	return f(credentials, region)
+}
+
+// DefaultFactory instantiates a default Factory.
+func DefaultFactory() Factory {
+	return FactoryFunc(NewComputeClientFromCredentials)
+}
+
+// NewComputeClientFromCredentials creates the compute client from credentials.
+func NewComputeClientFromCredentials(credentials *internal.Credentials, region string) (*ComputeClient, error) {
+	provider, err := newProviderClient(credentials, region)
+	if err != nil {
+		return nil, err
+	}
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{Region: region})
+	if err != nil {
+		return nil, err
+	}
+	client.Microversion = computeMicroversion
+
+	return &ComputeClient{
+		client: client,
+	}, nil
+}
+
+// CreateServerGroup creates a server group with name <name> and the scheduling policy <policy>.
+func (c *ComputeClient) CreateServerGroup(ctx context.Context, name, policy string) (*servergroups.ServerGroup, error) {
+	return servergroups.Create(c.client, servergroups.CreateOpts{
+		Name:     name,
+		Policies: []string{policy},
+	}).Extract()
+}
+
+// FindServerGroupByName returns the server group with name <name>. If it does not exist, nil is returned.
+func (c *ComputeClient) FindServerGroupByName(ctx context.Context, name string) (*servergroups.ServerGroup, error) {
+	var serverGroup *servergroups.ServerGroup
+
+	err := servergroups.List(c.client).EachPage(func(page pagination.Page) (bool, error) {
+		serverGroups, err := servergroups.ExtractServerGroups(page)
+		if err != nil {
+			return false, err
+		}
+		for _, sg := range serverGroups {
+			if sg.Name == name {
+				serverGroup = &sg
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return serverGroup, nil
+}
+
+// DeleteServerGroupIfExists deletes the server group with ID <id>. If it does not exist, no error is returned.
+func (c *ComputeClient) DeleteServerGroupIfExists(ctx context.Context, id string) error {
+	if err := servergroups.Delete(c.client, id).ExtractErr(); err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
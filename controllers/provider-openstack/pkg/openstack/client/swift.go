@@ -23,12 +23,15 @@ import (
 	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/containers"
 	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
 	"github.com/gophercloud/gophercloud/pagination"
-	"github.com/gophercloud/utils/openstack/clientconfig"
 
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// maxDeleteContainerAttempts is the maximum number of attempts to empty and delete a container. Objects that are
+// written concurrently may prevent the deletion of a container.
+const maxDeleteContainerAttempts = 3
+
 // NewStorageClientFromSecretRef retrieves the openstack client from specified by the secret reference.
 func NewStorageClientFromSecretRef(ctx context.Context, c client.Client, secretRef corev1.SecretReference, region string) (*StorageClient, error) {
 	credentials, err := internal.GetCredentials(ctx, c, secretRef)
@@ -41,31 +44,10 @@ func NewStorageClientFromSecretRef(ctx context.Context, c client.Client, secretR
 
 // newStorageClientFromCredentials create the storage client from credentials.
 func newStorageClientFromCredentials(credentials *internal.Credentials, region string) (*StorageClient, error) {
-	opts := &clientconfig.ClientOpts{
-		AuthInfo: &clientconfig.AuthInfo{
-			AuthURL:     credentials.AuthURL,
-			Username:    credentials.Username,
-			Password:    credentials.Password,
-			ProjectName: credentials.TenantName,
-			DomainName:  credentials.DomainName,
-		},
-		RegionName: region,
-	}
-	authOpts, err := clientconfig.AuthOptions(opts)
+	provider, err := newProviderClient(credentials, region)
 	if err != nil {
 		return nil, err
 	}
-
-	// AllowReauth should be set to true if you grant permission for Gophercloud to
-	// cache your credentials in memory, and to allow Gophercloud to attempt to
-	// re-authenticate automatically if/when your token expires.
-	authOpts.AllowReauth = true
-
-	provider, err := openstack.AuthenticatedClient(*authOpts)
-	if err != nil {
-		return nil, err
-
-	}
 	client, err := openstack.NewObjectStorageV1(provider, gophercloud.EndpointOpts{})
 	if err != nil {
 		return nil, err
@@ -111,9 +93,10 @@ func (s *StorageClient) DeleteObjectsWithPrefix(ctx context.Context, container,
 func (s *StorageClient) deleteObjectIfExists(ctx context.Context, container, objectName string) error {
 	result := objects.Delete(s.client, container, objectName, nil)
 	if _, err := result.Extract(); err != nil {
-		if _, ok := result.Err.(gophercloud.Err404er); ok {
-			return result.Err
+		if _, ok := result.Err.(gophercloud.ErrDefault404); ok {
+			return nil
 		}
+		return err
 	}
 	return nil
 }
@@ -130,22 +113,68 @@ func (s *StorageClient) CreateContainerIfNotExists(ctx context.Context, containe
 	return nil
 }
 
+// VersionsContainerName returns the name of the container that keeps the old object versions of the given <container>.
+func VersionsContainerName(container string) string {
+	return container + "-versions"
+}
+
+// UpdateContainerConfig converges the versioning and read ACL configuration of the openstack blob container with
+// name <container> to the given <config>. With versioning, old object versions are kept in the container returned by
+// VersionsContainerName.
+func (s *StorageClient) UpdateContainerConfig(ctx context.Context, container string, config *ContainerConfig) error {
+	opts := containerUpdateOpts{removeContainerRead: config.BlockPublicAccess}
+	if config.Versioning {
+		if err := s.CreateContainerIfNotExists(ctx, VersionsContainerName(container)); err != nil {
+			return err
+		}
+		opts.HistoryLocation = VersionsContainerName(container)
+	} else {
+		opts.RemoveHistoryLocation = "remove"
+	}
+
+	_, err := containers.Update(s.client, container, opts).Extract()
+	return err
+}
+
+// containerUpdateOpts extends the containers.UpdateOpts by the removal of the read ACL of a container.
+type containerUpdateOpts struct {
+	containers.UpdateOpts
+	removeContainerRead bool
+}
+
+// ToContainerUpdateMap implements containers.UpdateOptsBuilder.
+func (opts containerUpdateOpts) ToContainerUpdateMap() (map[string]string, error) {
+	headers, err := opts.UpdateOpts.ToContainerUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+	if opts.removeContainerRead {
+		headers["X-Remove-Container-Read"] = "remove"
+	}
+	return headers, nil
+}
+
 // DeleteContainerIfExists deletes the openstack blob container with name <container>. If it does not exist,
-// no error is returned.
+// no error is returned. A container that is not empty is emptied before its deletion is retried.
 func (s *StorageClient) DeleteContainerIfExists(ctx context.Context, container string) error {
-	result := containers.Delete(s.client, container)
-	if _, err := result.Extract(); err != nil {
+	for attempt := 1; ; attempt++ {
+		result := containers.Delete(s.client, container)
+		if _, err := result.Extract(); err == nil {
+			return nil
+		}
+
 		switch result.Err.(type) {
 		case gophercloud.ErrDefault404:
 			return nil
 		case gophercloud.ErrDefault409:
+			if attempt >= maxDeleteContainerAttempts {
+				return result.Err
+			}
 			if err := s.DeleteObjectsWithPrefix(ctx, container, ""); err != nil {
 				return err
 			}
-			return s.DeleteContainerIfExists(ctx, container)
 		default:
-			return err
+			return result.Err
 		}
 	}
-	return nil
 }
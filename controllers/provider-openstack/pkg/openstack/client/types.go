@@ -17,7 +17,9 @@ package client
 import (
 	"context"
 
+	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal"
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/servergroups"
 )
 
 // StorageClient represents a Azure storage client.
@@ -30,4 +32,31 @@ type Storage interface {
 	DeleteObjectsWithPrefix(ctx context.Context, container, prefix string) error
 	CreateContainerIfNotExists(ctx context.Context, container string) error
 	DeleteContainerIfExists(ctx context.Context, container string) error
+	UpdateContainerConfig(ctx context.Context, container string, config *ContainerConfig) error
+}
+
+// ComputeClient represents an Openstack compute client.
+type ComputeClient struct {
+	client *gophercloud.ServiceClient
+}
+
+// Compute represents an Openstack compute client.
+type Compute interface {
+	CreateServerGroup(ctx context.Context, name, policy string) (*servergroups.ServerGroup, error)
+	FindServerGroupByName(ctx context.Context, name string) (*servergroups.ServerGroup, error)
+	DeleteServerGroupIfExists(ctx context.Context, id string) error
+}
+
+// Factory is the factory to instantiate Openstack clients.
+type Factory interface {
+	// NewCompute creates a new compute client from the given credentials and region.
+	NewCompute(credentials *internal.Credentials, region string) (Compute, error)
+}
+
+// ContainerConfig contains the desired configuration of a Swift container.
+type ContainerConfig struct {
+	// Versioning indicates whether object versioning is enabled for the container.
+	Versioning bool
+	// BlockPublicAccess indicates whether the read ACL of the container is removed.
+	BlockPublicAccess bool
 }
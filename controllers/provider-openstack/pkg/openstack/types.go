@@ -28,6 +28,22 @@ const (
 	CloudControllerImageName = "openstack-cloud-controller-manager"
 	// ETCDBackupRestoreImageName is the name of the etcd backup and restore image.
 	ETCDBackupRestoreImageName = "etcd-backup-restore"
+	// CSIAttacherImageName is the name of the CSI attacher image.
+	CSIAttacherImageName = "csi-attacher"
+	// CSINodeDriverRegistrarImageName is the name of the CSI driver registrar image.
+	CSINodeDriverRegistrarImageName = "csi-node-driver-registrar"
+	// CSIProvisionerImageName is the name of the CSI provisioner image.
+	CSIProvisionerImageName = "csi-provisioner"
+	// CSISnapshotterImageName is the name of the CSI snapshotter image.
+	CSISnapshotterImageName = "csi-snapshotter"
+	// CSIDriverImageName is the name of the Cinder CSI driver image.
+	CSIDriverImageName = "cinder-csi-plugin"
+
+	// CSIMinimumKubernetesVersion is the minimum Kubernetes version for which the Cinder CSI driver can be deployed
+	// and the in-tree volume plugin can be migrated to it, see the storage section of the ControlPlaneConfig.
+	CSIMinimumKubernetesVersion = "1.14"
+	// CSIMigrationFeatureGate is the name of the feature gate that migrates the in-tree Cinder volume plugin to CSI.
+	CSIMigrationFeatureGate = "CSIMigrationOpenStack"
 
 	// AuthURL is a constant for the key in a cloud provider secret that holds the OpenStack auth url.
 	AuthURL = "authURL"
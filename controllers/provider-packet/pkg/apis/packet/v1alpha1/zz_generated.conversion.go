@@ -24,6 +24,7 @@ import (
 	unsafe "unsafe"
 
 	packet "github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/apis/packet"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -75,6 +76,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MachineControllerManagerSettings)(nil), (*packet.MachineControllerManagerSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineControllerManagerSettings_To_packet_MachineControllerManagerSettings(a.(*MachineControllerManagerSettings), b.(*packet.MachineControllerManagerSettings), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*packet.MachineControllerManagerSettings)(nil), (*MachineControllerManagerSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_packet_MachineControllerManagerSettings_To_v1alpha1_MachineControllerManagerSettings(a.(*packet.MachineControllerManagerSettings), b.(*MachineControllerManagerSettings), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MachineImage)(nil), (*packet.MachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_MachineImage_To_packet_MachineImage(a.(*MachineImage), b.(*packet.MachineImage), scope)
 	}); err != nil {
@@ -105,6 +116,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WorkerConfig)(nil), (*packet.WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerConfig_To_packet_WorkerConfig(a.(*WorkerConfig), b.(*packet.WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*packet.WorkerConfig)(nil), (*WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_packet_WorkerConfig_To_v1alpha1_WorkerConfig(a.(*packet.WorkerConfig), b.(*WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*WorkerStatus)(nil), (*packet.WorkerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_WorkerStatus_To_packet_WorkerStatus(a.(*WorkerStatus), b.(*packet.WorkerStatus), scope)
 	}); err != nil {
@@ -194,6 +215,28 @@ func Convert_packet_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in *pa
 	return autoConvert_packet_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_MachineControllerManagerSettings_To_packet_MachineControllerManagerSettings(in *MachineControllerManagerSettings, out *packet.MachineControllerManagerSettings, s conversion.Scope) error {
+	out.MachineDrainTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineDrainTimeout))
+	out.MachineCreationTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineCreationTimeout))
+	return nil
+}
+
+// Convert_v1alpha1_MachineControllerManagerSettings_To_packet_MachineControllerManagerSettings is an autogenerated conversion function.
+func Convert_v1alpha1_MachineControllerManagerSettings_To_packet_MachineControllerManagerSettings(in *MachineControllerManagerSettings, out *packet.MachineControllerManagerSettings, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineControllerManagerSettings_To_packet_MachineControllerManagerSettings(in, out, s)
+}
+
+func autoConvert_packet_MachineControllerManagerSettings_To_v1alpha1_MachineControllerManagerSettings(in *packet.MachineControllerManagerSettings, out *MachineControllerManagerSettings, s conversion.Scope) error {
+	out.MachineDrainTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineDrainTimeout))
+	out.MachineCreationTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineCreationTimeout))
+	return nil
+}
+
+// Convert_packet_MachineControllerManagerSettings_To_v1alpha1_MachineControllerManagerSettings is an autogenerated conversion function.
+func Convert_packet_MachineControllerManagerSettings_To_v1alpha1_MachineControllerManagerSettings(in *packet.MachineControllerManagerSettings, out *MachineControllerManagerSettings, s conversion.Scope) error {
+	return autoConvert_packet_MachineControllerManagerSettings_To_v1alpha1_MachineControllerManagerSettings(in, out, s)
+}
+
 func autoConvert_v1alpha1_MachineImage_To_packet_MachineImage(in *MachineImage, out *packet.MachineImage, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Version = in.Version
@@ -262,6 +305,26 @@ func Convert_packet_MachineImages_To_v1alpha1_MachineImages(in *packet.MachineIm
 	return autoConvert_packet_MachineImages_To_v1alpha1_MachineImages(in, out, s)
 }
 
+func autoConvert_v1alpha1_WorkerConfig_To_packet_WorkerConfig(in *WorkerConfig, out *packet.WorkerConfig, s conversion.Scope) error {
+	out.MachineControllerManagerSettings = (*packet.MachineControllerManagerSettings)(unsafe.Pointer(in.MachineControllerManagerSettings))
+	return nil
+}
+
+// Convert_v1alpha1_WorkerConfig_To_packet_WorkerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerConfig_To_packet_WorkerConfig(in *WorkerConfig, out *packet.WorkerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerConfig_To_packet_WorkerConfig(in, out, s)
+}
+
+func autoConvert_packet_WorkerConfig_To_v1alpha1_WorkerConfig(in *packet.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	out.MachineControllerManagerSettings = (*MachineControllerManagerSettings)(unsafe.Pointer(in.MachineControllerManagerSettings))
+	return nil
+}
+
+// Convert_packet_WorkerConfig_To_v1alpha1_WorkerConfig is an autogenerated conversion function.
+func Convert_packet_WorkerConfig_To_v1alpha1_WorkerConfig(in *packet.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	return autoConvert_packet_WorkerConfig_To_v1alpha1_WorkerConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_WorkerStatus_To_packet_WorkerStatus(in *WorkerStatus, out *packet.WorkerStatus, s conversion.Scope) error {
 	out.MachineImages = *(*[]packet.MachineImage)(unsafe.Pointer(&in.MachineImages))
 	return nil
@@ -21,7 +21,9 @@ import (
 	"github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/imagevector"
 	"github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/packet"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
 	"github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	glogger "github.com/gardener/gardener/pkg/logger"
@@ -41,6 +43,8 @@ import (
 type actuator struct {
 	logger logr.Logger
 
+	terraformerFactory extensionsterraformer.Factory
+
 	restConfig *rest.Config
 
 	client  client.Client
@@ -50,8 +54,17 @@ type actuator struct {
 
 // NewActuator creates a new Actuator that updates the status of the handled Infrastructure resources.
 func NewActuator() infrastructure.Actuator {
+	return NewActuatorWithDeps(
+		log.Log.WithName("infrastructure-actuator"),
+		extensionsterraformer.DefaultFactory(),
+	)
+}
+
+// NewActuatorWithDeps creates a new infrastructure.Actuator with the given dependencies.
+func NewActuatorWithDeps(logger logr.Logger, terraformerFactory extensionsterraformer.Factory) infrastructure.Actuator {
 	return &actuator{
-		logger: log.Log.WithName("infrastructure-actuator"),
+		logger:             logger,
+		terraformerFactory: terraformerFactory,
 	}
 }
 
@@ -71,18 +84,32 @@ func (a *actuator) InjectConfig(config *rest.Config) error {
 	return nil
 }
 
+// errorMatchers classify the errors of the Packet API and of the Terraform jobs.
+var errorMatchers = []controllererrors.Matcher{
+	controllererrors.TerraformMatcher(controllererrors.PacketMatcher),
+	controllererrors.PacketMatcher,
+}
+
 func (a *actuator) Reconcile(ctx context.Context, config *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
-	return a.reconcile(ctx, config, cluster)
+	return controllererrors.Classify(a.reconcile(ctx, config, cluster), errorMatchers...)
 }
 
 func (a *actuator) Delete(ctx context.Context, config *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
-	return a.delete(ctx, config, cluster)
+	return controllererrors.Classify(a.delete(ctx, config, cluster), errorMatchers...)
+}
+
+func (a *actuator) Migrate(ctx context.Context, config *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	return a.migrate(ctx, config, cluster)
+}
+
+func (a *actuator) Restore(ctx context.Context, config *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	return a.restore(ctx, config, cluster)
 }
 
 // Helper functions
 
-func (a *actuator) newTerraformer(purpose, namespace, name string) (*terraformer.Terraformer, error) {
-	t, err := terraformer.NewForConfig(glogger.NewLogger("info"), a.restConfig, purpose, namespace, name, imagevector.TerraformerImage())
+func (a *actuator) newTerraformer(purpose, namespace, name string) (extensionsterraformer.Interface, error) {
+	t, err := a.terraformerFactory.NewForConfig(glogger.NewLogger("info"), a.restConfig, purpose, namespace, name, imagevector.TerraformerImage())
 	if err != nil {
 		return nil, err
 	}
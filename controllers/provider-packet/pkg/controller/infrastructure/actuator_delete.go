@@ -20,6 +20,7 @@ import (
 
 	"github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/packet"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
@@ -37,6 +38,25 @@ func (a *actuator) delete(ctx context.Context, infrastructure *extensionsv1alpha
 		return err
 	}
 
+	if err := extensionsterraformer.RestoreInfrastructureState(ctx, a.client, infrastructure, packet.TerraformerPurposeInfra); err != nil {
+		return fmt.Errorf("could not restore Terraform state: %+v", err)
+	}
+
+	configExists, err := tf.ConfigExists()
+	if err != nil {
+		return fmt.Errorf("terraform configuration was not found: %+v", err)
+	}
+
+	// The Terraform configuration is missing if the state has been restored from the status, e.g. after the seed
+	// namespace has been recreated. It has to be rendered again so that the infrastructure can be destroyed.
+	if !configExists && len(infrastructure.Status.State) > 0 {
+		initializer, err := a.newInitializer(infrastructure, providerSecret)
+		if err != nil {
+			return err
+		}
+		tf.InitializeWith(initializer)
+	}
+
 	return tf.
 		SetVariablesEnvironment(generateTerraformInfraVariablesEnvironment(providerSecret)).
 		Destroy()
@@ -24,10 +24,10 @@ import (
 	"github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/packet"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/chartrenderer"
-	"github.com/gardener/gardener/pkg/operation/terraformer"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -41,16 +41,13 @@ func (a *actuator) reconcile(ctx context.Context, infrastructure *extensionsv1al
 		return err
 	}
 
-	terraformConfig := GenerateTerraformInfraConfig(infrastructure, string(providerSecret.Data[packet.ProjectID]))
-
-	chartRenderer, err := chartrenderer.NewForConfig(a.restConfig)
+	initializer, err := a.newInitializer(infrastructure, providerSecret)
 	if err != nil {
-		return fmt.Errorf("could not create chart renderer: %+v", err)
+		return err
 	}
 
-	release, err := chartRenderer.Render(filepath.Join(packet.InternalChartsPath, "packet-infra"), "packet-infra", infrastructure.Namespace, terraformConfig)
-	if err != nil {
-		return fmt.Errorf("could not render Terraform chart: %+v", err)
+	if err := extensionsterraformer.RestoreInfrastructureState(ctx, a.client, infrastructure, packet.TerraformerPurposeInfra); err != nil {
+		return fmt.Errorf("could not restore Terraform state: %+v", err)
 	}
 
 	tf, err := a.newTerraformer(packet.TerraformerPurposeInfra, infrastructure.Namespace, infrastructure.Name)
@@ -58,16 +55,15 @@ func (a *actuator) reconcile(ctx context.Context, infrastructure *extensionsv1al
 		return fmt.Errorf("could not create terraformer object: %+v", err)
 	}
 
-	if err := tf.
+	tf = tf.
 		SetVariablesEnvironment(generateTerraformInfraVariablesEnvironment(providerSecret)).
-		InitializeWith(terraformer.DefaultInitializer(
-			a.client,
-			release.FileContent("main.tf"),
-			release.FileContent("variables.tf"),
-			[]byte(release.FileContent("terraform.tfvars"))),
-		).
-		Apply(); err != nil {
+		InitializeWith(initializer)
 
+	if extensionsterraformer.IsPlanRequested(infrastructure) {
+		return extensionsterraformer.PlanInfrastructure(ctx, a.client, tf, infrastructure)
+	}
+
+	if err := tf.Apply(); err != nil {
 		a.logger.Error(err, "failed to apply the terraform config", "infrastructure", infrastructure.Name)
 		return &controllererrors.RequeueAfterError{
 			Cause:        err,
@@ -75,9 +71,35 @@ func (a *actuator) reconcile(ctx context.Context, infrastructure *extensionsv1al
 		}
 	}
 
+	if err := extensionsterraformer.StoreInfrastructureState(ctx, a.client, tf, infrastructure); err != nil {
+		return err
+	}
+
 	return a.updateProviderStatus(ctx, tf, infrastructure)
 }
 
+// newInitializer renders the Terraform chart for the given infrastructure and returns an initializer for it.
+func (a *actuator) newInitializer(infrastructure *extensionsv1alpha1.Infrastructure, providerSecret *corev1.Secret) (extensionsterraformer.Initializer, error) {
+	terraformConfig := GenerateTerraformInfraConfig(infrastructure, string(providerSecret.Data[packet.ProjectID]))
+
+	chartRenderer, err := chartrenderer.NewForConfig(a.restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("could not create chart renderer: %+v", err)
+	}
+
+	release, err := chartRenderer.Render(filepath.Join(packet.InternalChartsPath, "packet-infra"), "packet-infra", infrastructure.Namespace, terraformConfig)
+	if err != nil {
+		return nil, fmt.Errorf("could not render Terraform chart: %+v", err)
+	}
+
+	return a.terraformerFactory.DefaultInitializer(
+		a.client,
+		release.FileContent("main.tf"),
+		release.FileContent("variables.tf"),
+		[]byte(release.FileContent("terraform.tfvars")),
+	), nil
+}
+
 // GenerateTerraformInfraConfig generates the Packet Terraform configuration based on the given infrastructure and project.
 func GenerateTerraformInfraConfig(infrastructure *extensionsv1alpha1.Infrastructure, projectID string) map[string]interface{} {
 	return map[string]interface{}{
@@ -92,7 +114,7 @@ func GenerateTerraformInfraConfig(infrastructure *extensionsv1alpha1.Infrastruct
 	}
 }
 
-func (a *actuator) updateProviderStatus(ctx context.Context, tf *terraformer.Terraformer, infrastructure *extensionsv1alpha1.Infrastructure) error {
+func (a *actuator) updateProviderStatus(ctx context.Context, tf extensionsterraformer.Interface, infrastructure *extensionsv1alpha1.Infrastructure) error {
 	outputVarKeys := []string{
 		packet.SSHKeyID,
 	}
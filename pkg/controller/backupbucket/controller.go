@@ -15,6 +15,7 @@
 package backupbucket
 
 import (
+	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
 	extensionshandler "github.com/gardener/gardener-extensions/pkg/handler"
 	extensionspredicate "github.com/gardener/gardener-extensions/pkg/predicate"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -45,14 +46,20 @@ type AddArgs struct {
 	// Predicates are the predicates to use.
 	// If unset, GenerationChanged will be used.
 	Predicates []predicate.Predicate
+	// ErrorMatchers are used to classify the errors of the actuator before they are reported in the status.
+	ErrorMatchers []controllererrors.Matcher
 }
 
 // DefaultPredicates returns the default predicates for a controlplane reconciler.
 func DefaultPredicates(typeName string, ignoreOperationAnnotation bool) []predicate.Predicate {
+	// Changes of the provider config do not increase the generation of a BackupBucket as it is stored in an annotation.
 	if ignoreOperationAnnotation {
 		return []predicate.Predicate{
 			extensionspredicate.HasType(typeName),
-			extensionspredicate.GenerationChanged(),
+			extensionspredicate.Or(
+				extensionspredicate.GenerationChanged(),
+				extensionspredicate.AnnotationChanged(AnnotationKeyProviderConfig),
+			),
 		}
 	}
 
@@ -66,6 +73,7 @@ func DefaultPredicates(typeName string, ignoreOperationAnnotation bool) []predic
 		extensionspredicate.Or(
 			extensionspredicate.HasOperationAnnotation(),
 			extensionspredicate.GenerationChanged(),
+			extensionspredicate.AnnotationChanged(AnnotationKeyProviderConfig),
 		),
 	}
 }
@@ -73,7 +81,7 @@ func DefaultPredicates(typeName string, ignoreOperationAnnotation bool) []predic
 // Add creates a new BackupBucket Controller and adds it to the Manager.
 // and Start it when the Manager is Started.
 func Add(mgr manager.Manager, args AddArgs) error {
-	args.ControllerOptions.Reconciler = NewReconciler(mgr, args.Actuator)
+	args.ControllerOptions.Reconciler = NewReconciler(mgr, args.Actuator, args.ErrorMatchers...)
 	return add(mgr, args.ControllerOptions, args.Predicates)
 }
 
@@ -0,0 +1,53 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupbucket
+
+import (
+	"context"
+	"encoding/json"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// AnnotationKeyProviderConfig is the key of the annotation that contains the provider-specific configuration
+// of a BackupBucket. The extensions API does not offer a `providerConfig` field for BackupBuckets, hence the
+// configuration is passed as serialized JSON or YAML in this annotation.
+const AnnotationKeyProviderConfig = "backupbucket.extensions.gardener.cloud/provider-config"
+
+// GetProviderConfig returns the raw provider config of the given BackupBucket, or nil if it has none.
+func GetProviderConfig(bb *extensionsv1alpha1.BackupBucket) []byte {
+	if config := bb.Annotations[AnnotationKeyProviderConfig]; len(config) > 0 {
+		return []byte(config)
+	}
+	return nil
+}
+
+// UpdateProviderStatus serializes the given provider status into the state of the given BackupBucket.
+func UpdateProviderStatus(ctx context.Context, c client.Client, bb *extensionsv1alpha1.BackupBucket, status runtime.Object) error {
+	data, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+
+	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, c, bb, func() error {
+		bb.Status.State = string(data)
+		return nil
+	})
+}
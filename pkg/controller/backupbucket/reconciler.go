@@ -19,6 +19,8 @@ import (
 	"fmt"
 
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
+	"github.com/gardener/gardener-extensions/pkg/metrics"
 	"github.com/gardener/gardener-extensions/pkg/util"
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
@@ -44,8 +46,9 @@ const (
 )
 
 type reconciler struct {
-	logger   logr.Logger
-	actuator Actuator
+	logger        logr.Logger
+	actuator      Actuator
+	errorMatchers []controllererrors.Matcher
 
 	ctx      context.Context
 	client   client.Client
@@ -54,13 +57,14 @@ type reconciler struct {
 
 // NewReconciler creates a new reconcile.Reconciler that reconciles
 // backupbucket resources of Gardener's `extensions.gardener.cloud` API group.
-func NewReconciler(mgr manager.Manager, actuator Actuator) reconcile.Reconciler {
+func NewReconciler(mgr manager.Manager, actuator Actuator, errorMatchers ...controllererrors.Matcher) reconcile.Reconciler {
 	return extensionscontroller.OperationAnnotationWrapper(
 		&extensionsv1alpha1.BackupBucket{},
 		&reconciler{
-			logger:   log.Log.WithName(ControllerName),
-			actuator: actuator,
-			recorder: mgr.GetEventRecorderFor(ControllerName),
+			logger:        log.Log.WithName(ControllerName),
+			actuator:      actuator,
+			errorMatchers: errorMatchers,
+			recorder:      mgr.GetEventRecorderFor(ControllerName),
 		})
 }
 
@@ -116,7 +120,8 @@ func (r *reconciler) reconcile(ctx context.Context, bb *extensionsv1alpha1.Backu
 
 	r.logger.Info("Starting the reconciliation of backupbucket", "backupbucket", bb.Name)
 	r.recorder.Event(bb, corev1.EventTypeNormal, EventBackupBucketReconciliation, "Reconciling the backupbucket")
-	if err := r.actuator.Reconcile(ctx, bb); err != nil {
+	observe := metrics.StartOperation(extensionsv1alpha1.BackupBucketResource, bb.Spec.Type, operationType)
+	if err := observe(r.actuator.Reconcile(ctx, bb)); err != nil {
 		msg := "Error reconciling backupbucket"
 		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), bb, operationType, msg)
 		r.logger.Error(err, msg, "backupbucket", bb.Name)
@@ -151,7 +156,8 @@ func (r *reconciler) delete(ctx context.Context, bb *extensionsv1alpha1.BackupBu
 
 	r.logger.Info("Starting the deletion of backupbucket", "backupbucket", bb.Name)
 	r.recorder.Event(bb, corev1.EventTypeNormal, EventBackupBucketDeletion, "Deleting the backupbucket")
-	if err := r.actuator.Delete(r.ctx, bb); err != nil {
+	observe := metrics.StartOperation(extensionsv1alpha1.BackupBucketResource, bb.Spec.Type, operationType)
+	if err := observe(r.actuator.Delete(r.ctx, bb)); err != nil {
 		msg := "Error deleting backupbucket"
 		r.recorder.Eventf(bb, corev1.EventTypeWarning, EventBackupBucketDeletion, "%s: %+v", msg, err)
 		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), bb, operationType, msg)
@@ -193,9 +199,10 @@ func (r *reconciler) updateStatusProcessing(ctx context.Context, bb *extensionsv
 }
 
 func (r *reconciler) updateStatusError(ctx context.Context, err error, bb *extensionsv1alpha1.BackupBucket, lastOperationType gardencorev1alpha1.LastOperationType, description string) error {
+	err = controllererrors.Classify(err, r.errorMatchers...)
 	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, bb, func() error {
 		bb.Status.ObservedGeneration = bb.Generation
-		bb.Status.LastOperation, bb.Status.LastError = extensionscontroller.ReconcileError(lastOperationType, gardencorev1alpha1helper.FormatLastErrDescription(fmt.Errorf("%s: %v", description, err)), 50, gardencorev1alpha1helper.ExtractErrorCodes(err)...)
+		bb.Status.LastOperation, bb.Status.LastError = extensionscontroller.ReconcileError(lastOperationType, gardencorev1alpha1helper.FormatLastErrDescription(fmt.Errorf("%s: %v", description, err)), 50, controllererrors.ExtractErrorCodes(err)...)
 		return nil
 	})
 }
@@ -26,4 +26,8 @@ type Actuator interface {
 	Reconcile(context.Context, *extensionsv1alpha1.BackupEntry) error
 	// Delete deletes the BackupEntry.
 	Delete(context.Context, *extensionsv1alpha1.BackupEntry) error
+	// Migrate releases all resources of the BackupEntry in the seed without deleting the backup data.
+	Migrate(context.Context, *extensionsv1alpha1.BackupEntry) error
+	// Restore restores the BackupEntry after a previous migration.
+	Restore(context.Context, *extensionsv1alpha1.BackupEntry) error
 }
@@ -15,10 +15,14 @@
 package backupentry
 
 import (
+	"time"
+
+	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
 	extensionshandler "github.com/gardener/gardener-extensions/pkg/handler"
 	extensionspredicate "github.com/gardener/gardener-extensions/pkg/predicate"
 	corev1 "k8s.io/api/core/v1"
 
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -32,6 +36,16 @@ const (
 	FinalizerName = "extensions.gardener.cloud/backupentry"
 	// ControllerName is the name of the controller
 	ControllerName = "backupentry_controller"
+
+	// AnnotationKeyDeletionScheduled is the annotation that marks a deleted backupentry whose backup data is retained
+	// for the deletion grace period. Its value is the time of the planned purge in RFC 3339 format.
+	AnnotationKeyDeletionScheduled = "backupentry.extensions.gardener.cloud/deletion-scheduled"
+	// AnnotationKeyDeleteImmediately is the annotation that makes a deleted backupentry purge its backup data
+	// immediately instead of waiting for the end of the deletion grace period, if set to "true".
+	AnnotationKeyDeleteImmediately = "backupentry.extensions.gardener.cloud/delete-immediately"
+	// ConditionTypeDeletionScheduled is the type of the backupentry condition that reports the planned purge of
+	// the backup data of a deleted backupentry.
+	ConditionTypeDeletionScheduled gardencorev1alpha1.ConditionType = "DeletionScheduled"
 )
 
 // AddArgs are arguments for adding a BackupEntry controller to a manager.
@@ -45,6 +59,11 @@ type AddArgs struct {
 	// Predicates are the predicates to use.
 	// If unset, GenerationChanged will be used.
 	Predicates []predicate.Predicate
+	// DeletionGracePeriod is the period for which the backup data of a deleted BackupEntry is retained
+	// before it is purged. If zero, the backup data is deleted immediately.
+	DeletionGracePeriod time.Duration
+	// ErrorMatchers are used to classify the errors of the actuator before they are reported in the status.
+	ErrorMatchers []controllererrors.Matcher
 }
 
 // DefaultPredicates returns the default predicates for a controlplane reconciler.
@@ -52,7 +71,10 @@ func DefaultPredicates(typeName string, ignoreOperationAnnotation bool) []predic
 	if ignoreOperationAnnotation {
 		return []predicate.Predicate{
 			extensionspredicate.HasType(typeName),
-			extensionspredicate.GenerationChanged(),
+			extensionspredicate.Or(
+				extensionspredicate.GenerationChanged(),
+				extensionspredicate.AnnotationAdded(AnnotationKeyDeleteImmediately),
+			),
 		}
 	}
 
@@ -66,6 +88,7 @@ func DefaultPredicates(typeName string, ignoreOperationAnnotation bool) []predic
 		extensionspredicate.Or(
 			extensionspredicate.HasOperationAnnotation(),
 			extensionspredicate.GenerationChanged(),
+			extensionspredicate.AnnotationAdded(AnnotationKeyDeleteImmediately),
 		),
 	}
 }
@@ -73,7 +96,7 @@ func DefaultPredicates(typeName string, ignoreOperationAnnotation bool) []predic
 // Add creates a new BackupEntry Controller and adds it to the Manager.
 // and Start it when the Manager is Started.
 func Add(mgr manager.Manager, args AddArgs) error {
-	args.ControllerOptions.Reconciler = NewReconciler(mgr, args.Actuator)
+	args.ControllerOptions.Reconciler = NewReconciler(mgr, args.Actuator, args.DeletionGracePeriod, args.ErrorMatchers...)
 	return add(mgr, args.ControllerOptions, args.Predicates)
 }
 
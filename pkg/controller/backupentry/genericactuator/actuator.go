@@ -108,3 +108,20 @@ func (a *actuator) deployEtcdBackupSecret(ctx context.Context, be *extensionsv1a
 func (a *actuator) Delete(ctx context.Context, be *extensionsv1alpha1.BackupEntry) error {
 	return a.backupEntryDelegate.Delete(ctx, be)
 }
+
+// Migrate deletes the etcd backup secret from the seed namespace of the shoot without deleting the backup data.
+func (a *actuator) Migrate(ctx context.Context, be *extensionsv1alpha1.BackupEntry) error {
+	shootTechnicalID, _ := backupentry.ExtractShootDetailsFromBackupEntryName(be.Name)
+	etcdSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      EtcdBackupSecretName,
+			Namespace: shootTechnicalID,
+		},
+	}
+	return client.IgnoreNotFound(a.client.Delete(ctx, etcdSecret))
+}
+
+// Restore restores the BackupEntry after a previous migration.
+func (a *actuator) Restore(ctx context.Context, be *extensionsv1alpha1.BackupEntry) error {
+	return a.Reconcile(ctx, be)
+}
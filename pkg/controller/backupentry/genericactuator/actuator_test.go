@@ -165,4 +165,26 @@ var _ = Describe("Actuator", func() {
 			})
 		})
 	})
+
+	Describe("#Migrate", func() {
+		It("should delete the etcd backup secret", func() {
+			client := fakeclient.NewFakeClientWithScheme(scheme.Scheme, seedNamespace, beSecret, etcdBackupSecret.DeepCopy())
+
+			// Create mock values provider
+			backupEntryDelegate := mockgenericactuator.NewMockBackupEntryDelegate(ctrl)
+
+			// Create actuator
+			a := genericactuator.NewActuator(backupEntryDelegate, logger)
+			err := a.(inject.Client).InjectClient(client)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call Migrate method and check the result
+			err = a.Migrate(context.TODO(), be)
+			Expect(err).NotTo(HaveOccurred())
+
+			deployedSecret := &corev1.Secret{}
+			err = client.Get(context.TODO(), etcdBackupSecretKey, deployedSecret)
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
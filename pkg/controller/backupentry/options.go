@@ -0,0 +1,67 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupentry
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	// DeletionGracePeriodFlag is the name of the command line flag to specify the period for which the backup data
+	// of a deleted backupentry is retained before it is purged.
+	DeletionGracePeriodFlag = "deletion-grace-period"
+)
+
+// Options are command line options that can be set for the backupentry controller.
+type Options struct {
+	// DeletionGracePeriod is the period for which the backup data of a deleted backupentry is retained.
+	DeletionGracePeriod time.Duration
+
+	config *Config
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (c *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.DurationVar(&c.DeletionGracePeriod, DeletionGracePeriodFlag, c.DeletionGracePeriod, "Period for which the backup data of a deleted backupentry is retained before it is purged. Zero deletes the data immediately.")
+}
+
+// Complete implements Completer.Complete.
+func (c *Options) Complete() error {
+	if c.DeletionGracePeriod < 0 {
+		return fmt.Errorf("deletion grace period must not be negative: %s", c.DeletionGracePeriod)
+	}
+
+	c.config = &Config{c.DeletionGracePeriod}
+	return nil
+}
+
+// Completed returns the completed Config. Only call this if `Complete` was successful.
+func (c *Options) Completed() *Config {
+	return c.config
+}
+
+// Config is a completed backupentry controller configuration.
+type Config struct {
+	// DeletionGracePeriod is the period for which the backup data of a deleted backupentry is retained.
+	DeletionGracePeriod time.Duration
+}
+
+// Apply sets the values of this Config in the given deletion grace period.
+func (c *Config) Apply(deletionGracePeriod *time.Duration) {
+	*deletionGracePeriod = c.DeletionGracePeriod
+}
@@ -17,16 +17,21 @@ package backupentry
 import (
 	"context"
 	"fmt"
+	"time"
 
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
+	"github.com/gardener/gardener-extensions/pkg/metrics"
 	"github.com/gardener/gardener-extensions/pkg/util"
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -41,11 +46,19 @@ const (
 	EventBackupEntryReconciliation string = "BackupEntryReconciliation"
 	// EventBackupEntryDeletion an event reason to describe backup entry deletion.
 	EventBackupEntryDeletion string = "BackupEntryDeletion"
+	// EventBackupEntryMigration an event reason to describe backup entry migration.
+	EventBackupEntryMigration string = "BackupEntryMigration"
+	// EventBackupEntryRestoration an event reason to describe backup entry restoration.
+	EventBackupEntryRestoration string = "BackupEntryRestoration"
+
+	reasonDeletionGracePeriod = "DeletionGracePeriod"
 )
 
 type reconciler struct {
-	logger   logr.Logger
-	actuator Actuator
+	logger              logr.Logger
+	actuator            Actuator
+	deletionGracePeriod time.Duration
+	errorMatchers       []controllererrors.Matcher
 
 	ctx      context.Context
 	client   client.Client
@@ -54,13 +67,16 @@ type reconciler struct {
 
 // NewReconciler creates a new reconcile.Reconciler that reconciles
 // backupentry resources of Gardener's `extensions.gardener.cloud` API group.
-func NewReconciler(mgr manager.Manager, actuator Actuator) reconcile.Reconciler {
+// The backup data of deleted backupentries is retained for the given deletion grace period.
+func NewReconciler(mgr manager.Manager, actuator Actuator, deletionGracePeriod time.Duration, errorMatchers ...controllererrors.Matcher) reconcile.Reconciler {
 	return extensionscontroller.OperationAnnotationWrapper(
 		&extensionsv1alpha1.BackupEntry{},
 		&reconciler{
-			logger:   log.Log.WithName(ControllerName),
-			actuator: actuator,
-			recorder: mgr.GetEventRecorderFor(ControllerName),
+			logger:              log.Log.WithName(ControllerName),
+			actuator:            actuator,
+			deletionGracePeriod: deletionGracePeriod,
+			errorMatchers:       errorMatchers,
+			recorder:            mgr.GetEventRecorderFor(ControllerName),
 		})
 }
 
@@ -87,8 +103,16 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 		return reconcile.Result{}, err
 	}
 
-	if be.DeletionTimestamp != nil {
+	switch {
+	case extensionscontroller.HasOperation(be, v1alpha1constants.GardenerOperationMigrate):
+		return r.migrate(r.ctx, be)
+	case be.DeletionTimestamp != nil:
 		return r.delete(r.ctx, be)
+	case extensionscontroller.HasOperation(be, extensionscontroller.GardenerOperationRestore):
+		return r.restore(r.ctx, be)
+	case extensionscontroller.IsMigrated(be):
+		r.logger.Info("Skipping the reconciliation of migrated backupentry", "backupentry", be.Name)
+		return reconcile.Result{}, nil
 	}
 	return r.reconcile(r.ctx, be)
 }
@@ -115,7 +139,8 @@ func (r *reconciler) reconcile(ctx context.Context, be *extensionsv1alpha1.Backu
 
 	r.logger.Info("Starting the reconciliation of backupentry", "backupentry", be.Name)
 	r.recorder.Event(be, corev1.EventTypeNormal, EventBackupEntryReconciliation, "Reconciling the backupentry")
-	if err := r.actuator.Reconcile(ctx, be); err != nil {
+	observe := metrics.StartOperation(extensionsv1alpha1.BackupEntryResource, be.Spec.Type, operationType)
+	if err := observe(r.actuator.Reconcile(ctx, be)); err != nil {
 		msg := "Error reconciling backupentry"
 		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), be, operationType, msg)
 		r.logger.Error(err, msg, "backupentry", be.Name)
@@ -143,6 +168,34 @@ func (r *reconciler) delete(ctx context.Context, be *extensionsv1alpha1.BackupEn
 		return reconcile.Result{}, nil
 	}
 
+	if extensionscontroller.IsMigrated(be) {
+		r.logger.Info("BackupEntry has been migrated, removing finalizers without deleting the backupentry.", "backupentry", be.Name)
+		if err := r.deleteSecretFinalizer(ctx, be); err != nil {
+			return reconcile.Result{}, err
+		}
+		if err := extensionscontroller.DeleteFinalizer(ctx, r.client, FinalizerName, be); err != nil {
+			r.logger.Error(err, "Error removing finalizer from backupentry", "backupentry", be.Name)
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{}, nil
+	}
+
+	if r.deletionGracePeriod > 0 && !IsDeleteImmediately(be) {
+		scheduledTime, err := r.ensureDeletionScheduled(ctx, be)
+		if err != nil {
+			r.logger.Error(err, "Error scheduling the deletion of backupentry", "backupentry", be.Name)
+			return reconcile.Result{}, err
+		}
+
+		if remaining := time.Until(scheduledTime); remaining > 0 {
+			if err := r.updateStatusDeletionScheduled(ctx, be, scheduledTime); err != nil {
+				return reconcile.Result{}, err
+			}
+			r.logger.Info("Retaining the backup data of backupentry until the end of the deletion grace period", "backupentry", be.Name, "scheduledTime", scheduledTime)
+			return reconcile.Result{RequeueAfter: remaining}, nil
+		}
+	}
+
 	operationType := gardencorev1alpha1helper.ComputeOperationType(be.ObjectMeta, be.Status.LastOperation)
 	if err := r.updateStatusProcessing(ctx, be, operationType, "Deleting the backupentry"); err != nil {
 		return reconcile.Result{}, err
@@ -150,7 +203,8 @@ func (r *reconciler) delete(ctx context.Context, be *extensionsv1alpha1.BackupEn
 
 	r.logger.Info("Starting the deletion of backupentry", "backupentry", be.Name)
 	r.recorder.Event(be, corev1.EventTypeNormal, EventBackupEntryDeletion, "Deleting the backupentry")
-	if err := r.actuator.Delete(r.ctx, be); err != nil {
+	observe := metrics.StartOperation(extensionsv1alpha1.BackupEntryResource, be.Spec.Type, operationType)
+	if err := observe(r.actuator.Delete(r.ctx, be)); err != nil {
 		msg := "Error deleting backupentry"
 		r.recorder.Eventf(be, corev1.EventTypeWarning, EventBackupEntryDeletion, "%s: %+v", msg, err)
 		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), be, operationType, msg)
@@ -165,19 +219,130 @@ func (r *reconciler) delete(ctx context.Context, be *extensionsv1alpha1.BackupEn
 		return reconcile.Result{}, err
 	}
 
+	if err := r.deleteSecretFinalizer(ctx, be); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.logger.Info("Removing finalizer.", "backupentry", be.Name)
+	if err := extensionscontroller.DeleteFinalizer(ctx, r.client, FinalizerName, be); err != nil {
+		r.logger.Error(err, "Error removing finalizer from backupentry", "backupentry", be.Name)
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// ensureDeletionScheduled returns the time of the planned purge of the backup data of the given deleted backupentry.
+// If the purge has not been scheduled yet, it is scheduled for the end of the deletion grace period.
+func (r *reconciler) ensureDeletionScheduled(ctx context.Context, be *extensionsv1alpha1.BackupEntry) (time.Time, error) {
+	if scheduledTime, ok := GetDeletionScheduledTime(be); ok {
+		return scheduledTime, nil
+	}
+
+	scheduledTime := be.DeletionTimestamp.Add(r.deletionGracePeriod).UTC().Truncate(time.Second)
+	if err := extensionscontroller.TryUpdate(ctx, retry.DefaultBackoff, r.client, be, func() error {
+		metav1.SetMetaDataAnnotation(&be.ObjectMeta, AnnotationKeyDeletionScheduled, scheduledTime.Format(time.RFC3339))
+		return nil
+	}); err != nil {
+		return time.Time{}, err
+	}
+
+	r.recorder.Eventf(be, corev1.EventTypeNormal, EventBackupEntryDeletion, "Deletion of the backup data is scheduled for %s", scheduledTime.Format(time.RFC3339))
+	return scheduledTime, nil
+}
+
+// updateStatusDeletionScheduled reports the planned purge of the backup data in the status of the given backupentry.
+// The status is only updated if the deletion scheduled condition has changed.
+func (r *reconciler) updateStatusDeletionScheduled(ctx context.Context, be *extensionsv1alpha1.BackupEntry, scheduledTime time.Time) error {
+	description := fmt.Sprintf("Backup data is retained for the deletion grace period and will be deleted at %s", scheduledTime.Format(time.RFC3339))
+	condition := gardencorev1alpha1helper.GetOrInitCondition(be.Status.Conditions, ConditionTypeDeletionScheduled)
+	condition = gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, reasonDeletionGracePeriod, description)
+
+	existing := gardencorev1alpha1helper.GetCondition(be.Status.Conditions, ConditionTypeDeletionScheduled)
+	if existing != nil && existing.Status == condition.Status && existing.Message == condition.Message {
+		return nil
+	}
+
+	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, be, func() error {
+		be.Status.LastOperation = extensionscontroller.LastOperation(gardencorev1alpha1.LastOperationTypeDelete, gardencorev1alpha1.LastOperationStateProcessing, 1, description)
+		be.Status.Conditions = gardencorev1alpha1helper.MergeConditions(be.Status.Conditions, condition)
+		return nil
+	})
+}
+
+func (r *reconciler) deleteSecretFinalizer(ctx context.Context, be *extensionsv1alpha1.BackupEntry) error {
 	secret, err := extensionscontroller.GetSecretByReference(ctx, r.client, &be.Spec.SecretRef)
 	if err != nil {
 		r.logger.Info("failed to get backup entry secret, %v", err)
-		return reconcile.Result{}, err
+		return err
 	}
 	if err := extensionscontroller.DeleteFinalizer(ctx, r.client, FinalizerName, secret); err != nil {
 		r.logger.Info("failed to remove finalizer on backup entry secret, %v", err)
+		return err
+	}
+	return nil
+}
+
+func (r *reconciler) migrate(ctx context.Context, be *extensionsv1alpha1.BackupEntry) (reconcile.Result, error) {
+	operationType := extensionscontroller.LastOperationTypeMigrate
+	if err := r.updateStatusProcessing(ctx, be, operationType, "Migrating the backupentry"); err != nil {
 		return reconcile.Result{}, err
 	}
 
-	r.logger.Info("Removing finalizer.", "backupentry", be.Name)
-	if err := extensionscontroller.DeleteFinalizer(ctx, r.client, FinalizerName, be); err != nil {
-		r.logger.Error(err, "Error removing finalizer from backupentry", "backupentry", be.Name)
+	r.logger.Info("Starting the migration of backupentry", "backupentry", be.Name)
+	r.recorder.Event(be, corev1.EventTypeNormal, EventBackupEntryMigration, "Migrating the backupentry")
+	observe := metrics.StartOperation(extensionsv1alpha1.BackupEntryResource, be.Spec.Type, operationType)
+	if err := observe(r.actuator.Migrate(ctx, be)); err != nil {
+		msg := "Error migrating backupentry"
+		r.recorder.Eventf(be, corev1.EventTypeWarning, EventBackupEntryMigration, "%s: %+v", msg, err)
+		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), be, operationType, msg)
+		r.logger.Error(err, msg, "backupentry", be.Name)
+		return extensionscontroller.ReconcileErr(err)
+	}
+
+	msg := "Successfully migrated backupentry"
+	r.logger.Info(msg, "backupentry", be.Name)
+	r.recorder.Event(be, corev1.EventTypeNormal, EventBackupEntryMigration, msg)
+	if err := r.updateStatusSuccess(ctx, be, operationType, msg); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := extensionscontroller.RemoveOperationAnnotation(ctx, r.client, be); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *reconciler) restore(ctx context.Context, be *extensionsv1alpha1.BackupEntry) (reconcile.Result, error) {
+	if err := extensionscontroller.EnsureFinalizer(ctx, r.client, FinalizerName, be); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	operationType := extensionscontroller.LastOperationTypeRestore
+	if err := r.updateStatusProcessing(ctx, be, operationType, "Restoring the backupentry"); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.logger.Info("Starting the restoration of backupentry", "backupentry", be.Name)
+	r.recorder.Event(be, corev1.EventTypeNormal, EventBackupEntryRestoration, "Restoring the backupentry")
+	observe := metrics.StartOperation(extensionsv1alpha1.BackupEntryResource, be.Spec.Type, operationType)
+	if err := observe(r.actuator.Restore(ctx, be)); err != nil {
+		msg := "Error restoring backupentry"
+		r.recorder.Eventf(be, corev1.EventTypeWarning, EventBackupEntryRestoration, "%s: %+v", msg, err)
+		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), be, operationType, msg)
+		r.logger.Error(err, msg, "backupentry", be.Name)
+		return extensionscontroller.ReconcileErr(err)
+	}
+
+	msg := "Successfully restored backupentry"
+	r.logger.Info(msg, "backupentry", be.Name)
+	r.recorder.Event(be, corev1.EventTypeNormal, EventBackupEntryRestoration, msg)
+	if err := r.updateStatusSuccess(ctx, be, operationType, msg); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := extensionscontroller.RemoveOperationAnnotation(ctx, r.client, be); err != nil {
 		return reconcile.Result{}, err
 	}
 
@@ -192,9 +357,10 @@ func (r *reconciler) updateStatusProcessing(ctx context.Context, be *extensionsv
 }
 
 func (r *reconciler) updateStatusError(ctx context.Context, err error, be *extensionsv1alpha1.BackupEntry, lastOperationType gardencorev1alpha1.LastOperationType, description string) error {
+	err = controllererrors.Classify(err, r.errorMatchers...)
 	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, be, func() error {
 		be.Status.ObservedGeneration = be.Generation
-		be.Status.LastOperation, be.Status.LastError = extensionscontroller.ReconcileError(lastOperationType, gardencorev1alpha1helper.FormatLastErrDescription(fmt.Errorf("%s: %v", description, err)), 50, gardencorev1alpha1helper.ExtractErrorCodes(err)...)
+		be.Status.LastOperation, be.Status.LastError = extensionscontroller.ReconcileError(lastOperationType, gardencorev1alpha1helper.FormatLastErrDescription(fmt.Errorf("%s: %v", description, err)), 50, controllererrors.ExtractErrorCodes(err)...)
 		return nil
 	})
 }
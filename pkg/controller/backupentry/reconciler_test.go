@@ -0,0 +1,243 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupentry
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+type fakeActuator struct {
+	Actuator
+	deleteCalls int
+	deleteErr   error
+}
+
+func (a *fakeActuator) Delete(context.Context, *extensionsv1alpha1.BackupEntry) error {
+	a.deleteCalls++
+	return a.deleteErr
+}
+
+var _ = Describe("Reconciler", func() {
+	const deletionGracePeriod = time.Hour
+
+	var (
+		ctx               context.Context
+		actuator          *fakeActuator
+		c                 client.Client
+		r                 *reconciler
+		be                *extensionsv1alpha1.BackupEntry
+		secret            *corev1.Secret
+		deletionTimestamp metav1.Time
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		actuator = &fakeActuator{}
+		deletionTimestamp = metav1.NewTime(time.Now().Add(-time.Minute).Truncate(time.Second))
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "garden", Name: "backupprovider", Finalizers: []string{FinalizerName}},
+		}
+		be = &extensionsv1alpha1.BackupEntry{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "shoot--foo--bar--uid",
+				DeletionTimestamp: &deletionTimestamp,
+				Finalizers:        []string{FinalizerName},
+			},
+			Spec: extensionsv1alpha1.BackupEntrySpec{
+				SecretRef: corev1.SecretReference{Namespace: secret.Namespace, Name: secret.Name},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		s := runtime.NewScheme()
+		Expect(scheme.AddToScheme(s)).To(Succeed())
+		Expect(extensionsv1alpha1.AddToScheme(s)).To(Succeed())
+		c = fake.NewFakeClientWithScheme(s, be.DeepCopy(), secret.DeepCopy())
+
+		r = &reconciler{
+			logger:              log.Log.WithName("test"),
+			actuator:            actuator,
+			deletionGracePeriod: deletionGracePeriod,
+			ctx:                 ctx,
+			client:              c,
+			recorder:            record.NewFakeRecorder(10),
+		}
+	})
+
+	getBackupEntry := func() *extensionsv1alpha1.BackupEntry {
+		out := &extensionsv1alpha1.BackupEntry{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: be.Name}, out)).To(Succeed())
+		return out
+	}
+
+	getSecret := func() *corev1.Secret {
+		out := &corev1.Secret{}
+		Expect(c.Get(ctx, client.ObjectKey{Namespace: secret.Namespace, Name: secret.Name}, out)).To(Succeed())
+		return out
+	}
+
+	expectDeleted := func() {
+		Expect(actuator.deleteCalls).To(Equal(1))
+		Expect(getBackupEntry().Finalizers).To(BeEmpty())
+		Expect(getSecret().Finalizers).To(BeEmpty())
+	}
+
+	Describe("#delete", func() {
+		It("should schedule the deletion and requeue until the end of the grace period", func() {
+			scheduledTime := deletionTimestamp.Add(deletionGracePeriod).UTC()
+
+			result, err := r.delete(ctx, getBackupEntry())
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically("~", deletionGracePeriod-time.Minute, 5*time.Second))
+			Expect(actuator.deleteCalls).To(BeZero())
+
+			current := getBackupEntry()
+			Expect(current.Finalizers).To(ConsistOf(FinalizerName))
+			Expect(current.Annotations).To(HaveKeyWithValue(AnnotationKeyDeletionScheduled, scheduledTime.Format(time.RFC3339)))
+			Expect(current.Status.LastOperation.Type).To(Equal(gardencorev1alpha1.LastOperationTypeDelete))
+			Expect(current.Status.LastOperation.State).To(Equal(gardencorev1alpha1.LastOperationStateProcessing))
+
+			condition := gardencorev1alpha1helper.GetCondition(current.Status.Conditions, ConditionTypeDeletionScheduled)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(reasonDeletionGracePeriod))
+			Expect(condition.Message).To(ContainSubstring(scheduledTime.Format(time.RFC3339)))
+		})
+
+		It("should keep the scheduled time and status on subsequent reconciliations", func() {
+			_, err := r.delete(ctx, getBackupEntry())
+			Expect(err).NotTo(HaveOccurred())
+			first := getBackupEntry()
+
+			result, err := r.delete(ctx, first.DeepCopy())
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+			Expect(actuator.deleteCalls).To(BeZero())
+
+			current := getBackupEntry()
+			Expect(current.Annotations).To(Equal(first.Annotations))
+			Expect(current.Status).To(Equal(first.Status))
+		})
+
+		Context("scheduled time has passed", func() {
+			BeforeEach(func() {
+				be.Annotations = map[string]string{AnnotationKeyDeletionScheduled: time.Now().Add(-time.Second).UTC().Format(time.RFC3339)}
+			})
+
+			It("should delete the backup data", func() {
+				result, err := r.delete(ctx, getBackupEntry())
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.RequeueAfter).To(BeZero())
+				expectDeleted()
+			})
+		})
+
+		Context("immediate deletion requested", func() {
+			BeforeEach(func() {
+				be.Annotations = map[string]string{AnnotationKeyDeleteImmediately: "true"}
+			})
+
+			It("should delete the backup data without scheduling the deletion", func() {
+				result, err := r.delete(ctx, getBackupEntry())
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.RequeueAfter).To(BeZero())
+				expectDeleted()
+				Expect(getBackupEntry().Annotations).NotTo(HaveKey(AnnotationKeyDeletionScheduled))
+			})
+
+			It("should override an already scheduled deletion", func() {
+				be.Annotations[AnnotationKeyDeletionScheduled] = time.Now().Add(deletionGracePeriod).UTC().Format(time.RFC3339)
+
+				result, err := r.delete(ctx, getBackupEntry())
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.RequeueAfter).To(BeZero())
+				expectDeleted()
+			})
+		})
+
+		Context("deletion fails", func() {
+			BeforeEach(func() {
+				be.Annotations = map[string]string{AnnotationKeyDeleteImmediately: "true"}
+				actuator.deleteErr = errors.New("access denied")
+			})
+
+			It("should classify the error of the actuator with the error matchers", func() {
+				r.errorMatchers = []controllererrors.Matcher{
+					func(err error) gardencorev1alpha1.ErrorCode {
+						if err.Error() == "access denied" {
+							return gardencorev1alpha1.ErrorInfraInsufficientPrivileges
+						}
+						return ""
+					},
+				}
+
+				_, err := r.delete(ctx, getBackupEntry())
+
+				Expect(err).To(HaveOccurred())
+				current := getBackupEntry()
+				Expect(current.Finalizers).To(ConsistOf(FinalizerName))
+				Expect(current.Status.LastOperation.State).To(Equal(gardencorev1alpha1.LastOperationStateError))
+				Expect(current.Status.LastError).NotTo(BeNil())
+				Expect(current.Status.LastError.Codes).To(ConsistOf(gardencorev1alpha1.ErrorInfraInsufficientPrivileges))
+			})
+
+			It("should not set any error codes without error matchers", func() {
+				_, err := r.delete(ctx, getBackupEntry())
+
+				Expect(err).To(HaveOccurred())
+				Expect(getBackupEntry().Status.LastError).NotTo(BeNil())
+				Expect(getBackupEntry().Status.LastError.Codes).To(BeEmpty())
+			})
+		})
+
+		Context("no grace period", func() {
+			JustBeforeEach(func() {
+				r.deletionGracePeriod = 0
+			})
+
+			It("should delete the backup data immediately", func() {
+				result, err := r.delete(ctx, getBackupEntry())
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.RequeueAfter).To(BeZero())
+				expectDeleted()
+			})
+		})
+	})
+})
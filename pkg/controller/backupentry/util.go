@@ -16,6 +16,9 @@ package backupentry
 
 import (
 	"strings"
+	"time"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 )
 
 // ExtractShootDetailsFromBackupEntryName returns Shoot resource technicalID its UID from provided <backupEntryName>.
@@ -25,3 +28,23 @@ func ExtractShootDetailsFromBackupEntryName(backupEntryName string) (shootTechni
 	shootTechnicalID = strings.TrimSuffix(backupEntryName, "--"+shootUID)
 	return shootTechnicalID, shootUID
 }
+
+// GetDeletionScheduledTime returns the time of the planned purge of the backup data of the given BackupEntry. The
+// second return value is false if the BackupEntry is not annotated with a valid deletion schedule.
+func GetDeletionScheduledTime(be *extensionsv1alpha1.BackupEntry) (time.Time, bool) {
+	value, ok := be.Annotations[AnnotationKeyDeletionScheduled]
+	if !ok {
+		return time.Time{}, false
+	}
+	scheduledTime, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return scheduledTime, true
+}
+
+// IsDeleteImmediately returns true if the backup data of the given BackupEntry shall be deleted immediately,
+// regardless of the deletion grace period.
+func IsDeleteImmediately(be *extensionsv1alpha1.BackupEntry) bool {
+	return be.Annotations[AnnotationKeyDeleteImmediately] == "true"
+}
@@ -15,11 +15,16 @@
 package backupentry_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 
 	. "github.com/gardener/gardener-extensions/pkg/controller/backupentry"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var _ = Describe("Util", func() {
@@ -34,4 +39,31 @@ var _ = Describe("Util", func() {
 		Entry("with new shoot technical ID", "shoot--dev--example--f6c6fca8-9c99-11e9-829b-2a33b5079af0", "shoot--dev--example", "f6c6fca8-9c99-11e9-829b-2a33b5079af0"),
 		Entry("without -- deliminator", "shoot-dev-example-f6c6fca8-9c99-11e9-829b-2a33b5079af0", "shoot-dev-example-f6c6fca8-9c99-11e9-829b-2a33b5079af0", "shoot-dev-example-f6c6fca8-9c99-11e9-829b-2a33b5079af0"),
 	)
+
+	Describe("#GetDeletionScheduledTime", func() {
+		It("should return the scheduled time", func() {
+			be := &extensionsv1alpha1.BackupEntry{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{AnnotationKeyDeletionScheduled: "2019-11-20T10:00:00Z"}}}
+
+			scheduledTime, ok := GetDeletionScheduledTime(be)
+			Expect(ok).To(BeTrue())
+			Expect(scheduledTime).To(Equal(time.Date(2019, 11, 20, 10, 0, 0, 0, time.UTC)))
+		})
+
+		It("should return false if the annotation is missing or invalid", func() {
+			_, ok := GetDeletionScheduledTime(&extensionsv1alpha1.BackupEntry{})
+			Expect(ok).To(BeFalse())
+
+			_, ok = GetDeletionScheduledTime(&extensionsv1alpha1.BackupEntry{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{AnnotationKeyDeletionScheduled: "tomorrow"}}})
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	DescribeTable("#IsDeleteImmediately",
+		func(annotations map[string]string, expected bool) {
+			Expect(IsDeleteImmediately(&extensionsv1alpha1.BackupEntry{ObjectMeta: metav1.ObjectMeta{Annotations: annotations}})).To(Equal(expected))
+		},
+		Entry("without annotation", nil, false),
+		Entry("with annotation set to true", map[string]string{AnnotationKeyDeleteImmediately: "true"}, true),
+		Entry("with annotation set to false", map[string]string{AnnotationKeyDeleteImmediately: "false"}, false),
+	)
 })
@@ -28,4 +28,9 @@ type Actuator interface {
 	Reconcile(context.Context, *extensionsv1alpha1.ControlPlane, *extensionscontroller.Cluster) (bool, error)
 	// Delete deletes the ControlPlane.
 	Delete(context.Context, *extensionsv1alpha1.ControlPlane, *extensionscontroller.Cluster) error
+	// Migrate releases all resources of the ControlPlane in the seed without deleting the objects
+	// that have been deployed into the shoot cluster.
+	Migrate(context.Context, *extensionsv1alpha1.ControlPlane, *extensionscontroller.Cluster) error
+	// Restore restores the ControlPlane after a previous migration.
+	Restore(context.Context, *extensionsv1alpha1.ControlPlane, *extensionscontroller.Cluster) (bool, error)
 }
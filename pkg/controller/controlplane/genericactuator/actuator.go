@@ -15,16 +15,14 @@
 package genericactuator
 
 import (
-	"bytes"
 	"context"
-	"fmt"
 	"time"
 
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/controller/controlplane"
 	"github.com/gardener/gardener-extensions/pkg/util"
+	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
 	extensionswebhookshoot "github.com/gardener/gardener-extensions/pkg/webhook/shoot"
-	"github.com/gardener/gardener-resource-manager/pkg/manager"
 
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -32,11 +30,9 @@ import (
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
-	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime/serializer/json"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -68,7 +64,7 @@ func NewActuator(
 	chartRendererFactory extensionscontroller.ChartRendererFactory,
 	imageVector imagevector.ImageVector,
 	configName string,
-	shootWebhooks []admissionregistrationv1beta1.Webhook,
+	shootWebhooks *extensionswebhook.ShootWebhooks,
 	webhookServerPort int,
 	logger logr.Logger,
 ) controlplane.Actuator {
@@ -105,7 +101,7 @@ type actuator struct {
 	chartRendererFactory      extensionscontroller.ChartRendererFactory
 	imageVector               imagevector.ImageVector
 	configName                string
-	shootWebhooks             []admissionregistrationv1beta1.Webhook
+	shootWebhooks             *extensionswebhook.ShootWebhooks
 	webhookServerPort         int
 
 	clientset         kubernetes.Interface
@@ -214,32 +210,11 @@ func (a *actuator) reconcileControlPlane(
 	cluster *extensionscontroller.Cluster,
 ) (bool, error) {
 
-	if len(a.shootWebhooks) > 0 {
+	if shootWebhooks := a.shootWebhooks.Get(); len(shootWebhooks) > 0 {
 		// Deploy shoot webhook configurations
-		if err := extensionswebhookshoot.EnsureNetworkPolicy(ctx, a.client, cp.Namespace, a.providerName, a.webhookServerPort); err != nil {
-			return false, errors.Wrapf(err, "could not create or update network policy for shoot webhooks in namespace '%s'", cp.Namespace)
-		}
-
-		webhookConfiguration, err := marshalWebhooks(a.shootWebhooks, a.providerName)
-		if err != nil {
+		if err := extensionswebhookshoot.ReconcileWebhookConfig(ctx, a.client, cp.Namespace, a.providerName, shootWebhooksResourceName, a.webhookServerPort, shootWebhooks); err != nil {
 			return false, err
 		}
-
-		if err := manager.
-			NewSecret(a.client).
-			WithNamespacedName(cp.Namespace, shootWebhooksResourceName).
-			WithKeyValues(map[string][]byte{"mutatingwebhookconfiguration.yaml": webhookConfiguration}).
-			Reconcile(ctx); err != nil {
-			return false, errors.Wrapf(err, "could not create or update secret '%s/%s' of managed resource containing shoot webhooks", cp.Namespace, shootWebhooksResourceName)
-		}
-
-		if err := manager.
-			NewManagedResource(a.client).
-			WithNamespacedName(cp.Namespace, shootWebhooksResourceName).
-			WithSecretRef(shootWebhooksResourceName).
-			Reconcile(ctx); err != nil {
-			return false, errors.Wrapf(err, "could not create or update managed resource '%s/%s' containing shoot webhooks", cp.Namespace, shootWebhooksResourceName)
-		}
 	}
 
 	// Deploy secrets
@@ -434,13 +409,8 @@ func (a *actuator) deleteControlPlane(
 		return errors.Wrapf(err, "could not delete secrets for controlplane '%s'", util.ObjectName(cp))
 	}
 
-	if len(a.shootWebhooks) > 0 {
-		networkPolicy := extensionswebhookshoot.GetNetworkPolicyMeta(cp.Namespace, a.providerName)
-		if err := a.client.Delete(ctx, networkPolicy); client.IgnoreNotFound(err) != nil {
-			return errors.Wrapf(err, "could not delete network policy for shoot webhooks in namespace '%s'", cp.Namespace)
-		}
-
-		if err := extensionscontroller.DeleteManagedResource(ctx, a.client, cp.Namespace, shootWebhooksResourceName); err != nil {
+	if len(a.shootWebhooks.Get()) > 0 {
+		if err := extensionswebhookshoot.DeleteWebhookConfig(ctx, a.client, cp.Namespace, a.providerName, shootWebhooksResourceName); err != nil {
 			return errors.Wrapf(err, "could not delete managed resource containing shoot webhooks for controlplane '%s'", util.ObjectName(cp))
 		}
 
@@ -454,6 +424,34 @@ func (a *actuator) deleteControlPlane(
 	return nil
 }
 
+// Migrate reconciles the given controlplane and cluster, deleting the additional Seed control plane components
+// as needed. Contrary to Delete, the objects that have been deployed into the shoot cluster are kept.
+func (a *actuator) Migrate(
+	ctx context.Context,
+	cp *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
+) error {
+	if cp.Spec.Purpose != nil && *cp.Spec.Purpose == extensionsv1alpha1.Exposure {
+		return a.deleteControlPlaneExposure(ctx, cp, cluster)
+	}
+
+	for _, name := range []string{storageClassesChartResourceName, controlPlaneShootChartResourceName, shootWebhooksResourceName} {
+		if err := extensionscontroller.SetKeepObjects(ctx, a.client, cp.Namespace, name, true); err != nil {
+			return errors.Wrapf(err, "could not keep objects of managed resource '%s' for controlplane '%s'", name, util.ObjectName(cp))
+		}
+	}
+	return a.deleteControlPlane(ctx, cp, cluster)
+}
+
+// Restore restores the given controlplane and cluster after a previous migration by reconciling it.
+func (a *actuator) Restore(
+	ctx context.Context,
+	cp *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
+) (bool, error) {
+	return a.Reconcile(ctx, cp, cluster)
+}
+
 // computeChecksums computes and returns all needed checksums. This includes the checksums for the given deployed secrets,
 // as well as the cloud provider secret and configmap that are fetched from the cluster.
 func (a *actuator) computeChecksums(
@@ -485,28 +483,3 @@ func (a *actuator) computeChecksums(
 
 	return controlplane.ComputeChecksums(csSecrets, csConfigMaps), nil
 }
-
-func marshalWebhooks(webhooks []admissionregistrationv1beta1.Webhook, name string) ([]byte, error) {
-	var (
-		buf     = new(bytes.Buffer)
-		encoder = json.NewYAMLSerializer(json.DefaultMetaFactory, nil, nil)
-
-		apiVersion, kind             = admissionregistrationv1beta1.SchemeGroupVersion.WithKind("MutatingWebhookConfiguration").ToAPIVersionAndKind()
-		mutatingWebhookConfiguration = admissionregistrationv1beta1.MutatingWebhookConfiguration{
-			TypeMeta: metav1.TypeMeta{
-				APIVersion: apiVersion,
-				Kind:       kind,
-			},
-			ObjectMeta: metav1.ObjectMeta{
-				Name: fmt.Sprintf("gardener-extension-%s-shoot", name),
-			},
-			Webhooks: webhooks,
-		}
-	)
-
-	if err := encoder.Encode(&mutatingWebhookConfiguration, buf); err != nil {
-		return nil, err
-	}
-
-	return buf.Bytes(), nil
-}
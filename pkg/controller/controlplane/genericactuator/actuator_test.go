@@ -26,6 +26,7 @@ import (
 	mockchartrenderer "github.com/gardener/gardener-extensions/pkg/mock/gardener/chartrenderer"
 	mockkubernetes "github.com/gardener/gardener-extensions/pkg/mock/gardener/client/kubernetes"
 	"github.com/gardener/gardener-extensions/pkg/util"
+	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
 	extensionswebhookshoot "github.com/gardener/gardener-extensions/pkg/webhook/shoot"
 
 	resourcemanagerv1alpha1 "github.com/gardener/gardener-resource-manager/pkg/apis/resources/v1alpha1"
@@ -287,7 +288,7 @@ var _ = Describe("Actuator", func() {
 				client.EXPECT().Get(ctx, resourceKeyShootWebhooksNetworkPolicy, gomock.AssignableToTypeOf(&networkingv1.NetworkPolicy{})).Return(errNotFound)
 				client.EXPECT().Create(ctx, createdNetworkPolicyForShootWebhooks).Return(nil)
 
-				data, _ := marshalWebhooks(webhooks, providerName)
+				data, _ := extensionswebhookshoot.MarshalWebhooks(webhooks, providerName)
 				createdMRSecretForShootWebhooks := &corev1.Secret{
 					ObjectMeta: metav1.ObjectMeta{Name: shootWebhooksResourceName, Namespace: namespace},
 					Data:       map[string][]byte{"mutatingwebhookconfiguration.yaml": data},
@@ -350,7 +351,7 @@ var _ = Describe("Actuator", func() {
 			vp.EXPECT().GetStorageClassesChartValues(ctx, cp, cluster).Return(storageClassesChartValues, nil)
 
 			// Create actuator
-			a := NewActuator(providerName, secrets, nil, configChart, ccmChart, ccmShootChart, storageClassesChart, nil, vp, crf, imageVector, configName, webhooks, webhookServerPort, logger)
+			a := NewActuator(providerName, secrets, nil, configChart, ccmChart, ccmShootChart, storageClassesChart, nil, vp, crf, imageVector, configName, extensionswebhook.NewShootWebhooks(webhooks), webhookServerPort, logger)
 			err := a.(inject.Client).InjectClient(client)
 			Expect(err).NotTo(HaveOccurred())
 			a.(*actuator).gardenerClientset = gardenerClientset
@@ -402,7 +403,7 @@ var _ = Describe("Actuator", func() {
 			}
 
 			// Create actuator
-			a := NewActuator(providerName, secrets, nil, configChart, ccmChart, nil, nil, nil, nil, nil, nil, configName, webhooks, webhookServerPort, logger)
+			a := NewActuator(providerName, secrets, nil, configChart, ccmChart, nil, nil, nil, nil, nil, nil, configName, extensionswebhook.NewShootWebhooks(webhooks), webhookServerPort, logger)
 			err := a.(inject.Client).InjectClient(client)
 			Expect(err).NotTo(HaveOccurred())
 
@@ -415,6 +416,52 @@ var _ = Describe("Actuator", func() {
 		Entry("should delete secrets and charts (no webhook)", cloudProviderConfigName, []admissionregistrationv1beta1.Webhook{{}}),
 	)
 
+	DescribeTable("#Migrate",
+		func(webhooks []admissionregistrationv1beta1.Webhook) {
+			ctx := context.TODO()
+
+			// Create mock clients
+			client := mockclient.NewMockClient(ctrl)
+
+			client.EXPECT().Get(ctx, resourceKeyStorageClassesChart, gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResource{})).Return(nil)
+			client.EXPECT().Get(ctx, resourceKeyCPShootChart, gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResource{})).Return(nil)
+			client.EXPECT().Get(ctx, resourceKeyShootWebhooks, gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResource{})).Return(nil)
+			client.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResource{}), gomock.Any()).Return(nil).Times(3)
+
+			client.EXPECT().Delete(ctx, deleteMRForStorageClassesChart).Return(nil)
+			client.EXPECT().Delete(ctx, deletedMRSecretForStorageClassesChart).Return(nil)
+
+			client.EXPECT().Delete(ctx, deleteMRForCPShootChart).Return(nil)
+			client.EXPECT().Delete(ctx, deletedMRSecretForCPShootChart).Return(nil)
+
+			client.EXPECT().Get(gomock.Any(), resourceKeyStorageClassesChart, gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResource{})).Return(errors.NewNotFound(schema.GroupResource{}, deleteMRForStorageClassesChart.Name))
+			client.EXPECT().Get(gomock.Any(), resourceKeyCPShootChart, gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResource{})).Return(errors.NewNotFound(schema.GroupResource{}, deleteMRForCPShootChart.Name))
+
+			// Create mock secrets and charts
+			secrets := mockutil.NewMockSecrets(ctrl)
+			secrets.EXPECT().Delete(gomock.Any(), namespace).Return(nil)
+			ccmChart := mockutil.NewMockChart(ctrl)
+			ccmChart.EXPECT().Delete(ctx, client, namespace).Return(nil)
+
+			if len(webhooks) > 0 {
+				client.EXPECT().Delete(ctx, deletedNetworkPolicyForShootWebhooks).Return(nil)
+				client.EXPECT().Delete(ctx, deletedMRForShootWebhooks).Return(nil)
+				client.EXPECT().Delete(ctx, deletedMRSecretForShootWebhooks).Return(nil)
+				client.EXPECT().Get(gomock.Any(), resourceKeyShootWebhooks, gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResource{})).Return(errors.NewNotFound(schema.GroupResource{}, deletedMRForShootWebhooks.Name))
+			}
+
+			// Create actuator
+			a := NewActuator(providerName, secrets, nil, nil, ccmChart, nil, nil, nil, nil, nil, nil, "", extensionswebhook.NewShootWebhooks(webhooks), webhookServerPort, logger)
+			err := a.(inject.Client).InjectClient(client)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call Migrate method and check the result
+			err = a.Migrate(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+		},
+		Entry("should keep the shoot objects and delete secrets and charts", []admissionregistrationv1beta1.Webhook{{}}),
+	)
+
 	DescribeTable("#ReconcileExposure",
 		func() {
 			ctx := context.TODO()
@@ -20,9 +20,12 @@ import (
 	"time"
 
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
+	"github.com/gardener/gardener-extensions/pkg/metrics"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
@@ -41,14 +44,19 @@ const (
 	EventControlPlaneReconciliation string = "ControlPlaneReconciliation"
 	// EventControlPlaneDeletion an event reason to describe control plane deletion.
 	EventControlPlaneDeletion string = "ControlPlaneDeletion"
+	// EventControlPlaneMigration an event reason to describe control plane migration.
+	EventControlPlaneMigration string = "ControlPlaneMigration"
+	// EventControlPlaneRestoration an event reason to describe control plane restoration.
+	EventControlPlaneRestoration string = "ControlPlaneRestoration"
 
 	// RequeueAfter is the duration to requeue a controlplane reconciliation if indicated by the actuator.
 	RequeueAfter time.Duration = 2 * time.Second
 )
 
 type reconciler struct {
-	logger   logr.Logger
-	actuator Actuator
+	logger        logr.Logger
+	actuator      Actuator
+	errorMatchers []controllererrors.Matcher
 
 	ctx      context.Context
 	client   client.Client
@@ -57,13 +65,14 @@ type reconciler struct {
 
 // NewReconciler creates a new reconcile.Reconciler that reconciles
 // controlplane resources of Gardener's `extensions.gardener.cloud` API group.
-func NewReconciler(mgr manager.Manager, actuator Actuator) reconcile.Reconciler {
+func NewReconciler(mgr manager.Manager, actuator Actuator, errorMatchers ...controllererrors.Matcher) reconcile.Reconciler {
 	return extensionscontroller.OperationAnnotationWrapper(
 		&extensionsv1alpha1.ControlPlane{},
 		&reconciler{
-			logger:   log.Log.WithName(ControllerName),
-			actuator: actuator,
-			recorder: mgr.GetEventRecorderFor(ControllerName),
+			logger:        log.Log.WithName(ControllerName),
+			actuator:      actuator,
+			errorMatchers: errorMatchers,
+			recorder:      mgr.GetEventRecorderFor(ControllerName),
 		})
 }
 
@@ -95,8 +104,16 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 		return reconcile.Result{}, err
 	}
 
-	if cp.DeletionTimestamp != nil {
+	switch {
+	case extensionscontroller.HasOperation(cp, v1alpha1constants.GardenerOperationMigrate):
+		return r.migrate(r.ctx, cp, cluster)
+	case cp.DeletionTimestamp != nil:
 		return r.delete(r.ctx, cp, cluster)
+	case extensionscontroller.HasOperation(cp, extensionscontroller.GardenerOperationRestore):
+		return r.restore(r.ctx, cp, cluster)
+	case extensionscontroller.IsMigrated(cp):
+		r.logger.Info("Skipping the reconciliation of migrated controlplane", "controlplane", cp.Name)
+		return reconcile.Result{}, nil
 	}
 	return r.reconcile(r.ctx, cp, cluster)
 }
@@ -113,7 +130,9 @@ func (r *reconciler) reconcile(ctx context.Context, cp *extensionsv1alpha1.Contr
 
 	r.logger.Info("Starting the reconciliation of controlplane", "controlplane", cp.Name)
 	r.recorder.Event(cp, corev1.EventTypeNormal, EventControlPlaneReconciliation, "Reconciling the controlplane")
+	observe := metrics.StartOperation(extensionsv1alpha1.ControlPlaneResource, cp.Spec.Type, operationType)
 	requeue, err := r.actuator.Reconcile(ctx, cp, cluster)
+	observe(err)
 	if err != nil {
 		msg := "Error reconciling controlplane"
 		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), cp, operationType, msg)
@@ -145,6 +164,15 @@ func (r *reconciler) delete(ctx context.Context, cp *extensionsv1alpha1.ControlP
 		return reconcile.Result{}, nil
 	}
 
+	if extensionscontroller.IsMigrated(cp) {
+		r.logger.Info("Controlplane has been migrated, removing finalizer without deleting the controlplane.", "controlplane", cp.Name)
+		if err := extensionscontroller.DeleteFinalizer(ctx, r.client, FinalizerName, cp); err != nil {
+			r.logger.Error(err, "Error removing finalizer from ControlPlane", "controlplane", cp.Name)
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{}, nil
+	}
+
 	operationType := gardencorev1alpha1helper.ComputeOperationType(cp.ObjectMeta, cp.Status.LastOperation)
 	if err := r.updateStatusProcessing(ctx, cp, operationType, "Deleting the controlplane"); err != nil {
 		return reconcile.Result{}, err
@@ -152,7 +180,8 @@ func (r *reconciler) delete(ctx context.Context, cp *extensionsv1alpha1.ControlP
 
 	r.logger.Info("Starting the deletion of controlplane", "controlplane", cp.Name)
 	r.recorder.Event(cp, corev1.EventTypeNormal, EventControlPlaneDeletion, "Deleting the cp")
-	if err := r.actuator.Delete(r.ctx, cp, cluster); err != nil {
+	observe := metrics.StartOperation(extensionsv1alpha1.ControlPlaneResource, cp.Spec.Type, operationType)
+	if err := observe(r.actuator.Delete(r.ctx, cp, cluster)); err != nil {
 		msg := "Error deleting controlplane"
 		r.recorder.Eventf(cp, corev1.EventTypeWarning, EventControlPlaneDeletion, "%s: %+v", msg, err)
 		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), cp, operationType, msg)
@@ -176,14 +205,86 @@ func (r *reconciler) delete(ctx context.Context, cp *extensionsv1alpha1.ControlP
 	return reconcile.Result{}, nil
 }
 
+func (r *reconciler) migrate(ctx context.Context, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
+	operationType := extensionscontroller.LastOperationTypeMigrate
+	if err := r.updateStatusProcessing(ctx, cp, operationType, "Migrating the controlplane"); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.logger.Info("Starting the migration of controlplane", "controlplane", cp.Name)
+	r.recorder.Event(cp, corev1.EventTypeNormal, EventControlPlaneMigration, "Migrating the controlplane")
+	observe := metrics.StartOperation(extensionsv1alpha1.ControlPlaneResource, cp.Spec.Type, operationType)
+	if err := observe(r.actuator.Migrate(ctx, cp, cluster)); err != nil {
+		msg := "Error migrating controlplane"
+		r.recorder.Eventf(cp, corev1.EventTypeWarning, EventControlPlaneMigration, "%s: %+v", msg, err)
+		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), cp, operationType, msg)
+		r.logger.Error(err, msg, "controlplane", cp.Name)
+		return extensionscontroller.ReconcileErr(err)
+	}
+
+	msg := "Successfully migrated controlplane"
+	r.logger.Info(msg, "controlplane", cp.Name)
+	r.recorder.Event(cp, corev1.EventTypeNormal, EventControlPlaneMigration, msg)
+	if err := r.updateStatusSuccess(ctx, cp, operationType, msg); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := extensionscontroller.RemoveOperationAnnotation(ctx, r.client, cp); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *reconciler) restore(ctx context.Context, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
+	if err := extensionscontroller.EnsureFinalizer(ctx, r.client, FinalizerName, cp); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	operationType := extensionscontroller.LastOperationTypeRestore
+	if err := r.updateStatusProcessing(ctx, cp, operationType, "Restoring the controlplane"); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.logger.Info("Starting the restoration of controlplane", "controlplane", cp.Name)
+	r.recorder.Event(cp, corev1.EventTypeNormal, EventControlPlaneRestoration, "Restoring the controlplane")
+	observe := metrics.StartOperation(extensionsv1alpha1.ControlPlaneResource, cp.Spec.Type, operationType)
+	requeue, err := r.actuator.Restore(ctx, cp, cluster)
+	observe(err)
+	if err != nil {
+		msg := "Error restoring controlplane"
+		r.recorder.Eventf(cp, corev1.EventTypeWarning, EventControlPlaneRestoration, "%s: %+v", msg, err)
+		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), cp, operationType, msg)
+		r.logger.Error(err, msg, "controlplane", cp.Name)
+		return extensionscontroller.ReconcileErr(err)
+	}
+
+	msg := "Successfully restored controlplane"
+	r.logger.Info(msg, "controlplane", cp.Name)
+	r.recorder.Event(cp, corev1.EventTypeNormal, EventControlPlaneRestoration, msg)
+	if err := r.updateStatusSuccess(ctx, cp, operationType, msg); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := extensionscontroller.RemoveOperationAnnotation(ctx, r.client, cp); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if requeue {
+		return reconcile.Result{RequeueAfter: RequeueAfter}, nil
+	}
+	return reconcile.Result{}, nil
+}
+
 func (r *reconciler) updateStatusProcessing(ctx context.Context, cp *extensionsv1alpha1.ControlPlane, lastOperationType gardencorev1alpha1.LastOperationType, description string) error {
 	cp.Status.LastOperation = extensionscontroller.LastOperation(lastOperationType, gardencorev1alpha1.LastOperationStateProcessing, 1, description)
 	return r.client.Status().Update(ctx, cp)
 }
 
 func (r *reconciler) updateStatusError(ctx context.Context, err error, cp *extensionsv1alpha1.ControlPlane, lastOperationType gardencorev1alpha1.LastOperationType, description string) error {
+	err = controllererrors.Classify(err, r.errorMatchers...)
 	cp.Status.ObservedGeneration = cp.Generation
-	cp.Status.LastOperation, cp.Status.LastError = extensionscontroller.ReconcileError(lastOperationType, gardencorev1alpha1helper.FormatLastErrDescription(fmt.Errorf("%s: %v", description, err)), 50, gardencorev1alpha1helper.ExtractErrorCodes(err)...)
+	cp.Status.LastOperation, cp.Status.LastError = extensionscontroller.ReconcileError(lastOperationType, gardencorev1alpha1helper.FormatLastErrDescription(fmt.Errorf("%s: %v", description, err)), 50, controllererrors.ExtractErrorCodes(err)...)
 	return r.client.Status().Update(ctx, cp)
 }
 
@@ -0,0 +1,69 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package error
+
+import (
+	"regexp"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	"github.com/pkg/errors"
+)
+
+// The dependency codes have to be evaluated before the insufficient privileges codes as some of them share the
+// `Forbidden` prefix.
+var (
+	alicloudErrorCodes = codeTable{
+		{gardencorev1alpha1.ErrorInfraUnauthorized, []string{
+			`\bInvalidAccessKeyId(?:\.[\w.]+)?`,
+			`\bInvalidAccessKeySecret\b`,
+			`\bSignatureDoesNotMatch\b`,
+			`\bIncompleteSignature\b`,
+		}},
+		{gardencorev1alpha1.ErrorInfraQuotaExceeded, []string{
+			`\bQuotaExceed[\w.]*`,
+			`\b[\w.]*LimitExceed[\w.]*`,
+		}},
+		{gardencorev1alpha1.ErrorInfraDependencies, []string{
+			`\bDependencyViolation[\w.]*`,
+			`\bOperationConflict\b`,
+			`\bForbidden\.InUse\b`,
+		}},
+		{gardencorev1alpha1.ErrorInfraInsufficientPrivileges, []string{
+			`\bForbidden(?:\.[\w.]+)?`,
+			`\bNoPermission\b`,
+		}},
+	}.compile()
+
+	alicloudCodeRegexp = regexp.MustCompile(`ErrorCode: ([\w.]+)`)
+)
+
+// AlicloudMatcher determines the error code of errors returned by the Alicloud API. Errors exposing an Alicloud
+// error code are classified by that code, all others by the code contained in their message or by the message
+// itself.
+func AlicloudMatcher(err error) gardencorev1alpha1.ErrorCode {
+	if aliErr, ok := errors.Cause(err).(interface{ ErrorCode() string }); ok {
+		if code := alicloudErrorCodes.lookup(aliErr.ErrorCode()); code != "" {
+			return code
+		}
+	}
+
+	message := err.Error()
+	for _, match := range alicloudCodeRegexp.FindAllStringSubmatch(message, -1) {
+		if code := alicloudErrorCodes.lookup(match[1]); code != "" {
+			return code
+		}
+	}
+	return alicloudErrorCodes.find(message)
+}
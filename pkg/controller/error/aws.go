@@ -0,0 +1,59 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package error
+
+import (
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	"github.com/pkg/errors"
+)
+
+var awsErrorCodes = codeTable{
+	{gardencorev1alpha1.ErrorInfraUnauthorized, []string{
+		`\bAuthFailure\b`,
+		`\bInvalidClientTokenId\b`,
+		`\bInvalidAccessKeyId\b`,
+		`\bSignatureDoesNotMatch\b`,
+		`\bIncompleteSignature\b`,
+		`\bUnrecognizedClientException\b`,
+		`\bMissingAuthenticationToken\b`,
+		`\bExpiredToken\b`,
+	}},
+	{gardencorev1alpha1.ErrorInfraQuotaExceeded, []string{
+		`\b\w*LimitExceeded\b`,
+		`\bMaxSpotInstanceCountExceeded\b`,
+		`\bInsufficientAddressCapacity\b`,
+	}},
+	{gardencorev1alpha1.ErrorInfraInsufficientPrivileges, []string{
+		`\bUnauthorizedOperation\b`,
+		`\bAccessDenied(?:Exception)?\b`,
+	}},
+	{gardencorev1alpha1.ErrorInfraDependencies, []string{
+		`\bDependencyViolation\b`,
+		`\bDeleteConflict\b`,
+		`\bPendingVerification\b`,
+		`\bOptInRequired\b`,
+	}},
+}.compile()
+
+// AWSMatcher determines the error code of errors returned by the AWS API. Errors exposing an AWS error code
+// are classified by that code, all others by their message.
+func AWSMatcher(err error) gardencorev1alpha1.ErrorCode {
+	if awsErr, ok := errors.Cause(err).(interface{ Code() string }); ok {
+		if code := awsErrorCodes.lookup(awsErr.Code()); code != "" {
+			return code
+		}
+	}
+	return awsErrorCodes.find(err.Error())
+}
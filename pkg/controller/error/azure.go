@@ -0,0 +1,67 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package error
+
+import (
+	"regexp"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+)
+
+var (
+	azureErrorCodes = codeTable{
+		{gardencorev1alpha1.ErrorInfraUnauthorized, []string{
+			`\bInvalidAuthenticationToken\w*\b`,
+			`\bExpiredAuthenticationToken\b`,
+			`\bAuthenticationFailed\b`,
+			`\bSubscriptionNotFound\b`,
+			`\binvalid_client\b`,
+			`\binvalid_grant\b`,
+			`\bunauthorized_client\b`,
+			`\bStatusCode=401\b`,
+		}},
+		{gardencorev1alpha1.ErrorInfraQuotaExceeded, []string{
+			`\b\w*QuotaExceeded\b`,
+			`\b\w*LimitReached\b`,
+			`\b\w*LimitExceeded\b`,
+		}},
+		{gardencorev1alpha1.ErrorInfraInsufficientPrivileges, []string{
+			`\b(?:Linked)?AuthorizationFailed\b`,
+			`\bRequestDisallowedByPolicy\b`,
+			`\bStatusCode=403\b`,
+		}},
+		{gardencorev1alpha1.ErrorInfraDependencies, []string{
+			`\bInUse\w*CannotBeDeleted\b`,
+			`\bMissingSubscriptionRegistration\b`,
+			`\bResourceGroupBeingDeleted\b`,
+			`\bAnotherOperationInProgress\b`,
+		}},
+	}.compile()
+
+	azureCodeRegexp = regexp.MustCompile(`(?:Code=|"code":\s*)"(\w+)"`)
+)
+
+// AzureMatcher determines the error code of errors returned by the Azure API. The Azure error codes are
+// extracted from the error message (e.g. `Code="AuthorizationFailed"`), if that fails the whole message is
+// classified.
+func AzureMatcher(err error) gardencorev1alpha1.ErrorCode {
+	message := err.Error()
+	for _, match := range azureCodeRegexp.FindAllStringSubmatch(message, -1) {
+		if code := azureErrorCodes.lookup(match[1]); code != "" {
+			return code
+		}
+	}
+	return azureErrorCodes.find(message)
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package error
+
+import (
+	"regexp"
+	"strings"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+// Matcher determines the error code of an error returned by a cloud provider. It returns an empty error code
+// if the error is not known to the matcher.
+type Matcher func(err error) gardencorev1alpha1.ErrorCode
+
+type errorWithCode struct {
+	code gardencorev1alpha1.ErrorCode
+	err  error
+}
+
+// WithCode wraps the given error with the given error code. The code is exposed via the
+// gardencorev1alpha1helper.Coder interface while the error message stays unchanged.
+func WithCode(code gardencorev1alpha1.ErrorCode, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &errorWithCode{code, err}
+}
+
+// Code implements gardencorev1alpha1helper.Coder.
+func (e *errorWithCode) Code() gardencorev1alpha1.ErrorCode {
+	return e.code
+}
+
+// Error implements error.
+func (e *errorWithCode) Error() string {
+	return e.err.Error()
+}
+
+// Cause returns the wrapped error.
+func (e *errorWithCode) Cause() error {
+	return e.err
+}
+
+// Classify wraps the given error with the error code determined by the first matcher that knows the error.
+// If no matcher knows the error, it is returned unchanged. Requeue errors stay requeue errors, only their cause
+// is classified.
+func Classify(err error, matchers ...Matcher) error {
+	if err == nil {
+		return nil
+	}
+
+	if requeueErr, ok := err.(*RequeueAfterError); ok {
+		return &RequeueAfterError{
+			Cause:        Classify(requeueErr.Cause, matchers...),
+			RequeueAfter: requeueErr.RequeueAfter,
+		}
+	}
+
+	for _, matcher := range matchers {
+		if code := matcher(err); code != "" {
+			return WithCode(code, err)
+		}
+	}
+	return err
+}
+
+// ExtractErrorCodes extracts the error codes of the given error. In contrast to
+// gardencorev1alpha1helper.ExtractErrorCodes, it also considers the causes of wrapped errors and requeue errors.
+// For every error in a multi error only the outermost error code is taken into account as it is the most
+// specific classification.
+func ExtractErrorCodes(err error) []gardencorev1alpha1.ErrorCode {
+	var (
+		codes []gardencorev1alpha1.ErrorCode
+		seen  = make(map[gardencorev1alpha1.ErrorCode]struct{})
+	)
+
+	for _, err := range utils.Errors(err) {
+		if code := extractErrorCode(err); code != "" {
+			if _, ok := seen[code]; !ok {
+				seen[code] = struct{}{}
+				codes = append(codes, code)
+			}
+		}
+	}
+	return codes
+}
+
+func extractErrorCode(err error) gardencorev1alpha1.ErrorCode {
+	for err != nil {
+		if coder, ok := err.(gardencorev1alpha1helper.Coder); ok {
+			return coder.Code()
+		}
+
+		switch e := err.(type) {
+		case *RequeueAfterError:
+			err = e.Cause
+		case interface{ Cause() error }:
+			err = e.Cause()
+		default:
+			return ""
+		}
+	}
+	return ""
+}
+
+// codeTable maps patterns of provider specific error codes or messages to Gardener error codes. The entries are
+// evaluated in order, the first matching entry wins.
+type codeTable []codeTableEntry
+
+type codeTableEntry struct {
+	code     gardencorev1alpha1.ErrorCode
+	patterns []string
+}
+
+type compiledCodeTable []compiledCodeTableEntry
+
+type compiledCodeTableEntry struct {
+	code    gardencorev1alpha1.ErrorCode
+	exact   *regexp.Regexp
+	message *regexp.Regexp
+}
+
+func (t codeTable) compile() compiledCodeTable {
+	out := make(compiledCodeTable, 0, len(t))
+	for _, entry := range t {
+		pattern := strings.Join(entry.patterns, "|")
+		out = append(out, compiledCodeTableEntry{
+			code:    entry.code,
+			exact:   regexp.MustCompile(`(?i)^(?:` + pattern + `)$`),
+			message: regexp.MustCompile(`(?i)(?:` + pattern + `)`),
+		})
+	}
+	return out
+}
+
+// lookup returns the Gardener error code for the given provider specific error code.
+func (t compiledCodeTable) lookup(providerCode string) gardencorev1alpha1.ErrorCode {
+	for _, entry := range t {
+		if entry.exact.MatchString(providerCode) {
+			return entry.code
+		}
+	}
+	return ""
+}
+
+// find returns the Gardener error code of the first entry matching somewhere in the given message.
+func (t compiledCodeTable) find(message string) gardencorev1alpha1.ErrorCode {
+	for _, entry := range t {
+		if entry.message.MatchString(message) {
+			return entry.code
+		}
+	}
+	return ""
+}
@@ -0,0 +1,187 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package error_test
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/gardener/gardener-extensions/pkg/controller/error"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	"github.com/hashicorp/go-multierror"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+)
+
+type awsError struct {
+	code, message string
+}
+
+func (e *awsError) Code() string  { return e.code }
+func (e *awsError) Error() string { return e.code + ": " + e.message }
+
+type alicloudError struct {
+	code string
+}
+
+func (e *alicloudError) ErrorCode() string { return e.code }
+func (e *alicloudError) Error() string     { return "SDK.ServerError" }
+
+var _ = Describe("Codes", func() {
+	Describe("#Classify", func() {
+		It("should return nil for nil errors", func() {
+			Expect(Classify(nil, AWSMatcher)).To(BeNil())
+		})
+
+		It("should return the error unchanged if no matcher knows it", func() {
+			err := errors.New("foo")
+			Expect(Classify(err, AWSMatcher)).To(BeIdenticalTo(err))
+		})
+
+		It("should wrap the error with the code of the first matching matcher", func() {
+			err := errors.New("DependencyViolation: resource has a dependent object")
+
+			classified := Classify(err, AWSMatcher)
+			Expect(classified.Error()).To(Equal(err.Error()))
+			Expect(ExtractErrorCodes(classified)).To(ConsistOf(gardencorev1alpha1.ErrorInfraDependencies))
+		})
+
+		It("should classify the cause of requeue errors", func() {
+			err := &RequeueAfterError{Cause: errors.New("UnauthorizedOperation"), RequeueAfter: time.Minute}
+
+			classified := Classify(err, AWSMatcher)
+			Expect(classified).To(BeAssignableToTypeOf(&RequeueAfterError{}))
+			Expect(classified.(*RequeueAfterError).RequeueAfter).To(Equal(time.Minute))
+			Expect(ExtractErrorCodes(classified)).To(ConsistOf(gardencorev1alpha1.ErrorInfraInsufficientPrivileges))
+		})
+	})
+
+	Describe("#ExtractErrorCodes", func() {
+		It("should return no codes for errors without code", func() {
+			Expect(ExtractErrorCodes(errors.New("foo"))).To(BeEmpty())
+		})
+
+		It("should extract the codes of wrapped errors", func() {
+			err := errors.Wrap(gardencorev1alpha1helper.NewErrorWithCode(gardencorev1alpha1.ErrorInfraQuotaExceeded, "foo"), "bar")
+			Expect(ExtractErrorCodes(err)).To(ConsistOf(gardencorev1alpha1.ErrorInfraQuotaExceeded))
+		})
+
+		It("should prefer the outermost code", func() {
+			err := WithCode(gardencorev1alpha1.ErrorInfraInsufficientPrivileges, gardencorev1alpha1helper.NewErrorWithCode(gardencorev1alpha1.ErrorInfraUnauthorized, "foo"))
+			Expect(ExtractErrorCodes(err)).To(ConsistOf(gardencorev1alpha1.ErrorInfraInsufficientPrivileges))
+		})
+
+		It("should extract the unique codes of multi errors", func() {
+			err := multierror.Append(nil,
+				WithCode(gardencorev1alpha1.ErrorInfraUnauthorized, errors.New("foo")),
+				WithCode(gardencorev1alpha1.ErrorInfraDependencies, errors.New("bar")),
+				WithCode(gardencorev1alpha1.ErrorInfraUnauthorized, errors.New("baz")),
+			)
+			Expect(ExtractErrorCodes(err)).To(Equal([]gardencorev1alpha1.ErrorCode{gardencorev1alpha1.ErrorInfraUnauthorized, gardencorev1alpha1.ErrorInfraDependencies}))
+		})
+	})
+
+	DescribeTable("#AWSMatcher",
+		func(err error, code gardencorev1alpha1.ErrorCode) {
+			Expect(AWSMatcher(err)).To(Equal(code))
+		},
+		Entry("typed unauthorized", errors.Wrap(&awsError{"AuthFailure", "foo"}, "bar"), gardencorev1alpha1.ErrorInfraUnauthorized),
+		Entry("typed quota", &awsError{"VpcLimitExceeded", "foo"}, gardencorev1alpha1.ErrorInfraQuotaExceeded),
+		Entry("message privileges", errors.New("UnauthorizedOperation: You are not authorized to perform this operation."), gardencorev1alpha1.ErrorInfraInsufficientPrivileges),
+		Entry("message dependencies", errors.New("DependencyViolation: The vpc has dependencies and cannot be deleted."), gardencorev1alpha1.ErrorInfraDependencies),
+		Entry("unknown", &awsError{"InvalidParameterValue", "foo"}, gardencorev1alpha1.ErrorCode("")),
+	)
+
+	DescribeTable("#AzureMatcher",
+		func(err error, code gardencorev1alpha1.ErrorCode) {
+			Expect(AzureMatcher(err)).To(Equal(code))
+		},
+		Entry("unauthorized", errors.New(`StatusCode=401 -- Original Error: Code="InvalidAuthenticationTokenTenant" Message="foo"`), gardencorev1alpha1.ErrorInfraUnauthorized),
+		Entry("privileges", errors.New(`StatusCode=403 -- Original Error: Code="AuthorizationFailed" Message="foo"`), gardencorev1alpha1.ErrorInfraInsufficientPrivileges),
+		Entry("quota", errors.New(`Code="OperationNotAllowed" Message="foo" Details=[{"code":"QuotaExceeded"}]`), gardencorev1alpha1.ErrorInfraQuotaExceeded),
+		Entry("dependencies", errors.New(`Code="InUseSubnetCannotBeDeleted" Message="foo"`), gardencorev1alpha1.ErrorInfraDependencies),
+		Entry("unknown", errors.New(`Code="ResourceNotFound" Message="foo"`), gardencorev1alpha1.ErrorCode("")),
+	)
+
+	DescribeTable("#GCPMatcher",
+		func(err error, code gardencorev1alpha1.ErrorCode) {
+			Expect(GCPMatcher(err)).To(Equal(code))
+		},
+		Entry("unauthorized", errors.New("googleapi: Error 401: Invalid Credentials, authError"), gardencorev1alpha1.ErrorInfraUnauthorized),
+		Entry("quota", errors.New("googleapi: Error 403: Quota 'CPUS' exceeded. Limit: 24.0 in region europe-west1., quotaExceeded"), gardencorev1alpha1.ErrorInfraQuotaExceeded),
+		Entry("dependencies", errors.New("googleapi: Error 403: Access Not Configured. Compute Engine API has not been used in project 123 before or it is disabled., accessNotConfigured"), gardencorev1alpha1.ErrorInfraDependencies),
+		Entry("privileges", errors.New("googleapi: Error 403: Required 'compute.networks.create' permission for 'projects/foo', forbidden"), gardencorev1alpha1.ErrorInfraInsufficientPrivileges),
+		Entry("unknown", errors.New("googleapi: Error 404: The resource was not found, notFound"), gardencorev1alpha1.ErrorCode("")),
+	)
+
+	DescribeTable("#OpenStackMatcher",
+		func(err error, code gardencorev1alpha1.ErrorCode) {
+			Expect(OpenStackMatcher(err)).To(Equal(code))
+		},
+		Entry("unauthorized", errors.New("Authentication failed"), gardencorev1alpha1.ErrorInfraUnauthorized),
+		Entry("quota", errors.New("Expected HTTP response code [201] when accessing [POST https://foo/v2.0/floatingips], but got 409 instead\n{\"NeutronError\": {\"type\": \"OverQuota\"}}"), gardencorev1alpha1.ErrorInfraQuotaExceeded),
+		Entry("privileges", errors.New("Request forbidden: [POST https://foo/v2.0/routers], error message: policy"), gardencorev1alpha1.ErrorInfraInsufficientPrivileges),
+		Entry("dependencies", errors.New("Unable to delete subnet: {\"NeutronError\": {\"type\": \"SubnetInUse\"}}"), gardencorev1alpha1.ErrorInfraDependencies),
+		Entry("unknown", errors.New("Resource not found"), gardencorev1alpha1.ErrorCode("")),
+	)
+
+	DescribeTable("#AlicloudMatcher",
+		func(err error, code gardencorev1alpha1.ErrorCode) {
+			Expect(AlicloudMatcher(err)).To(Equal(code))
+		},
+		Entry("typed unauthorized", &alicloudError{"InvalidAccessKeyId.NotFound"}, gardencorev1alpha1.ErrorInfraUnauthorized),
+		Entry("typed quota", errors.Wrap(&alicloudError{"QuotaExceeded.Eip"}, "foo"), gardencorev1alpha1.ErrorInfraQuotaExceeded),
+		Entry("message dependencies", errors.New("SDK.ServerError\nErrorCode: DependencyViolation.SecurityGroup\nMessage: foo"), gardencorev1alpha1.ErrorInfraDependencies),
+		Entry("message privileges", errors.New("SDK.ServerError\nErrorCode: Forbidden.RAM\nMessage: foo"), gardencorev1alpha1.ErrorInfraInsufficientPrivileges),
+		Entry("unknown", &alicloudError{"InvalidVSwitchId.NotFound"}, gardencorev1alpha1.ErrorCode("")),
+	)
+
+	DescribeTable("#PacketMatcher",
+		func(err error, code gardencorev1alpha1.ErrorCode) {
+			Expect(PacketMatcher(err)).To(Equal(code))
+		},
+		Entry("unauthorized", errors.New("GET https://api.packet.net/projects/foo: 401 Invalid authentication token"), gardencorev1alpha1.ErrorInfraUnauthorized),
+		Entry("quota", errors.New("POST https://api.packet.net/projects/foo/devices: 422 Project foo has exceeded its quota"), gardencorev1alpha1.ErrorInfraQuotaExceeded),
+		Entry("privileges", errors.New("POST https://api.packet.net/projects/foo/devices: 403 You are not authorized"), gardencorev1alpha1.ErrorInfraInsufficientPrivileges),
+		Entry("unknown", errors.New("GET https://api.packet.net/projects/foo: 404 Not found"), gardencorev1alpha1.ErrorCode("")),
+	)
+
+	Describe("#TerraformMatcher", func() {
+		terraformError := func(issues string) error {
+			return fmt.Errorf("Terraform execution job 'foo.infra.tf-job' could not be completed. The following issues have been found in the logs:\n\n-> Pod 'foo' reported:\n%s", issues)
+		}
+
+		It("should classify the issues of Terraform jobs with the provider matcher", func() {
+			err := terraformError("* aws_vpc.vpc: Error creating VPC: VpcLimitExceeded: The maximum number of VPCs has been reached.")
+			Expect(TerraformMatcher(AWSMatcher)(err)).To(Equal(gardencorev1alpha1.ErrorInfraQuotaExceeded))
+		})
+
+		It("should not match errors without Terraform issues", func() {
+			Expect(TerraformMatcher(AWSMatcher)(errors.New("VpcLimitExceeded"))).To(BeEmpty())
+			Expect(TerraformMatcher(AWSMatcher)(terraformError(""))).To(BeEmpty())
+		})
+
+		It("should take precedence over the code determined by the Terraformer", func() {
+			err := gardencorev1alpha1helper.DetermineError(terraformError(`* azurerm_resource_group.rg: Code="AuthorizationFailed" Message="foo"`).Error())
+
+			classified := Classify(err, TerraformMatcher(AzureMatcher))
+			Expect(ExtractErrorCodes(classified)).To(ConsistOf(gardencorev1alpha1.ErrorInfraInsufficientPrivileges))
+		})
+	})
+})
@@ -0,0 +1,54 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package error
+
+import (
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+)
+
+// The dependency and quota codes have to be evaluated before the insufficient privileges codes as GCP reports
+// them with status 403, too.
+var gcpErrorCodes = codeTable{
+	{gardencorev1alpha1.ErrorInfraUnauthorized, []string{
+		`\bError 401\b`,
+		`\bauthError\b`,
+		`\binvalid_grant\b`,
+		`\binvalid_client\b`,
+		`\bcannot fetch token\b`,
+	}},
+	{gardencorev1alpha1.ErrorInfraQuotaExceeded, []string{
+		`\b\w*quotaExceeded\b`,
+		`\bQUOTA_EXCEEDED\b`,
+		`\b\w*limitExceeded\b`,
+	}},
+	{gardencorev1alpha1.ErrorInfraDependencies, []string{
+		`\baccessNotConfigured\b`,
+		`\bSERVICE_DISABLED\b`,
+		`\bresourceInUseByAnotherResource\b`,
+		`\bresourceNotReady\b`,
+	}},
+	{gardencorev1alpha1.ErrorInfraInsufficientPrivileges, []string{
+		`\bError 403\b`,
+		`\bforbidden\b`,
+		`\binsufficientPermissions\b`,
+		`\baccessDenied\b`,
+	}},
+}.compile()
+
+// GCPMatcher determines the error code of errors returned by the GCP API. The googleapi errors contain the
+// status code and the reason in their message (e.g. `googleapi: Error 403: Quota exceeded, quotaExceeded`).
+func GCPMatcher(err error) gardencorev1alpha1.ErrorCode {
+	return gcpErrorCodes.find(err.Error())
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package error
+
+import (
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+)
+
+var openstackErrorCodes = codeTable{
+	{gardencorev1alpha1.ErrorInfraUnauthorized, []string{
+		`\bAuthentication failed\b`,
+		`\bbut got 401 instead\b`,
+		`\bThe request you have made requires authentication\b`,
+	}},
+	{gardencorev1alpha1.ErrorInfraQuotaExceeded, []string{
+		`\bQuota exceeded\b`,
+		`\bOverQuota(?:Client)?\b`,
+		`\bbut got 413 instead\b`,
+	}},
+	{gardencorev1alpha1.ErrorInfraInsufficientPrivileges, []string{
+		`\bbut got 403 instead\b`,
+		`\bRequest forbidden\b`,
+		`\bPolicy doesn't allow\b`,
+	}},
+	{gardencorev1alpha1.ErrorInfraDependencies, []string{
+		`\bbut got 409 instead\b`,
+		`\b\w*InUse\b`,
+		`\bis still in use\b`,
+	}},
+}.compile()
+
+// OpenStackMatcher determines the error code of errors returned by the OpenStack APIs. The gophercloud errors
+// only expose the status code and the response body in their message, hence the message is classified.
+func OpenStackMatcher(err error) gardencorev1alpha1.ErrorCode {
+	return openstackErrorCodes.find(err.Error())
+}
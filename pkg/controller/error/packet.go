@@ -0,0 +1,43 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package error
+
+import (
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+)
+
+var packetErrorCodes = codeTable{
+	{gardencorev1alpha1.ErrorInfraUnauthorized, []string{
+		`: 401\b`,
+		`\bInvalid authentication token\b`,
+	}},
+	{gardencorev1alpha1.ErrorInfraQuotaExceeded, []string{
+		`\bquota\b`,
+		`\blimit reached\b`,
+	}},
+	{gardencorev1alpha1.ErrorInfraInsufficientPrivileges, []string{
+		`: 403\b`,
+	}},
+	{gardencorev1alpha1.ErrorInfraDependencies, []string{
+		`: 409\b`,
+		`\bis in use\b`,
+	}},
+}.compile()
+
+// PacketMatcher determines the error code of errors returned by the Packet API. The packngo errors contain the
+// status code and the error messages of the response (e.g. `GET https://api.packet.net/projects: 401 ...`).
+func PacketMatcher(err error) gardencorev1alpha1.ErrorCode {
+	return packetErrorCodes.find(err.Error())
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package error
+
+import (
+	"errors"
+	"strings"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+)
+
+// terraformIssuesMarker separates the description of a failed Terraform job from the issues found in its logs.
+const terraformIssuesMarker = "The following issues have been found in the logs:"
+
+// TerraformMatcher returns a matcher for errors of failed Terraform jobs. It classifies the issues found in the
+// Terraform logs with the given provider matcher. Errors not stemming from Terraform are not matched.
+func TerraformMatcher(matcher Matcher) Matcher {
+	return func(err error) gardencorev1alpha1.ErrorCode {
+		message := err.Error()
+
+		idx := strings.Index(message, terraformIssuesMarker)
+		if idx == -1 {
+			return ""
+		}
+
+		issues := strings.TrimSpace(message[idx+len(terraformIssuesMarker):])
+		if issues == "" {
+			return ""
+		}
+		return matcher(errors.New(issues))
+	}
+}
@@ -26,4 +26,9 @@ type Actuator interface {
 	Reconcile(ctx context.Context, ex *extensionsv1alpha1.Extension) error
 	// Delete the Extension resource.
 	Delete(ctx context.Context, ex *extensionsv1alpha1.Extension) error
+	// Migrate the Extension resource. It releases all resources in the seed without deleting the objects
+	// that have been deployed into the shoot cluster.
+	Migrate(ctx context.Context, ex *extensionsv1alpha1.Extension) error
+	// Restore the Extension resource after a previous migration.
+	Restore(ctx context.Context, ex *extensionsv1alpha1.Extension) error
 }
@@ -20,11 +20,14 @@ import (
 	"time"
 
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
 	extensionshandler "github.com/gardener/gardener-extensions/pkg/handler"
+	"github.com/gardener/gardener-extensions/pkg/metrics"
 	extensionspredicate "github.com/gardener/gardener-extensions/pkg/predicate"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
@@ -171,11 +174,19 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 		err    error
 	)
 
-	if ex.DeletionTimestamp != nil {
+	switch {
+	case extensionscontroller.HasOperation(ex, v1alpha1constants.GardenerOperationMigrate):
+		return r.migrate(r.ctx, ex)
+	case ex.DeletionTimestamp != nil:
 		return r.delete(r.ctx, ex)
+	case extensionscontroller.HasOperation(ex, extensionscontroller.GardenerOperationRestore):
+		result, err = r.restore(r.ctx, ex)
+	case extensionscontroller.IsMigrated(ex):
+		r.logger.Info("Skipping the reconciliation of migrated Extension resource", "extension", ex.Name, "namespace", ex.Namespace)
+		return reconcile.Result{}, nil
+	default:
+		result, err = r.reconcile(r.ctx, ex)
 	}
-
-	result, err = r.reconcile(r.ctx, ex)
 	if err != nil {
 		return result, err
 	}
@@ -194,7 +205,8 @@ func (r *reconciler) reconcile(ctx context.Context, ex *extensionsv1alpha1.Exten
 		return reconcile.Result{}, err
 	}
 
-	if err := r.actuator.Reconcile(ctx, ex); err != nil {
+	observe := metrics.StartOperation(extensionsv1alpha1.ExtensionResource, ex.Spec.Type, operationType)
+	if err := observe(r.actuator.Reconcile(ctx, ex)); err != nil {
 		msg := "Unable to reconcile Extension resource"
 		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), ex, operationType, msg)
 		r.logger.Error(err, msg, "extension", ex.Name, "namespace", ex.Namespace)
@@ -221,12 +233,22 @@ func (r *reconciler) delete(ctx context.Context, ex *extensionsv1alpha1.Extensio
 		return reconcile.Result{}, nil
 	}
 
+	if extensionscontroller.IsMigrated(ex) {
+		r.logger.Info("Extension resource has been migrated, removing finalizer without deleting its resources.", "extension", ex.Name, "namespace", ex.Namespace)
+		if err := extensionscontroller.DeleteFinalizer(ctx, r.client, r.finalizerName, ex); err != nil {
+			r.logger.Error(err, "Error removing finalizer from Extension resource", "extension", ex.Name, "namespace", ex.Namespace)
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{}, nil
+	}
+
 	operationType := gardencorev1alpha1helper.ComputeOperationType(ex.ObjectMeta, ex.Status.LastOperation)
 	if err := r.updateStatusProcessing(ctx, ex, operationType, "Deleting Extension resource."); err != nil {
 		return reconcile.Result{}, err
 	}
 
-	if err := r.actuator.Delete(ctx, ex); err != nil {
+	observe := metrics.StartOperation(extensionsv1alpha1.ExtensionResource, ex.Spec.Type, operationType)
+	if err := observe(r.actuator.Delete(ctx, ex)); err != nil {
 		msg := "Error deleting Extension resource"
 		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), ex, operationType, msg)
 		r.logger.Error(err, msg, "extension", ex.Name, "namespace", ex.Namespace)
@@ -247,6 +269,66 @@ func (r *reconciler) delete(ctx context.Context, ex *extensionsv1alpha1.Extensio
 	return reconcile.Result{}, nil
 }
 
+func (r *reconciler) migrate(ctx context.Context, ex *extensionsv1alpha1.Extension) (reconcile.Result, error) {
+	msg := "Migrating Extension resource"
+	r.logger.Info(msg, "extension", ex.Name, "namespace", ex.Namespace)
+	operationType := extensionscontroller.LastOperationTypeMigrate
+	if err := r.updateStatusProcessing(ctx, ex, operationType, msg); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	observe := metrics.StartOperation(extensionsv1alpha1.ExtensionResource, ex.Spec.Type, operationType)
+	if err := observe(r.actuator.Migrate(ctx, ex)); err != nil {
+		msg := "Error migrating Extension resource"
+		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), ex, operationType, msg)
+		r.logger.Error(err, msg, "extension", ex.Name, "namespace", ex.Namespace)
+		return extensionscontroller.ReconcileErr(err)
+	}
+
+	msg = "Successfully migrated Extension resource"
+	r.logger.Info(msg, "extension", ex.Name, "namespace", ex.Namespace)
+	if err := r.updateStatusSuccess(ctx, ex, operationType, msg); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := extensionscontroller.RemoveOperationAnnotation(ctx, r.client, ex); err != nil {
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{}, nil
+}
+
+func (r *reconciler) restore(ctx context.Context, ex *extensionsv1alpha1.Extension) (reconcile.Result, error) {
+	if err := extensionscontroller.EnsureFinalizer(ctx, r.client, r.finalizerName, ex); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	msg := "Restoring Extension resource"
+	r.logger.Info(msg, "extension", ex.Name, "namespace", ex.Namespace)
+	operationType := extensionscontroller.LastOperationTypeRestore
+	if err := r.updateStatusProcessing(ctx, ex, operationType, msg); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	observe := metrics.StartOperation(extensionsv1alpha1.ExtensionResource, ex.Spec.Type, operationType)
+	if err := observe(r.actuator.Restore(ctx, ex)); err != nil {
+		msg := "Error restoring Extension resource"
+		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), ex, operationType, msg)
+		r.logger.Error(err, msg, "extension", ex.Name, "namespace", ex.Namespace)
+		return extensionscontroller.ReconcileErr(err)
+	}
+
+	msg = "Successfully restored Extension resource"
+	r.logger.Info(msg, "extension", ex.Name, "namespace", ex.Namespace)
+	if err := r.updateStatusSuccess(ctx, ex, operationType, msg); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := extensionscontroller.RemoveOperationAnnotation(ctx, r.client, ex); err != nil {
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{}, nil
+}
+
 func (r *reconciler) updateStatusProcessing(ctx context.Context, ex *extensionsv1alpha1.Extension, lastOperationType gardencorev1alpha1.LastOperationType, description string) error {
 	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, ex, func() error {
 		ex.Status.LastOperation = extensionscontroller.LastOperation(lastOperationType, gardencorev1alpha1.LastOperationStateProcessing, 1, description)
@@ -257,7 +339,7 @@ func (r *reconciler) updateStatusProcessing(ctx context.Context, ex *extensionsv
 func (r *reconciler) updateStatusError(ctx context.Context, err error, ex *extensionsv1alpha1.Extension, lastOperationType gardencorev1alpha1.LastOperationType, description string) error {
 	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, ex, func() error {
 		ex.Status.ObservedGeneration = ex.Generation
-		ex.Status.LastOperation, ex.Status.LastError = extensionscontroller.ReconcileError(lastOperationType, gardencorev1alpha1helper.FormatLastErrDescription(fmt.Errorf("%s: %v", description, err)), 50, gardencorev1alpha1helper.ExtractErrorCodes(err)...)
+		ex.Status.LastOperation, ex.Status.LastError = extensionscontroller.ReconcileError(lastOperationType, gardencorev1alpha1helper.FormatLastErrDescription(fmt.Errorf("%s: %v", description, err)), 50, controllererrors.ExtractErrorCodes(err)...)
 		return nil
 	})
 }
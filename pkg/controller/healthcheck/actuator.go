@@ -0,0 +1,142 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/gardener/gardener-extensions/pkg/util"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+type actuator struct {
+	logger logr.Logger
+
+	provider      string
+	extensionKind string
+	healthChecks  []ConditionTypeToHealthCheck
+
+	seedClient     client.Client
+	newShootClient func(ctx context.Context, namespace string) (client.Client, error)
+}
+
+// NewActuator creates a new HealthCheckActuator that executes the given health checks for the extension resources
+// of the given kind deployed by the given provider.
+func NewActuator(provider, extensionKind string, healthChecks []ConditionTypeToHealthCheck) HealthCheckActuator {
+	a := &actuator{
+		logger:        log.Log.WithName(fmt.Sprintf("%s-%s-healthcheck-actuator", provider, extensionKind)),
+		provider:      provider,
+		extensionKind: extensionKind,
+		healthChecks:  healthChecks,
+	}
+	a.newShootClient = func(ctx context.Context, namespace string) (client.Client, error) {
+		_, shootClient, err := util.NewClientForShoot(ctx, a.seedClient, namespace, client.Options{})
+		return shootClient, err
+	}
+	return a
+}
+
+func (a *actuator) InjectClient(client client.Client) error {
+	a.seedClient = client
+	return nil
+}
+
+// ExecuteHealthCheckFunctions implements HealthCheckActuator.
+func (a *actuator) ExecuteHealthCheckFunctions(ctx context.Context, request types.NamespacedName) ([]Result, error) {
+	var (
+		shootClient    client.Client
+		shootClientErr error
+	)
+	for _, healthCheck := range a.healthChecks {
+		if _, ok := healthCheck.HealthCheck.(ShootClient); ok {
+			shootClient, shootClientErr = a.newShootClient(ctx, request.Namespace)
+			if shootClientErr != nil {
+				a.logger.Error(shootClientErr, "could not create shoot client", "namespace", request.Namespace)
+			}
+			break
+		}
+	}
+
+	type checkResult struct {
+		conditionType gardencorev1alpha1.ConditionType
+		result        *SingleCheckResult
+		err           error
+	}
+
+	var (
+		wg      sync.WaitGroup
+		results = make(chan checkResult, len(a.healthChecks))
+	)
+
+	for _, healthCheck := range a.healthChecks {
+		check := healthCheck.HealthCheck.DeepCopy()
+		if seedCheck, ok := check.(SeedClient); ok {
+			seedCheck.InjectSeedClient(a.seedClient)
+		}
+		if shootCheck, ok := check.(ShootClient); ok {
+			if shootClientErr != nil {
+				results <- checkResult{conditionType: healthCheck.ConditionType, err: fmt.Errorf("could not create shoot client: %+v", shootClientErr)}
+				continue
+			}
+			shootCheck.InjectShootClient(shootClient)
+		}
+
+		wg.Add(1)
+		go func(conditionType gardencorev1alpha1.ConditionType, check HealthCheck) {
+			defer wg.Done()
+			result, err := check.Check(ctx, request)
+			results <- checkResult{conditionType: conditionType, result: result, err: err}
+		}(healthCheck.ConditionType, check)
+	}
+
+	wg.Wait()
+	close(results)
+
+	var (
+		conditionTypes []gardencorev1alpha1.ConditionType
+		aggregated     = map[gardencorev1alpha1.ConditionType]*Result{}
+	)
+	for _, healthCheck := range a.healthChecks {
+		if _, ok := aggregated[healthCheck.ConditionType]; !ok {
+			conditionTypes = append(conditionTypes, healthCheck.ConditionType)
+			aggregated[healthCheck.ConditionType] = &Result{ConditionType: healthCheck.ConditionType, IsHealthy: true}
+		}
+	}
+
+	for r := range results {
+		result := aggregated[r.conditionType]
+		switch {
+		case r.err != nil:
+			result.IsHealthy = false
+			result.Errors = append(result.Errors, r.err)
+		case !r.result.IsHealthy:
+			result.IsHealthy = false
+			result.Details = append(result.Details, r.result.Detail)
+		}
+	}
+
+	out := make([]Result, 0, len(conditionTypes))
+	for _, conditionType := range conditionTypes {
+		out = append(out, *aggregated[conditionType])
+	}
+	return out, nil
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	extensionspredicate "github.com/gardener/gardener-extensions/pkg/predicate"
+
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	// ControllerName is the name of the health check controller.
+	ControllerName = "healthcheck_controller"
+
+	// DefaultSyncPeriod is the default period after which the health checks are executed again.
+	DefaultSyncPeriod = 30 * time.Second
+)
+
+// AddArgs are arguments for adding a health check controller to a manager.
+type AddArgs struct {
+	// ControllerOptions are the controller options used for creating a controller.
+	// The options.Reconciler is always overridden with a reconciler created from the
+	// given actuator.
+	ControllerOptions controller.Options
+	// Predicates are the predicates to use, usually DefaultPredicates.
+	Predicates []predicate.Predicate
+	// SyncPeriod is the period after which the health checks are executed again.
+	// If unset, DefaultSyncPeriod will be used.
+	SyncPeriod time.Duration
+}
+
+// DefaultPredicates returns the default predicates for a health check reconciler. Status updates are ignored as the
+// reconciler updates the status itself and requeues the resources periodically.
+func DefaultPredicates(typeName string) []predicate.Predicate {
+	return []predicate.Predicate{
+		extensionspredicate.HasType(typeName),
+		extensionspredicate.GenerationChanged(),
+	}
+}
+
+// Add creates a new health check controller for the extension resources of the given kind and adds it to the
+// Manager. The health checks of the given actuator are executed periodically for each resource matching the predicates.
+func Add(mgr manager.Manager, extensionKind string, getExtensionObjFunc GetExtensionObjectFunc, actuator HealthCheckActuator, args AddArgs) error {
+	if args.SyncPeriod == 0 {
+		args.SyncPeriod = DefaultSyncPeriod
+	}
+	args.ControllerOptions.Reconciler = NewReconciler(actuator, getExtensionObjFunc, args.SyncPeriod)
+
+	ctrl, err := controller.New(fmt.Sprintf("%s_%s", strings.ToLower(extensionKind), ControllerName), mgr, args.ControllerOptions)
+	if err != nil {
+		return err
+	}
+
+	return ctrl.Watch(&source.Kind{Type: getExtensionObjFunc()}, &handler.EnqueueRequestForObject{}, args.Predicates...)
+}
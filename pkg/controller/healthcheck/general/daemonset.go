@@ -0,0 +1,68 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package general
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/healthcheck"
+
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ShootDaemonSetHealthChecker checks the health of a daemon set in the shoot cluster.
+type ShootDaemonSetHealthChecker struct {
+	name      string
+	namespace string
+	client    client.Client
+}
+
+// NewShootDaemonSetHealthChecker returns a health check that checks whether the daemon set with the given name in
+// the given namespace of the shoot cluster has been rolled out, e.g. calico-node.
+func NewShootDaemonSetHealthChecker(namespace, name string) *ShootDaemonSetHealthChecker {
+	return &ShootDaemonSetHealthChecker{name: name, namespace: namespace}
+}
+
+// InjectShootClient implements healthcheck.ShootClient.
+func (h *ShootDaemonSetHealthChecker) InjectShootClient(shootClient client.Client) {
+	h.client = shootClient
+}
+
+// DeepCopy implements healthcheck.HealthCheck.
+func (h *ShootDaemonSetHealthChecker) DeepCopy() healthcheck.HealthCheck {
+	copied := *h
+	return &copied
+}
+
+// Check executes the health check.
+func (h *ShootDaemonSetHealthChecker) Check(ctx context.Context, _ types.NamespacedName) (*healthcheck.SingleCheckResult, error) {
+	daemonSet := &appsv1.DaemonSet{}
+	if err := h.client.Get(ctx, client.ObjectKey{Namespace: h.namespace, Name: h.name}, daemonSet); err != nil {
+		if errors.IsNotFound(err) {
+			return &healthcheck.SingleCheckResult{Detail: fmt.Sprintf("daemon set %s/%s not found", h.namespace, h.name)}, nil
+		}
+		return nil, err
+	}
+
+	if err := health.CheckDaemonSet(daemonSet); err != nil {
+		return &healthcheck.SingleCheckResult{Detail: fmt.Sprintf("daemon set %s/%s is unhealthy: %v", h.namespace, h.name, err)}, nil
+	}
+	return &healthcheck.SingleCheckResult{IsHealthy: true}, nil
+}
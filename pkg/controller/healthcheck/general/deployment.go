@@ -0,0 +1,101 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package general
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/healthcheck"
+
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DeploymentHealthChecker checks the health of a deployment.
+type DeploymentHealthChecker struct {
+	name      string
+	namespace string
+	checkSeed bool
+	client    client.Client
+}
+
+// NewSeedDeploymentHealthChecker returns a health check that checks the deployment with the given name in the
+// namespace of the extension resource in the seed cluster, e.g. the cloud-controller-manager.
+func NewSeedDeploymentHealthChecker(name string) *SeedDeploymentHealthChecker {
+	return &SeedDeploymentHealthChecker{DeploymentHealthChecker{name: name, checkSeed: true}}
+}
+
+// NewShootDeploymentHealthChecker returns a health check that checks the deployment with the given name in the
+// given namespace of the shoot cluster.
+func NewShootDeploymentHealthChecker(namespace, name string) *ShootDeploymentHealthChecker {
+	return &ShootDeploymentHealthChecker{DeploymentHealthChecker{name: name, namespace: namespace}}
+}
+
+// SeedDeploymentHealthChecker checks the health of a deployment in the seed cluster.
+type SeedDeploymentHealthChecker struct {
+	DeploymentHealthChecker
+}
+
+// InjectSeedClient implements healthcheck.SeedClient.
+func (h *SeedDeploymentHealthChecker) InjectSeedClient(seedClient client.Client) {
+	h.client = seedClient
+}
+
+// DeepCopy implements healthcheck.HealthCheck.
+func (h *SeedDeploymentHealthChecker) DeepCopy() healthcheck.HealthCheck {
+	copied := *h
+	return &copied
+}
+
+// ShootDeploymentHealthChecker checks the health of a deployment in the shoot cluster.
+type ShootDeploymentHealthChecker struct {
+	DeploymentHealthChecker
+}
+
+// InjectShootClient implements healthcheck.ShootClient.
+func (h *ShootDeploymentHealthChecker) InjectShootClient(shootClient client.Client) {
+	h.client = shootClient
+}
+
+// DeepCopy implements healthcheck.HealthCheck.
+func (h *ShootDeploymentHealthChecker) DeepCopy() healthcheck.HealthCheck {
+	copied := *h
+	return &copied
+}
+
+// Check executes the health check.
+func (h *DeploymentHealthChecker) Check(ctx context.Context, request types.NamespacedName) (*healthcheck.SingleCheckResult, error) {
+	namespace := h.namespace
+	if h.checkSeed {
+		namespace = request.Namespace
+	}
+
+	deployment := &appsv1.Deployment{}
+	if err := h.client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: h.name}, deployment); err != nil {
+		if errors.IsNotFound(err) {
+			return &healthcheck.SingleCheckResult{Detail: fmt.Sprintf("deployment %s/%s not found", namespace, h.name)}, nil
+		}
+		return nil, err
+	}
+
+	if err := health.CheckDeployment(deployment); err != nil {
+		return &healthcheck.SingleCheckResult{Detail: fmt.Sprintf("deployment %s/%s is unhealthy: %v", namespace, h.name, err)}, nil
+	}
+	return &healthcheck.SingleCheckResult{IsHealthy: true}, nil
+}
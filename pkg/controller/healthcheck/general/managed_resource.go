@@ -0,0 +1,68 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package general
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/healthcheck"
+
+	resourcesv1alpha1 "github.com/gardener/gardener-resource-manager/pkg/apis/resources/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ManagedResourceHealthChecker checks whether a ManagedResource in the seed cluster has been applied.
+type ManagedResourceHealthChecker struct {
+	name   string
+	client client.Client
+}
+
+// NewManagedResourceHealthChecker returns a health check that checks whether the gardener-resource-manager has
+// applied the latest generation of the ManagedResource with the given name in the namespace of the extension resource.
+func NewManagedResourceHealthChecker(name string) *ManagedResourceHealthChecker {
+	return &ManagedResourceHealthChecker{name: name}
+}
+
+// InjectSeedClient implements healthcheck.SeedClient.
+func (h *ManagedResourceHealthChecker) InjectSeedClient(seedClient client.Client) {
+	h.client = seedClient
+}
+
+// DeepCopy implements healthcheck.HealthCheck.
+func (h *ManagedResourceHealthChecker) DeepCopy() healthcheck.HealthCheck {
+	copied := *h
+	return &copied
+}
+
+// Check executes the health check.
+func (h *ManagedResourceHealthChecker) Check(ctx context.Context, request types.NamespacedName) (*healthcheck.SingleCheckResult, error) {
+	managedResource := &resourcesv1alpha1.ManagedResource{}
+	if err := h.client.Get(ctx, client.ObjectKey{Namespace: request.Namespace, Name: h.name}, managedResource); err != nil {
+		if errors.IsNotFound(err) {
+			return &healthcheck.SingleCheckResult{Detail: fmt.Sprintf("managed resource %s/%s not found", request.Namespace, h.name)}, nil
+		}
+		return nil, err
+	}
+
+	if managedResource.Status.ObservedGeneration != managedResource.Generation {
+		return &healthcheck.SingleCheckResult{
+			Detail: fmt.Sprintf("managed resource %s/%s has not been applied yet (observed generation %d, generation %d)", request.Namespace, h.name, managedResource.Status.ObservedGeneration, managedResource.Generation),
+		}, nil
+	}
+	return &healthcheck.SingleCheckResult{IsHealthy: true}, nil
+}
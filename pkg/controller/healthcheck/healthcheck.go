@@ -0,0 +1,92 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck
+
+import (
+	"context"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+/*
+	Each extension can register multiple HealthCheckActuators with various health checks to check the API objects it
+	deploys. Each HealthCheckActuator is responsible for a single extension resource kind (e.g. Worker) - it provides
+	the health check results for the conditions of that resource.
+
+	The reconciler of the health check controller periodically executes the HealthCheckActuator and writes the results
+	as conditions into the status of the extension resource.
+*/
+
+// GetExtensionObjectFunc returns a new, empty extension resource of the kind the health checks are executed for.
+type GetExtensionObjectFunc = func() runtime.Object
+
+// HealthCheckActuator executes the health checks of an extension resource kind.
+type HealthCheckActuator interface {
+	// ExecuteHealthCheckFunctions executes all registered health checks for the extension resource with the given
+	// request and returns their results aggregated by condition type.
+	ExecuteHealthCheckFunctions(ctx context.Context, request types.NamespacedName) ([]Result, error)
+}
+
+// HealthCheck represents a single health check. Health checks can access the seed and the shoot cluster by
+// implementing the SeedClient and ShootClient interfaces.
+type HealthCheck interface {
+	// Check executes the health check for the extension resource with the given request.
+	Check(ctx context.Context, request types.NamespacedName) (*SingleCheckResult, error)
+	// DeepCopy clones the health check. Every execution works on its own copy as the clients are injected per shoot.
+	DeepCopy() HealthCheck
+}
+
+// SeedClient can be implemented by health checks that need a client for the seed cluster.
+type SeedClient interface {
+	// InjectSeedClient injects the client for the seed cluster.
+	InjectSeedClient(client.Client)
+}
+
+// ShootClient can be implemented by health checks that need a client for the shoot cluster.
+type ShootClient interface {
+	// InjectShootClient injects the client for the shoot cluster.
+	InjectShootClient(client.Client)
+}
+
+// ConditionTypeToHealthCheck maps a condition type to a health check.
+type ConditionTypeToHealthCheck struct {
+	// ConditionType is the type of the condition the health check contributes to.
+	ConditionType gardencorev1alpha1.ConditionType
+	// HealthCheck is the health check.
+	HealthCheck HealthCheck
+}
+
+// SingleCheckResult is the result of a single health check.
+type SingleCheckResult struct {
+	// IsHealthy indicates whether the checked component is healthy.
+	IsHealthy bool
+	// Detail contains the reason why the checked component is unhealthy.
+	Detail string
+}
+
+// Result is the aggregated result of all health checks of a condition type.
+type Result struct {
+	// ConditionType is the type of the condition.
+	ConditionType gardencorev1alpha1.ConditionType
+	// IsHealthy indicates whether all health checks of the condition type have been successful.
+	IsHealthy bool
+	// Details contains the details of all unsuccessful health checks.
+	Details []string
+	// Errors contains the errors of all health checks that could not be executed.
+	Errors []error
+}
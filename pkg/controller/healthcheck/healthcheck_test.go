@@ -0,0 +1,174 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/healthcheck"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+)
+
+type fakeHealthCheck struct {
+	result *healthcheck.SingleCheckResult
+	err    error
+}
+
+func (f *fakeHealthCheck) Check(_ context.Context, _ types.NamespacedName) (*healthcheck.SingleCheckResult, error) {
+	return f.result, f.err
+}
+
+func (f *fakeHealthCheck) DeepCopy() healthcheck.HealthCheck {
+	copied := *f
+	return &copied
+}
+
+type fakeActuator struct {
+	results []healthcheck.Result
+}
+
+func (f *fakeActuator) ExecuteHealthCheckFunctions(_ context.Context, _ types.NamespacedName) ([]healthcheck.Result, error) {
+	return f.results, nil
+}
+
+var _ = Describe("HealthCheck", func() {
+	const (
+		namespace = "shoot--foo--bar"
+		name      = "worker"
+	)
+
+	var (
+		ctx     = context.TODO()
+		request = types.NamespacedName{Namespace: namespace, Name: name}
+	)
+
+	Describe("#ExecuteHealthCheckFunctions", func() {
+		It("should aggregate the results per condition type", func() {
+			actuator := healthcheck.NewActuator("test", "Worker", []healthcheck.ConditionTypeToHealthCheck{
+				{
+					ConditionType: gardencorev1alpha1.ShootControlPlaneHealthy,
+					HealthCheck:   &fakeHealthCheck{result: &healthcheck.SingleCheckResult{IsHealthy: true}},
+				},
+				{
+					ConditionType: gardencorev1alpha1.ShootEveryNodeReady,
+					HealthCheck:   &fakeHealthCheck{result: &healthcheck.SingleCheckResult{IsHealthy: false, Detail: "node not ready"}},
+				},
+				{
+					ConditionType: gardencorev1alpha1.ShootEveryNodeReady,
+					HealthCheck:   &fakeHealthCheck{err: errors.New("boom")},
+				},
+			})
+
+			results, err := actuator.ExecuteHealthCheckFunctions(ctx, request)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(results).To(HaveLen(2))
+			Expect(results[0]).To(Equal(healthcheck.Result{
+				ConditionType: gardencorev1alpha1.ShootControlPlaneHealthy,
+				IsHealthy:     true,
+			}))
+			Expect(results[1].ConditionType).To(Equal(gardencorev1alpha1.ShootEveryNodeReady))
+			Expect(results[1].IsHealthy).To(BeFalse())
+			Expect(results[1].Details).To(ConsistOf("node not ready"))
+			Expect(results[1].Errors).To(ConsistOf(MatchError("boom")))
+		})
+	})
+
+	Describe("#Reconcile", func() {
+		var (
+			scheme *runtime.Scheme
+			worker *extensionsv1alpha1.Worker
+		)
+
+		BeforeEach(func() {
+			scheme = runtime.NewScheme()
+			Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+			worker = &extensionsv1alpha1.Worker{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+				Status: extensionsv1alpha1.WorkerStatus{
+					DefaultStatus: extensionsv1alpha1.DefaultStatus{
+						LastOperation: &gardencorev1alpha1.LastOperation{
+							Type:  gardencorev1alpha1.LastOperationTypeReconcile,
+							State: gardencorev1alpha1.LastOperationStateSucceeded,
+						},
+					},
+				},
+			}
+		})
+
+		newReconciler := func(c client.Client, results ...healthcheck.Result) reconcile.Reconciler {
+			reconciler := healthcheck.NewReconciler(&fakeActuator{results: results}, func() runtime.Object {
+				return &extensionsv1alpha1.Worker{}
+			}, healthcheck.DefaultSyncPeriod)
+
+			Expect(reconciler.(inject.Client).InjectClient(c)).To(Succeed())
+			Expect(reconciler.(inject.Stoppable).InjectStopChannel(make(chan struct{}))).To(Succeed())
+			return reconciler
+		}
+
+		It("should write the health check results as conditions", func() {
+			cluster := &extensionsv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: namespace}}
+			c := fakeclient.NewFakeClientWithScheme(scheme, worker, cluster)
+
+			reconciler := newReconciler(c,
+				healthcheck.Result{ConditionType: gardencorev1alpha1.ShootControlPlaneHealthy, IsHealthy: true},
+				healthcheck.Result{ConditionType: gardencorev1alpha1.ShootEveryNodeReady, Details: []string{"b", "a"}},
+			)
+
+			result, err := reconciler.Reconcile(reconcile.Request{NamespacedName: request})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{RequeueAfter: healthcheck.DefaultSyncPeriod}))
+
+			updated := &extensionsv1alpha1.Worker{}
+			Expect(c.Get(ctx, request, updated)).To(Succeed())
+			Expect(updated.Status.Conditions).To(HaveLen(2))
+			Expect(updated.Status.Conditions[0].Type).To(Equal(gardencorev1alpha1.ShootControlPlaneHealthy))
+			Expect(updated.Status.Conditions[0].Status).To(Equal(gardencorev1alpha1.ConditionTrue))
+			Expect(updated.Status.Conditions[0].Reason).To(Equal(healthcheck.ReasonSuccessful))
+			Expect(updated.Status.Conditions[1].Type).To(Equal(gardencorev1alpha1.ShootEveryNodeReady))
+			Expect(updated.Status.Conditions[1].Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+			Expect(updated.Status.Conditions[1].Reason).To(Equal(healthcheck.ReasonUnsuccessful))
+			Expect(updated.Status.Conditions[1].Message).To(Equal("Health checks failed: a; b"))
+		})
+
+		It("should not execute the health checks before the resource has been created", func() {
+			worker.Status.LastOperation.Type = gardencorev1alpha1.LastOperationTypeCreate
+			worker.Status.LastOperation.State = gardencorev1alpha1.LastOperationStateProcessing
+			c := fakeclient.NewFakeClientWithScheme(scheme, worker)
+
+			reconciler := newReconciler(c, healthcheck.Result{ConditionType: gardencorev1alpha1.ShootEveryNodeReady, IsHealthy: true})
+
+			_, err := reconciler.Reconcile(reconcile.Request{NamespacedName: request})
+			Expect(err).NotTo(HaveOccurred())
+
+			updated := &extensionsv1alpha1.Worker{}
+			Expect(c.Get(ctx, request, updated)).To(Succeed())
+			Expect(updated.Status.Conditions).To(BeEmpty())
+		})
+	})
+})
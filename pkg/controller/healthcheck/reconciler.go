@@ -0,0 +1,245 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/util"
+
+	"github.com/gardener/gardener/pkg/api/extensions"
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+)
+
+const (
+	// ReasonSuccessful is the reason of conditions whose health checks have been successful.
+	ReasonSuccessful = "HealthCheckSuccessful"
+	// ReasonUnsuccessful is the reason of conditions whose health checks have been unsuccessful.
+	ReasonUnsuccessful = "HealthCheckUnsuccessful"
+	// ReasonHibernated is the reason of conditions whose health checks have been skipped as the shoot is hibernated.
+	ReasonHibernated = "ShootIsHibernated"
+)
+
+type reconciler struct {
+	logger   logr.Logger
+	actuator HealthCheckActuator
+
+	getExtensionObjFunc GetExtensionObjectFunc
+	syncPeriod          time.Duration
+
+	ctx    context.Context
+	client client.Client
+}
+
+// NewReconciler creates a new reconcile.Reconciler that periodically executes the health checks of the given
+// actuator and writes their results as conditions into the status of the extension resources.
+func NewReconciler(actuator HealthCheckActuator, getExtensionObjFunc GetExtensionObjectFunc, syncPeriod time.Duration) reconcile.Reconciler {
+	return &reconciler{
+		logger:              log.Log.WithName(ControllerName),
+		actuator:            actuator,
+		getExtensionObjFunc: getExtensionObjFunc,
+		syncPeriod:          syncPeriod,
+	}
+}
+
+func (r *reconciler) InjectFunc(f inject.Func) error {
+	return f(r.actuator)
+}
+
+func (r *reconciler) InjectClient(client client.Client) error {
+	r.client = client
+	return nil
+}
+
+func (r *reconciler) InjectStopChannel(stopCh <-chan struct{}) error {
+	r.ctx = util.ContextFromStopChannel(stopCh)
+	return nil
+}
+
+func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	obj := r.getExtensionObjFunc()
+	if err := r.client.Get(r.ctx, request.NamespacedName, obj); err != nil {
+		if errors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	acc, err := extensions.Accessor(obj)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if acc.GetDeletionTimestamp() != nil {
+		r.logger.Info("Skipping the health checks of deleting resource", "namespace", request.Namespace, "name", request.Name)
+		return reconcile.Result{}, nil
+	}
+
+	// The health checks are only meaningful once the resource has been reconciled successfully at least once.
+	if lastOperation := acc.GetExtensionStatus().GetLastOperation(); lastOperation == nil ||
+		(lastOperation.GetType() == gardencorev1alpha1.LastOperationTypeCreate && lastOperation.GetState() != gardencorev1alpha1.LastOperationStateSucceeded) {
+		return reconcile.Result{RequeueAfter: r.syncPeriod}, nil
+	}
+
+	cluster, err := extensionscontroller.GetCluster(r.ctx, r.client, request.Namespace)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	var conditions []gardencorev1alpha1.Condition
+	if extensionscontroller.IsHibernated(cluster) {
+		conditions, err = r.hibernatedConditions(obj)
+	} else {
+		conditions, err = r.healthCheckConditions(obj, request)
+	}
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := updateConditions(r.ctx, r.client, obj, conditions); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: r.syncPeriod}, nil
+}
+
+func (r *reconciler) healthCheckConditions(obj runtime.Object, request reconcile.Request) ([]gardencorev1alpha1.Condition, error) {
+	status, err := defaultStatusOf(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.actuator.ExecuteHealthCheckFunctions(r.ctx, request.NamespacedName)
+	if err != nil {
+		return nil, err
+	}
+
+	conditions := make([]gardencorev1alpha1.Condition, 0, len(results))
+	for _, result := range results {
+		condition := gardencorev1alpha1helper.GetOrInitCondition(status.Conditions, result.ConditionType)
+
+		switch {
+		case len(result.Errors) > 0:
+			messages := make([]string, 0, len(result.Errors))
+			for _, err := range result.Errors {
+				messages = append(messages, err.Error())
+			}
+			r.logger.Info("Health checks could not be executed", "namespace", request.Namespace, "name", request.Name, "condition", result.ConditionType, "errors", messages)
+			condition = gardencorev1alpha1helper.UpdatedConditionUnknownErrorMessage(condition, joinMessages("Health checks could not be executed", messages))
+		case !result.IsHealthy:
+			condition = gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, ReasonUnsuccessful, joinMessages("Health checks failed", result.Details))
+		default:
+			condition = gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, ReasonSuccessful, "All health checks successful")
+		}
+
+		conditions = append(conditions, condition)
+	}
+	return conditions, nil
+}
+
+func (r *reconciler) hibernatedConditions(obj runtime.Object) ([]gardencorev1alpha1.Condition, error) {
+	status, err := defaultStatusOf(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	var conditions []gardencorev1alpha1.Condition
+	for _, condition := range status.Conditions {
+		if condition.Reason == ReasonHibernated {
+			conditions = append(conditions, condition)
+			continue
+		}
+		conditions = append(conditions, gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, ReasonHibernated, "Shoot is hibernated"))
+	}
+	return conditions, nil
+}
+
+func joinMessages(prefix string, messages []string) string {
+	sort.Strings(messages)
+	return fmt.Sprintf("%s: %s", prefix, strings.Join(messages, "; "))
+}
+
+func updateConditions(ctx context.Context, c client.Client, obj runtime.Object, conditions []gardencorev1alpha1.Condition) error {
+	status, err := defaultStatusOf(obj)
+	if err != nil {
+		return err
+	}
+
+	newConditions := gardencorev1alpha1helper.MergeConditions(status.Conditions, conditions...)
+	if !conditionsNeedUpdate(status.Conditions, newConditions) {
+		return nil
+	}
+
+	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, c, obj, func() error {
+		status.Conditions = gardencorev1alpha1helper.MergeConditions(status.Conditions, conditions...)
+		return nil
+	})
+}
+
+// conditionsNeedUpdate returns true if the conditions differ in anything but their last update time. This prevents
+// status updates in every sync period if the health of the components has not changed.
+func conditionsNeedUpdate(existingConditions, newConditions []gardencorev1alpha1.Condition) bool {
+	if len(existingConditions) != len(newConditions) {
+		return true
+	}
+	for i := range existingConditions {
+		existing, updated := existingConditions[i], newConditions[i]
+		if existing.Type != updated.Type || existing.Status != updated.Status || existing.Reason != updated.Reason || existing.Message != updated.Message {
+			return true
+		}
+	}
+	return false
+}
+
+// defaultStatusOf returns a pointer to the DefaultStatus embedded into the status of the given extension resource.
+// The generic extensionsv1alpha1.Status interface does not provide access to the conditions.
+func defaultStatusOf(obj runtime.Object) (*extensionsv1alpha1.DefaultStatus, error) {
+	value := reflect.ValueOf(obj)
+	if value.Kind() != reflect.Ptr || value.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%T is not a pointer to an extension resource", obj)
+	}
+
+	status := value.Elem().FieldByName("Status")
+	if !status.IsValid() || status.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%T has no status", obj)
+	}
+
+	defaultStatus := status.FieldByName("DefaultStatus")
+	if !defaultStatus.IsValid() || !defaultStatus.CanAddr() {
+		return nil, fmt.Errorf("status of %T does not embed the default status", obj)
+	}
+
+	result, ok := defaultStatus.Addr().Interface().(*extensionsv1alpha1.DefaultStatus)
+	if !ok {
+		return nil, fmt.Errorf("status of %T does not embed the default status", obj)
+	}
+	return result, nil
+}
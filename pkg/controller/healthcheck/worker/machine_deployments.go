@@ -0,0 +1,64 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/healthcheck"
+
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// MachineDeploymentsHealthChecker checks whether the machine deployments of a shoot are available.
+type MachineDeploymentsHealthChecker struct {
+	client client.Client
+}
+
+// NewMachineDeploymentsHealthChecker returns a health check that checks whether all machine deployments in the
+// namespace of the extension resource in the seed cluster are available.
+func NewMachineDeploymentsHealthChecker() *MachineDeploymentsHealthChecker {
+	return &MachineDeploymentsHealthChecker{}
+}
+
+// InjectSeedClient implements healthcheck.SeedClient.
+func (h *MachineDeploymentsHealthChecker) InjectSeedClient(seedClient client.Client) {
+	h.client = seedClient
+}
+
+// DeepCopy implements healthcheck.HealthCheck.
+func (h *MachineDeploymentsHealthChecker) DeepCopy() healthcheck.HealthCheck {
+	copied := *h
+	return &copied
+}
+
+// Check executes the health check.
+func (h *MachineDeploymentsHealthChecker) Check(ctx context.Context, request types.NamespacedName) (*healthcheck.SingleCheckResult, error) {
+	machineDeployments := &machinev1alpha1.MachineDeploymentList{}
+	if err := h.client.List(ctx, machineDeployments, client.InNamespace(request.Namespace)); err != nil {
+		return nil, err
+	}
+
+	for _, machineDeployment := range machineDeployments.Items {
+		if err := health.CheckMachineDeployment(&machineDeployment); err != nil {
+			return &healthcheck.SingleCheckResult{Detail: fmt.Sprintf("machine deployment %s is unhealthy: %v", machineDeployment.Name, err)}, nil
+		}
+	}
+	return &healthcheck.SingleCheckResult{IsHealthy: true}, nil
+}
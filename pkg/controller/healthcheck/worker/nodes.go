@@ -0,0 +1,63 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/healthcheck"
+
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NodesHealthChecker checks whether the nodes of a shoot are ready.
+type NodesHealthChecker struct {
+	client client.Client
+}
+
+// NewNodesHealthChecker returns a health check that checks whether all nodes of the shoot cluster are ready.
+func NewNodesHealthChecker() *NodesHealthChecker {
+	return &NodesHealthChecker{}
+}
+
+// InjectShootClient implements healthcheck.ShootClient.
+func (h *NodesHealthChecker) InjectShootClient(shootClient client.Client) {
+	h.client = shootClient
+}
+
+// DeepCopy implements healthcheck.HealthCheck.
+func (h *NodesHealthChecker) DeepCopy() healthcheck.HealthCheck {
+	copied := *h
+	return &copied
+}
+
+// Check executes the health check.
+func (h *NodesHealthChecker) Check(ctx context.Context, _ types.NamespacedName) (*healthcheck.SingleCheckResult, error) {
+	nodes := &corev1.NodeList{}
+	if err := h.client.List(ctx, nodes); err != nil {
+		return nil, err
+	}
+
+	for _, node := range nodes.Items {
+		if err := health.CheckNode(&node); err != nil {
+			return &healthcheck.SingleCheckResult{Detail: fmt.Sprintf("node %s is unhealthy: %v", node.Name, err)}, nil
+		}
+	}
+	return &healthcheck.SingleCheckResult{IsHealthy: true}, nil
+}
@@ -27,4 +27,10 @@ type Actuator interface {
 	Reconcile(context.Context, *extensionsv1alpha1.Infrastructure, *extensionscontroller.Cluster) error
 	// Delete the Infrastructure config.
 	Delete(context.Context, *extensionsv1alpha1.Infrastructure, *extensionscontroller.Cluster) error
+	// Migrate the Infrastructure config. It exports the state that is required to restore the Infrastructure
+	// in another seed into the status and releases all resources in the seed without deleting the infrastructure
+	// in the cloud provider.
+	Migrate(context.Context, *extensionsv1alpha1.Infrastructure, *extensionscontroller.Cluster) error
+	// Restore the Infrastructure config from the state that has been exported during a previous migration.
+	Restore(context.Context, *extensionsv1alpha1.Infrastructure, *extensionscontroller.Cluster) error
 }
@@ -16,6 +16,7 @@ package infrastructure
 
 import (
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 	extensionshandler "github.com/gardener/gardener-extensions/pkg/handler"
 	extensionspredicate "github.com/gardener/gardener-extensions/pkg/predicate"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -51,10 +52,15 @@ type AddArgs struct {
 
 // DefaultPredicates returns the default predicates for an infrastructure reconciler.
 func DefaultPredicates(typeName string, ignoreOperationAnnotation bool) []predicate.Predicate {
+	// Requesting or leaving the plan mode does not increase the generation of an Infrastructure as it is done with an
+	// annotation. Removing the annotation has to apply the planned changes.
 	if ignoreOperationAnnotation {
 		return []predicate.Predicate{
 			extensionspredicate.HasType(typeName),
-			extensionspredicate.GenerationChanged(),
+			extensionspredicate.Or(
+				extensionspredicate.GenerationChanged(),
+				extensionspredicate.AnnotationChanged(extensionsterraformer.AnnotationKeyPlan),
+			),
 			extensionspredicate.ShootNotFailed(),
 		}
 	}
@@ -70,6 +76,7 @@ func DefaultPredicates(typeName string, ignoreOperationAnnotation bool) []predic
 		extensionspredicate.Or(
 			extensionspredicate.HasOperationAnnotation(),
 			extensionspredicate.GenerationChanged(),
+			extensionspredicate.AnnotationChanged(extensionsterraformer.AnnotationKeyPlan),
 		),
 	}
 }
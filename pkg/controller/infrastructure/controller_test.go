@@ -0,0 +1,123 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+)
+
+type fakeCache struct {
+	cache.Cache
+}
+
+func (fakeCache) WaitForCacheSync(<-chan struct{}) bool {
+	return true
+}
+
+var _ = Describe("Controller", func() {
+	Describe("#DefaultPredicates", func() {
+		var (
+			stopCh chan struct{}
+			old    *extensionsv1alpha1.Infrastructure
+		)
+
+		newPredicates := func(ignoreOperationAnnotation bool) []predicate.Predicate {
+			s := runtime.NewScheme()
+			Expect(scheme.AddToScheme(s)).To(Succeed())
+			Expect(extensionsv1alpha1.AddToScheme(s)).To(Succeed())
+			c := fake.NewFakeClientWithScheme(s, &extensionsv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: old.Namespace}})
+
+			predicates := DefaultPredicates("aws", ignoreOperationAnnotation)
+			for _, p := range predicates {
+				_, err := inject.InjectorInto(func(i interface{}) error {
+					if _, err := inject.ClientInto(c, i); err != nil {
+						return err
+					}
+					if _, err := inject.StopChannelInto(stopCh, i); err != nil {
+						return err
+					}
+					_, err := inject.CacheInto(fakeCache{}, i)
+					return err
+				}, p)
+				Expect(err).NotTo(HaveOccurred())
+			}
+			return predicates
+		}
+
+		update := func(predicates []predicate.Predicate, new *extensionsv1alpha1.Infrastructure) bool {
+			e := event.UpdateEvent{MetaOld: old, ObjectOld: old, MetaNew: new, ObjectNew: new}
+			for _, p := range predicates {
+				if !p.Update(e) {
+					return false
+				}
+			}
+			return true
+		}
+
+		BeforeEach(func() {
+			stopCh = make(chan struct{})
+			old = &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "shoot--foo--bar",
+					Name:        "infrastructure",
+					Generation:  1,
+					Annotations: map[string]string{extensionsterraformer.AnnotationKeyPlan: "true"},
+				},
+				Spec: extensionsv1alpha1.InfrastructureSpec{
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "aws"},
+				},
+			}
+		})
+
+		AfterEach(func() {
+			close(stopCh)
+		})
+
+		It("should apply the infrastructure when the plan annotation is removed", func() {
+			new := old.DeepCopy()
+			delete(new.Annotations, extensionsterraformer.AnnotationKeyPlan)
+
+			Expect(update(newPredicates(true), new)).To(BeTrue())
+		})
+
+		It("should apply the infrastructure when the plan annotation is removed after a failed operation", func() {
+			old.Status.LastOperation = &gardencorev1alpha1.LastOperation{State: gardencorev1alpha1.LastOperationStateFailed}
+			new := old.DeepCopy()
+			delete(new.Annotations, extensionsterraformer.AnnotationKeyPlan)
+
+			Expect(update(newPredicates(false), new)).To(BeTrue())
+		})
+
+		It("should ignore updates that neither change the generation nor the plan annotation", func() {
+			new := old.DeepCopy()
+			new.Labels = map[string]string{"foo": "bar"}
+
+			Expect(update(newPredicates(true), new)).To(BeFalse())
+		})
+	})
+})
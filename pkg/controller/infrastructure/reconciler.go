@@ -19,9 +19,13 @@ import (
 	"fmt"
 
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
+	"github.com/gardener/gardener-extensions/pkg/metrics"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 
@@ -45,6 +49,10 @@ const (
 	EventInfrastructureReconciliation string = "InfrastructureReconciliation"
 	// EventInfrastructureDeleton an event reason to describe infrastructure deletion.
 	EventInfrastructureDeleton string = "InfrastructureDeleton"
+	// EventInfrastructureMigration an event reason to describe infrastructure migration.
+	EventInfrastructureMigration string = "InfrastructureMigration"
+	// EventInfrastructureRestoration an event reason to describe infrastructure restoration.
+	EventInfrastructureRestoration string = "InfrastructureRestoration"
 )
 
 type reconciler struct {
@@ -97,8 +105,16 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 		return reconcile.Result{}, err
 	}
 
-	if infrastructure.DeletionTimestamp != nil {
+	switch {
+	case extensionscontroller.HasOperation(infrastructure, v1alpha1constants.GardenerOperationMigrate):
+		return r.migrate(r.ctx, infrastructure, cluster)
+	case infrastructure.DeletionTimestamp != nil:
 		return r.delete(r.ctx, infrastructure, cluster)
+	case extensionscontroller.HasOperation(infrastructure, extensionscontroller.GardenerOperationRestore):
+		return r.restore(r.ctx, infrastructure, cluster)
+	case extensionscontroller.IsMigrated(infrastructure):
+		r.logger.Info("Skipping the reconciliation of migrated infrastructure", "infrastructure", infrastructure.Name)
+		return reconcile.Result{}, nil
 	}
 	return r.reconcile(r.ctx, infrastructure, cluster)
 }
@@ -108,6 +124,10 @@ func (r *reconciler) reconcile(ctx context.Context, infrastructure *extensionsv1
 		return reconcile.Result{}, err
 	}
 
+	if extensionsterraformer.IsPlanRequested(infrastructure) {
+		return r.plan(ctx, infrastructure, cluster)
+	}
+
 	operationType := gardencorev1alpha1helper.ComputeOperationType(infrastructure.ObjectMeta, infrastructure.Status.LastOperation)
 	if err := r.updateStatusProcessing(ctx, infrastructure, operationType, "Reconciling the infrastructure"); err != nil {
 		return reconcile.Result{}, err
@@ -115,7 +135,8 @@ func (r *reconciler) reconcile(ctx context.Context, infrastructure *extensionsv1
 
 	r.logger.Info("Starting the reconciliation of infrastructure", "infrastructure", infrastructure.Name)
 	r.recorder.Event(infrastructure, corev1.EventTypeNormal, EventInfrastructureReconciliation, "Reconciling the infrastructure")
-	if err := r.actuator.Reconcile(ctx, infrastructure, cluster); err != nil {
+	observe := metrics.StartOperation(extensionsv1alpha1.InfrastructureResource, infrastructure.Spec.Type, operationType)
+	if err := observe(r.actuator.Reconcile(ctx, infrastructure, cluster)); err != nil {
 		msg := "Error reconciling infrastructure"
 		utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), infrastructure, operationType, msg))
 		r.logger.Error(err, msg, "infrastructure", infrastructure.Name)
@@ -132,6 +153,23 @@ func (r *reconciler) reconcile(ctx context.Context, infrastructure *extensionsv1
 	return reconcile.Result{}, nil
 }
 
+// plan lets the actuator only plan the changes of the infrastructure. The last operation of the infrastructure is not
+// updated as nothing is applied, the result of the plan is reported in the TerraformPlan condition instead.
+func (r *reconciler) plan(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
+	r.logger.Info("Planning the changes of infrastructure", "infrastructure", infrastructure.Name)
+	if err := r.actuator.Reconcile(ctx, infrastructure, cluster); err != nil {
+		msg := "Error planning the changes of infrastructure"
+		r.recorder.Event(infrastructure, corev1.EventTypeWarning, EventInfrastructureReconciliation, fmt.Sprintf("%s: %v", msg, err))
+		r.logger.Error(err, msg, "infrastructure", infrastructure.Name)
+		return extensionscontroller.ReconcileErr(err)
+	}
+
+	msg := "Successfully planned the changes of infrastructure"
+	r.logger.Info(msg, "infrastructure", infrastructure.Name)
+	r.recorder.Event(infrastructure, corev1.EventTypeNormal, EventInfrastructureReconciliation, msg)
+	return reconcile.Result{}, nil
+}
+
 func (r *reconciler) delete(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
 	hasFinalizer, err := extensionscontroller.HasFinalizer(infrastructure, FinalizerName)
 	if err != nil {
@@ -143,6 +181,15 @@ func (r *reconciler) delete(ctx context.Context, infrastructure *extensionsv1alp
 		return reconcile.Result{}, nil
 	}
 
+	if extensionscontroller.IsMigrated(infrastructure) {
+		r.logger.Info("Infrastructure has been migrated, removing finalizer without deleting the infrastructure.", "infrastructure", infrastructure.Name)
+		if err := extensionscontroller.DeleteFinalizer(ctx, r.client, FinalizerName, infrastructure); err != nil {
+			r.logger.Error(err, "Error removing finalizer from Infrastructure", "infrastructure", infrastructure.Name)
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{}, nil
+	}
+
 	operationType := gardencorev1alpha1helper.ComputeOperationType(infrastructure.ObjectMeta, infrastructure.Status.LastOperation)
 	if err := r.updateStatusProcessing(ctx, infrastructure, operationType, "Deleting the infrastructure"); err != nil {
 		return reconcile.Result{}, err
@@ -150,7 +197,8 @@ func (r *reconciler) delete(ctx context.Context, infrastructure *extensionsv1alp
 
 	r.logger.Info("Starting the deletion of infrastructure", "infrastructure", infrastructure.Name)
 	r.recorder.Event(infrastructure, corev1.EventTypeNormal, EventInfrastructureDeleton, "Deleting the infrastructure")
-	if err := r.actuator.Delete(r.ctx, infrastructure, cluster); err != nil {
+	observe := metrics.StartOperation(extensionsv1alpha1.InfrastructureResource, infrastructure.Spec.Type, operationType)
+	if err := observe(r.actuator.Delete(r.ctx, infrastructure, cluster)); err != nil {
 		msg := "Error deleting infrastructure"
 		r.recorder.Eventf(infrastructure, corev1.EventTypeWarning, EventInfrastructureDeleton, "%s: %+v", msg, err)
 		utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), infrastructure, operationType, msg))
@@ -174,6 +222,72 @@ func (r *reconciler) delete(ctx context.Context, infrastructure *extensionsv1alp
 	return reconcile.Result{}, nil
 }
 
+func (r *reconciler) migrate(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
+	operationType := extensionscontroller.LastOperationTypeMigrate
+	if err := r.updateStatusProcessing(ctx, infrastructure, operationType, "Migrating the infrastructure"); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.logger.Info("Starting the migration of infrastructure", "infrastructure", infrastructure.Name)
+	r.recorder.Event(infrastructure, corev1.EventTypeNormal, EventInfrastructureMigration, "Migrating the infrastructure")
+	observe := metrics.StartOperation(extensionsv1alpha1.InfrastructureResource, infrastructure.Spec.Type, operationType)
+	if err := observe(r.actuator.Migrate(ctx, infrastructure, cluster)); err != nil {
+		msg := "Error migrating infrastructure"
+		r.recorder.Eventf(infrastructure, corev1.EventTypeWarning, EventInfrastructureMigration, "%s: %+v", msg, err)
+		utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), infrastructure, operationType, msg))
+		r.logger.Error(err, msg, "infrastructure", infrastructure.Name)
+		return extensionscontroller.ReconcileErr(err)
+	}
+
+	msg := "Successfully migrated infrastructure"
+	r.logger.Info(msg, "infrastructure", infrastructure.Name)
+	r.recorder.Event(infrastructure, corev1.EventTypeNormal, EventInfrastructureMigration, msg)
+	if err := r.updateStatusSuccess(ctx, infrastructure, operationType, msg); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := extensionscontroller.RemoveOperationAnnotation(ctx, r.client, infrastructure); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *reconciler) restore(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
+	if err := extensionscontroller.EnsureFinalizer(ctx, r.client, FinalizerName, infrastructure); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	operationType := extensionscontroller.LastOperationTypeRestore
+	if err := r.updateStatusProcessing(ctx, infrastructure, operationType, "Restoring the infrastructure"); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.logger.Info("Starting the restoration of infrastructure", "infrastructure", infrastructure.Name)
+	r.recorder.Event(infrastructure, corev1.EventTypeNormal, EventInfrastructureRestoration, "Restoring the infrastructure")
+	observe := metrics.StartOperation(extensionsv1alpha1.InfrastructureResource, infrastructure.Spec.Type, operationType)
+	if err := observe(r.actuator.Restore(ctx, infrastructure, cluster)); err != nil {
+		msg := "Error restoring infrastructure"
+		r.recorder.Eventf(infrastructure, corev1.EventTypeWarning, EventInfrastructureRestoration, "%s: %+v", msg, err)
+		utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), infrastructure, operationType, msg))
+		r.logger.Error(err, msg, "infrastructure", infrastructure.Name)
+		return extensionscontroller.ReconcileErr(err)
+	}
+
+	msg := "Successfully restored infrastructure"
+	r.logger.Info(msg, "infrastructure", infrastructure.Name)
+	r.recorder.Event(infrastructure, corev1.EventTypeNormal, EventInfrastructureRestoration, msg)
+	if err := r.updateStatusSuccess(ctx, infrastructure, operationType, msg); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := extensionscontroller.RemoveOperationAnnotation(ctx, r.client, infrastructure); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
 func (r *reconciler) updateStatusProcessing(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, lastOperationType gardencorev1alpha1.LastOperationType, description string) error {
 	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, infrastructure, func() error {
 		infrastructure.Status.LastOperation = extensionscontroller.LastOperation(lastOperationType, gardencorev1alpha1.LastOperationStateProcessing, 1, description)
@@ -184,7 +298,7 @@ func (r *reconciler) updateStatusProcessing(ctx context.Context, infrastructure
 func (r *reconciler) updateStatusError(ctx context.Context, err error, infrastructure *extensionsv1alpha1.Infrastructure, lastOperationType gardencorev1alpha1.LastOperationType, description string) error {
 	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, infrastructure, func() error {
 		infrastructure.Status.ObservedGeneration = infrastructure.Generation
-		infrastructure.Status.LastOperation, infrastructure.Status.LastError = extensionscontroller.ReconcileError(lastOperationType, gardencorev1alpha1helper.FormatLastErrDescription(fmt.Errorf("%s: %v", description, err)), 50, gardencorev1alpha1helper.ExtractErrorCodes(err)...)
+		infrastructure.Status.LastOperation, infrastructure.Status.LastError = extensionscontroller.ReconcileError(lastOperationType, gardencorev1alpha1helper.FormatLastErrDescription(fmt.Errorf("%s: %v", description, err)), 50, controllererrors.ExtractErrorCodes(err)...)
 		return nil
 	})
 }
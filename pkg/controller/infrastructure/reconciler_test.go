@@ -0,0 +1,117 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+	"errors"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+type fakeActuator struct {
+	Actuator
+	reconcileErr   error
+	reconcileCalls int
+}
+
+func (a *fakeActuator) Reconcile(context.Context, *extensionsv1alpha1.Infrastructure, *extensionscontroller.Cluster) error {
+	a.reconcileCalls++
+	return a.reconcileErr
+}
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx      context.Context
+		actuator *fakeActuator
+		c        client.Client
+		r        *reconciler
+		infra    *extensionsv1alpha1.Infrastructure
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		actuator = &fakeActuator{}
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar", Name: "infra"},
+		}
+	})
+
+	JustBeforeEach(func() {
+		s := runtime.NewScheme()
+		Expect(scheme.AddToScheme(s)).To(Succeed())
+		Expect(extensionsv1alpha1.AddToScheme(s)).To(Succeed())
+		c = fake.NewFakeClientWithScheme(s, infra.DeepCopy())
+
+		r = &reconciler{
+			logger:   log.Log.WithName("test"),
+			actuator: actuator,
+			client:   c,
+			recorder: record.NewFakeRecorder(10),
+		}
+	})
+
+	getInfrastructure := func() *extensionsv1alpha1.Infrastructure {
+		out := &extensionsv1alpha1.Infrastructure{}
+		Expect(c.Get(ctx, client.ObjectKey{Namespace: infra.Namespace, Name: infra.Name}, out)).To(Succeed())
+		return out
+	}
+
+	Describe("#reconcile", func() {
+		It("should report a successful reconciliation in the last operation", func() {
+			_, err := r.reconcile(ctx, getInfrastructure(), nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actuator.reconcileCalls).To(Equal(1))
+			Expect(getInfrastructure().Status.LastOperation.State).To(Equal(gardencorev1alpha1.LastOperationStateSucceeded))
+		})
+
+		Context("plan mode", func() {
+			BeforeEach(func() {
+				infra.Annotations = map[string]string{extensionsterraformer.AnnotationKeyPlan: "true"}
+			})
+
+			It("should not update the last operation", func() {
+				_, err := r.reconcile(ctx, getInfrastructure(), nil)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(actuator.reconcileCalls).To(Equal(1))
+				Expect(getInfrastructure().Status.LastOperation).To(BeNil())
+			})
+
+			It("should not update the last operation if planning fails", func() {
+				actuator.reconcileErr = errors.New("fake")
+
+				_, err := r.reconcile(ctx, getInfrastructure(), nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(getInfrastructure().Status.LastOperation).To(BeNil())
+			})
+		})
+	})
+})
@@ -25,8 +25,10 @@ import (
 	"github.com/gardener/gardener/pkg/chartrenderer"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -126,6 +128,30 @@ func DeleteManagedResource(ctx context.Context, client client.Client, namespace
 	return nil
 }
 
+// SetKeepObjects sets the keepObjects field of the managed resource with the given <name> so that the
+// objects it manages are either kept or deleted when the managed resource itself is deleted.
+func SetKeepObjects(ctx context.Context, c client.Client, namespace, name string, keepObjects bool) error {
+	mr := &resourcesv1alpha1.ManagedResource{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, mr); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return errors.Wrapf(err, "could not get managed resource '%s/%s'", namespace, name)
+	}
+
+	if mr.Spec.KeepObjects != nil && *mr.Spec.KeepObjects == keepObjects {
+		return nil
+	}
+
+	withOldKeepObjects := mr.DeepCopy()
+	mr.Spec.KeepObjects = &keepObjects
+	if err := c.Patch(ctx, mr, client.MergeFrom(withOldKeepObjects)); err != nil {
+		return errors.Wrapf(err, "could not update keepObjects of managed resource '%s/%s'", namespace, name)
+	}
+
+	return nil
+}
+
 // WaitUntilManagedResourceDeleted waits until the given managed resource is deleted.
 func WaitUntilManagedResourceDeleted(ctx context.Context, client client.Client, namespace, name string) error {
 	mr := &resourcesv1alpha1.ManagedResource{
@@ -136,3 +162,18 @@ func WaitUntilManagedResourceDeleted(ctx context.Context, client client.Client,
 	}
 	return WaitUntilResourceDeleted(ctx, client, mr, 2*time.Second)
 }
+
+// WaitUntilManagedResourceHealthy waits until the given managed resource has been applied by the
+// gardener-resource-manager, i.e. until its observed generation matches its generation.
+func WaitUntilManagedResourceHealthy(ctx context.Context, c client.Client, namespace, name string) error {
+	mr := &resourcesv1alpha1.ManagedResource{}
+	return wait.PollImmediateUntil(2*time.Second, func() (done bool, err error) {
+		if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, mr); err != nil {
+			if apierrors.IsNotFound(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		return mr.Status.ObservedGeneration == mr.Generation, nil
+	}, ctx.Done())
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/gardener/gardener-extensions/pkg/controller"
+	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+
+	resourcesv1alpha1 "github.com/gardener/gardener-resource-manager/pkg/apis/resources/v1alpha1"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("ManagedResources", func() {
+	const (
+		namespace = "shoot--foo--bar"
+		name      = "foo"
+	)
+
+	var (
+		ctrl *gomock.Controller
+		c    *mockclient.MockClient
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		c = mockclient.NewMockClient(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#WaitUntilManagedResourceHealthy", func() {
+		It("should succeed once the managed resource has been applied", func() {
+			c.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: namespace, Name: name}, gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResource{})).
+				DoAndReturn(func(_ context.Context, _ client.ObjectKey, mr *resourcesv1alpha1.ManagedResource) error {
+					mr.Generation = 2
+					mr.Status.ObservedGeneration = 2
+					return nil
+				})
+
+			Expect(controller.WaitUntilManagedResourceHealthy(context.TODO(), c, namespace, name)).To(Succeed())
+		})
+
+		It("should time out if the managed resource has not been applied", func() {
+			c.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: namespace, Name: name}, gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResource{})).
+				DoAndReturn(func(_ context.Context, _ client.ObjectKey, mr *resourcesv1alpha1.ManagedResource) error {
+					mr.Generation = 2
+					mr.Status.ObservedGeneration = 1
+					return nil
+				}).AnyTimes()
+
+			ctx, cancel := context.WithTimeout(context.TODO(), 100*time.Millisecond)
+			defer cancel()
+
+			Expect(controller.WaitUntilManagedResourceHealthy(ctx, c, namespace, name)).To(HaveOccurred())
+		})
+	})
+})
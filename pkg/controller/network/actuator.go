@@ -28,4 +28,9 @@ type Actuator interface {
 	Reconcile(context.Context, *extensionsv1alpha1.Network, *extensioncontroller.Cluster) error
 	// Delete deletes the Network resource.
 	Delete(context.Context, *extensionsv1alpha1.Network, *extensioncontroller.Cluster) error
+	// Migrate releases all resources of the Network resource in the seed without deleting the objects
+	// that have been deployed into the shoot cluster.
+	Migrate(context.Context, *extensionsv1alpha1.Network, *extensioncontroller.Cluster) error
+	// Restore restores the Network resource after a previous migration.
+	Restore(context.Context, *extensionsv1alpha1.Network, *extensioncontroller.Cluster) error
 }
@@ -0,0 +1,127 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ignition
+
+import (
+	"encoding/base64"
+	"fmt"
+	"path"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/operatingsystemconfig/oscommon/generator"
+)
+
+// IgnitionGenerator generates Ignition configs.
+type IgnitionGenerator struct {
+	version        SpecVersion
+	cmd            string
+	bootstrapUnits []Unit
+}
+
+// NewIgnitionGenerator creates a new IgnitionGenerator for the given Ignition spec version. The given command must
+// contain a `%s` placeholder for the path of the config. The given bootstrap units are added to the configs of
+// bootstrapping OperatingSystemConfigs, e.g. to mask units of the operating system.
+func NewIgnitionGenerator(version SpecVersion, cmd string, bootstrapUnits ...Unit) *IgnitionGenerator {
+	return &IgnitionGenerator{
+		version:        version,
+		cmd:            cmd,
+		bootstrapUnits: bootstrapUnits,
+	}
+}
+
+// Generate generates an Ignition config from the given OperatingSystemConfig.
+func (g *IgnitionGenerator) Generate(data *generator.OperatingSystemConfig) ([]byte, *string, error) {
+	if g.version != SpecVersion2 && g.version != SpecVersion3 {
+		return nil, nil, fmt.Errorf("unsupported Ignition spec version %q", g.version)
+	}
+
+	config := &Config{
+		Ignition: Ignition{Version: g.version},
+	}
+
+	if len(data.Files) > 0 {
+		config.Storage = &Storage{}
+	}
+	for _, file := range data.Files {
+		config.Storage.Files = append(config.Storage.Files, g.file(file))
+	}
+
+	var units []Unit
+	if data.Bootstrap {
+		units = append(units, g.bootstrapUnits...)
+	}
+	for _, unit := range data.Units {
+		units = append(units, g.unit(unit))
+	}
+	if len(units) > 0 {
+		config.Systemd = &Systemd{Units: units}
+	}
+
+	out, err := config.String()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var cmd *string
+	if data.Path != nil {
+		c := fmt.Sprintf(g.cmd, *data.Path)
+		cmd = &c
+	}
+
+	return []byte(out), cmd, nil
+}
+
+func (g *IgnitionGenerator) file(file *generator.File) File {
+	f := File{
+		Path:     path.Clean(file.Path),
+		Contents: FileContents{Source: DataURL(file.Content)},
+	}
+
+	switch g.version {
+	case SpecVersion2:
+		f.Filesystem = filesystemRoot
+	case SpecVersion3:
+		overwrite := true
+		f.Overwrite = &overwrite
+	}
+
+	if file.Permissions != nil {
+		mode := int(*file.Permissions)
+		f.Mode = &mode
+	}
+	return f
+}
+
+func (g *IgnitionGenerator) unit(unit *generator.Unit) Unit {
+	u := Unit{Name: unit.Name}
+
+	// Units without content only carry drop-ins for units shipped with the operating system, hence they
+	// are not enabled explicitly.
+	if unit.Content != nil {
+		enabled := true
+		u.Enabled = &enabled
+		u.Contents = string(unit.Content)
+	}
+
+	for _, dropIn := range unit.DropIns {
+		u.DropIns = append(u.DropIns, DropIn{Name: dropIn.Name, Contents: string(dropIn.Content)})
+	}
+	return u
+}
+
+// DataURL returns a base64 encoded data URL (RFC 2397) with the given data, as used for the contents of
+// files in Ignition configs.
+func DataURL(data []byte) string {
+	return "data:;base64," + base64.StdEncoding.EncodeToString(data)
+}
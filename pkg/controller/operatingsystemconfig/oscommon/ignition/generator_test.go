@@ -0,0 +1,127 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ignition_test
+
+import (
+	"encoding/json"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/operatingsystemconfig/oscommon/generator"
+	. "github.com/gardener/gardener-extensions/pkg/controller/operatingsystemconfig/oscommon/ignition"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("IgnitionGenerator", func() {
+	var (
+		permissions = int32(0600)
+		path        = "/var/lib/cloud-config-downloader/downloads/cloud_config"
+
+		osc *generator.OperatingSystemConfig
+
+		decode = func(data []byte) map[string]interface{} {
+			out := map[string]interface{}{}
+			Expect(json.Unmarshal(data, &out)).To(Succeed())
+			return out
+		}
+	)
+
+	BeforeEach(func() {
+		osc = &generator.OperatingSystemConfig{
+			Files: []*generator.File{
+				{Path: "/foo", Content: []byte("bar"), Permissions: &permissions},
+			},
+			Units: []*generator.Unit{
+				{
+					Name:    "kubelet.service",
+					Content: []byte("unit"),
+					DropIns: []*generator.DropIn{{Name: "10-opts.conf", Content: []byte("override")}},
+				},
+				{
+					Name:    "docker.service",
+					DropIns: []*generator.DropIn{{Name: "10-docker-opts.conf", Content: []byte("override")}},
+				},
+			},
+			Bootstrap: true,
+			Path:      &path,
+		}
+	})
+
+	Describe("#Generate", func() {
+		It("should generate a config of version 2 of the specification", func() {
+			g := NewIgnitionGenerator(SpecVersion2, "apply %s", Unit{Name: "locksmithd.service", Mask: true})
+
+			data, cmd, err := g.Generate(osc)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*cmd).To(Equal("apply " + path))
+			Expect(decode(data)).To(Equal(decode([]byte(`{
+  "ignition": {"version": "2.3.0"},
+  "storage": {
+    "files": [
+      {"filesystem": "root", "path": "/foo", "contents": {"source": "data:;base64,YmFy"}, "mode": 384}
+    ]
+  },
+  "systemd": {
+    "units": [
+      {"name": "locksmithd.service", "mask": true},
+      {"name": "kubelet.service", "enabled": true, "contents": "unit", "dropins": [{"name": "10-opts.conf", "contents": "override"}]},
+      {"name": "docker.service", "dropins": [{"name": "10-docker-opts.conf", "contents": "override"}]}
+    ]
+  }
+}`))))
+		})
+
+		It("should generate a config of version 3 of the specification", func() {
+			g := NewIgnitionGenerator(SpecVersion3, "apply %s")
+			osc.Units = osc.Units[:1]
+
+			data, _, err := g.Generate(osc)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decode(data)).To(Equal(decode([]byte(`{
+  "ignition": {"version": "3.0.0"},
+  "storage": {
+    "files": [
+      {"path": "/foo", "overwrite": true, "contents": {"source": "data:;base64,YmFy"}, "mode": 384}
+    ]
+  },
+  "systemd": {
+    "units": [
+      {"name": "kubelet.service", "enabled": true, "contents": "unit", "dropins": [{"name": "10-opts.conf", "contents": "override"}]}
+    ]
+  }
+}`))))
+		})
+
+		It("should only add the bootstrap units when bootstrapping", func() {
+			g := NewIgnitionGenerator(SpecVersion2, "apply %s", Unit{Name: "locksmithd.service", Mask: true})
+			osc.Bootstrap = false
+			osc.Path = nil
+
+			data, cmd, err := g.Generate(osc)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cmd).To(BeNil())
+			Expect(string(data)).NotTo(ContainSubstring("locksmithd.service"))
+		})
+
+		It("should fail for unsupported versions of the specification", func() {
+			_, _, err := NewIgnitionGenerator("1.0.0", "apply %s").Generate(osc)
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
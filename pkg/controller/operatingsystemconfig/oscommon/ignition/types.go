@@ -0,0 +1,95 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ignition
+
+import "encoding/json"
+
+// SpecVersion is a version of the Ignition config specification.
+type SpecVersion string
+
+const (
+	// SpecVersion2 is the version 2 of the Ignition config specification.
+	SpecVersion2 SpecVersion = "2.3.0"
+	// SpecVersion3 is the version 3 of the Ignition config specification.
+	SpecVersion3 SpecVersion = "3.0.0"
+
+	// filesystemRoot is the name of the root filesystem in version 2 of the Ignition config specification.
+	filesystemRoot = "root"
+)
+
+// Config is an Ignition config.
+type Config struct {
+	Ignition Ignition `json:"ignition"`
+	Storage  *Storage `json:"storage,omitempty"`
+	Systemd  *Systemd `json:"systemd,omitempty"`
+}
+
+// Ignition contains the metadata of an Ignition config.
+type Ignition struct {
+	Version SpecVersion `json:"version"`
+}
+
+// Storage describes the files to be written by Ignition.
+type Storage struct {
+	Files []File `json:"files,omitempty"`
+}
+
+// File is a file to be written by Ignition.
+type File struct {
+	// Filesystem is the name of the filesystem of the file. It is only used in version 2 of the specification.
+	Filesystem string `json:"filesystem,omitempty"`
+	Path       string `json:"path"`
+	// Overwrite specifies whether an existing file is replaced. It is only used in version 3 of the specification,
+	// version 2 always replaces existing files.
+	Overwrite *bool        `json:"overwrite,omitempty"`
+	Contents  FileContents `json:"contents"`
+	// Mode is the permission mode of the file in decimal notation.
+	Mode *int `json:"mode,omitempty"`
+}
+
+// FileContents are the contents of a file.
+type FileContents struct {
+	// Source is the URL of the contents, usually a data URL.
+	Source string `json:"source"`
+}
+
+// Systemd describes the systemd units to be configured by Ignition.
+type Systemd struct {
+	Units []Unit `json:"units,omitempty"`
+}
+
+// Unit is a systemd unit to be configured by Ignition.
+type Unit struct {
+	Name     string   `json:"name"`
+	Enabled  *bool    `json:"enabled,omitempty"`
+	Mask     bool     `json:"mask,omitempty"`
+	Contents string   `json:"contents,omitempty"`
+	DropIns  []DropIn `json:"dropins,omitempty"`
+}
+
+// DropIn is a drop-in of a systemd unit.
+type DropIn struct {
+	Name     string `json:"name"`
+	Contents string `json:"contents"`
+}
+
+// String returns the JSON representation of the Ignition config.
+func (c *Config) String() (string, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -21,6 +21,8 @@ import (
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
+	"github.com/gardener/gardener-extensions/pkg/metrics"
 	"github.com/gardener/gardener-extensions/pkg/util"
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
@@ -113,7 +115,9 @@ func (r *reconciler) reconcile(ctx context.Context, osc *extensionsv1alpha1.Oper
 	}
 
 	r.logger.Info("Starting the reconciliation of operating system config", "osc", osc.Name)
+	observe := metrics.StartOperation(extensionsv1alpha1.OperatingSystemConfigResource, osc.Spec.Type, operationType)
 	userData, command, units, err := r.actuator.Reconcile(ctx, osc)
+	observe(err)
 	if err != nil {
 		msg := "Error reconciling operating system config"
 		utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), osc, operationType, msg))
@@ -173,7 +177,8 @@ func (r *reconciler) delete(ctx context.Context, osc *extensionsv1alpha1.Operati
 	}
 
 	r.logger.Info("Starting the deletion of operating system config", "osc", osc.Name)
-	if err := r.actuator.Delete(ctx, osc); err != nil {
+	observe := metrics.StartOperation(extensionsv1alpha1.OperatingSystemConfigResource, osc.Spec.Type, operationType)
+	if err := observe(r.actuator.Delete(ctx, osc)); err != nil {
 		msg := "Error deleting operating system config"
 		utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), osc, operationType, msg))
 		r.logger.Error(err, msg, "osc", osc.Name)
@@ -202,7 +207,7 @@ func (r *reconciler) updateStatusProcessing(ctx context.Context, osc *extensions
 
 func (r *reconciler) updateStatusError(ctx context.Context, err error, osc *extensionsv1alpha1.OperatingSystemConfig, lastOperationType gardencorev1alpha1.LastOperationType, description string) error {
 	osc.Status.ObservedGeneration = osc.Generation
-	osc.Status.LastOperation, osc.Status.LastError = extensionscontroller.ReconcileError(lastOperationType, gardencorev1alpha1helper.FormatLastErrDescription(fmt.Errorf("%s: %v", description, err)), 50, gardencorev1alpha1helper.ExtractErrorCodes(err)...)
+	osc.Status.LastOperation, osc.Status.LastError = extensionscontroller.ReconcileError(lastOperationType, gardencorev1alpha1helper.FormatLastErrDescription(fmt.Errorf("%s: %v", description, err)), 50, controllererrors.ExtractErrorCodes(err)...)
 	return r.client.Status().Update(ctx, osc)
 }
 
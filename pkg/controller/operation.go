@@ -0,0 +1,69 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// GardenerOperationRestore is the value of the Gardener operation annotation that triggers the restoration
+	// of an extension resource from the state that has been exported during a previous migration.
+	GardenerOperationRestore = "restore"
+
+	// LastOperationTypeMigrate indicates a `Migrate` operation.
+	LastOperationTypeMigrate gardencorev1alpha1.LastOperationType = "Migrate"
+	// LastOperationTypeRestore indicates a `Restore` operation.
+	LastOperationTypeRestore gardencorev1alpha1.LastOperationType = "Restore"
+)
+
+// HasOperation checks whether the Gardener operation annotation of the given object has the given value.
+func HasOperation(obj metav1.Object, operation string) bool {
+	return obj.GetAnnotations()[v1alpha1constants.GardenerOperation] == operation
+}
+
+// IsMigrated checks whether the last operation of the given extension object is a successful migration.
+func IsMigrated(obj extensionsv1alpha1.Object) bool {
+	lastOp := obj.GetExtensionStatus().GetLastOperation()
+	return lastOp != nil &&
+		lastOp.GetType() == LastOperationTypeMigrate &&
+		lastOp.GetState() == gardencorev1alpha1.LastOperationStateSucceeded
+}
+
+// RemoveOperationAnnotation removes the Gardener operation annotation from the given object.
+func RemoveOperationAnnotation(ctx context.Context, c client.Client, obj runtime.Object) error {
+	acc, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+
+	annotations := acc.GetAnnotations()
+	if _, ok := annotations[v1alpha1constants.GardenerOperation]; !ok {
+		return nil
+	}
+
+	withOpAnnotation := obj.DeepCopyObject()
+	delete(annotations, v1alpha1constants.GardenerOperation)
+	acc.SetAnnotations(annotations)
+	return c.Patch(ctx, obj, client.MergeFrom(withOpAnnotation))
+}
@@ -35,6 +35,8 @@ type operationAnnotationWrapper struct {
 
 // OperationAnnotationWrapper is a wrapper for an reconciler that
 // removes the Gardener operation annotation before `Reconcile` is called.
+// The `migrate` and `restore` operations are left untouched, they are removed
+// by the inner reconciler once the respective operation has succeeded.
 //
 // This is useful in conjunction with the HasOperationAnnotationPredicate.
 func OperationAnnotationWrapper(objectType runtime.Object, reconciler reconcile.Reconciler) reconcile.Reconciler {
@@ -73,12 +75,8 @@ func (o *operationAnnotationWrapper) Reconcile(request reconcile.Request) (recon
 		return reconcile.Result{}, err
 	}
 
-	annotations := acc.GetAnnotations()
-	if annotations[v1alpha1constants.GardenerOperation] == v1alpha1constants.GardenerOperationReconcile {
-		withOpAnnotation := obj.DeepCopyObject()
-		delete(annotations, v1alpha1constants.GardenerOperation)
-		acc.SetAnnotations(annotations)
-		if err := o.client.Patch(o.ctx, obj, client.MergeFrom(withOpAnnotation)); err != nil {
+	if HasOperation(acc, v1alpha1constants.GardenerOperationReconcile) {
+		if err := RemoveOperationAnnotation(o.ctx, o.client, obj); err != nil {
 			return reconcile.Result{}, err
 		}
 	}
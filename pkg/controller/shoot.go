@@ -16,6 +16,7 @@ package controller
 
 import (
 	"github.com/gardener/gardener/pkg/chartrenderer"
+	"github.com/gardener/gardener/pkg/utils"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -91,6 +92,34 @@ func GetServiceNetwork(cluster *Cluster) string {
 	return ""
 }
 
+// GetNodeNetwork returns the node network CIDR of the given Shoot.
+func GetNodeNetwork(cluster *Cluster) string {
+	if cluster.Shoot != nil {
+		var nodes *string
+		cloud := cluster.Shoot.Spec.Cloud
+		switch {
+		case cloud.AWS != nil:
+			nodes = cloud.AWS.Networks.K8SNetworks.Nodes
+		case cloud.Azure != nil:
+			nodes = cloud.Azure.Networks.K8SNetworks.Nodes
+		case cloud.GCP != nil:
+			nodes = cloud.GCP.Networks.K8SNetworks.Nodes
+		case cloud.OpenStack != nil:
+			nodes = cloud.OpenStack.Networks.K8SNetworks.Nodes
+		case cloud.Alicloud != nil:
+			nodes = cloud.Alicloud.Networks.K8SNetworks.Nodes
+		case cloud.Packet != nil:
+			nodes = cloud.Packet.Networks.K8SNetworks.Nodes
+		}
+		if nodes != nil {
+			return *nodes
+		}
+	} else if cluster.CoreShoot != nil {
+		return cluster.CoreShoot.Spec.Networking.Nodes
+	}
+	return ""
+}
+
 // IsHibernated returns true if the shoot is hibernated, or false otherwise.
 func IsHibernated(cluster *Cluster) bool {
 	if cluster.Shoot != nil {
@@ -111,6 +140,12 @@ func GetKubernetesVersion(cluster *Cluster) string {
 	return ""
 }
 
+// IsKubernetesVersionAtLeast returns true if the kubernetes version of the given Shoot is greater than or equal to
+// the given version, or false otherwise.
+func IsKubernetesVersionAtLeast(cluster *Cluster, version string) (bool, error) {
+	return utils.CompareVersions(GetKubernetesVersion(cluster), ">=", version)
+}
+
 // GetTechnicalID returns the shoot technical id.
 func GetTechnicalID(cluster *Cluster) string {
 	if cluster.Shoot != nil {
@@ -129,6 +129,46 @@ var _ = Describe("Shoot", func() {
 		}, cidr),
 	)
 
+	DescribeTable("#GetNodeNetwork",
+		func(cluster *Cluster, cidr string) {
+			Expect(GetNodeNetwork(cluster)).To(Equal(cidr))
+		},
+
+		Entry("node cidr is given", &Cluster{
+			CoreShoot: &gardencorev1alpha1.Shoot{
+				Spec: gardencorev1alpha1.ShootSpec{
+					Networking: gardencorev1alpha1.Networking{
+						Nodes: cidr,
+					},
+				},
+			},
+		}, cidr),
+
+		Entry("cloud is AWS", &Cluster{
+			Shoot: &gardenv1beta1.Shoot{
+				Spec: gardenv1beta1.ShootSpec{
+					Cloud: gardenv1beta1.Cloud{
+						AWS: &gardenv1beta1.AWSCloud{
+							Networks: gardenv1beta1.AWSNetworks{
+								K8SNetworks: gardenv1beta1.K8SNetworks{Nodes: &cidr},
+							},
+						},
+					},
+				},
+			},
+		}, cidr),
+
+		Entry("node cidr is not given", &Cluster{
+			Shoot: &gardenv1beta1.Shoot{
+				Spec: gardenv1beta1.ShootSpec{
+					Cloud: gardenv1beta1.Cloud{
+						GCP: &gardenv1beta1.GCPCloud{},
+					},
+				},
+			},
+		}, ""),
+	)
+
 	DescribeTable("#IsHibernated (gardenv1beta1.Shoot)",
 		func(hibernation *gardenv1beta1.Hibernation, expectation bool) {
 			cluster := &Cluster{
@@ -198,4 +238,26 @@ var _ = Describe("Shoot", func() {
 		Entry("hibernation is not enabled", nil, 3, 3),
 		Entry("hibernation is enabled", &gardencorev1alpha1.Hibernation{Enabled: &trueVar}, 1, 0),
 	)
+
+	DescribeTable("#IsKubernetesVersionAtLeast",
+		func(kubernetesVersion, version string, expectation bool) {
+			cluster := &Cluster{
+				CoreShoot: &gardencorev1alpha1.Shoot{
+					Spec: gardencorev1alpha1.ShootSpec{
+						Kubernetes: gardencorev1alpha1.Kubernetes{
+							Version: kubernetesVersion,
+						},
+					},
+				},
+			}
+
+			atLeast, err := IsKubernetesVersionAtLeast(cluster, version)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(atLeast).To(Equal(expectation))
+		},
+
+		Entry("version is lower", "1.13.4", "1.14", false),
+		Entry("version is equal", "1.14.0", "1.14", true),
+		Entry("version is higher", "1.15.3", "1.14", true),
+	)
 })
@@ -28,4 +28,9 @@ type Actuator interface {
 	Reconcile(context.Context, *extensionsv1alpha1.Worker, *extensionscontroller.Cluster) error
 	// Delete deletes the Worker.
 	Delete(context.Context, *extensionsv1alpha1.Worker, *extensionscontroller.Cluster) error
+	// Migrate exports the state of the Worker into its status and releases all resources in the seed
+	// without deleting the machines in the cloud provider.
+	Migrate(context.Context, *extensionsv1alpha1.Worker, *extensionscontroller.Cluster) error
+	// Restore restores the Worker from the state that has been exported during a previous migration.
+	Restore(context.Context, *extensionsv1alpha1.Worker, *extensionscontroller.Cluster) error
 }
@@ -15,6 +15,7 @@
 package worker
 
 import (
+	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
 	extensionshandler "github.com/gardener/gardener-extensions/pkg/handler"
 	extensionspredicate "github.com/gardener/gardener-extensions/pkg/predicate"
 
@@ -44,6 +45,8 @@ type AddArgs struct {
 	// Predicates are the predicates to use.
 	// If unset, GenerationChanged will be used.
 	Predicates []predicate.Predicate
+	// ErrorMatchers are used to classify the errors of the actuator before they are reported in the status.
+	ErrorMatchers []controllererrors.Matcher
 }
 
 // DefaultPredicates returns the default predicates for a Worker reconciler.
@@ -73,7 +76,7 @@ func DefaultPredicates(typeName string, ignoreOperationAnnotation bool) []predic
 // Add creates a new Worker Controller and adds it to the Manager.
 // and Start it when the Manager is Started.
 func Add(mgr manager.Manager, args AddArgs) error {
-	args.ControllerOptions.Reconciler = NewReconciler(mgr, args.Actuator)
+	args.ControllerOptions.Reconciler = NewReconciler(mgr, args.Actuator, args.ErrorMatchers...)
 	return add(mgr, args.ControllerOptions, args.Predicates)
 }
 
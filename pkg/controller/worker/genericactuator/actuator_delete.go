@@ -50,7 +50,7 @@ func (a *genericActuator) Delete(ctx context.Context, worker *extensionsv1alpha1
 
 	// Deploy the machine-controller-manager into the cluster to make sure worker nodes can be removed.
 	a.logger.Info("Deploying the machine-controller-manager", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
-	if err := a.deployMachineControllerManager(ctx, worker, cluster, workerDelegate, replicaFunc); err != nil {
+	if err := a.deployMachineControllerManager(ctx, worker, cluster, workerDelegate, replicaFunc, nil); err != nil {
 		return err
 	}
 
@@ -89,6 +89,13 @@ func (a *genericActuator) Delete(ctx context.Context, worker *extensionsv1alpha1
 		return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("Failed while waiting for all machine resources to be deleted: '%s'", err.Error()))
 	}
 
+	// Run the provider-specific steps that require all machine resources to be gone, if any.
+	if hooks, ok := workerDelegate.(WorkerDelegateHooks); ok {
+		if err := hooks.PostDeleteHook(ctx); err != nil {
+			return errors.Wrapf(err, "failed to execute the post delete hook")
+		}
+	}
+
 	// Delete the machine-controller-manager.
 	if err := a.deleteMachineControllerManager(ctx, worker); err != nil {
 		return errors.Wrapf(err, "failed deleting machine-controller-manager")
@@ -0,0 +1,177 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genericactuator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gardener/gardener-extensions/pkg/controller"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	gardenerkubernetes "github.com/gardener/gardener/pkg/client/kubernetes"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func (a *genericActuator) Migrate(ctx context.Context, worker *extensionsv1alpha1.Worker, cluster *controller.Cluster) error {
+	workerDelegate, err := a.delegateFactory.WorkerDelegate(ctx, worker, cluster)
+	if err != nil {
+		return errors.Wrapf(err, "could not instantiate actuator context")
+	}
+
+	// Export the state of all machine resources into the status of the worker.
+	a.logger.Info("Exporting the state of the machine resources", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+	state, err := a.computeWorkerState(ctx, worker.Namespace)
+	if err != nil {
+		return errors.Wrapf(err, "computing the state of the machine resources failed")
+	}
+	if err := a.updateWorkerStatusState(ctx, worker, state); err != nil {
+		return errors.Wrapf(err, "updating the state of the worker failed")
+	}
+
+	// Make sure the machine-controller-manager does not act on the machine resources anymore. The objects which have
+	// been deployed into the shoot cluster are kept.
+	if err := extensionscontroller.SetKeepObjects(ctx, a.client, worker.Namespace, mcmShootResourceName, true); err != nil {
+		return err
+	}
+	if err := a.scaleDownMachineControllerManager(ctx, worker); err != nil {
+		return errors.Wrapf(err, "failed scaling down machine-controller-manager")
+	}
+	if err := a.deleteMachineControllerManager(ctx, worker); err != nil {
+		return errors.Wrapf(err, "failed deleting machine-controller-manager")
+	}
+
+	// Release all machine resources without deleting the machines in the cloud provider.
+	a.logger.Info("Releasing all machine resources", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+	if err := a.shallowDeleteMachineResources(ctx, worker.Namespace, workerDelegate.MachineClassList()); err != nil {
+		return errors.Wrapf(err, "releasing machine resources failed")
+	}
+
+	return nil
+}
+
+// computeWorkerState computes the encoded state of all machine resources in the given namespace.
+func (a *genericActuator) computeWorkerState(ctx context.Context, namespace string) (string, error) {
+	existingMachineDeployments := &machinev1alpha1.MachineDeploymentList{}
+	if err := a.client.List(ctx, existingMachineDeployments, client.InNamespace(namespace)); err != nil {
+		return "", err
+	}
+
+	existingMachineSets := &machinev1alpha1.MachineSetList{}
+	if err := a.client.List(ctx, existingMachineSets, client.InNamespace(namespace)); err != nil {
+		return "", err
+	}
+
+	existingMachines := &machinev1alpha1.MachineList{}
+	if err := a.client.List(ctx, existingMachines, client.InNamespace(namespace)); err != nil {
+		return "", err
+	}
+
+	return worker.EncodeState(worker.ComputeState(existingMachineDeployments.Items, existingMachineSets.Items, existingMachines.Items))
+}
+
+func (a *genericActuator) updateWorkerStatusState(ctx context.Context, worker *extensionsv1alpha1.Worker, state string) error {
+	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, a.client, worker, func() error {
+		worker.Status.State = state
+		return nil
+	})
+}
+
+// scaleDownMachineControllerManager scales the machine-controller-manager deployment to zero replicas and waits
+// until all of its pods are gone.
+func (a *genericActuator) scaleDownMachineControllerManager(ctx context.Context, workerObj *extensionsv1alpha1.Worker) error {
+	a.logger.Info("Scaling down the machine-controller-manager", "worker", fmt.Sprintf("%s/%s", workerObj.Namespace, workerObj.Name))
+
+	key := kutil.Key(workerObj.Namespace, a.mcmName)
+	if err := gardenerkubernetes.ScaleDeployment(ctx, a.client, key, 0); client.IgnoreNotFound(err) != nil {
+		return err
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	return wait.PollUntil(5*time.Second, func() (bool, error) {
+		deployment := &appsv1.Deployment{}
+		if err := a.client.Get(ctx, key, deployment); err != nil {
+			if apierrors.IsNotFound(err) {
+				return true, nil
+			}
+			return false, err
+		}
+		return deployment.Status.Replicas == 0, nil
+	}, timeoutCtx.Done())
+}
+
+// shallowDeleteMachineResources removes the finalizers of all machine resources in the given namespace and deletes
+// them afterwards. As the machine-controller-manager is not running anymore the machines in the cloud provider are
+// not affected.
+func (a *genericActuator) shallowDeleteMachineResources(ctx context.Context, namespace string, machineClassList runtime.Object) error {
+	for _, list := range []runtime.Object{
+		&machinev1alpha1.MachineList{},
+		&machinev1alpha1.MachineSetList{},
+		&machinev1alpha1.MachineDeploymentList{},
+		machineClassList,
+	} {
+		if err := a.client.List(ctx, list, client.InNamespace(namespace)); err != nil {
+			return err
+		}
+		if err := meta.EachListItem(list, func(obj runtime.Object) error {
+			return a.shallowDelete(ctx, obj)
+		}); err != nil {
+			return err
+		}
+	}
+
+	secretList, err := a.listMachineClassSecrets(ctx, namespace)
+	if err != nil {
+		return err
+	}
+	for _, secret := range secretList.Items {
+		if err := a.shallowDelete(ctx, secret.DeepCopy()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (a *genericActuator) shallowDelete(ctx context.Context, obj runtime.Object) error {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+
+	if len(accessor.GetFinalizers()) > 0 {
+		withFinalizers := obj.DeepCopyObject()
+		accessor.SetFinalizers(nil)
+		if err := a.client.Patch(ctx, obj, client.MergeFrom(withFinalizers)); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	return client.IgnoreNotFound(a.client.Delete(ctx, obj))
+}
@@ -17,6 +17,7 @@ package genericactuator
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/gardener/gardener-extensions/pkg/controller"
@@ -24,12 +25,10 @@ import (
 	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
-	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1/helper"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
-	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
@@ -37,6 +36,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -64,53 +64,46 @@ func (a *genericActuator) Reconcile(ctx context.Context, worker *extensionsv1alp
 		return 1, nil
 	}
 
-	// Deploy the machine-controller-manager into the cluster.
-	a.logger.Info("Deploying the machine-controller-manager", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
-	if err := a.deployMachineControllerManager(ctx, worker, cluster, workerDelegate, replicaFunc); err != nil {
-		return err
-	}
-
 	// Generate the desired machine deployments.
 	wantedMachineDeployments, err := workerDelegate.GenerateMachineDeployments(ctx)
 	if err != nil {
 		return errors.Wrapf(err, "failed to generate the machine deployments")
 	}
 
-	// Get list of existing machine class names and list of used machine class secrets.
-	existingMachineClassNames, err := a.listMachineClassNames(ctx, worker.Namespace, workerDelegate.MachineClassList())
-	if err != nil {
+	// Deploy the machine-controller-manager into the cluster.
+	a.logger.Info("Deploying the machine-controller-manager", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+	if err := a.deployMachineControllerManager(ctx, worker, cluster, workerDelegate, replicaFunc, wantedMachineDeployments); err != nil {
+		return err
+	}
+
+	// Get the list of all existing machine deployments.
+	existingMachineDeployments := &machinev1alpha1.MachineDeploymentList{}
+	if err := a.client.List(ctx, existingMachineDeployments, client.InNamespace(worker.Namespace)); err != nil {
 		return err
 	}
 
-	// During the time a rolling update happens we do not want the cluster autoscaler to interfer, hence it
-	// is removed for now.
+	// During the time a rolling update of a machine deployment happens we do not want the cluster autoscaler to interfere
+	// with it. Instead of removing the cluster autoscaler for the whole shoot, only the rolling machine deployments are
+	// frozen at their current size so that all other worker pools can still be scaled. Additionally, the nodes of the
+	// rolling machine deployments are protected from being scaled down while they are replaced.
 	var (
-		clusterAutoscalerUsed = extensionsv1alpha1helper.ClusterAutoscalerRequired(worker.Spec.Pools)
-		rollingUpdate         = false
+		clusterAutoscalerUsed     = extensionsv1alpha1helper.ClusterAutoscalerRequired(worker.Spec.Pools)
+		rollingMachineDeployments = computeRollingMachineDeployments(existingMachineDeployments, wantedMachineDeployments, worker.Status.MachineDeployments)
+		shootClient               client.Client
 	)
 
-	if clusterAutoscalerUsed {
-		// Check whether new machine classes have been computed (resulting in a rolling update of the nodes).
-		for _, machineDeployment := range wantedMachineDeployments {
-			if !existingMachineClassNames.Has(machineDeployment.ClassName) {
-				rollingUpdate = true
-				break
-			}
+	if clusterAutoscalerUsed && !controller.IsHibernated(cluster) && (rollingMachineDeployments.Len() > 0 || rollingUpdateProgressing(worker)) {
+		_, shootClient, err = util.NewClientForShoot(ctx, a.client, worker.Namespace, client.Options{})
+		if err != nil {
+			return errors.Wrapf(err, "failed to create shoot client")
 		}
+	}
 
-		// When the Shoot gets hibernated we want to remove the cluster auto scaler so that it does not interfer
-		// with Gardeners modifications on the machine deployment's replicas fields.
-		if controller.IsHibernated(cluster) || rollingUpdate {
-			deployment := &appsv1.Deployment{}
-			if err := a.client.Get(ctx, kutil.Key(worker.Namespace, v1alpha1constants.DeploymentNameClusterAutoscaler), deployment); err != nil {
-				if !apierrors.IsNotFound(err) {
-					return err
-				}
-			} else {
-				if err := util.ScaleDeployment(ctx, a.client, deployment, 0); err != nil {
-					return err
-				}
-			}
+	// When the Shoot gets hibernated we want to remove the cluster auto scaler so that it does not interfer
+	// with Gardeners modifications on the machine deployment's replicas fields.
+	if clusterAutoscalerUsed && controller.IsHibernated(cluster) {
+		if err := a.scaleClusterAutoscaler(ctx, worker.Namespace, 0); err != nil {
+			return err
 		}
 	}
 
@@ -129,10 +122,20 @@ func (a *genericActuator) Reconcile(ctx context.Context, worker *extensionsv1alp
 		return errors.Wrapf(err, "failed to update the machine images in worker status")
 	}
 
-	// Get the list of all existing machine deployments.
-	existingMachineDeployments := &machinev1alpha1.MachineDeploymentList{}
-	if err := a.client.List(ctx, existingMachineDeployments, client.InNamespace(worker.Namespace)); err != nil {
-		return err
+	// Report the rolling machine deployments with their current size as minimum and maximum so that the cluster
+	// autoscaler does not scale them until their rollout has finished. The frozen bounds are also set on the running
+	// cluster autoscaler as it only picks up the worker status after the worker has been reconciled.
+	if rollingMachineDeployments.Len() > 0 {
+		a.logger.Info("Rolling out machine deployments", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name), "machineDeployments", rollingMachineDeployments.List())
+		frozenMachineDeployments := freezeMachineDeployments(wantedMachineDeployments, existingMachineDeployments, rollingMachineDeployments)
+		if err := a.updateWorkerStatusMachineDeployments(ctx, worker, frozenMachineDeployments); err != nil {
+			return errors.Wrapf(err, "failed to update the machine deployments in worker status")
+		}
+		if clusterAutoscalerUsed && !controller.IsHibernated(cluster) {
+			if err := updateClusterAutoscalerNodeGroups(ctx, a.client, worker.Namespace, frozenMachineDeployments); err != nil {
+				return errors.Wrapf(err, "failed to freeze the rolling machine deployments in the cluster autoscaler")
+			}
+		}
 	}
 
 	// Generate machine deployment configuration based on previously computed list of deployments and deploy them.
@@ -145,7 +148,7 @@ func (a *genericActuator) Reconcile(ctx context.Context, worker *extensionsv1alp
 	timeoutCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
 	defer cancel()
 
-	if err := a.waitUntilMachineDeploymentsAvailable(timeoutCtx, cluster, worker, wantedMachineDeployments); err != nil {
+	if err := a.waitUntilMachineDeploymentsAvailable(timeoutCtx, cluster, worker, wantedMachineDeployments, shootClient, rollingMachineDeployments); err != nil {
 		return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("Failed while waiting for all machine deployments to be ready: '%s'", err.Error()))
 	}
 
@@ -175,16 +178,22 @@ func (a *genericActuator) Reconcile(ctx context.Context, worker *extensionsv1alp
 		}
 	}
 
-	if rollingUpdate {
-		deployment := &appsv1.Deployment{}
-		if err := a.client.Get(ctx, kutil.Key(worker.Namespace, v1alpha1constants.DeploymentNameClusterAutoscaler), deployment); err != nil {
-			if !apierrors.IsNotFound(err) {
-				return err
-			}
-		} else {
-			if err := util.ScaleDeployment(ctx, a.client, deployment, 1); err != nil {
-				return err
-			}
+	// Previous versions removed the cluster autoscaler for the whole shoot during rolling updates. Bring it back in case
+	// such a rolling update has been interrupted.
+	if clusterAutoscalerUsed && !controller.IsHibernated(cluster) {
+		if err := a.scaleUpClusterAutoscalerIfScaledDown(ctx, worker.Namespace); err != nil {
+			return err
+		}
+		// Unfreeze the machine deployments in the cluster autoscaler after their rollout has finished.
+		if err := updateClusterAutoscalerNodeGroups(ctx, a.client, worker.Namespace, wantedMachineDeployments); err != nil {
+			return errors.Wrapf(err, "failed to unfreeze the machine deployments in the cluster autoscaler")
+		}
+	}
+
+	// Run the provider-specific steps that require the old machine resources to be gone, if any.
+	if hooks, ok := workerDelegate.(WorkerDelegateHooks); ok {
+		if err := hooks.PostReconcileHook(ctx); err != nil {
+			return errors.Wrapf(err, "failed to execute the post reconcile hook")
 		}
 	}
 
@@ -246,8 +255,9 @@ func (a *genericActuator) deployMachineDeployments(ctx context.Context, cluster
 
 		if _, err := controllerutil.CreateOrUpdate(ctx, a.client, machineDeployment, func() error {
 			machineDeployment.Spec = machinev1alpha1.MachineDeploymentSpec{
-				Replicas:        int32(replicas),
-				MinReadySeconds: 500,
+				Replicas:                int32(replicas),
+				MinReadySeconds:         500,
+				ProgressDeadlineSeconds: progressDeadlineSeconds(deployment.MachineConfiguration),
 				Strategy: machinev1alpha1.MachineDeploymentStrategy{
 					Type: machinev1alpha1.RollingUpdateMachineDeploymentStrategyType,
 					RollingUpdate: &machinev1alpha1.RollingUpdateMachineDeployment{
@@ -289,59 +299,62 @@ func (a *genericActuator) deployMachineDeployments(ctx context.Context, cluster
 	return nil
 }
 
-// waitUntilMachineDeploymentsAvailable waits for a maximum of 30 minutes until all the desired <machineDeployments>
-// were marked as healthy/available by the machine-controller-manager. It polls the status every 5 seconds.
-func (a *genericActuator) waitUntilMachineDeploymentsAvailable(ctx context.Context, cluster *controller.Cluster, worker *extensionsv1alpha1.Worker, wantedMachineDeployments worker.MachineDeployments) error {
+// waitUntilMachineDeploymentsAvailable waits until all the desired <machineDeployments> were marked as healthy/available
+// by the machine-controller-manager. It polls the status every 5 seconds and reports the progress of every machine
+// deployment in the rolling update condition of the worker. The rollout of each machine deployment is tracked on its
+// own, i.e. a machine deployment with failed machines or an exceeded progress deadline fails immediately.
+// If a shoot client is given then the nodes of the <rollingMachineDeployments> are protected from being scaled down by
+// the cluster autoscaler until all machine deployments are available.
+func (a *genericActuator) waitUntilMachineDeploymentsAvailable(ctx context.Context, cluster *controller.Cluster, worker *extensionsv1alpha1.Worker, wantedMachineDeployments worker.MachineDeployments, shootClient client.Client, rollingMachineDeployments sets.String) error {
 	return wait.PollUntil(5*time.Second, func() (bool, error) {
-		var numHealthyDeployments, numUpdated, numDesired, numberOfAwakeMachines int32
-
 		// Get the list of all existing machine deployments
 		existingMachineDeployments := &machinev1alpha1.MachineDeploymentList{}
 		if err := a.client.List(ctx, existingMachineDeployments, client.InNamespace(worker.Namespace)); err != nil {
 			return false, err
 		}
 
-		// Collect the numbers of ready and desired replicas.
-		for _, existingMachineDeployment := range existingMachineDeployments.Items {
-			// If the shoot get hibernated we want to wait until all machine deployments have been deleted entirely.
-			if controller.IsHibernated(cluster) {
+		// If the shoot get hibernated we want to wait until all machine deployments have been deleted entirely.
+		if controller.IsHibernated(cluster) {
+			var numberOfAwakeMachines int32
+			for _, existingMachineDeployment := range existingMachineDeployments.Items {
 				numberOfAwakeMachines += existingMachineDeployment.Status.Replicas
-				continue
 			}
 
-			// If the Shoot is not hibernated we want to wait until all machine deployments have been as many ready
-			// replicas as desired (specified in the .spec.replicas). However, if we see any error in the status of
-			// the deployment then we return it.
-			for _, failedMachine := range existingMachineDeployment.Status.FailedMachines {
-				return false, fmt.Errorf("Machine %s failed: %s", failedMachine.Name, failedMachine.LastOperation.Description)
+			if numberOfAwakeMachines == 0 {
+				return true, nil
 			}
+			a.logger.Info(fmt.Sprintf("Waiting until all machines have been hibernated (%d still awake)...", numberOfAwakeMachines), "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+			return false, nil
+		}
 
-			// If the Shoot is not hibernated we want to wait until all machine deployments have been as many ready
-			// replicas as desired (specified in the .spec.replicas).
-			for _, machineDeployment := range wantedMachineDeployments {
-				if machineDeployment.Name == existingMachineDeployment.Name {
-					if health.CheckMachineDeployment(&existingMachineDeployment) == nil {
-						numHealthyDeployments++
-					}
-					numDesired += existingMachineDeployment.Spec.Replicas
-					numUpdated += existingMachineDeployment.Status.UpdatedReplicas
+		// If the Shoot is not hibernated we want to wait until all machine deployments have been as many ready
+		// replicas as desired (specified in the .spec.replicas). However, if we see any error in the status of
+		// a deployment then we return it.
+		progress, err := computeMachineDeploymentsProgress(existingMachineDeployments, wantedMachineDeployments)
+		if err != nil {
+			return false, err
+		}
+
+		if shootClient != nil {
+			if len(progress) > 0 {
+				// New machines of the rolling machine deployments join continuously, hence their nodes are protected in
+				// every iteration.
+				if err := protectNodesFromScaleDown(ctx, a.client, shootClient, worker.Namespace, rollingMachineDeployments); err != nil {
+					return false, errors.Wrapf(err, "failed to protect the nodes of the rolling machine deployments from scale-down")
 				}
+			} else if err := removeScaleDownProtection(ctx, shootClient); err != nil {
+				return false, errors.Wrapf(err, "failed to remove the scale-down protection of the rolled out machine deployments")
 			}
 		}
 
-		switch {
-		case !controller.IsHibernated(cluster):
-			a.logger.Info(fmt.Sprintf("Waiting until all desired machines are ready (%d/%d machine objects up-to-date, %d/%d machinedeployments available)...", numUpdated, numDesired, numHealthyDeployments, len(wantedMachineDeployments)), "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
-			if numUpdated >= numDesired && int(numHealthyDeployments) == len(wantedMachineDeployments) {
-				return true, nil
-			}
-		default:
-			if numberOfAwakeMachines == 0 {
-				return true, nil
-			}
-			a.logger.Info(fmt.Sprintf("Waiting until all machines have been hibernated (%d still awake)...", numberOfAwakeMachines), "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+		if err := a.updateWorkerStatusRollingUpdate(ctx, worker, progress); err != nil {
+			return false, err
 		}
 
+		if len(progress) == 0 {
+			return true, nil
+		}
+		a.logger.Info(fmt.Sprintf("Waiting until all desired machines are ready (%s)...", strings.Join(progress, "; ")), "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
 		return false, nil
 	}, ctx.Done())
 }
@@ -0,0 +1,64 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genericactuator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+func (a *genericActuator) Restore(ctx context.Context, worker *extensionsv1alpha1.Worker, cluster *controller.Cluster) error {
+	// Restore the machine sets and machines before the machine-controller-manager is deployed so that it adopts
+	// the existing machines instead of creating new ones.
+	a.logger.Info("Restoring the machine resources", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+	if err := a.restoreMachineResources(ctx, worker.Namespace, worker.Status.State); err != nil {
+		return errors.Wrapf(err, "restoring the machine resources failed")
+	}
+
+	return a.Reconcile(ctx, worker, cluster)
+}
+
+// restoreMachineResources creates the machine sets and machines contained in the given encoded state.
+func (a *genericActuator) restoreMachineResources(ctx context.Context, namespace, encodedState string) error {
+	state, err := worker.DecodeState(encodedState)
+	if err != nil {
+		return err
+	}
+
+	for _, machineDeploymentState := range state.MachineDeployments {
+		for _, machineSet := range machineDeploymentState.MachineSets {
+			machineSet.Namespace = namespace
+			if err := a.client.Create(ctx, &machineSet); err != nil && !apierrors.IsAlreadyExists(err) {
+				return err
+			}
+		}
+
+		for _, machine := range machineDeploymentState.Machines {
+			machine.Namespace = namespace
+			if err := a.client.Create(ctx, &machine); err != nil && !apierrors.IsAlreadyExists(err) {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
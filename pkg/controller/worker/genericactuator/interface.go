@@ -49,6 +49,16 @@ type WorkerDelegate interface {
 	GetMachineImages(context.Context) (runtime.Object, error)
 }
 
+// WorkerDelegateHooks can optionally be implemented by a WorkerDelegate to run provider-specific steps after the
+// machine resources of a `Worker` resource have been reconciled or deleted.
+type WorkerDelegateHooks interface {
+	// PostReconcileHook is called after all machine deployments have been rolled out and the old machine resources
+	// have been cleaned up.
+	PostReconcileHook(context.Context) error
+	// PostDeleteHook is called after all machine resources have been deleted.
+	PostDeleteHook(context.Context) error
+}
+
 // DelegateFactory acts upon Worker resources.
 type DelegateFactory interface {
 	// WorkerDelegate returns a worker delegate interface that is used for the Worker reconciliation
@@ -21,6 +21,7 @@ import (
 
 	"github.com/gardener/gardener-extensions/pkg/controller"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -36,7 +37,7 @@ const mcmShootResourceName = "extension-worker-mcm-shoot"
 // ReplicaCount determines the number of replicas.
 type ReplicaCount func() (int32, error)
 
-func (a *genericActuator) deployMachineControllerManager(ctx context.Context, workerObj *extensionsv1alpha1.Worker, cluster *controller.Cluster, workerDelegate WorkerDelegate, replicas ReplicaCount) error {
+func (a *genericActuator) deployMachineControllerManager(ctx context.Context, workerObj *extensionsv1alpha1.Worker, cluster *controller.Cluster, workerDelegate WorkerDelegate, replicas ReplicaCount, machineDeployments worker.MachineDeployments) error {
 	mcmValues, err := workerDelegate.GetMachineControllerManagerChartValues(ctx)
 	if err != nil {
 		return err
@@ -54,6 +55,7 @@ func (a *genericActuator) deployMachineControllerManager(ctx context.Context, wo
 		return err
 	}
 	mcmValues["replicas"] = replicaCount
+	injectMachineTimeouts(mcmValues, machineDeployments)
 
 	if err := a.mcmSeedChart.Apply(ctx, a.chartApplier, workerObj.Namespace,
 		a.imageVector, a.gardenerClientset.Version(), extensionscontroller.GetKubernetesVersion(cluster), mcmValues); err != nil {
@@ -107,6 +109,31 @@ func (a *genericActuator) applyMachineControllerManagerShootChart(ctx context.Co
 	return nil
 }
 
+// injectMachineTimeouts injects the machine creation and drain timeouts of the given machine deployments into the
+// machine-controller-manager values. The machine-controller-manager only supports these timeouts for all machines of a
+// shoot, hence the longest ones across all machine deployments are used.
+func injectMachineTimeouts(values map[string]interface{}, machineDeployments worker.MachineDeployments) {
+	var creationTimeout, drainTimeout *metav1.Duration
+	for _, deployment := range machineDeployments {
+		if deployment.MachineConfiguration == nil {
+			continue
+		}
+		if timeout := deployment.MachineConfiguration.CreationTimeout; timeout != nil && (creationTimeout == nil || timeout.Duration > creationTimeout.Duration) {
+			creationTimeout = timeout
+		}
+		if timeout := deployment.MachineConfiguration.DrainTimeout; timeout != nil && (drainTimeout == nil || timeout.Duration > drainTimeout.Duration) {
+			drainTimeout = timeout
+		}
+	}
+
+	if creationTimeout != nil {
+		values["machineCreationTimeout"] = creationTimeout.Duration.String()
+	}
+	if drainTimeout != nil {
+		values["machineDrainTimeout"] = drainTimeout.Duration.String()
+	}
+}
+
 // createKubeconfigForMachineControllerManager generates a new certificate and kubeconfig for the machine-controller-manager. If
 // such credentials already exist then they will be returned.
 func createKubeconfigForMachineControllerManager(ctx context.Context, c client.Client, namespace, name string) (*corev1.Secret, error) {
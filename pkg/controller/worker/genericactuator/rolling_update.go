@@ -0,0 +1,344 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genericactuator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
+	"github.com/gardener/gardener-extensions/pkg/metrics"
+	"github.com/gardener/gardener-extensions/pkg/util"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ConditionTypeRollingUpdate is the type of the worker condition that reports the progress of the machine
+	// deployments which are not yet up-to-date and available.
+	ConditionTypeRollingUpdate gardencorev1alpha1.ConditionType = "RollingUpdate"
+	// AnnotationKeyScaleDownDisabled is the node annotation that prevents the cluster autoscaler from removing a node.
+	AnnotationKeyScaleDownDisabled = "cluster-autoscaler.kubernetes.io/scale-down-disabled"
+	// AnnotationKeyRollingMachineDeployment is the node annotation that marks the scale-down protection of a node as
+	// added for the rollout of the machine deployment given as value.
+	AnnotationKeyRollingMachineDeployment = "worker.extensions.gardener.cloud/rolling-machine-deployment"
+
+	reasonRollingUpdateProgressing = "RollingUpdateProgressing"
+	reasonRollingUpdateCompleted   = "RollingUpdateCompleted"
+)
+
+// computeRollingMachineDeployments returns the names of the machine deployments scaled by the cluster autoscaler which
+// are rolled out, i.e. the existing ones whose machine class changes and the ones whose rollout has not finished in a
+// previous reconciliation. The latter are still reported as frozen in the given status of the worker.
+func computeRollingMachineDeployments(existingMachineDeployments *machinev1alpha1.MachineDeploymentList, wantedMachineDeployments worker.MachineDeployments, statusMachineDeployments []extensionsv1alpha1.MachineDeployment) sets.String {
+	rolling := sets.NewString()
+	for _, deployment := range wantedMachineDeployments {
+		if deployment.Minimum == deployment.Maximum {
+			continue
+		}
+
+		existingMachineDeployment := getExistingMachineDeployment(existingMachineDeployments, deployment.Name)
+		if existingMachineDeployment != nil && existingMachineDeployment.Spec.Template.Spec.Class.Name != deployment.ClassName {
+			rolling.Insert(deployment.Name)
+			continue
+		}
+
+		for _, statusMachineDeployment := range statusMachineDeployments {
+			if statusMachineDeployment.Name == deployment.Name && statusMachineDeployment.Minimum == statusMachineDeployment.Maximum {
+				rolling.Insert(deployment.Name)
+			}
+		}
+	}
+	return rolling
+}
+
+// freezeMachineDeployments returns a copy of the wanted machine deployments in which the minimum and maximum of the
+// rolling machine deployments are set to their current number of replicas.
+func freezeMachineDeployments(wantedMachineDeployments worker.MachineDeployments, existingMachineDeployments *machinev1alpha1.MachineDeploymentList, rollingMachineDeployments sets.String) worker.MachineDeployments {
+	frozen := make(worker.MachineDeployments, 0, len(wantedMachineDeployments))
+	for _, deployment := range wantedMachineDeployments {
+		if rollingMachineDeployments.Has(deployment.Name) {
+			replicas := getDeploymentSpecReplicas(existingMachineDeployments, deployment.Name)
+			switch {
+			case replicas < deployment.Minimum:
+				replicas = deployment.Minimum
+			case replicas > deployment.Maximum:
+				replicas = deployment.Maximum
+			}
+			deployment.Minimum, deployment.Maximum = replicas, replicas
+		}
+		frozen = append(frozen, deployment)
+	}
+	return frozen
+}
+
+// progressDeadlineSeconds computes the progress deadline of a machine deployment out of the timeouts of its machines.
+// A machine deployment that does not make progress within the time needed to create a new machine and to drain an
+// old one is considered failed.
+func progressDeadlineSeconds(machineConfiguration *worker.MachineConfiguration) *int32 {
+	if machineConfiguration == nil || (machineConfiguration.CreationTimeout == nil && machineConfiguration.DrainTimeout == nil) {
+		return nil
+	}
+
+	var seconds int32
+	if machineConfiguration.CreationTimeout != nil {
+		seconds += int32(machineConfiguration.CreationTimeout.Seconds())
+	}
+	if machineConfiguration.DrainTimeout != nil {
+		seconds += int32(machineConfiguration.DrainTimeout.Seconds())
+	}
+	return &seconds
+}
+
+// computeMachineDeploymentsProgress returns a progress message for every wanted machine deployment that is not yet
+// up-to-date and available. It returns an error if a machine deployment has failed machines or has exceeded its
+// progress deadline.
+func computeMachineDeploymentsProgress(existingMachineDeployments *machinev1alpha1.MachineDeploymentList, wantedMachineDeployments worker.MachineDeployments) ([]string, error) {
+	var progress []string
+
+	for _, deployment := range wantedMachineDeployments {
+		existingMachineDeployment := getExistingMachineDeployment(existingMachineDeployments, deployment.Name)
+		if existingMachineDeployment == nil {
+			progress = append(progress, fmt.Sprintf("%s: not yet created", deployment.Name))
+			continue
+		}
+
+		for _, failedMachine := range existingMachineDeployment.Status.FailedMachines {
+			return nil, fmt.Errorf("Machine %s of machine deployment %s failed: %s", failedMachine.Name, deployment.Name, failedMachine.LastOperation.Description)
+		}
+
+		for _, condition := range existingMachineDeployment.Status.Conditions {
+			if condition.Type == machinev1alpha1.MachineDeploymentProgressing && condition.Status == machinev1alpha1.ConditionFalse {
+				return nil, fmt.Errorf("Machine deployment %s did not make progress: %s", deployment.Name, condition.Message)
+			}
+		}
+
+		if health.CheckMachineDeployment(existingMachineDeployment) == nil && existingMachineDeployment.Status.UpdatedReplicas >= existingMachineDeployment.Spec.Replicas {
+			continue
+		}
+
+		progress = append(progress, fmt.Sprintf("%s: %d/%d machine objects up-to-date, %d/%d available", deployment.Name,
+			existingMachineDeployment.Status.UpdatedReplicas, existingMachineDeployment.Spec.Replicas,
+			existingMachineDeployment.Status.AvailableReplicas, existingMachineDeployment.Spec.Replicas))
+	}
+
+	return progress, nil
+}
+
+// updateWorkerStatusRollingUpdate reports the given progress of the machine deployments in the rolling update
+// condition of the worker. The status is only updated if the condition has changed.
+func (a *genericActuator) updateWorkerStatusRollingUpdate(ctx context.Context, worker *extensionsv1alpha1.Worker, progress []string) error {
+	condition := gardencorev1alpha1helper.GetOrInitCondition(worker.Status.Conditions, ConditionTypeRollingUpdate)
+	if len(progress) == 0 {
+		condition = gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, reasonRollingUpdateCompleted, "All machine deployments are up-to-date and available.")
+	} else {
+		condition = gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, reasonRollingUpdateProgressing, strings.Join(progress, "; "))
+	}
+
+	existing := gardencorev1alpha1helper.GetCondition(worker.Status.Conditions, ConditionTypeRollingUpdate)
+	if existing != nil && existing.Status == condition.Status && existing.Message == condition.Message {
+		return nil
+	}
+
+	// The condition has been progressing since the rolling update started, hence its last transition marks the start.
+	var rolloutStart *metav1.Time
+	if existing != nil && existing.Status == gardencorev1alpha1.ConditionTrue && condition.Status == gardencorev1alpha1.ConditionFalse {
+		start := existing.LastTransitionTime
+		rolloutStart = &start
+	}
+
+	if err := extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, a.client, worker, func() error {
+		worker.Status.Conditions = gardencorev1alpha1helper.MergeConditions(worker.Status.Conditions, condition)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if rolloutStart != nil {
+		metrics.ObserveMachineDeploymentRollout(worker.Spec.Type, rolloutStart.Time)
+	}
+	return nil
+}
+
+// rollingUpdateProgressing returns true if the rolling update condition of the worker reports a rollout in progress.
+func rollingUpdateProgressing(worker *extensionsv1alpha1.Worker) bool {
+	condition := gardencorev1alpha1helper.GetCondition(worker.Status.Conditions, ConditionTypeRollingUpdate)
+	return condition != nil && condition.Status == gardencorev1alpha1.ConditionTrue
+}
+
+// protectNodesFromScaleDown disables the scale-down of the nodes of the given machine deployments by the cluster
+// autoscaler. Nodes whose scale-down has already been disabled by someone else are left untouched.
+func protectNodesFromScaleDown(ctx context.Context, c, shootClient client.Client, namespace string, machineDeploymentNames sets.String) error {
+	for _, name := range machineDeploymentNames.List() {
+		machines := &machinev1alpha1.MachineList{}
+		if err := c.List(ctx, machines, client.InNamespace(namespace), client.MatchingLabels(map[string]string{"name": name})); err != nil {
+			return err
+		}
+
+		for _, machine := range machines.Items {
+			if len(machine.Status.Node) == 0 {
+				continue
+			}
+
+			node := &corev1.Node{}
+			if err := shootClient.Get(ctx, kutil.Key(machine.Status.Node), node); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return err
+			}
+
+			if _, ok := node.Annotations[AnnotationKeyScaleDownDisabled]; ok {
+				continue
+			}
+
+			if err := extensionscontroller.TryUpdate(ctx, retry.DefaultBackoff, shootClient, node, func() error {
+				metav1.SetMetaDataAnnotation(&node.ObjectMeta, AnnotationKeyScaleDownDisabled, "true")
+				metav1.SetMetaDataAnnotation(&node.ObjectMeta, AnnotationKeyRollingMachineDeployment, name)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// removeScaleDownProtection removes the scale-down protection of all nodes that has been added for rollouts.
+func removeScaleDownProtection(ctx context.Context, shootClient client.Client) error {
+	nodes := &corev1.NodeList{}
+	if err := shootClient.List(ctx, nodes); err != nil {
+		return err
+	}
+
+	for _, n := range nodes.Items {
+		if _, ok := n.Annotations[AnnotationKeyRollingMachineDeployment]; !ok {
+			continue
+		}
+
+		node := n.DeepCopy()
+		if err := extensionscontroller.TryUpdate(ctx, retry.DefaultBackoff, shootClient, node, func() error {
+			delete(node.Annotations, AnnotationKeyScaleDownDisabled)
+			delete(node.Annotations, AnnotationKeyRollingMachineDeployment)
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scaleClusterAutoscaler scales the cluster autoscaler deployment to the given number of replicas if it exists.
+func (a *genericActuator) scaleClusterAutoscaler(ctx context.Context, namespace string, replicas int32) error {
+	deployment := &appsv1.Deployment{}
+	if err := a.client.Get(ctx, kutil.Key(namespace, v1alpha1constants.DeploymentNameClusterAutoscaler), deployment); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	return util.ScaleDeployment(ctx, a.client, deployment, replicas)
+}
+
+// scaleUpClusterAutoscalerIfScaledDown scales the cluster autoscaler deployment to one replica if it exists and has
+// been scaled down to zero replicas.
+func (a *genericActuator) scaleUpClusterAutoscalerIfScaledDown(ctx context.Context, namespace string) error {
+	deployment := &appsv1.Deployment{}
+	if err := a.client.Get(ctx, kutil.Key(namespace, v1alpha1constants.DeploymentNameClusterAutoscaler), deployment); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	if deployment.Spec.Replicas == nil || *deployment.Spec.Replicas != 0 {
+		return nil
+	}
+	return util.ScaleDeployment(ctx, a.client, deployment, 1)
+}
+
+// updateClusterAutoscalerNodeGroups sets the minimum and maximum of the node groups of the given machine deployments in
+// the arguments of the cluster autoscaler deployment if it exists. The cluster autoscaler only knows the bounds of its
+// node groups from its `--nodes=<min>:<max>:<namespace>.<name>` arguments which are rendered out of the worker status
+// after the worker has been reconciled. Hence, the bounds of rolling machine deployments are frozen here so that the
+// cluster autoscaler respects them already during the rollout.
+func updateClusterAutoscalerNodeGroups(ctx context.Context, c client.Client, namespace string, machineDeployments worker.MachineDeployments) error {
+	deployment := &appsv1.Deployment{}
+	if err := c.Get(ctx, kutil.Key(namespace, v1alpha1constants.DeploymentNameClusterAutoscaler), deployment); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	if !setNodeGroupBounds(deployment.DeepCopy(), namespace, machineDeployments) {
+		return nil
+	}
+
+	return extensionscontroller.TryUpdate(ctx, retry.DefaultBackoff, c, deployment, func() error {
+		setNodeGroupBounds(deployment, namespace, machineDeployments)
+		return nil
+	})
+}
+
+// setNodeGroupBounds sets the minimum and maximum of the node groups of the given machine deployments in the `--nodes`
+// arguments of the containers of the given cluster autoscaler deployment. It returns true if any argument has changed.
+func setNodeGroupBounds(deployment *appsv1.Deployment, namespace string, machineDeployments worker.MachineDeployments) bool {
+	const flag = "--nodes="
+
+	nodeGroups := make(map[string]string, len(machineDeployments))
+	for _, machineDeployment := range machineDeployments {
+		nodeGroup := fmt.Sprintf("%s.%s", namespace, machineDeployment.Name)
+		nodeGroups[nodeGroup] = fmt.Sprintf("%s%d:%d:%s", flag, machineDeployment.Minimum, machineDeployment.Maximum, nodeGroup)
+	}
+
+	var changed bool
+	for i := range deployment.Spec.Template.Spec.Containers {
+		container := &deployment.Spec.Template.Spec.Containers[i]
+		for _, args := range [][]string{container.Command, container.Args} {
+			for j, arg := range args {
+				if !strings.HasPrefix(arg, flag) {
+					continue
+				}
+
+				bounds := strings.SplitN(strings.TrimPrefix(arg, flag), ":", 3)
+				if len(bounds) != 3 {
+					continue
+				}
+
+				if nodeGroup, ok := nodeGroups[bounds[2]]; ok && nodeGroup != arg {
+					args[j] = nodeGroup
+					changed = true
+				}
+			}
+		}
+	}
+	return changed
+}
@@ -0,0 +1,306 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genericactuator
+
+import (
+	"context"
+	"time"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
+
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("RollingUpdate", func() {
+	newMachineDeployment := func(name, className string, replicas int32) machinev1alpha1.MachineDeployment {
+		return machinev1alpha1.MachineDeployment{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: machinev1alpha1.MachineDeploymentSpec{
+				Replicas: replicas,
+				Template: machinev1alpha1.MachineTemplateSpec{
+					Spec: machinev1alpha1.MachineSpec{
+						Class: machinev1alpha1.ClassSpec{Name: className},
+					},
+				},
+			},
+		}
+	}
+
+	var (
+		existingMachineDeployments *machinev1alpha1.MachineDeploymentList
+		wantedMachineDeployments   worker.MachineDeployments
+	)
+
+	BeforeEach(func() {
+		existingMachineDeployments = &machinev1alpha1.MachineDeploymentList{
+			Items: []machinev1alpha1.MachineDeployment{
+				newMachineDeployment("pool-a", "class-a-old", 3),
+				newMachineDeployment("pool-b", "class-b", 5),
+			},
+		}
+		wantedMachineDeployments = worker.MachineDeployments{
+			{Name: "pool-a", ClassName: "class-a-new", Minimum: 1, Maximum: 10},
+			{Name: "pool-b", ClassName: "class-b", Minimum: 1, Maximum: 10},
+			{Name: "pool-c", ClassName: "class-c", Minimum: 1, Maximum: 10},
+		}
+	})
+
+	Describe("#computeRollingMachineDeployments", func() {
+		It("should only return existing machine deployments whose class changes", func() {
+			Expect(computeRollingMachineDeployments(existingMachineDeployments, wantedMachineDeployments, nil)).To(Equal(sets.NewString("pool-a")))
+		})
+
+		It("should return the machine deployments whose rollout has not yet finished", func() {
+			existingMachineDeployments.Items[0].Spec.Template.Spec.Class.Name = "class-a-new"
+			statusMachineDeployments := []extensionsv1alpha1.MachineDeployment{
+				{Name: "pool-a", Minimum: 3, Maximum: 3},
+				{Name: "pool-b", Minimum: 1, Maximum: 10},
+			}
+
+			Expect(computeRollingMachineDeployments(existingMachineDeployments, wantedMachineDeployments, statusMachineDeployments)).To(Equal(sets.NewString("pool-a")))
+		})
+
+		It("should ignore machine deployments that are not scaled by the cluster autoscaler", func() {
+			wantedMachineDeployments[0].Maximum = 1
+
+			Expect(computeRollingMachineDeployments(existingMachineDeployments, wantedMachineDeployments, nil)).To(BeEmpty())
+		})
+	})
+
+	Describe("#freezeMachineDeployments", func() {
+		It("should freeze the rolling machine deployments at their current size", func() {
+			frozen := freezeMachineDeployments(wantedMachineDeployments, existingMachineDeployments, sets.NewString("pool-a"))
+
+			Expect(frozen[0].Minimum).To(Equal(3))
+			Expect(frozen[0].Maximum).To(Equal(3))
+			Expect(frozen[1:]).To(Equal(wantedMachineDeployments[1:]))
+			Expect(wantedMachineDeployments[0].Minimum).To(Equal(1))
+		})
+
+		It("should keep the frozen size within the bounds of the pool", func() {
+			wantedMachineDeployments[0].Maximum = 2
+
+			frozen := freezeMachineDeployments(wantedMachineDeployments, existingMachineDeployments, sets.NewString("pool-a"))
+
+			Expect(frozen[0].Minimum).To(Equal(2))
+			Expect(frozen[0].Maximum).To(Equal(2))
+		})
+	})
+
+	Describe("#progressDeadlineSeconds", func() {
+		It("should return nil if no timeouts are configured", func() {
+			Expect(progressDeadlineSeconds(nil)).To(BeNil())
+			Expect(progressDeadlineSeconds(&worker.MachineConfiguration{})).To(BeNil())
+		})
+
+		It("should add up the creation and drain timeouts", func() {
+			seconds := progressDeadlineSeconds(&worker.MachineConfiguration{
+				CreationTimeout: &metav1.Duration{Duration: 20 * time.Minute},
+				DrainTimeout:    &metav1.Duration{Duration: time.Hour},
+			})
+
+			Expect(seconds).NotTo(BeNil())
+			Expect(*seconds).To(Equal(int32(4800)))
+		})
+	})
+
+	Describe("#protectNodesFromScaleDown", func() {
+		var (
+			ctx         = context.TODO()
+			c           client.Client
+			shootClient client.Client
+		)
+
+		newNode := func(name string, annotations map[string]string) *corev1.Node {
+			return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Annotations: annotations}}
+		}
+
+		BeforeEach(func() {
+			scheme := runtime.NewScheme()
+			Expect(machinev1alpha1.AddToScheme(scheme)).To(Succeed())
+
+			newMachine := func(name, machineDeploymentName, nodeName string) *machinev1alpha1.Machine {
+				return &machinev1alpha1.Machine{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "shoot--foo--bar", Labels: map[string]string{"name": machineDeploymentName}},
+					Status:     machinev1alpha1.MachineStatus{Node: nodeName},
+				}
+			}
+
+			c = fake.NewFakeClientWithScheme(scheme,
+				newMachine("machine-a-1", "pool-a", "node-a-1"),
+				newMachine("machine-a-2", "pool-a", "node-a-2"),
+				newMachine("machine-a-3", "pool-a", ""),
+				newMachine("machine-b-1", "pool-b", "node-b-1"),
+			)
+			shootClient = fake.NewFakeClient(
+				newNode("node-a-1", nil),
+				newNode("node-a-2", map[string]string{AnnotationKeyScaleDownDisabled: "false"}),
+				newNode("node-b-1", nil),
+			)
+		})
+
+		It("should protect the nodes of the rolling machine deployments and remove the protection afterwards", func() {
+			Expect(protectNodesFromScaleDown(ctx, c, shootClient, "shoot--foo--bar", sets.NewString("pool-a"))).To(Succeed())
+
+			node := &corev1.Node{}
+			Expect(shootClient.Get(ctx, kutil.Key("node-a-1"), node)).To(Succeed())
+			Expect(node.Annotations).To(Equal(map[string]string{
+				AnnotationKeyScaleDownDisabled:        "true",
+				AnnotationKeyRollingMachineDeployment: "pool-a",
+			}))
+			node = &corev1.Node{}
+			Expect(shootClient.Get(ctx, kutil.Key("node-a-2"), node)).To(Succeed())
+			Expect(node.Annotations).To(Equal(map[string]string{AnnotationKeyScaleDownDisabled: "false"}))
+			node = &corev1.Node{}
+			Expect(shootClient.Get(ctx, kutil.Key("node-b-1"), node)).To(Succeed())
+			Expect(node.Annotations).To(BeEmpty())
+
+			Expect(removeScaleDownProtection(ctx, shootClient)).To(Succeed())
+
+			node = &corev1.Node{}
+			Expect(shootClient.Get(ctx, kutil.Key("node-a-1"), node)).To(Succeed())
+			Expect(node.Annotations).To(BeEmpty())
+			node = &corev1.Node{}
+			Expect(shootClient.Get(ctx, kutil.Key("node-a-2"), node)).To(Succeed())
+			Expect(node.Annotations).To(Equal(map[string]string{AnnotationKeyScaleDownDisabled: "false"}))
+		})
+	})
+
+	Describe("#updateClusterAutoscalerNodeGroups", func() {
+		var (
+			ctx = context.TODO()
+			c   client.Client
+		)
+
+		getNodeGroups := func() []string {
+			deployment := &appsv1.Deployment{}
+			ExpectWithOffset(1, c.Get(ctx, kutil.Key("shoot--foo--bar", v1alpha1constants.DeploymentNameClusterAutoscaler), deployment)).To(Succeed())
+			return deployment.Spec.Template.Spec.Containers[0].Command
+		}
+
+		BeforeEach(func() {
+			c = fake.NewFakeClient(&appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: v1alpha1constants.DeploymentNameClusterAutoscaler, Namespace: "shoot--foo--bar"},
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{
+								Name: "cluster-autoscaler",
+								Command: []string{
+									"./cluster-autoscaler",
+									"--nodes=1:10:shoot--foo--bar.pool-a",
+									"--nodes=1:10:shoot--foo--bar.pool-b",
+									"--expander=least-waste",
+								},
+							}},
+						},
+					},
+				},
+			})
+		})
+
+		It("should let the cluster autoscaler see the frozen bounds while rolling and unfreeze them afterwards", func() {
+			frozenMachineDeployments := freezeMachineDeployments(wantedMachineDeployments, existingMachineDeployments, sets.NewString("pool-a"))
+
+			Expect(updateClusterAutoscalerNodeGroups(ctx, c, "shoot--foo--bar", frozenMachineDeployments)).To(Succeed())
+			Expect(getNodeGroups()).To(Equal([]string{
+				"./cluster-autoscaler",
+				"--nodes=3:3:shoot--foo--bar.pool-a",
+				"--nodes=1:10:shoot--foo--bar.pool-b",
+				"--expander=least-waste",
+			}))
+
+			Expect(updateClusterAutoscalerNodeGroups(ctx, c, "shoot--foo--bar", wantedMachineDeployments)).To(Succeed())
+			Expect(getNodeGroups()).To(Equal([]string{
+				"./cluster-autoscaler",
+				"--nodes=1:10:shoot--foo--bar.pool-a",
+				"--nodes=1:10:shoot--foo--bar.pool-b",
+				"--expander=least-waste",
+			}))
+		})
+
+		It("should succeed if the cluster autoscaler does not exist", func() {
+			Expect(updateClusterAutoscalerNodeGroups(ctx, fake.NewFakeClient(), "shoot--foo--bar", wantedMachineDeployments)).To(Succeed())
+		})
+	})
+
+	Describe("#injectMachineTimeouts", func() {
+		It("should not inject any timeouts if none are configured", func() {
+			values := map[string]interface{}{}
+			injectMachineTimeouts(values, wantedMachineDeployments)
+			Expect(values).To(BeEmpty())
+		})
+
+		It("should inject the longest timeouts of all machine deployments", func() {
+			wantedMachineDeployments[0].MachineConfiguration = &worker.MachineConfiguration{
+				CreationTimeout: &metav1.Duration{Duration: 30 * time.Minute},
+				DrainTimeout:    &metav1.Duration{Duration: time.Hour},
+			}
+			wantedMachineDeployments[1].MachineConfiguration = &worker.MachineConfiguration{
+				DrainTimeout: &metav1.Duration{Duration: 3 * time.Hour},
+			}
+
+			values := map[string]interface{}{}
+			injectMachineTimeouts(values, wantedMachineDeployments)
+			Expect(values).To(Equal(map[string]interface{}{
+				"machineCreationTimeout": "30m0s",
+				"machineDrainTimeout":    "3h0m0s",
+			}))
+		})
+	})
+
+	Describe("#computeMachineDeploymentsProgress", func() {
+		It("should report the machine deployments that are not yet available", func() {
+			existingMachineDeployments.Items[0].Status.UpdatedReplicas = 1
+			existingMachineDeployments.Items[0].Status.AvailableReplicas = 2
+			existingMachineDeployments.Items[1].Status = machinev1alpha1.MachineDeploymentStatus{
+				UpdatedReplicas: 5,
+				Conditions: []machinev1alpha1.MachineDeploymentCondition{
+					{Type: machinev1alpha1.MachineDeploymentAvailable, Status: machinev1alpha1.ConditionTrue},
+				},
+			}
+
+			progress, err := computeMachineDeploymentsProgress(existingMachineDeployments, wantedMachineDeployments)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(progress).To(Equal([]string{
+				"pool-a: 1/3 machine objects up-to-date, 2/3 available",
+				"pool-c: not yet created",
+			}))
+		})
+
+		It("should fail if a machine deployment exceeded its progress deadline", func() {
+			existingMachineDeployments.Items[0].Status.Conditions = []machinev1alpha1.MachineDeploymentCondition{
+				{Type: machinev1alpha1.MachineDeploymentProgressing, Status: machinev1alpha1.ConditionFalse, Message: "deadline exceeded"},
+			}
+
+			_, err := computeMachineDeploymentsProgress(existingMachineDeployments, wantedMachineDeployments)
+
+			Expect(err).To(MatchError(ContainSubstring("pool-a")))
+		})
+	})
+})
@@ -22,6 +22,7 @@ import (
 
 	"github.com/gardener/gardener/pkg/utils"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 )
@@ -47,6 +48,18 @@ type MachineDeployment struct {
 	Labels         map[string]string
 	Annotations    map[string]string
 	Taints         []corev1.Taint
+
+	MachineConfiguration *MachineConfiguration
+}
+
+// MachineConfiguration contains the timeouts that are applied to the rollout of a MachineDeployment. The
+// machine-controller-manager only supports these timeouts for all machines of a shoot, hence the longest timeouts of all
+// MachineDeployments are used for the machines of all MachineDeployments.
+type MachineConfiguration struct {
+	// DrainTimeout is the maximum duration for draining a machine that is replaced.
+	DrainTimeout *metav1.Duration
+	// CreationTimeout is the maximum duration for creating a machine until its node has joined the cluster.
+	CreationTimeout *metav1.Duration
 }
 
 // MachineDeployments is a list of machine deployments.
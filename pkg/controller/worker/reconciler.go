@@ -20,9 +20,12 @@ import (
 
 	"github.com/gardener/gardener-extensions/pkg/controller"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
+	"github.com/gardener/gardener-extensions/pkg/metrics"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 
@@ -40,8 +43,9 @@ import (
 )
 
 type reconciler struct {
-	logger   logr.Logger
-	actuator Actuator
+	logger        logr.Logger
+	actuator      Actuator
+	errorMatchers []controllererrors.Matcher
 
 	ctx    context.Context
 	client client.Client
@@ -49,12 +53,13 @@ type reconciler struct {
 
 // NewReconciler creates a new reconcile.Reconciler that reconciles
 // Worker resources of Gardener's `extensions.gardener.cloud` API group.
-func NewReconciler(mgr manager.Manager, actuator Actuator) reconcile.Reconciler {
+func NewReconciler(mgr manager.Manager, actuator Actuator, errorMatchers ...controllererrors.Matcher) reconcile.Reconciler {
 	return extensionscontroller.OperationAnnotationWrapper(
 		&extensionsv1alpha1.Worker{},
 		&reconciler{
-			logger:   log.Log.WithName(ControllerName),
-			actuator: actuator,
+			logger:        log.Log.WithName(ControllerName),
+			actuator:      actuator,
+			errorMatchers: errorMatchers,
 		},
 	)
 }
@@ -87,6 +92,35 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 		return reconcile.Result{}, err
 	}
 
+	// Migration flow
+	if extensionscontroller.HasOperation(worker, v1alpha1constants.GardenerOperationMigrate) {
+		operationType := extensionscontroller.LastOperationTypeMigrate
+		if err := r.updateStatusProcessing(r.ctx, worker, operationType, "Migrating the worker"); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		r.logger.Info("Starting the migration of worker", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+		observe := metrics.StartOperation(extensionsv1alpha1.WorkerResource, worker.Spec.Type, operationType)
+		if err := observe(r.actuator.Migrate(r.ctx, worker, cluster)); err != nil {
+			msg := "Error migrating worker"
+			utilruntime.HandleError(r.updateStatusError(r.ctx, extensionscontroller.ReconcileErrCauseOrErr(err), worker, operationType, msg))
+			r.logger.Error(err, msg, "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+			return extensionscontroller.ReconcileErr(err)
+		}
+
+		msg := "Successfully migrated worker"
+		r.logger.Info(msg, "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+		if err := r.updateStatusSuccess(r.ctx, worker, operationType, msg); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		if err := extensionscontroller.RemoveOperationAnnotation(r.ctx, r.client, worker); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		return reconcile.Result{}, nil
+	}
+
 	// Deletion flow
 	if worker.DeletionTimestamp != nil {
 		hasFinalizer, err := extensionscontroller.HasFinalizer(worker, FinalizerName)
@@ -99,13 +133,23 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 			return reconcile.Result{}, nil
 		}
 
+		if extensionscontroller.IsMigrated(worker) {
+			r.logger.Info("Worker has been migrated, removing finalizer without deleting the machines.", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+			if err := extensionscontroller.DeleteFinalizer(r.ctx, r.client, FinalizerName, worker); err != nil {
+				r.logger.Error(err, "Error removing finalizer from Worker", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+				return reconcile.Result{}, err
+			}
+			return reconcile.Result{}, nil
+		}
+
 		operationType := gardencorev1alpha1helper.ComputeOperationType(worker.ObjectMeta, worker.Status.LastOperation)
 		if err := r.updateStatusProcessing(r.ctx, worker, operationType, "Deleting the worker"); err != nil {
 			return reconcile.Result{}, err
 		}
 
 		r.logger.Info("Starting the deletion of worker", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
-		if err := r.actuator.Delete(r.ctx, worker, cluster); err != nil {
+		observe := metrics.StartOperation(extensionsv1alpha1.WorkerResource, worker.Spec.Type, operationType)
+		if err := observe(r.actuator.Delete(r.ctx, worker, cluster)); err != nil {
 			msg := "Error deleting worker"
 			utilruntime.HandleError(r.updateStatusError(r.ctx, extensionscontroller.ReconcileErrCauseOrErr(err), worker, operationType, msg))
 			r.logger.Error(err, msg, "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
@@ -127,6 +171,44 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 		return reconcile.Result{}, nil
 	}
 
+	// Restoration flow
+	if extensionscontroller.HasOperation(worker, extensionscontroller.GardenerOperationRestore) {
+		if err := controller.EnsureFinalizer(r.ctx, r.client, FinalizerName, worker); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		operationType := extensionscontroller.LastOperationTypeRestore
+		if err := r.updateStatusProcessing(r.ctx, worker, operationType, "Restoring the worker"); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		r.logger.Info("Starting the restoration of worker", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+		observe := metrics.StartOperation(extensionsv1alpha1.WorkerResource, worker.Spec.Type, operationType)
+		if err := observe(r.actuator.Restore(r.ctx, worker, cluster)); err != nil {
+			msg := "Error restoring worker"
+			utilruntime.HandleError(r.updateStatusError(r.ctx, extensionscontroller.ReconcileErrCauseOrErr(err), worker, operationType, msg))
+			r.logger.Error(err, msg, "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+			return extensionscontroller.ReconcileErr(err)
+		}
+
+		msg := "Successfully restored worker"
+		r.logger.Info(msg, "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+		if err := r.updateStatusSuccess(r.ctx, worker, operationType, msg); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		if err := extensionscontroller.RemoveOperationAnnotation(r.ctx, r.client, worker); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		return reconcile.Result{}, nil
+	}
+
+	if extensionscontroller.IsMigrated(worker) {
+		r.logger.Info("Skipping the reconciliation of migrated worker", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+		return reconcile.Result{}, nil
+	}
+
 	// Reconcile flow
 	if err := controller.EnsureFinalizer(r.ctx, r.client, FinalizerName, worker); err != nil {
 		return reconcile.Result{}, err
@@ -137,7 +219,8 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 		return reconcile.Result{}, err
 	}
 
-	if err := r.actuator.Reconcile(r.ctx, worker, cluster); err != nil {
+	observe := metrics.StartOperation(extensionsv1alpha1.WorkerResource, worker.Spec.Type, operationType)
+	if err := observe(r.actuator.Reconcile(r.ctx, worker, cluster)); err != nil {
 		msg := "Error reconciling worker"
 		utilruntime.HandleError(r.updateStatusError(r.ctx, extensionscontroller.ReconcileErrCauseOrErr(err), worker, operationType, msg))
 		r.logger.Error(err, msg, "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
@@ -161,9 +244,10 @@ func (r *reconciler) updateStatusProcessing(ctx context.Context, worker *extensi
 }
 
 func (r *reconciler) updateStatusError(ctx context.Context, err error, worker *extensionsv1alpha1.Worker, lastOperationType gardencorev1alpha1.LastOperationType, description string) error {
+	err = controllererrors.Classify(err, r.errorMatchers...)
 	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, worker, func() error {
 		worker.Status.ObservedGeneration = worker.Generation
-		worker.Status.LastOperation, worker.Status.LastError = extensionscontroller.ReconcileError(lastOperationType, gardencorev1alpha1helper.FormatLastErrDescription(fmt.Errorf("%s: %v", description, err)), 50, gardencorev1alpha1helper.ExtractErrorCodes(err)...)
+		worker.Status.LastOperation, worker.Status.LastError = extensionscontroller.ReconcileError(lastOperationType, gardencorev1alpha1helper.FormatLastErrDescription(fmt.Errorf("%s: %v", description, err)), 50, controllererrors.ExtractErrorCodes(err)...)
 		return nil
 	})
 }
@@ -0,0 +1,117 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"encoding/json"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// State contains the state of the machine resources of a Worker that is required to restore
+// the Worker in another seed without recreating its machines.
+type State struct {
+	// MachineDeployments maps the names of the machine deployments to their state.
+	MachineDeployments map[string]*MachineDeploymentState `json:"machineDeployments,omitempty"`
+}
+
+// MachineDeploymentState stores the replicas as well as the machine sets and machines of a machine deployment.
+type MachineDeploymentState struct {
+	Replicas    int32                        `json:"replicas,omitempty"`
+	MachineSets []machinev1alpha1.MachineSet `json:"machineSets,omitempty"`
+	Machines    []machinev1alpha1.Machine    `json:"machines,omitempty"`
+}
+
+// ComputeState computes the State out of the given machine deployments, machine sets and machines. Machine sets
+// and machines are assigned to their machine deployment based on their owner references. The object metadata
+// which is specific to the cluster the objects live in (uid, resource version, owner references, finalizers, ...)
+// is dropped.
+func ComputeState(machineDeployments []machinev1alpha1.MachineDeployment, machineSets []machinev1alpha1.MachineSet, machines []machinev1alpha1.Machine) *State {
+	var (
+		state                     = &State{MachineDeployments: make(map[string]*MachineDeploymentState, len(machineDeployments))}
+		machineSetToDeploymentMap = make(map[string]string, len(machineSets))
+	)
+
+	for _, machineDeployment := range machineDeployments {
+		state.MachineDeployments[machineDeployment.Name] = &MachineDeploymentState{
+			Replicas: machineDeployment.Spec.Replicas,
+		}
+	}
+
+	for _, machineSet := range machineSets {
+		deploymentName := ownerName(machineSet.OwnerReferences, "MachineDeployment")
+		deploymentState, ok := state.MachineDeployments[deploymentName]
+		if !ok {
+			continue
+		}
+
+		machineSetToDeploymentMap[machineSet.Name] = deploymentName
+		machineSet.ObjectMeta = stripObjectMeta(machineSet.ObjectMeta)
+		machineSet.Status = machinev1alpha1.MachineSetStatus{}
+		deploymentState.MachineSets = append(deploymentState.MachineSets, machineSet)
+	}
+
+	for _, machine := range machines {
+		deploymentState, ok := state.MachineDeployments[machineSetToDeploymentMap[ownerName(machine.OwnerReferences, "MachineSet")]]
+		if !ok {
+			continue
+		}
+
+		machine.ObjectMeta = stripObjectMeta(machine.ObjectMeta)
+		deploymentState.Machines = append(deploymentState.Machines, machine)
+	}
+
+	return state
+}
+
+// EncodeState encodes the given State so that it can be stored in the status of a Worker.
+func EncodeState(state *State) (string, error) {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// DecodeState decodes the given state of a Worker. An empty string results in an empty State.
+func DecodeState(data string) (*State, error) {
+	state := &State{}
+	if len(data) == 0 {
+		return state, nil
+	}
+	if err := json.Unmarshal([]byte(data), state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+func ownerName(ownerReferences []metav1.OwnerReference, kind string) string {
+	for _, ownerReference := range ownerReferences {
+		if ownerReference.Kind == kind {
+			return ownerReference.Name
+		}
+	}
+	return ""
+}
+
+func stripObjectMeta(meta metav1.ObjectMeta) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:        meta.Name,
+		Namespace:   meta.Namespace,
+		Labels:      meta.Labels,
+		Annotations: meta.Annotations,
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker_test
+
+import (
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("State", func() {
+	var (
+		machineDeployments = []machinev1alpha1.MachineDeployment{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "pool-a", Namespace: "shoot--foo--bar"},
+				Spec:       machinev1alpha1.MachineDeploymentSpec{Replicas: 2},
+			},
+		}
+		machineSets = []machinev1alpha1.MachineSet{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "pool-a-123",
+					Namespace:       "shoot--foo--bar",
+					UID:             "uid-1",
+					ResourceVersion: "42",
+					Finalizers:      []string{"machine.sapcloud.io/machine-controller-manager"},
+					OwnerReferences: []metav1.OwnerReference{{Kind: "MachineDeployment", Name: "pool-a"}},
+				},
+				Status: machinev1alpha1.MachineSetStatus{Replicas: 2},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "orphan",
+					Namespace:       "shoot--foo--bar",
+					OwnerReferences: []metav1.OwnerReference{{Kind: "MachineDeployment", Name: "unknown"}},
+				},
+			},
+		}
+		machines = []machinev1alpha1.Machine{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "pool-a-123-abc",
+					Namespace:       "shoot--foo--bar",
+					UID:             "uid-2",
+					Labels:          map[string]string{"node": "node-1"},
+					OwnerReferences: []metav1.OwnerReference{{Kind: "MachineSet", Name: "pool-a-123"}},
+				},
+				Spec: machinev1alpha1.MachineSpec{ProviderID: "provider:///node-1"},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "orphan-abc",
+					Namespace:       "shoot--foo--bar",
+					OwnerReferences: []metav1.OwnerReference{{Kind: "MachineSet", Name: "orphan"}},
+				},
+			},
+		}
+	)
+
+	Describe("#ComputeState", func() {
+		It("should assign the machine sets and machines to their machine deployments", func() {
+			state := worker.ComputeState(machineDeployments, machineSets, machines)
+
+			Expect(state.MachineDeployments).To(HaveLen(1))
+			Expect(state.MachineDeployments).To(HaveKey("pool-a"))
+
+			deploymentState := state.MachineDeployments["pool-a"]
+			Expect(deploymentState.Replicas).To(Equal(int32(2)))
+			Expect(deploymentState.MachineSets).To(Equal([]machinev1alpha1.MachineSet{
+				{ObjectMeta: metav1.ObjectMeta{Name: "pool-a-123", Namespace: "shoot--foo--bar"}},
+			}))
+			Expect(deploymentState.Machines).To(Equal([]machinev1alpha1.Machine{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "pool-a-123-abc", Namespace: "shoot--foo--bar", Labels: map[string]string{"node": "node-1"}},
+					Spec:       machinev1alpha1.MachineSpec{ProviderID: "provider:///node-1"},
+				},
+			}))
+		})
+	})
+
+	Describe("#EncodeState, #DecodeState", func() {
+		It("should decode the encoded state", func() {
+			state := worker.ComputeState(machineDeployments, machineSets, machines)
+
+			data, err := worker.EncodeState(state)
+			Expect(err).NotTo(HaveOccurred())
+
+			decoded, err := worker.DecodeState(data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decoded.MachineDeployments).To(HaveKey("pool-a"))
+			Expect(decoded.MachineDeployments["pool-a"].Machines).To(HaveLen(1))
+		})
+
+		It("should return an empty state for empty data", func() {
+			state, err := worker.DecodeState("")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state.MachineDeployments).To(BeEmpty())
+		})
+	})
+})
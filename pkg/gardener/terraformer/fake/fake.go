@@ -0,0 +1,393 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fake
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
+	"github.com/gardener/gardener-extensions/pkg/util/chart"
+
+	"github.com/gardener/gardener/pkg/chartrenderer"
+	"github.com/gardener/gardener/pkg/operation/common"
+	gardenerterraformer "github.com/gardener/gardener/pkg/operation/terraformer"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/helm/pkg/manifest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var (
+	_ terraformer.Factory     = &Factory{}
+	_ terraformer.Interface   = &Terraformer{}
+	_ terraformer.Initializer = &Initializer{}
+)
+
+// Factory is a terraformer.Factory that produces in-process Terraformers instead of spawning terraformer pods.
+// The produced Terraformers are kept by the factory so that tests can script their behaviour before and inspect
+// them after the code under test has been executed.
+type Factory struct {
+	terraformers map[string]*Terraformer
+}
+
+// NewFactory creates a new fake Factory.
+func NewFactory() *Factory {
+	return &Factory{terraformers: make(map[string]*Terraformer)}
+}
+
+// Terraformer returns the Terraformer for the given purpose, namespace and name. It is created if it does not exist yet.
+func (f *Factory) Terraformer(purpose, namespace, name string) *Terraformer {
+	key := fmt.Sprintf("%s/%s/%s", namespace, name, purpose)
+	if tf, ok := f.terraformers[key]; ok {
+		return tf
+	}
+
+	tf := &Terraformer{
+		Purpose:              purpose,
+		Namespace:            namespace,
+		Name:                 name,
+		StateOutputVariables: make(map[string]string),
+	}
+	f.terraformers[key] = tf
+	return tf
+}
+
+// NewForConfig implements terraformer.Factory.
+func (f *Factory) NewForConfig(_ logrus.FieldLogger, _ *rest.Config, purpose, namespace, name, image string) (terraformer.Interface, error) {
+	return f.New(nil, nil, nil, purpose, namespace, name, image), nil
+}
+
+// New implements terraformer.Factory.
+func (f *Factory) New(_ logrus.FieldLogger, _ client.Client, _ corev1client.CoreV1Interface, purpose, namespace, name, image string) terraformer.Interface {
+	tf := f.Terraformer(purpose, namespace, name)
+	tf.Image = image
+	return tf
+}
+
+// DefaultInitializer implements terraformer.Factory.
+func (f *Factory) DefaultInitializer(_ client.Client, main, variables string, tfVars []byte) terraformer.Initializer {
+	return &Initializer{
+		Files: chart.TerraformFiles{
+			Main:      main,
+			Variables: variables,
+			TFVars:    tfVars,
+		},
+	}
+}
+
+// Initializer is a terraformer.Initializer that carries the Terraform files it was created with.
+type Initializer struct {
+	Files chart.TerraformFiles
+}
+
+// Initialize implements terraformer.Initializer. It validates the Terraform files.
+func (i *Initializer) Initialize(_ *gardenerterraformer.InitializerConfig) error {
+	_, err := ValidateFiles(&i.Files)
+	return err
+}
+
+// ValidateFiles validates the given Terraform files the same way they are validated after rendering the Terraform chart.
+func ValidateFiles(files *chart.TerraformFiles) (*chart.TerraformFiles, error) {
+	return chart.ExtractTerraformFiles(&chartrenderer.RenderedChart{
+		Manifests: []manifest.Manifest{
+			{Name: "/templates/" + chart.TerraformMainTFFilename, Content: files.Main},
+			{Name: "/templates/" + chart.TerraformVariablesTFFilename, Content: files.Variables},
+			{Name: "/templates/" + chart.TerraformTFVarsFilename, Content: string(files.TFVars)},
+		},
+	})
+}
+
+// Application is a recorded execution of the Terraform configuration.
+type Application struct {
+	// Files are the Terraform files that have been applied.
+	Files chart.TerraformFiles
+	// VariablesEnvironment are the variables that have been passed via the environment.
+	VariablesEnvironment map[string]string
+}
+
+// Terraformer is an in-process terraformer.Interface. The exported error and result fields script its behaviour,
+// the remaining fields record how it has been used.
+type Terraformer struct {
+	// Purpose, Namespace, Name and Image are the values the Terraformer has been created with.
+	Purpose   string
+	Namespace string
+	Name      string
+	Image     string
+
+	// VariablesEnvironment is the last variables environment that has been set.
+	VariablesEnvironment map[string]string
+	// JobBackoffLimit is the last job backoff limit that has been set.
+	JobBackoffLimit int32
+	// ActiveDeadlineSeconds is the last active deadline that has been set.
+	ActiveDeadlineSeconds int64
+	// DeadlineCleaning is the last cleaning deadline that has been set.
+	DeadlineCleaning time.Duration
+	// DeadlinePod is the last pod deadline that has been set.
+	DeadlinePod time.Duration
+	// DeadlineJob is the last job deadline that has been set.
+	DeadlineJob time.Duration
+
+	// Configuration is the Terraform configuration the Terraformer has been initialized with.
+	// It is nil if no configuration exists.
+	Configuration *chart.TerraformFiles
+	// InitializeErr is the error of the last initialization.
+	InitializeErr error
+	// Applications are all successful executions of `terraform apply`.
+	Applications []Application
+	// Destroyed is true after a successful execution of `terraform destroy`.
+	Destroyed bool
+	// CleanedUp is true after the configuration has been cleaned up.
+	CleanedUp bool
+
+	// StateOutputVariables are the output variables returned by GetStateOutputVariables.
+	StateOutputVariables map[string]string
+	// StateOutputVariablesAfterApply are added to the StateOutputVariables by a successful Apply.
+	StateOutputVariablesAfterApply map[string]string
+	// State is the state returned by GetState. A successful Apply replaces it with StateAfterApply or, if that is
+	// not set, with a Terraform state containing the StateOutputVariables as outputs.
+	State []byte
+	// StateAfterApply is the state that is stored by a successful Apply.
+	StateAfterApply []byte
+	// PlanResult is the plan returned by Plan.
+	PlanResult *terraformer.Plan
+
+	// ApplyErr is returned by Apply.
+	ApplyErr error
+	// DestroyErr is returned by Destroy.
+	DestroyErr error
+	// PlanErr is returned by Plan.
+	PlanErr error
+	// GetStateOutputVariablesErr is returned by GetStateOutputVariables.
+	GetStateOutputVariablesErr error
+	// ConfigExistsErr is returned by ConfigExists.
+	ConfigExistsErr error
+	// GetStateErr is returned by GetState.
+	GetStateErr error
+	// CleanupConfigurationErr is returned by CleanupConfiguration.
+	CleanupConfigurationErr error
+}
+
+// SetVariablesEnvironment implements terraformer.Interface.
+func (t *Terraformer) SetVariablesEnvironment(tfVarsEnvironment map[string]string) terraformer.Interface {
+	t.VariablesEnvironment = tfVarsEnvironment
+	return t
+}
+
+// SetJobBackoffLimit implements terraformer.Interface.
+func (t *Terraformer) SetJobBackoffLimit(val int32) terraformer.Interface {
+	t.JobBackoffLimit = val
+	return t
+}
+
+// SetActiveDeadlineSeconds implements terraformer.Interface.
+func (t *Terraformer) SetActiveDeadlineSeconds(val int64) terraformer.Interface {
+	t.ActiveDeadlineSeconds = val
+	return t
+}
+
+// SetDeadlineCleaning implements terraformer.Interface.
+func (t *Terraformer) SetDeadlineCleaning(val time.Duration) terraformer.Interface {
+	t.DeadlineCleaning = val
+	return t
+}
+
+// SetDeadlinePod implements terraformer.Interface.
+func (t *Terraformer) SetDeadlinePod(val time.Duration) terraformer.Interface {
+	t.DeadlinePod = val
+	return t
+}
+
+// SetDeadlineJob implements terraformer.Interface.
+func (t *Terraformer) SetDeadlineJob(val time.Duration) terraformer.Interface {
+	t.DeadlineJob = val
+	return t
+}
+
+// InitializeWith implements terraformer.Interface. Initializers of the fake Factory store their Terraform files as
+// configuration of the Terraformer, all other initializers are called with the configuration of a real Terraformer.
+func (t *Terraformer) InitializeWith(initializer terraformer.Initializer) terraformer.Interface {
+	prefix := fmt.Sprintf("%s.%s", t.Name, t.Purpose)
+	t.InitializeErr = initializer.Initialize(&gardenerterraformer.InitializerConfig{
+		Namespace:         t.Namespace,
+		ConfigurationName: prefix + common.TerraformerConfigSuffix,
+		VariablesName:     prefix + common.TerraformerVariablesSuffix,
+		StateName:         prefix + common.TerraformerStateSuffix,
+		InitializeState:   len(t.State) == 0,
+	})
+	if t.InitializeErr != nil {
+		return t
+	}
+
+	if i, ok := initializer.(*Initializer); ok {
+		files := i.Files
+		t.Configuration = &files
+	}
+	return t
+}
+
+// Apply implements terraformer.Interface.
+func (t *Terraformer) Apply() error {
+	if t.Configuration == nil {
+		return fmt.Errorf("terraformer configuration has not been defined, cannot execute the Terraform scripts")
+	}
+	if t.ApplyErr != nil {
+		return t.ApplyErr
+	}
+
+	t.Applications = append(t.Applications, Application{
+		Files:                *t.Configuration,
+		VariablesEnvironment: t.VariablesEnvironment,
+	})
+	for variable, value := range t.StateOutputVariablesAfterApply {
+		t.StateOutputVariables[variable] = value
+	}
+
+	if t.StateAfterApply != nil {
+		t.State = t.StateAfterApply
+		return nil
+	}
+	state, err := t.renderState()
+	if err != nil {
+		return err
+	}
+	t.State = state
+	return nil
+}
+
+type stateOutput struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type state struct {
+	Version int                    `json:"version"`
+	Serial  int                    `json:"serial"`
+	Lineage string                 `json:"lineage"`
+	Outputs map[string]stateOutput `json:"outputs"`
+}
+
+// renderState renders a Terraform state with the current StateOutputVariables as outputs. Its serial is the
+// number of applications so that every Apply produces a distinguishable state.
+func (t *Terraformer) renderState() ([]byte, error) {
+	outputs := make(map[string]stateOutput, len(t.StateOutputVariables))
+	for variable, value := range t.StateOutputVariables {
+		outputs[variable] = stateOutput{Type: "string", Value: value}
+	}
+
+	return json.Marshal(&state{
+		Version: 4,
+		Serial:  len(t.Applications),
+		Lineage: fmt.Sprintf("%s/%s/%s", t.Namespace, t.Name, t.Purpose),
+		Outputs: outputs,
+	})
+}
+
+// Plan implements terraformer.Interface.
+func (t *Terraformer) Plan(_ context.Context) (*terraformer.Plan, error) {
+	if t.PlanErr != nil {
+		return nil, t.PlanErr
+	}
+	if t.PlanResult == nil {
+		return &terraformer.Plan{}, nil
+	}
+	return t.PlanResult, nil
+}
+
+// Destroy implements terraformer.Interface.
+func (t *Terraformer) Destroy() error {
+	if t.DestroyErr != nil {
+		return t.DestroyErr
+	}
+
+	t.Destroyed = true
+	t.State = nil
+	t.StateOutputVariables = make(map[string]string)
+	return t.CleanupConfiguration(context.TODO())
+}
+
+// GetStateOutputVariables implements terraformer.Interface. Missing variables are reported with the same error as
+// the real Terraformer, so that terraformer.IsVariablesNotFoundError can be used.
+func (t *Terraformer) GetStateOutputVariables(variables ...string) (map[string]string, error) {
+	if t.GetStateOutputVariablesErr != nil {
+		return nil, t.GetStateOutputVariablesErr
+	}
+
+	var (
+		output  = make(map[string]string)
+		missing []string
+	)
+	for _, variable := range variables {
+		value, ok := t.StateOutputVariables[variable]
+		if !ok {
+			missing = append(missing, variable)
+			continue
+		}
+		output[variable] = value
+	}
+
+	if len(missing) > 0 {
+		return nil, variablesNotFoundError(missing...)
+	}
+	return output, nil
+}
+
+// ConfigExists implements terraformer.Interface.
+func (t *Terraformer) ConfigExists() (bool, error) {
+	if t.ConfigExistsErr != nil {
+		return false, t.ConfigExistsErr
+	}
+	return t.Configuration != nil, nil
+}
+
+// GetState implements terraformer.Interface.
+func (t *Terraformer) GetState() ([]byte, error) {
+	if t.GetStateErr != nil {
+		return nil, t.GetStateErr
+	}
+	return t.State, nil
+}
+
+// CleanupConfiguration implements terraformer.Interface.
+func (t *Terraformer) CleanupConfiguration(_ context.Context) error {
+	if t.CleanupConfigurationErr != nil {
+		return t.CleanupConfigurationErr
+	}
+
+	t.Configuration = nil
+	t.CleanedUp = true
+	return nil
+}
+
+// variablesNotFoundError returns the error of the real Terraformer for the given missing variables. Its type is not
+// exported, hence it is produced by a real Terraformer reading an empty state.
+func variablesNotFoundError(variables ...string) error {
+	const purpose, namespace, name = "fake", "fake", "fake"
+
+	c := fakeclient.NewFakeClient(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      terraformer.StateConfigMapName(name, purpose),
+		},
+	})
+	_, err := gardenerterraformer.New(logrus.New(), c, nil, purpose, namespace, name, "").GetStateOutputVariables(variables...)
+	return err
+}
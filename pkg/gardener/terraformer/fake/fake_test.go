@@ -0,0 +1,206 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fake_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/gardener/gardener-extensions/pkg/gardener/terraformer/fake"
+	"github.com/gardener/gardener-extensions/pkg/util/chart"
+
+	gardenerterraformer "github.com/gardener/gardener/pkg/operation/terraformer"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type initializerFunc func(config *gardenerterraformer.InitializerConfig) error
+
+func (f initializerFunc) Initialize(config *gardenerterraformer.InitializerConfig) error {
+	return f(config)
+}
+
+var _ = Describe("Fake", func() {
+	const (
+		purpose   = "infra"
+		namespace = "shoot--foo--bar"
+		name      = "bar"
+		image     = "terraformer:latest"
+	)
+
+	var (
+		factory *Factory
+		files   chart.TerraformFiles
+	)
+
+	BeforeEach(func() {
+		factory = NewFactory()
+		files = chart.TerraformFiles{
+			Main:      "main",
+			Variables: "variables",
+			TFVars:    []byte("tfvars"),
+		}
+	})
+
+	Describe("Factory", func() {
+		It("should return the same Terraformer for the same purpose, namespace and name", func() {
+			scripted := factory.Terraformer(purpose, namespace, name)
+
+			tf, err := factory.NewForConfig(nil, nil, purpose, namespace, name, image)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tf).To(BeIdenticalTo(scripted))
+			Expect(scripted.Image).To(Equal(image))
+
+			Expect(factory.New(nil, nil, nil, "other", namespace, name, image)).NotTo(BeIdenticalTo(scripted))
+		})
+	})
+
+	Describe("Terraformer", func() {
+		var tf *Terraformer
+
+		BeforeEach(func() {
+			tf = factory.Terraformer(purpose, namespace, name)
+		})
+
+		It("should record the settings", func() {
+			env := map[string]string{"TF_VAR_foo": "bar"}
+
+			tf.SetVariablesEnvironment(env).
+				SetJobBackoffLimit(1).
+				SetActiveDeadlineSeconds(2).
+				SetDeadlineCleaning(3 * time.Minute).
+				SetDeadlinePod(4 * time.Minute).
+				SetDeadlineJob(5 * time.Minute)
+
+			Expect(tf.VariablesEnvironment).To(Equal(env))
+			Expect(tf.JobBackoffLimit).To(Equal(int32(1)))
+			Expect(tf.ActiveDeadlineSeconds).To(Equal(int64(2)))
+			Expect(tf.DeadlineCleaning).To(Equal(3 * time.Minute))
+			Expect(tf.DeadlinePod).To(Equal(4 * time.Minute))
+			Expect(tf.DeadlineJob).To(Equal(5 * time.Minute))
+		})
+
+		It("should record the applied configuration", func() {
+			env := map[string]string{"TF_VAR_foo": "bar"}
+
+			Expect(tf.SetVariablesEnvironment(env).
+				InitializeWith(factory.DefaultInitializer(nil, files.Main, files.Variables, files.TFVars)).
+				Apply()).To(Succeed())
+
+			Expect(tf.InitializeErr).NotTo(HaveOccurred())
+			Expect(tf.Configuration).To(Equal(&files))
+			Expect(tf.Applications).To(Equal([]Application{{Files: files, VariablesEnvironment: env}}))
+
+			exists, err := tf.ConfigExists()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeTrue())
+		})
+
+		It("should reject empty Terraform files", func() {
+			Expect(tf.InitializeWith(factory.DefaultInitializer(nil, files.Main, "", files.TFVars)).Apply()).
+				To(MatchError(ContainSubstring("configuration has not been defined")))
+
+			Expect(tf.InitializeErr).To(MatchError(fmt.Sprintf("empty %s file", chart.TerraformVariablesTFFilename)))
+			Expect(tf.Configuration).To(BeNil())
+			Expect(tf.Applications).To(BeEmpty())
+		})
+
+		It("should call foreign initializers with the Terraformer's configuration", func() {
+			var config *gardenerterraformer.InitializerConfig
+			tf.InitializeWith(initializerFunc(func(c *gardenerterraformer.InitializerConfig) error {
+				config = c
+				return nil
+			}))
+
+			Expect(config).To(Equal(&gardenerterraformer.InitializerConfig{
+				Namespace:         namespace,
+				ConfigurationName: "bar.infra.tf-config",
+				VariablesName:     "bar.infra.tf-vars",
+				StateName:         "bar.infra.tf-state",
+				InitializeState:   true,
+			}))
+		})
+
+		It("should return the scripted errors", func() {
+			applyErr := fmt.Errorf("apply failed")
+			tf.ApplyErr = applyErr
+			tf.DestroyErr = fmt.Errorf("destroy failed")
+
+			Expect(tf.InitializeWith(factory.DefaultInitializer(nil, files.Main, files.Variables, files.TFVars)).Apply()).To(BeIdenticalTo(applyErr))
+			Expect(tf.Destroy()).To(MatchError("destroy failed"))
+			Expect(tf.Applications).To(BeEmpty())
+			Expect(tf.Destroyed).To(BeFalse())
+		})
+
+		It("should add the scripted output variables after applying", func() {
+			tf.StateOutputVariablesAfterApply = map[string]string{"vpc_id": "vpc-1234"}
+
+			_, err := tf.GetStateOutputVariables("vpc_id")
+			Expect(gardenerterraformer.IsVariablesNotFoundError(err)).To(BeTrue())
+
+			Expect(tf.InitializeWith(factory.DefaultInitializer(nil, files.Main, files.Variables, files.TFVars)).Apply()).To(Succeed())
+			Expect(tf.GetStateOutputVariables("vpc_id")).To(Equal(map[string]string{"vpc_id": "vpc-1234"}))
+		})
+
+		It("should store the output variables in the state after applying", func() {
+			tf.StateOutputVariablesAfterApply = map[string]string{"vpc_id": "vpc-1234"}
+
+			Expect(tf.InitializeWith(factory.DefaultInitializer(nil, files.Main, files.Variables, files.TFVars)).Apply()).To(Succeed())
+
+			state, err := tf.GetState()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state).To(MatchJSON(`{"version":4,"serial":1,"lineage":"shoot--foo--bar/bar/infra","outputs":{"vpc_id":{"type":"string","value":"vpc-1234"}}}`))
+		})
+
+		It("should store the scripted state after applying", func() {
+			tf.StateAfterApply = []byte(`{"version":3}`)
+
+			Expect(tf.InitializeWith(factory.DefaultInitializer(nil, files.Main, files.Variables, files.TFVars)).Apply()).To(Succeed())
+			Expect(tf.GetState()).To(Equal([]byte(`{"version":3}`)))
+		})
+
+		It("should return the scripted output variables", func() {
+			tf.StateOutputVariables["vpc_id"] = "vpc-1234"
+
+			Expect(tf.GetStateOutputVariables("vpc_id")).To(Equal(map[string]string{"vpc_id": "vpc-1234"}))
+
+			_, err := tf.GetStateOutputVariables("vpc_id", "subnet_id")
+			Expect(gardenerterraformer.IsVariablesNotFoundError(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("subnet_id")))
+		})
+
+		It("should clean up the configuration when destroying", func() {
+			tf.State = []byte(`{"version":3}`)
+			tf.StateOutputVariables["vpc_id"] = "vpc-1234"
+			tf.InitializeWith(factory.DefaultInitializer(nil, files.Main, files.Variables, files.TFVars))
+
+			Expect(tf.Destroy()).To(Succeed())
+			Expect(tf.Destroyed).To(BeTrue())
+			Expect(tf.CleanedUp).To(BeTrue())
+			Expect(tf.GetState()).To(BeEmpty())
+
+			exists, err := tf.ConfigExists()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeFalse())
+		})
+
+		It("should return an empty plan unless scripted", func() {
+			plan, err := tf.Plan(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.HasChanges()).To(BeFalse())
+		})
+	})
+})
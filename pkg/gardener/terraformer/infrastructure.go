@@ -0,0 +1,102 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// AnnotationKeyPlan is the annotation that makes the infrastructure actuators only plan the changes of an
+	// Infrastructure instead of applying them. The last operation of the Infrastructure is not updated while planning.
+	AnnotationKeyPlan = "infrastructure.extensions.gardener.cloud/terraform-plan"
+
+	// ConditionTypeTerraformPlan is the type of the Infrastructure condition holding the summary of the last
+	// Terraform plan.
+	ConditionTypeTerraformPlan gardencorev1alpha1.ConditionType = "TerraformPlan"
+	// ConditionReasonChangesPlanned is the reason of the plan condition if Terraform plans to change resources.
+	ConditionReasonChangesPlanned = "ChangesPlanned"
+	// ConditionReasonNoChanges is the reason of the plan condition if Terraform does not plan any changes.
+	ConditionReasonNoChanges = "NoChanges"
+
+	// maxPlannedResourceChanges is the maximum number of resource changes listed in the plan condition.
+	maxPlannedResourceChanges = 50
+)
+
+// IsPlanRequested returns true if the given Infrastructure is annotated to only plan its changes.
+func IsPlanRequested(infra *extensionsv1alpha1.Infrastructure) bool {
+	return infra.Annotations[AnnotationKeyPlan] == "true"
+}
+
+// PlanInfrastructure plans the changes of the given Infrastructure with the given Planner and stores the summary
+// of the plan in the TerraformPlan condition of the Infrastructure.
+func PlanInfrastructure(ctx context.Context, c client.Client, planner Planner, infra *extensionsv1alpha1.Infrastructure) error {
+	plan, err := planner.Plan(ctx)
+	if err != nil {
+		return err
+	}
+
+	var (
+		condition = gardencorev1alpha1helper.GetOrInitCondition(infra.Status.Conditions, ConditionTypeTerraformPlan)
+		status    = gardencorev1alpha1.ConditionFalse
+		reason    = ConditionReasonNoChanges
+	)
+
+	if plan.HasChanges() {
+		status = gardencorev1alpha1.ConditionTrue
+		reason = ConditionReasonChangesPlanned
+	}
+	condition = gardencorev1alpha1helper.UpdatedCondition(condition, status, reason, planMessage(plan))
+
+	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, c, infra, func() error {
+		infra.Status.Conditions = gardencorev1alpha1helper.MergeConditions(infra.Status.Conditions, condition)
+		return nil
+	})
+}
+
+func planMessage(plan *Plan) string {
+	if len(plan.ResourceChanges) <= maxPlannedResourceChanges {
+		return plan.String()
+	}
+
+	truncated := *plan
+	truncated.ResourceChanges = plan.ResourceChanges[:maxPlannedResourceChanges]
+	return strings.Join([]string{
+		truncated.String(),
+		fmt.Sprintf("... and %d more", len(plan.ResourceChanges)-maxPlannedResourceChanges),
+	}, "\n")
+}
+
+// RemovePlanCondition removes the TerraformPlan condition from the given conditions. It is meant to be called
+// after the Terraform configuration has been applied.
+func RemovePlanCondition(conditions []gardencorev1alpha1.Condition) []gardencorev1alpha1.Condition {
+	var out []gardencorev1alpha1.Condition
+	for _, condition := range conditions {
+		if condition.Type != ConditionTypeTerraformPlan {
+			out = append(out, condition)
+		}
+	}
+	return out
+}
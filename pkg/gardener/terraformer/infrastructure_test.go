@@ -0,0 +1,131 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformer_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+type fakePlanner struct {
+	plan *Plan
+	err  error
+}
+
+func (p *fakePlanner) Plan(_ context.Context) (*Plan, error) {
+	return p.plan, p.err
+}
+
+type fakeStateGetter struct {
+	state []byte
+}
+
+func (g *fakeStateGetter) GetState() ([]byte, error) {
+	return g.state, nil
+}
+
+var _ = Describe("Infrastructure", func() {
+	var (
+		ctx = context.TODO()
+
+		c     client.Client
+		infra *extensionsv1alpha1.Infrastructure
+	)
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "shoot--foo--bar",
+				Name:        "bar",
+				Annotations: map[string]string{AnnotationKeyPlan: "true"},
+			},
+		}
+		c = fakeclient.NewFakeClientWithScheme(scheme, infra.DeepCopy())
+	})
+
+	Describe("#IsPlanRequested", func() {
+		It("should return true if the infrastructure is annotated", func() {
+			Expect(IsPlanRequested(infra)).To(BeTrue())
+		})
+
+		It("should return false if the infrastructure is not annotated", func() {
+			Expect(IsPlanRequested(&extensionsv1alpha1.Infrastructure{})).To(BeFalse())
+		})
+	})
+
+	Describe("#PlanInfrastructure", func() {
+		It("should store the planned changes in the status", func() {
+			planner := &fakePlanner{plan: &Plan{
+				Add:             1,
+				ResourceChanges: []ResourceChange{{Address: "aws_vpc.vpc", Action: PlanActionCreate}},
+			}}
+
+			Expect(PlanInfrastructure(ctx, c, planner, infra)).To(Succeed())
+
+			actual := &extensionsv1alpha1.Infrastructure{}
+			Expect(c.Get(ctx, kutil.Key(infra.Namespace, infra.Name), actual)).To(Succeed())
+			condition := gardencorev1alpha1helper.GetCondition(actual.Status.Conditions, ConditionTypeTerraformPlan)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(ConditionReasonChangesPlanned))
+			Expect(condition.Message).To(Equal("Plan: 1 to add, 0 to change, 0 to destroy.\n+ aws_vpc.vpc"))
+		})
+
+		It("should store that there are no changes in the status", func() {
+			Expect(PlanInfrastructure(ctx, c, &fakePlanner{plan: &Plan{}}, infra)).To(Succeed())
+
+			actual := &extensionsv1alpha1.Infrastructure{}
+			Expect(c.Get(ctx, kutil.Key(infra.Namespace, infra.Name), actual)).To(Succeed())
+			condition := gardencorev1alpha1helper.GetCondition(actual.Status.Conditions, ConditionTypeTerraformPlan)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(ConditionReasonNoChanges))
+		})
+
+		It("should return the error of the planner", func() {
+			err := errors.New("foo")
+			Expect(PlanInfrastructure(ctx, c, &fakePlanner{err: err}, infra)).To(Equal(err))
+		})
+	})
+
+	Describe("#StoreInfrastructureState", func() {
+		It("should remove the plan condition after the configuration has been applied", func() {
+			Expect(PlanInfrastructure(ctx, c, &fakePlanner{plan: &Plan{}}, infra)).To(Succeed())
+			Expect(c.Get(ctx, kutil.Key(infra.Namespace, infra.Name), infra)).To(Succeed())
+
+			Expect(StoreInfrastructureState(ctx, c, &fakeStateGetter{}, infra)).To(Succeed())
+
+			actual := &extensionsv1alpha1.Infrastructure{}
+			Expect(c.Get(ctx, kutil.Key(infra.Namespace, infra.Name), actual)).To(Succeed())
+			Expect(gardencorev1alpha1helper.GetCondition(actual.Status.Conditions, ConditionTypeTerraformPlan)).To(BeNil())
+		})
+	})
+})
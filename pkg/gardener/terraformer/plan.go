@@ -0,0 +1,153 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformer
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// PlanAction is the action Terraform plans for a resource.
+type PlanAction string
+
+const (
+	// PlanActionCreate indicates that a resource will be created.
+	PlanActionCreate PlanAction = "create"
+	// PlanActionUpdate indicates that a resource will be updated in-place.
+	PlanActionUpdate PlanAction = "update"
+	// PlanActionReplace indicates that a resource will be destroyed and re-created.
+	PlanActionReplace PlanAction = "replace"
+	// PlanActionDelete indicates that a resource will be destroyed.
+	PlanActionDelete PlanAction = "delete"
+)
+
+// ResourceChange is a change Terraform plans for a single resource.
+type ResourceChange struct {
+	// Address is the address of the resource, e.g. `aws_vpc.vpc`.
+	Address string
+	// Action is the action Terraform plans for the resource.
+	Action PlanAction
+}
+
+// Plan is the summary of a Terraform plan.
+type Plan struct {
+	// Add is the number of resources that will be created.
+	Add int
+	// Change is the number of resources that will be updated in-place.
+	Change int
+	// Destroy is the number of resources that will be destroyed.
+	Destroy int
+	// ResourceChanges are the changes planned for the single resources.
+	ResourceChanges []ResourceChange
+}
+
+// HasChanges returns true if Terraform plans to change any resources.
+func (p *Plan) HasChanges() bool {
+	return p.Add > 0 || p.Change > 0 || p.Destroy > 0 || len(p.ResourceChanges) > 0
+}
+
+var planActionSymbols = map[PlanAction]string{
+	PlanActionCreate:  "+",
+	PlanActionUpdate:  "~",
+	PlanActionReplace: "-/+",
+	PlanActionDelete:  "-",
+}
+
+// String returns the summary of the plan in the same format Terraform uses, followed by the planned resource changes.
+func (p *Plan) String() string {
+	if !p.HasChanges() {
+		return "No changes."
+	}
+
+	lines := []string{fmt.Sprintf("Plan: %d to add, %d to change, %d to destroy.", p.Add, p.Change, p.Destroy)}
+	for _, change := range p.ResourceChanges {
+		lines = append(lines, fmt.Sprintf("%s %s", planActionSymbols[change.Action], change.Address))
+	}
+	return strings.Join(lines, "\n")
+}
+
+var (
+	ansiEscapeRegexp  = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+	planSummaryRegexp = regexp.MustCompile(`Plan: (\d+) to add, (\d+) to change, (\d+) to destroy\.`)
+
+	// resourceChangeRegexp matches the resource change headers of Terraform >= 0.12, e.g. `# aws_vpc.vpc will be created`.
+	resourceChangeRegexp = regexp.MustCompile(`^\s*# (\S+) (will be created|will be updated in-place|must be replaced|will be destroyed)\s*$`)
+	// legacyResourceChangeRegexp matches the resource change headers of Terraform < 0.12, e.g. `+ aws_vpc.vpc`.
+	legacyResourceChangeRegexp = regexp.MustCompile(`^\s*(-/\+|\+/-|\+|~|-) ([\w-]+(?:\.[\w\-\[\]]+)+)(?: \(new resource required\))?\s*$`)
+
+	resourceChangeActions = map[string]PlanAction{
+		"will be created":          PlanActionCreate,
+		"will be updated in-place": PlanActionUpdate,
+		"must be replaced":         PlanActionReplace,
+		"will be destroyed":        PlanActionDelete,
+		"+":                        PlanActionCreate,
+		"~":                        PlanActionUpdate,
+		"-/+":                      PlanActionReplace,
+		"+/-":                      PlanActionReplace,
+		"-":                        PlanActionDelete,
+	}
+)
+
+// ParsePlan parses the human readable output of `terraform plan` into a Plan. It supports the output formats of
+// Terraform before and since version 0.12.
+func ParsePlan(output string) (*Plan, error) {
+	var (
+		plan          = &Plan{}
+		legacyChanges []ResourceChange
+	)
+
+	output = ansiEscapeRegexp.ReplaceAllString(output, "")
+	for _, line := range strings.Split(output, "\n") {
+		if match := resourceChangeRegexp.FindStringSubmatch(line); match != nil {
+			plan.ResourceChanges = append(plan.ResourceChanges, ResourceChange{Address: match[1], Action: resourceChangeActions[match[2]]})
+			continue
+		}
+		if match := legacyResourceChangeRegexp.FindStringSubmatch(line); match != nil {
+			legacyChanges = append(legacyChanges, ResourceChange{Address: match[2], Action: resourceChangeActions[match[1]]})
+		}
+	}
+	if len(plan.ResourceChanges) == 0 {
+		plan.ResourceChanges = legacyChanges
+	}
+
+	match := planSummaryRegexp.FindStringSubmatch(output)
+	if match == nil {
+		for _, change := range plan.ResourceChanges {
+			switch change.Action {
+			case PlanActionCreate:
+				plan.Add++
+			case PlanActionUpdate:
+				plan.Change++
+			case PlanActionReplace:
+				plan.Add++
+				plan.Destroy++
+			case PlanActionDelete:
+				plan.Destroy++
+			}
+		}
+		return plan, nil
+	}
+
+	for i, count := range []*int{&plan.Add, &plan.Change, &plan.Destroy} {
+		value, err := strconv.Atoi(match[i+1])
+		if err != nil {
+			return nil, fmt.Errorf("could not parse Terraform plan summary %q: %+v", match[0], err)
+		}
+		*count = value
+	}
+	return plan, nil
+}
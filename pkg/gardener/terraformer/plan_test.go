@@ -0,0 +1,126 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformer_test
+
+import (
+	. "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Plan", func() {
+	Describe("#ParsePlan", func() {
+		It("should parse the plan output of Terraform >= 0.12", func() {
+			output := "\x1b[1mAn execution plan has been generated and is shown below.\x1b[0m\n" + `Resource actions are indicated with the following symbols:
+  + create
+  ~ update in-place
+-/+ destroy and then create replacement
+
+Terraform will perform the following actions:
+
+  # aws_subnet.nodes_z0 will be updated in-place
+  ~ resource "aws_subnet" "nodes_z0" {
+      ~ tags = {
+          + "foo" = "bar"
+        }
+    }
+
+  # aws_vpc.vpc must be replaced
+-/+ resource "aws_vpc" "vpc" {
+      ~ cidr_block = "10.250.0.0/16" -> "10.251.0.0/16" # forces replacement
+    }
+
+  # aws_eip.eip_natgw_z1 will be created
+  + resource "aws_eip" "eip_natgw_z1" {
+      + vpc = true
+      + tags = [
+          + "10.0.0.0",
+        ]
+    }
+
+  # aws_eip.eip_natgw_z2 will be destroyed
+  - resource "aws_eip" "eip_natgw_z2" {
+      - vpc = true
+    }
+
+Plan: 2 to add, 1 to change, 2 to destroy.
+`
+			plan, err := ParsePlan(output)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan).To(Equal(&Plan{
+				Add:     2,
+				Change:  1,
+				Destroy: 2,
+				ResourceChanges: []ResourceChange{
+					{Address: "aws_subnet.nodes_z0", Action: PlanActionUpdate},
+					{Address: "aws_vpc.vpc", Action: PlanActionReplace},
+					{Address: "aws_eip.eip_natgw_z1", Action: PlanActionCreate},
+					{Address: "aws_eip.eip_natgw_z2", Action: PlanActionDelete},
+				},
+			}))
+		})
+
+		It("should parse the plan output of Terraform < 0.12", func() {
+			output := `Terraform will perform the following actions:
+
+  ~ aws_subnet.nodes_z0
+      tags.%:     "1" => "2"
+
+-/+ aws_vpc.vpc (new resource required)
+      cidr_block: "10.250.0.0/16" => "10.251.0.0/16" (forces new resource)
+
+  + aws_eip.eip_natgw_z1
+      id:         <computed>
+
+  - aws_eip.eip_natgw_z2
+`
+			plan, err := ParsePlan(output)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan).To(Equal(&Plan{
+				Add:     2,
+				Change:  1,
+				Destroy: 2,
+				ResourceChanges: []ResourceChange{
+					{Address: "aws_subnet.nodes_z0", Action: PlanActionUpdate},
+					{Address: "aws_vpc.vpc", Action: PlanActionReplace},
+					{Address: "aws_eip.eip_natgw_z1", Action: PlanActionCreate},
+					{Address: "aws_eip.eip_natgw_z2", Action: PlanActionDelete},
+				},
+			}))
+		})
+
+		It("should parse plans without changes", func() {
+			plan, err := ParsePlan("No changes. Infrastructure is up-to-date.")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.HasChanges()).To(BeFalse())
+			Expect(plan.String()).To(Equal("No changes."))
+		})
+	})
+
+	Describe("#String", func() {
+		It("should summarize the plan", func() {
+			plan := &Plan{
+				Add:     1,
+				Destroy: 1,
+				ResourceChanges: []ResourceChange{
+					{Address: "aws_vpc.vpc", Action: PlanActionReplace},
+				},
+			}
+
+			Expect(plan.String()).To(Equal("Plan: 1 to add, 0 to change, 1 to destroy.\n-/+ aws_vpc.vpc"))
+		})
+	})
+})
@@ -0,0 +1,356 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformer
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener-extensions/pkg/metrics"
+
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/retry"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// terraformerName is the name of the service account the Terraformer pods run with.
+	terraformerName = "terraformer"
+	// terraformerRBACName is the name of the role and role binding of the Terraformer service account.
+	terraformerRBACName = "gardener.cloud:system:terraformer"
+	// planPodSuffix is the suffix of the name of the pods executing `terraform plan`.
+	planPodSuffix = ".tf-plan"
+)
+
+// terraformErrorRegexp matches the errors Terraform reports in its output.
+var terraformErrorRegexp = regexp.MustCompile(`(?:Error *[^:]*|Errors): *([\s\S]*)`)
+
+// Planner can plan the changes of a Terraform configuration.
+type Planner interface {
+	// Plan executes `terraform plan` for the Terraform configuration, variables and state and returns a summary of
+	// the planned changes. Nothing is changed on the infrastructure.
+	Plan(ctx context.Context) (*Plan, error)
+}
+
+// planner executes `terraform plan` in a pod using the Terraformer image. It uses the same configuration,
+// variables and state resources as the gardener Terraformer with the same name and purpose.
+type planner struct {
+	logger       logrus.FieldLogger
+	client       client.Client
+	coreV1Client corev1client.CoreV1Interface
+
+	purpose   string
+	namespace string
+	image     string
+
+	configName           string
+	variablesName        string
+	stateName            string
+	podName              string
+	variablesEnvironment map[string]string
+
+	activeDeadlineSeconds int64
+	deadlinePod           time.Duration
+}
+
+// NewPlanner creates a new Planner for the Terraform configuration with the given <purpose> and <name> in the
+// given <namespace>.
+func NewPlanner(logger logrus.FieldLogger, client client.Client, coreV1Client corev1client.CoreV1Interface, purpose, namespace, name, image string, variablesEnvironment map[string]string) Planner {
+	return newPlanner(logger, client, coreV1Client, purpose, namespace, name, image).setVariablesEnvironment(variablesEnvironment)
+}
+
+// NewPlannerForConfig creates a new Planner and its dependencies from the given configuration.
+func NewPlannerForConfig(logger logrus.FieldLogger, config *rest.Config, purpose, namespace, name, image string, variablesEnvironment map[string]string) (Planner, error) {
+	c, err := client.New(config, client.Options{})
+	if err != nil {
+		return nil, err
+	}
+
+	coreV1Client, err := corev1client.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPlanner(logger, c, coreV1Client, purpose, namespace, name, image, variablesEnvironment), nil
+}
+
+func newPlanner(logger logrus.FieldLogger, client client.Client, coreV1Client corev1client.CoreV1Interface, purpose, namespace, name, image string) *planner {
+	prefix := fmt.Sprintf("%s.%s", name, purpose)
+
+	return &planner{
+		logger:       logger,
+		client:       client,
+		coreV1Client: coreV1Client,
+
+		purpose:   purpose,
+		namespace: namespace,
+		image:     image,
+
+		configName:    prefix + common.TerraformerConfigSuffix,
+		variablesName: prefix + common.TerraformerVariablesSuffix,
+		stateName:     prefix + common.TerraformerStateSuffix,
+		podName:       fmt.Sprintf("%s-%s", prefix+planPodSuffix, utils.ComputeSHA256Hex([]byte(time.Now().String()))[:5]),
+
+		activeDeadlineSeconds: int64(3600),
+		deadlinePod:           10 * time.Minute,
+	}
+}
+
+func (p *planner) setVariablesEnvironment(variablesEnvironment map[string]string) *planner {
+	p.variablesEnvironment = variablesEnvironment
+	return p
+}
+
+// Plan implements Planner.
+func (p *planner) Plan(ctx context.Context) (*Plan, error) {
+	observe := metrics.StartTerraformerJob(p.purpose, metrics.TerraformerCommandPlan)
+	plan, err := p.plan(ctx)
+	return plan, observe(err)
+}
+
+func (p *planner) plan(ctx context.Context) (*Plan, error) {
+	if err := p.verifyConfigExists(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := p.createOrUpdateTerraformerAuth(ctx); err != nil {
+		return nil, err
+	}
+
+	pod := p.pod()
+	if err := p.client.Create(ctx, pod); err != nil {
+		return nil, fmt.Errorf("could not create Terraform plan pod '%s': %+v", p.podName, err)
+	}
+	defer func() {
+		if err := p.client.Delete(ctx, pod); err != nil && !apierrors.IsNotFound(err) {
+			p.logger.Errorf("Could not delete Terraform plan pod '%s': %s", p.podName, err.Error())
+		}
+	}()
+
+	exitCode, err := p.waitForPod(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, err := kubernetes.GetPodLogs(p.coreV1Client.Pods(p.namespace), p.podName, &corev1.PodLogOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve the logs of Terraform plan pod '%s': %+v", p.podName, err)
+	}
+	p.logger.Infof("Logs of Terraform plan pod '%s':\n%s", p.podName, logs)
+
+	// `terraform plan -detailed-exitcode` exits with 0 if there are no changes and with 2 if there are changes.
+	switch exitCode {
+	case 0:
+		return &Plan{}, nil
+	case 2:
+		return ParsePlan(string(logs))
+	default:
+		errorMessage := fmt.Sprintf("Terraform plan pod '%s' failed with exit code %d.", p.podName, exitCode)
+		if match := terraformErrorRegexp.FindStringSubmatch(string(logs)); match != nil {
+			errorMessage += fmt.Sprintf(" The following issues have been found in the logs:\n\n%s", strings.TrimSpace(match[1]))
+		}
+		return nil, gardencorev1alpha1helper.DetermineError(errorMessage)
+	}
+}
+
+func (p *planner) verifyConfigExists(ctx context.Context) error {
+	for name, obj := range map[string]runtime.Object{
+		p.configName:    &corev1.ConfigMap{},
+		p.variablesName: &corev1.Secret{},
+		p.stateName:     &corev1.ConfigMap{},
+	} {
+		if err := p.client.Get(ctx, kutil.Key(p.namespace, name), obj); err != nil {
+			if apierrors.IsNotFound(err) {
+				return fmt.Errorf("Terraformer configuration has not been defined, cannot plan the Terraform scripts: %+v", err)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *planner) createOrUpdateTerraformerAuth(ctx context.Context) error {
+	serviceAccount := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Namespace: p.namespace, Name: terraformerName}}
+	if err := kutil.CreateOrUpdate(ctx, p.client, serviceAccount, func() error { return nil }); err != nil {
+		return err
+	}
+
+	role := &rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Namespace: p.namespace, Name: terraformerRBACName}}
+	if err := kutil.CreateOrUpdate(ctx, p.client, role, func() error {
+		role.Rules = []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{""},
+				Resources: []string{"configmaps"},
+				Verbs:     []string{"*"},
+			},
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	roleBinding := &rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Namespace: p.namespace, Name: terraformerRBACName}}
+	return kutil.CreateOrUpdate(ctx, p.client, roleBinding, func() error {
+		roleBinding.RoleRef = rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "Role",
+			Name:     terraformerRBACName,
+		}
+		roleBinding.Subjects = []rbacv1.Subject{
+			{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      terraformerName,
+				Namespace: p.namespace,
+			},
+		}
+		return nil
+	})
+}
+
+// waitForPod waits until the plan pod has terminated and returns the exit code of its container.
+func (p *planner) waitForPod(ctx context.Context) (int32, error) {
+	var exitCode int32
+
+	if err := retry.UntilTimeout(ctx, 5*time.Second, p.deadlinePod, func(ctx context.Context) (done bool, err error) {
+		p.logger.Infof("Waiting for Terraform plan pod '%s' to be completed...", p.podName)
+		pod := &corev1.Pod{}
+		if err := p.client.Get(ctx, kutil.Key(p.namespace, p.podName), pod); err != nil {
+			return retry.SevereError(err)
+		}
+
+		if pod.Status.Phase != corev1.PodSucceeded && pod.Status.Phase != corev1.PodFailed {
+			return retry.MinorError(fmt.Errorf("pod is not completed (phase=%s)", pod.Status.Phase))
+		}
+		if len(pod.Status.ContainerStatuses) == 0 || pod.Status.ContainerStatuses[0].State.Terminated == nil {
+			return retry.SevereError(fmt.Errorf("could not determine the exit code of the Terraform plan pod '%s'", p.podName))
+		}
+
+		exitCode = pod.Status.ContainerStatuses[0].State.Terminated.ExitCode
+		return retry.Ok()
+	}); err != nil {
+		return 0, err
+	}
+
+	return exitCode, nil
+}
+
+func (p *planner) pod() *corev1.Pod {
+	const (
+		tfVolume      = "tf"
+		tfVarsVolume  = "tfvars"
+		tfStateVolume = "tfstate"
+
+		tfStateVolumeMountPath = "tf-state-in"
+	)
+
+	var (
+		activeDeadlineSeconds         = p.activeDeadlineSeconds
+		terminationGracePeriodSeconds = p.activeDeadlineSeconds
+		env                           = []corev1.EnvVar{
+			{Name: "MAX_BACKOFF_SEC", Value: "60"},
+			{Name: "MAX_TIME_SEC", Value: "1800"},
+			{Name: "TF_STATE_CONFIG_MAP_NAME", Value: p.stateName},
+		}
+	)
+
+	for k, v := range p.variablesEnvironment {
+		env = append(env, corev1.EnvVar{Name: k, Value: v})
+	}
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: p.namespace,
+			Name:      p.podName,
+			Labels: map[string]string{
+				v1alpha1constants.LabelNetworkPolicyToDNS:             v1alpha1constants.LabelNetworkPolicyAllowed,
+				v1alpha1constants.LabelNetworkPolicyToPrivateNetworks: v1alpha1constants.LabelNetworkPolicyAllowed,
+				v1alpha1constants.LabelNetworkPolicyToPublicNetworks:  v1alpha1constants.LabelNetworkPolicyAllowed,
+				v1alpha1constants.LabelNetworkPolicyToSeedAPIServer:   v1alpha1constants.LabelNetworkPolicyAllowed,
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy:         corev1.RestartPolicyNever,
+			ActiveDeadlineSeconds: &activeDeadlineSeconds,
+			Containers: []corev1.Container{
+				{
+					Name:            "terraform",
+					Image:           p.image,
+					ImagePullPolicy: corev1.PullIfNotPresent,
+					// The `validate` command of the Terraformer runs `terraform plan -detailed-exitcode`.
+					Command: []string{"sh", "-c", "sh /terraform.sh validate 2>&1"},
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("50m"),
+							corev1.ResourceMemory: resource.MustParse("200Mi"),
+						},
+						Limits: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("200m"),
+							corev1.ResourceMemory: resource.MustParse("512Mi"),
+						},
+					},
+					Env: env,
+					VolumeMounts: []corev1.VolumeMount{
+						{Name: tfVolume, MountPath: "/" + tfVolume},
+						{Name: tfVarsVolume, MountPath: "/" + tfVarsVolume},
+						{Name: tfStateVolume, MountPath: "/" + tfStateVolumeMountPath},
+					},
+				},
+			},
+			ServiceAccountName:            terraformerName,
+			TerminationGracePeriodSeconds: &terminationGracePeriodSeconds,
+			Volumes: []corev1.Volume{
+				{
+					Name: tfVolume,
+					VolumeSource: corev1.VolumeSource{
+						ConfigMap: &corev1.ConfigMapVolumeSource{
+							LocalObjectReference: corev1.LocalObjectReference{Name: p.configName},
+						},
+					},
+				},
+				{
+					Name: tfVarsVolume,
+					VolumeSource: corev1.VolumeSource{
+						Secret: &corev1.SecretVolumeSource{SecretName: p.variablesName},
+					},
+				},
+				{
+					Name: tfStateVolume,
+					VolumeSource: corev1.VolumeSource{
+						ConfigMap: &corev1.ConfigMapVolumeSource{
+							LocalObjectReference: corev1.LocalObjectReference{Name: p.stateName},
+						},
+					},
+				},
+			},
+		},
+	}
+}
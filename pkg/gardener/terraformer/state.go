@@ -0,0 +1,169 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformer
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/operation/common"
+	gardenerterraformer "github.com/gardener/gardener/pkg/operation/terraformer"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// StateGetter is implemented by Terraformers whose state can be read.
+type StateGetter interface {
+	GetState() ([]byte, error)
+}
+
+// StateMigrator is implemented by Terraformers whose state can be exported and cleaned up.
+type StateMigrator interface {
+	StateGetter
+	CleanupConfiguration(ctx context.Context) error
+}
+
+// StateConfigMapName returns the name of the ConfigMap in which the Terraformer with the given <name> and
+// <purpose> stores its state.
+func StateConfigMapName(name, purpose string) string {
+	return fmt.Sprintf("%s.%s%s", name, purpose, common.TerraformerStateSuffix)
+}
+
+// CompressState compresses the given Terraform state with gzip and encodes the result with base64.
+func CompressState(state []byte) (string, error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(state); err != nil {
+		return "", err
+	}
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// DecompressState decodes and decompresses the given Terraform state that has been compressed with CompressState.
+// States that have not been compressed are returned as they are.
+func DecompressState(state string) ([]byte, error) {
+	data, err := base64.StdEncoding.DecodeString(state)
+	if err != nil {
+		return []byte(state), nil
+	}
+
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return []byte(state), nil
+	}
+	defer r.Close()
+
+	return ioutil.ReadAll(r)
+}
+
+// ExportState reads the state of the given Terraformer and returns it in compressed form. It returns an empty
+// string if the state does not exist.
+func ExportState(tf StateGetter) (string, error) {
+	state, err := tf.GetState()
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	if len(state) == 0 {
+		return "", nil
+	}
+
+	return CompressState(state)
+}
+
+// RestoreState creates the state ConfigMap of the Terraformer with the given <name> and <purpose> from the given
+// compressed <state> in case it does not exist or is empty. It is a no-op if the given <state> is empty.
+func RestoreState(ctx context.Context, c client.Client, namespace, name, purpose, state string) error {
+	if len(state) == 0 {
+		return nil
+	}
+
+	stateName := StateConfigMapName(name, purpose)
+
+	configMap := &corev1.ConfigMap{}
+	if err := c.Get(ctx, kutil.Key(namespace, stateName), configMap); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+	} else if len(configMap.Data[gardenerterraformer.StateKey]) > 0 {
+		return nil
+	}
+
+	data, err := DecompressState(state)
+	if err != nil {
+		return fmt.Errorf("could not decompress Terraform state: %+v", err)
+	}
+
+	_, err = gardenerterraformer.CreateOrUpdateStateConfigMap(ctx, c, namespace, stateName, string(data))
+	return err
+}
+
+// StoreInfrastructureState exports the state of the given Terraformer into the status of the given Infrastructure.
+// The state in the status is left untouched if the state does not exist. As the Terraform configuration has been
+// applied, the summary of a previous plan is removed from the status.
+func StoreInfrastructureState(ctx context.Context, c client.Client, tf StateGetter, infra *extensionsv1alpha1.Infrastructure) error {
+	state, err := ExportState(tf)
+	if err != nil {
+		return fmt.Errorf("could not export Terraform state: %+v", err)
+	}
+
+	var (
+		stateChanged = len(state) > 0 && state != infra.Status.State
+		planExists   = gardencorev1alpha1helper.GetCondition(infra.Status.Conditions, ConditionTypeTerraformPlan) != nil
+	)
+	if !stateChanged && !planExists {
+		return nil
+	}
+
+	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, c, infra, func() error {
+		if stateChanged {
+			infra.Status.State = state
+		}
+		infra.Status.Conditions = RemovePlanCondition(infra.Status.Conditions)
+		return nil
+	})
+}
+
+// RestoreInfrastructureState re-creates the state ConfigMap of the Terraformer with the given <purpose> from the
+// status of the given Infrastructure in case it is missing, e.g. because the seed namespace has been recreated.
+func RestoreInfrastructureState(ctx context.Context, c client.Client, infra *extensionsv1alpha1.Infrastructure, purpose string) error {
+	return RestoreState(ctx, c, infra.Namespace, infra.Name, purpose, infra.Status.State)
+}
+
+// MigrateInfrastructureState exports the state of the given Terraformer into the status of the given Infrastructure
+// and cleans up the configuration, variables and state resources of the Terraformer afterwards.
+func MigrateInfrastructureState(ctx context.Context, c client.Client, tf StateMigrator, infra *extensionsv1alpha1.Infrastructure) error {
+	if err := StoreInfrastructureState(ctx, c, tf, infra); err != nil {
+		return err
+	}
+
+	return tf.CleanupConfiguration(ctx)
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformer_test
+
+import (
+	"context"
+
+	. "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
+
+	gardenerterraformer "github.com/gardener/gardener/pkg/operation/terraformer"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("State", func() {
+	const (
+		namespace = "shoot--foo--bar"
+		name      = "bar"
+		purpose   = "infra"
+		state     = `{"version":3,"modules":[]}`
+	)
+
+	var ctx = context.TODO()
+
+	Describe("#CompressState, #DecompressState", func() {
+		It("should compress and decompress the state", func() {
+			compressed, err := CompressState([]byte(state))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(compressed).NotTo(Equal(state))
+
+			decompressed, err := DecompressState(compressed)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(decompressed)).To(Equal(state))
+		})
+
+		It("should return uncompressed states as they are", func() {
+			decompressed, err := DecompressState(state)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(decompressed)).To(Equal(state))
+		})
+	})
+
+	Describe("#RestoreState", func() {
+		var compressed string
+
+		BeforeEach(func() {
+			var err error
+			compressed, err = CompressState([]byte(state))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should create the state config map if it is missing", func() {
+			c := fakeclient.NewFakeClientWithScheme(scheme.Scheme)
+
+			Expect(RestoreState(ctx, c, namespace, name, purpose, compressed)).To(Succeed())
+
+			configMap := &corev1.ConfigMap{}
+			Expect(c.Get(ctx, kutil.Key(namespace, StateConfigMapName(name, purpose)), configMap)).To(Succeed())
+			Expect(configMap.Data).To(HaveKeyWithValue(gardenerterraformer.StateKey, state))
+		})
+
+		It("should not overwrite an existing state", func() {
+			existing := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: StateConfigMapName(name, purpose)},
+				Data:       map[string]string{gardenerterraformer.StateKey: "current"},
+			}
+			c := fakeclient.NewFakeClientWithScheme(scheme.Scheme, existing)
+
+			Expect(RestoreState(ctx, c, namespace, name, purpose, compressed)).To(Succeed())
+
+			configMap := &corev1.ConfigMap{}
+			Expect(c.Get(ctx, kutil.Key(namespace, StateConfigMapName(name, purpose)), configMap)).To(Succeed())
+			Expect(configMap.Data).To(HaveKeyWithValue(gardenerterraformer.StateKey, "current"))
+		})
+
+		It("should do nothing if the state is empty", func() {
+			c := fakeclient.NewFakeClientWithScheme(scheme.Scheme)
+
+			Expect(RestoreState(ctx, c, namespace, name, purpose, "")).To(Succeed())
+
+			configMap := &corev1.ConfigMap{}
+			Expect(c.Get(ctx, kutil.Key(namespace, StateConfigMapName(name, purpose)), configMap)).NotTo(Succeed())
+		})
+	})
+})
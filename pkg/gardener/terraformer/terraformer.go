@@ -15,8 +15,11 @@
 package terraformer
 
 import (
+	"context"
 	"time"
 
+	"github.com/gardener/gardener-extensions/pkg/metrics"
+
 	gardenerterraformer "github.com/gardener/gardener/pkg/operation/terraformer"
 	"github.com/sirupsen/logrus"
 	v1 "k8s.io/client-go/kubernetes/typed/core/v1"
@@ -25,52 +28,62 @@ import (
 )
 
 type terraformer struct {
-	tf *gardenerterraformer.Terraformer
+	tf      *gardenerterraformer.Terraformer
+	planner *planner
 }
 
 // SetVariablesEnvironment implements Terraformer.
 func (t *terraformer) SetVariablesEnvironment(tfVarsEnvironment map[string]string) Interface {
-	return &terraformer{t.tf.SetVariablesEnvironment(tfVarsEnvironment)}
+	return &terraformer{t.tf.SetVariablesEnvironment(tfVarsEnvironment), t.planner.setVariablesEnvironment(tfVarsEnvironment)}
 }
 
 // SetJobBackoffLimit implements Terraformer.
 func (t *terraformer) SetJobBackoffLimit(val int32) Interface {
-	return &terraformer{t.tf.SetJobBackoffLimit(val)}
+	return &terraformer{t.tf.SetJobBackoffLimit(val), t.planner}
 }
 
 // SetActiveDeadlineSeconds implements Terraformer.
 func (t *terraformer) SetActiveDeadlineSeconds(val int64) Interface {
-	return &terraformer{t.tf.SetActiveDeadlineSeconds(val)}
+	t.planner.activeDeadlineSeconds = val
+	return &terraformer{t.tf.SetActiveDeadlineSeconds(val), t.planner}
 }
 
 // SetDeadlineCleaning implements Terraformer.
 func (t *terraformer) SetDeadlineCleaning(val time.Duration) Interface {
-	return &terraformer{t.tf.SetDeadlineCleaning(val)}
+	return &terraformer{t.tf.SetDeadlineCleaning(val), t.planner}
 }
 
 // SetDeadlinePod implements Terraformer.
 func (t *terraformer) SetDeadlinePod(val time.Duration) Interface {
-	return &terraformer{t.tf.SetDeadlinePod(val)}
+	t.planner.deadlinePod = val
+	return &terraformer{t.tf.SetDeadlinePod(val), t.planner}
 }
 
 // SetDeadlineJob implements Terraformer.
 func (t *terraformer) SetDeadlineJob(val time.Duration) Interface {
-	return &terraformer{t.tf.SetDeadlineJob(val)}
+	return &terraformer{t.tf.SetDeadlineJob(val), t.planner}
 }
 
 // InitializeWith implements Terraformer.
 func (t *terraformer) InitializeWith(initializer Initializer) Interface {
-	return &terraformer{t.tf.InitializeWith(initializer.Initialize)}
+	return &terraformer{t.tf.InitializeWith(initializer.Initialize), t.planner}
 }
 
 // Apply implements Terraformer.
 func (t *terraformer) Apply() error {
-	return t.tf.Apply()
+	observe := metrics.StartTerraformerJob(t.planner.purpose, metrics.TerraformerCommandApply)
+	return observe(t.tf.Apply())
+}
+
+// Plan implements Terraformer.
+func (t *terraformer) Plan(ctx context.Context) (*Plan, error) {
+	return t.planner.Plan(ctx)
 }
 
 // Destroy implements Terraformer.
 func (t *terraformer) Destroy() error {
-	return t.tf.Destroy()
+	observe := metrics.StartTerraformerJob(t.planner.purpose, metrics.TerraformerCommandDestroy)
+	return observe(t.tf.Destroy())
 }
 
 // GetStateOutputVariables implements Terraformer.
@@ -83,6 +96,16 @@ func (t *terraformer) ConfigExists() (bool, error) {
 	return t.tf.ConfigExists()
 }
 
+// GetState implements Terraformer.
+func (t *terraformer) GetState() ([]byte, error) {
+	return t.tf.GetState()
+}
+
+// CleanupConfiguration implements Terraformer.
+func (t *terraformer) CleanupConfiguration(ctx context.Context) error {
+	return t.tf.CleanupConfiguration(ctx)
+}
+
 type initializerFunc func(config *gardenerterraformer.InitializerConfig) error
 
 // Initialize implements Initializer.
@@ -94,17 +117,25 @@ type factory struct{}
 
 // NewForConfig implements Factory.
 func (factory) NewForConfig(logger logrus.FieldLogger, config *rest.Config, purpose, namespace, name, image string) (Interface, error) {
-	tf, err := gardenerterraformer.NewForConfig(logger, config, purpose, namespace, name, image)
+	c, err := client.New(config, client.Options{})
+	if err != nil {
+		return nil, err
+	}
+
+	coreV1Client, err := v1.NewForConfig(config)
 	if err != nil {
 		return nil, err
 	}
 
-	return &terraformer{tf}, nil
+	return factory{}.New(logger, c, coreV1Client, purpose, namespace, name, image), nil
 }
 
 // New implements Factory.
 func (factory) New(logger logrus.FieldLogger, client client.Client, coreV1Client v1.CoreV1Interface, purpose, namespace, name, image string) Interface {
-	return &terraformer{gardenerterraformer.New(logger, client, coreV1Client, purpose, namespace, name, image)}
+	return &terraformer{
+		gardenerterraformer.New(logger, client, coreV1Client, purpose, namespace, name, image),
+		newPlanner(logger, client, coreV1Client, purpose, namespace, name, image),
+	}
 }
 
 // DefaultInitializer implements Factory.
@@ -15,6 +15,7 @@
 package terraformer
 
 import (
+	"context"
 	"time"
 
 	gardenerterraformer "github.com/gardener/gardener/pkg/operation/terraformer"
@@ -35,9 +36,12 @@ type Interface interface {
 	SetDeadlineJob(time.Duration) Interface
 	InitializeWith(initializer Initializer) Interface
 	Apply() error
+	Plan(ctx context.Context) (*Plan, error)
 	Destroy() error
 	GetStateOutputVariables(variables ...string) (map[string]string, error)
 	ConfigExists() (bool, error)
+	GetState() ([]byte, error)
+	CleanupConfiguration(ctx context.Context) error
 }
 
 // Factory is a factory that can produce Interface and Initializer.
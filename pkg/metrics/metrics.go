@@ -0,0 +1,178 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"time"
+
+	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	namespace = "gardener_extensions"
+
+	// ResultSuccess is the value of the result label for successful operations.
+	ResultSuccess = "success"
+	// ResultError is the value of the result label for failed operations.
+	ResultError = "error"
+
+	// CodeUnknown is the value of the code label for errors without error code.
+	CodeUnknown = "unknown"
+
+	// TerraformerCommandApply is the value of the command label for Terraformer jobs applying the configuration.
+	TerraformerCommandApply = "apply"
+	// TerraformerCommandDestroy is the value of the command label for Terraformer jobs destroying the configuration.
+	TerraformerCommandDestroy = "destroy"
+	// TerraformerCommandPlan is the value of the command label for Terraformer pods planning the configuration.
+	TerraformerCommandPlan = "plan"
+)
+
+var (
+	// OperationDuration is the histogram of the durations of the operations the actuators execute for the
+	// extension resources.
+	OperationDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "controller",
+			Name:      "operation_duration_seconds",
+			Help:      "Duration of the operations of the extension actuators in seconds.",
+			Buckets:   []float64{1, 5, 10, 30, 60, 120, 300, 600, 1200, 1800, 3600},
+		},
+		[]string{"kind", "type", "operation", "result"},
+	)
+
+	// OperationErrors is the counter of the failed operations the actuators execute for the extension resources,
+	// partitioned by their error codes.
+	OperationErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "controller",
+			Name:      "operation_errors_total",
+			Help:      "Number of failed operations of the extension actuators by error code.",
+		},
+		[]string{"kind", "type", "operation", "code"},
+	)
+
+	// TerraformerJobDuration is the histogram of the durations of the Terraformer jobs.
+	TerraformerJobDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "terraformer",
+			Name:      "job_duration_seconds",
+			Help:      "Duration of the Terraformer jobs in seconds.",
+			Buckets:   []float64{10, 30, 60, 120, 300, 600, 900, 1200, 1800, 3600},
+		},
+		[]string{"purpose", "command", "result"},
+	)
+
+	// MachineDeploymentRolloutDuration is the histogram of the durations of the rolling updates of the machine
+	// deployments of workers.
+	MachineDeploymentRolloutDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "worker",
+			Name:      "machine_deployment_rollout_duration_seconds",
+			Help:      "Duration of the rolling updates of the machine deployments of workers in seconds.",
+			Buckets:   []float64{60, 300, 600, 1200, 1800, 3600, 7200, 14400},
+		},
+		[]string{"type"},
+	)
+
+	// WebhookMutationDuration is the histogram of the latencies of the mutating webhooks.
+	WebhookMutationDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "webhook",
+			Name:      "mutation_duration_seconds",
+			Help:      "Latency of the mutations of the extension webhooks in seconds.",
+			Buckets:   []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+		},
+		[]string{"kind", "operation", "result"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		OperationDuration,
+		OperationErrors,
+		TerraformerJobDuration,
+		MachineDeploymentRolloutDuration,
+		WebhookMutationDuration,
+	)
+}
+
+// ObserveFunc records the outcome of an operation that has been started before. It returns the given error.
+type ObserveFunc func(err error) error
+
+// StartOperation starts measuring an operation of an actuator for an extension resource of the given kind and
+// type. The returned function records the duration of the operation and, if it failed, its error codes.
+func StartOperation(kind, extensionType string, operation gardencorev1alpha1.LastOperationType) ObserveFunc {
+	start := time.Now()
+
+	return func(err error) error {
+		OperationDuration.WithLabelValues(kind, extensionType, string(operation), result(err)).Observe(time.Since(start).Seconds())
+		if err == nil {
+			return nil
+		}
+
+		codes := controllererrors.ExtractErrorCodes(err)
+		if len(codes) == 0 {
+			OperationErrors.WithLabelValues(kind, extensionType, string(operation), CodeUnknown).Inc()
+		}
+		for _, code := range codes {
+			OperationErrors.WithLabelValues(kind, extensionType, string(operation), string(code)).Inc()
+		}
+		return err
+	}
+}
+
+// StartTerraformerJob starts measuring a Terraformer job with the given purpose and command. The returned function
+// records the duration of the job.
+func StartTerraformerJob(purpose, command string) ObserveFunc {
+	start := time.Now()
+
+	return func(err error) error {
+		TerraformerJobDuration.WithLabelValues(purpose, command, result(err)).Observe(time.Since(start).Seconds())
+		return err
+	}
+}
+
+// ObserveMachineDeploymentRollout records the duration of a completed rolling update of the machine deployments of
+// a worker of the given type that has been started at the given time.
+func ObserveMachineDeploymentRollout(workerType string, start time.Time) {
+	MachineDeploymentRolloutDuration.WithLabelValues(workerType).Observe(time.Since(start).Seconds())
+}
+
+// StartWebhookMutation starts measuring the mutation of an object of the given kind for an admission request with
+// the given operation. The returned function records the latency of the mutation.
+func StartWebhookMutation(kind, operation string) ObserveFunc {
+	start := time.Now()
+
+	return func(err error) error {
+		WebhookMutationDuration.WithLabelValues(kind, operation, result(err)).Observe(time.Since(start).Seconds())
+		return err
+	}
+}
+
+func result(err error) string {
+	if err != nil {
+		return ResultError
+	}
+	return ResultSuccess
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics_test
+
+import (
+	"fmt"
+	"time"
+
+	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
+	. "github.com/gardener/gardener-extensions/pkg/metrics"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+func sampleCount(observer prometheus.Observer) uint64 {
+	metric := &dto.Metric{}
+	ExpectWithOffset(1, observer.(prometheus.Metric).Write(metric)).To(Succeed())
+	return metric.GetHistogram().GetSampleCount()
+}
+
+func counterValue(counter prometheus.Counter) float64 {
+	metric := &dto.Metric{}
+	ExpectWithOffset(1, counter.Write(metric)).To(Succeed())
+	return metric.GetCounter().GetValue()
+}
+
+var _ = Describe("Metrics", func() {
+	It("should register all metrics with the controller-runtime registry", func() {
+		for _, collector := range []prometheus.Collector{
+			OperationDuration,
+			OperationErrors,
+			TerraformerJobDuration,
+			MachineDeploymentRolloutDuration,
+			WebhookMutationDuration,
+		} {
+			Expect(ctrlmetrics.Registry.Register(collector)).To(BeAssignableToTypeOf(prometheus.AlreadyRegisteredError{}))
+		}
+	})
+
+	Describe("#StartOperation", func() {
+		const kind = "Infrastructure"
+
+		It("should record the duration of a successful operation", func() {
+			Expect(StartOperation(kind, "success", gardencorev1alpha1.LastOperationTypeReconcile)(nil)).To(Succeed())
+
+			Expect(sampleCount(OperationDuration.WithLabelValues(kind, "success", "Reconcile", ResultSuccess))).To(Equal(uint64(1)))
+			Expect(counterValue(OperationErrors.WithLabelValues(kind, "success", "Reconcile", CodeUnknown))).To(BeZero())
+		})
+
+		It("should record the error codes of a failed operation", func() {
+			err := controllererrors.WithCode(gardencorev1alpha1.ErrorInfraQuotaExceeded, fmt.Errorf("quota exceeded"))
+
+			Expect(StartOperation(kind, "classified", gardencorev1alpha1.LastOperationTypeDelete)(err)).To(BeIdenticalTo(err))
+
+			Expect(sampleCount(OperationDuration.WithLabelValues(kind, "classified", "Delete", ResultError))).To(Equal(uint64(1)))
+			Expect(counterValue(OperationErrors.WithLabelValues(kind, "classified", "Delete", string(gardencorev1alpha1.ErrorInfraQuotaExceeded)))).To(Equal(float64(1)))
+			Expect(counterValue(OperationErrors.WithLabelValues(kind, "classified", "Delete", CodeUnknown))).To(BeZero())
+		})
+
+		It("should record errors without error code as unknown", func() {
+			err := fmt.Errorf("some error")
+
+			Expect(StartOperation(kind, "unclassified", gardencorev1alpha1.LastOperationTypeReconcile)(err)).To(BeIdenticalTo(err))
+
+			Expect(counterValue(OperationErrors.WithLabelValues(kind, "unclassified", "Reconcile", CodeUnknown))).To(Equal(float64(1)))
+		})
+	})
+
+	Describe("#StartTerraformerJob", func() {
+		It("should record the duration of the job with its result", func() {
+			Expect(StartTerraformerJob("infra", TerraformerCommandApply)(nil)).To(Succeed())
+			Expect(StartTerraformerJob("infra", TerraformerCommandDestroy)(fmt.Errorf("failed"))).To(HaveOccurred())
+
+			Expect(sampleCount(TerraformerJobDuration.WithLabelValues("infra", TerraformerCommandApply, ResultSuccess))).To(Equal(uint64(1)))
+			Expect(sampleCount(TerraformerJobDuration.WithLabelValues("infra", TerraformerCommandDestroy, ResultError))).To(Equal(uint64(1)))
+		})
+	})
+
+	Describe("#ObserveMachineDeploymentRollout", func() {
+		It("should record the duration of the rollout", func() {
+			ObserveMachineDeploymentRollout("rollout", time.Now().Add(-10*time.Minute))
+
+			metric := &dto.Metric{}
+			Expect(MachineDeploymentRolloutDuration.WithLabelValues("rollout").(prometheus.Metric).Write(metric)).To(Succeed())
+			Expect(metric.GetHistogram().GetSampleCount()).To(Equal(uint64(1)))
+			Expect(metric.GetHistogram().GetSampleSum()).To(BeNumerically(">=", (10 * time.Minute).Seconds()))
+		})
+	})
+
+	Describe("#StartWebhookMutation", func() {
+		It("should record the latency of the mutation", func() {
+			Expect(StartWebhookMutation("Deployment", "CREATE")(nil)).To(Succeed())
+
+			Expect(sampleCount(WebhookMutationDuration.WithLabelValues("Deployment", "CREATE", ResultSuccess))).To(Equal(uint64(1)))
+		})
+	})
+})
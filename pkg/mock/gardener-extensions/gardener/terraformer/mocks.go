@@ -5,6 +5,7 @@
 package terraformer
 
 import (
+	context "context"
 	terraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 	terraformer0 "github.com/gardener/gardener/pkg/operation/terraformer"
 	gomock "github.com/golang/mock/gomock"
@@ -53,6 +54,20 @@ func (mr *MockInterfaceMockRecorder) Apply() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Apply", reflect.TypeOf((*MockInterface)(nil).Apply))
 }
 
+// CleanupConfiguration mocks base method
+func (m *MockInterface) CleanupConfiguration(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CleanupConfiguration", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CleanupConfiguration indicates an expected call of CleanupConfiguration
+func (mr *MockInterfaceMockRecorder) CleanupConfiguration(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanupConfiguration", reflect.TypeOf((*MockInterface)(nil).CleanupConfiguration), arg0)
+}
+
 // ConfigExists mocks base method
 func (m *MockInterface) ConfigExists() (bool, error) {
 	m.ctrl.T.Helper()
@@ -82,6 +97,21 @@ func (mr *MockInterfaceMockRecorder) Destroy() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Destroy", reflect.TypeOf((*MockInterface)(nil).Destroy))
 }
 
+// GetState mocks base method
+func (m *MockInterface) GetState() ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetState")
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetState indicates an expected call of GetState
+func (mr *MockInterfaceMockRecorder) GetState() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetState", reflect.TypeOf((*MockInterface)(nil).GetState))
+}
+
 // GetStateOutputVariables mocks base method
 func (m *MockInterface) GetStateOutputVariables(arg0 ...string) (map[string]string, error) {
 	m.ctrl.T.Helper()
@@ -115,6 +145,21 @@ func (mr *MockInterfaceMockRecorder) InitializeWith(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitializeWith", reflect.TypeOf((*MockInterface)(nil).InitializeWith), arg0)
 }
 
+// Plan mocks base method
+func (m *MockInterface) Plan(arg0 context.Context) (*terraformer.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Plan", arg0)
+	ret0, _ := ret[0].(*terraformer.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Plan indicates an expected call of Plan
+func (mr *MockInterfaceMockRecorder) Plan(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Plan", reflect.TypeOf((*MockInterface)(nil).Plan), arg0)
+}
+
 // SetActiveDeadlineSeconds mocks base method
 func (m *MockInterface) SetActiveDeadlineSeconds(arg0 int64) terraformer.Interface {
 	m.ctrl.T.Helper()
@@ -27,6 +27,7 @@ import (
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -105,6 +106,34 @@ func GenerationChanged() predicate.Predicate {
 	}
 }
 
+// AnnotationAdded is a predicate for update events that add the annotation with the given key to an object.
+func AnnotationAdded(key string) predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc:  func(event.CreateEvent) bool { return false },
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			_, hadAnnotation := e.MetaOld.GetAnnotations()[key]
+			_, hasAnnotation := e.MetaNew.GetAnnotations()[key]
+			return !hadAnnotation && hasAnnotation
+		},
+	}
+}
+
+// AnnotationChanged is a predicate for update events that add, change or remove the annotation with the given key.
+func AnnotationChanged(key string) predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc:  func(event.CreateEvent) bool { return false },
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldValue, hadAnnotation := e.MetaOld.GetAnnotations()[key]
+			newValue, hasAnnotation := e.MetaNew.GetAnnotations()[key]
+			return hadAnnotation != hasAnnotation || oldValue != newValue
+		},
+	}
+}
+
 type or struct {
 	predicates []predicate.Predicate
 }
@@ -173,10 +202,31 @@ func HasName(name string) predicate.Predicate {
 	}), CreateTrigger, UpdateNewTrigger, DeleteTrigger, GenericTrigger)
 }
 
-// HasOperationAnnotation is a predicate for the operation annotation.
+// HasPurpose filters the incoming ControlPlanes for ones that have the given purpose. ControlPlanes without purpose
+// have the purpose "normal".
+func HasPurpose(purpose extensionsv1alpha1.Purpose) predicate.Predicate {
+	return FromMapper(MapperFunc(func(e event.GenericEvent) bool {
+		controlPlane, ok := e.Object.(*extensionsv1alpha1.ControlPlane)
+		if !ok {
+			return false
+		}
+
+		if controlPlane.Spec.Purpose == nil {
+			return purpose == extensionsv1alpha1.Normal
+		}
+		return *controlPlane.Spec.Purpose == purpose
+	}), CreateTrigger, UpdateNewTrigger, DeleteTrigger, GenericTrigger)
+}
+
+// HasOperationAnnotation is a predicate for the operation annotation. It matches the `reconcile`,
+// `migrate` and `restore` operations.
 func HasOperationAnnotation() predicate.Predicate {
 	return FromMapper(MapperFunc(func(e event.GenericEvent) bool {
-		return e.Meta.GetAnnotations()[v1alpha1constants.GardenerOperation] == v1alpha1constants.GardenerOperationReconcile
+		switch e.Meta.GetAnnotations()[v1alpha1constants.GardenerOperation] {
+		case v1alpha1constants.GardenerOperationReconcile, v1alpha1constants.GardenerOperationMigrate, controller.GardenerOperationRestore:
+			return true
+		}
+		return false
 	}), CreateTrigger, UpdateNewTrigger, GenericTrigger)
 }
 
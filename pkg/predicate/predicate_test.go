@@ -125,4 +125,113 @@ var _ = Describe("Predicate", func() {
 			Expect(predicate.Generic(genericEvent)).To(BeFalse())
 		})
 	})
+
+	Describe("#HasPurpose", func() {
+		var (
+			controlPlane *v1alpha1.ControlPlane
+			createEvent  event.CreateEvent
+		)
+
+		BeforeEach(func() {
+			controlPlane = &v1alpha1.ControlPlane{}
+			createEvent = event.CreateEvent{
+				Object: controlPlane,
+			}
+		})
+
+		It("should match the normal purpose if no purpose is set", func() {
+			Expect(predicate.HasPurpose(v1alpha1.Normal).Create(createEvent)).To(BeTrue())
+			Expect(predicate.HasPurpose(v1alpha1.Exposure).Create(createEvent)).To(BeFalse())
+		})
+
+		It("should match the given purpose", func() {
+			purpose := v1alpha1.Exposure
+			controlPlane.Spec.Purpose = &purpose
+
+			Expect(predicate.HasPurpose(v1alpha1.Exposure).Create(createEvent)).To(BeTrue())
+			Expect(predicate.HasPurpose(v1alpha1.Normal).Create(createEvent)).To(BeFalse())
+		})
+
+		It("should not match other resources", func() {
+			Expect(predicate.HasPurpose(v1alpha1.Normal).Create(event.CreateEvent{Object: &v1alpha1.Worker{}})).To(BeFalse())
+		})
+	})
+
+	Describe("#AnnotationAdded", func() {
+		const key = "foo"
+
+		var (
+			oldMeta, newMeta metav1.ObjectMeta
+			updateEvent      = func() event.UpdateEvent {
+				return event.UpdateEvent{MetaOld: &oldMeta, MetaNew: &newMeta}
+			}
+		)
+
+		BeforeEach(func() {
+			oldMeta = metav1.ObjectMeta{}
+			newMeta = metav1.ObjectMeta{}
+		})
+
+		It("should match if the annotation is added", func() {
+			newMeta.Annotations = map[string]string{key: "true"}
+
+			Expect(predicate.AnnotationAdded(key).Update(updateEvent())).To(BeTrue())
+		})
+
+		It("should not match if the annotation was already present", func() {
+			oldMeta.Annotations = map[string]string{key: "true"}
+			newMeta.Annotations = map[string]string{key: "true"}
+
+			Expect(predicate.AnnotationAdded(key).Update(updateEvent())).To(BeFalse())
+		})
+
+		It("should not match if another annotation is added", func() {
+			newMeta.Annotations = map[string]string{"bar": "true"}
+
+			Expect(predicate.AnnotationAdded(key).Update(updateEvent())).To(BeFalse())
+		})
+
+		It("should not match create events", func() {
+			Expect(predicate.AnnotationAdded(key).Create(event.CreateEvent{Meta: &metav1.ObjectMeta{Annotations: map[string]string{key: "true"}}})).To(BeFalse())
+		})
+	})
+
+	Describe("#AnnotationChanged", func() {
+		const key = "foo"
+
+		var (
+			oldMeta, newMeta metav1.ObjectMeta
+			updateEvent      = func() event.UpdateEvent {
+				return event.UpdateEvent{MetaOld: &oldMeta, MetaNew: &newMeta}
+			}
+		)
+
+		BeforeEach(func() {
+			oldMeta = metav1.ObjectMeta{}
+			newMeta = metav1.ObjectMeta{}
+		})
+
+		It("should match if the annotation is added, changed or removed", func() {
+			newMeta.Annotations = map[string]string{key: "true"}
+			Expect(predicate.AnnotationChanged(key).Update(updateEvent())).To(BeTrue())
+
+			oldMeta.Annotations = map[string]string{key: "true"}
+			newMeta.Annotations = map[string]string{key: "false"}
+			Expect(predicate.AnnotationChanged(key).Update(updateEvent())).To(BeTrue())
+
+			newMeta.Annotations = nil
+			Expect(predicate.AnnotationChanged(key).Update(updateEvent())).To(BeTrue())
+		})
+
+		It("should not match if the annotation is unchanged", func() {
+			oldMeta.Annotations = map[string]string{key: "true"}
+			newMeta.Annotations = map[string]string{key: "true", "bar": "true"}
+
+			Expect(predicate.AnnotationChanged(key).Update(updateEvent())).To(BeFalse())
+		})
+
+		It("should not match create events", func() {
+			Expect(predicate.AnnotationChanged(key).Create(event.CreateEvent{Meta: &metav1.ObjectMeta{Annotations: map[string]string{key: "true"}}})).To(BeFalse())
+		})
+	})
 })
@@ -0,0 +1,119 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cidr
+
+import (
+	"fmt"
+	"net"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// CIDR is a CIDR together with the path of the field it is configured in.
+type CIDR struct {
+	value   string
+	fldPath *field.Path
+	ipNet   *net.IPNet
+}
+
+// NewCIDR creates a new CIDR for the given value and field path. The value is parsed immediately, use
+// ValidateParse to report values that are not valid CIDRs. CIDRs that cannot be parsed (e.g., empty values) are
+// ignored by all other validations.
+func NewCIDR(value string, fldPath *field.Path) *CIDR {
+	_, ipNet, _ := net.ParseCIDR(value)
+	return &CIDR{value, fldPath, ipNet}
+}
+
+// Value returns the value of the CIDR.
+func (c *CIDR) Value() string {
+	return c.value
+}
+
+// Parsed returns whether the value of the CIDR could be parsed.
+func (c *CIDR) Parsed() bool {
+	return c.ipNet != nil
+}
+
+// ValidateParse validates that the value of the CIDR can be parsed.
+func (c *CIDR) ValidateParse() field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if _, _, err := net.ParseCIDR(c.value); err != nil {
+		allErrs = append(allErrs, field.Invalid(c.fldPath, c.value, err.Error()))
+	}
+
+	return allErrs
+}
+
+// ValidateSubset validates that the given CIDRs lie within this CIDR. CIDRs that cannot be parsed are ignored.
+func (c *CIDR) ValidateSubset(subsets ...*CIDR) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if !c.Parsed() {
+		return allErrs
+	}
+
+	for _, subset := range subsets {
+		if !subset.Parsed() {
+			continue
+		}
+
+		if !c.contains(subset) {
+			allErrs = append(allErrs, field.Invalid(subset.fldPath, subset.value, fmt.Sprintf("must be a subset of %q (%s)", c.fldPath.String(), c.value)))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateNotOverlap validates that the given CIDRs do not overlap with this CIDR. CIDRs that cannot be parsed are
+// ignored.
+func (c *CIDR) ValidateNotOverlap(others ...*CIDR) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if !c.Parsed() {
+		return allErrs
+	}
+
+	for _, other := range others {
+		if !other.Parsed() {
+			continue
+		}
+
+		if c.contains(other) || other.contains(c) {
+			allErrs = append(allErrs, field.Invalid(other.fldPath, other.value, fmt.Sprintf("must not overlap with %q (%s)", c.fldPath.String(), c.value)))
+		}
+	}
+
+	return allErrs
+}
+
+// contains returns whether the given CIDR lies within this CIDR. Both CIDRs must have been parsed.
+func (c *CIDR) contains(other *CIDR) bool {
+	thisOnes, thisBits := c.ipNet.Mask.Size()
+	otherOnes, otherBits := other.ipNet.Mask.Size()
+
+	return thisBits == otherBits && thisOnes <= otherOnes && c.ipNet.Contains(other.ipNet.IP)
+}
+
+// ValidateNoOverlaps validates that none of the given CIDRs overlap with each other. CIDRs that cannot be parsed are
+// ignored.
+func ValidateNoOverlaps(cidrs ...*CIDR) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, cidr := range cidrs {
+		allErrs = append(allErrs, cidr.ValidateNotOverlap(cidrs[i+1:]...)...)
+	}
+
+	return allErrs
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cidr_test
+
+import (
+	. "github.com/gardener/gardener-extensions/pkg/util/validation/cidr"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("CIDR", func() {
+	var (
+		vpc      = NewCIDR("10.250.0.0/16", field.NewPath("vpc"))
+		workers  = NewCIDR("10.250.0.0/19", field.NewPath("workers"))
+		public   = NewCIDR("10.250.32.0/20", field.NewPath("public"))
+		pods     = NewCIDR("100.96.0.0/11", field.NewPath("pods"))
+		invalid  = NewCIDR("10.250.0.0", field.NewPath("invalid"))
+		outside  = NewCIDR("10.251.0.0/24", field.NewPath("outside"))
+		superset = NewCIDR("10.0.0.0/8", field.NewPath("superset"))
+	)
+
+	Describe("#ValidateParse", func() {
+		It("should accept valid CIDRs", func() {
+			Expect(vpc.Parsed()).To(BeTrue())
+			Expect(vpc.ValidateParse()).To(BeEmpty())
+		})
+
+		It("should reject invalid CIDRs", func() {
+			Expect(invalid.Parsed()).To(BeFalse())
+			Expect(invalid.ValidateParse()).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("invalid"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateSubset", func() {
+		It("should accept subsets", func() {
+			Expect(vpc.ValidateSubset(workers, public, vpc)).To(BeEmpty())
+		})
+
+		It("should reject CIDRs outside of the CIDR", func() {
+			Expect(vpc.ValidateSubset(workers, outside, superset)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("outside"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("superset"),
+				})),
+			))
+		})
+
+		It("should ignore CIDRs that cannot be parsed", func() {
+			Expect(vpc.ValidateSubset(invalid)).To(BeEmpty())
+			Expect(invalid.ValidateSubset(workers)).To(BeEmpty())
+		})
+	})
+
+	Describe("#ValidateNotOverlap", func() {
+		It("should accept disjoint CIDRs", func() {
+			Expect(workers.ValidateNotOverlap(public, pods, outside)).To(BeEmpty())
+		})
+
+		It("should reject overlapping CIDRs", func() {
+			Expect(workers.ValidateNotOverlap(vpc, superset, pods)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("vpc"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("superset"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateNoOverlaps", func() {
+		It("should accept disjoint CIDRs", func() {
+			Expect(ValidateNoOverlaps(workers, public, pods)).To(BeEmpty())
+		})
+
+		It("should reject overlapping CIDRs", func() {
+			Expect(ValidateNoOverlaps(workers, public, outside, vpc)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("vpc"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("vpc"),
+				})),
+			))
+		})
+	})
+})
@@ -20,6 +20,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"time"
 
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/secrets"
@@ -45,24 +46,29 @@ const (
 	ModeURLWithServiceName = "url-service"
 
 	certSecretName = "gardener-extension-webhook-cert"
+	// dataKeyCABundle is the key in the cert secret that contains all CA certificates that are currently trusted.
+	dataKeyCABundle = "bundle.crt"
 )
 
 // GenerateCertificates generates the certificates that are required for a webhook. It returns the ca bundle, and it
-// stores the server certificate and key locally on the file system.
+// stores the server certificate and key locally on the file system. Existing certificates that are about to expire are
+// renewed, see CertificateRotator for details.
 func GenerateCertificates(ctx context.Context, mgr manager.Manager, certDir, namespace, name, mode, url string) ([]byte, error) {
-	var (
-		caCert     *secrets.Certificate
-		serverCert *secrets.Certificate
-		err        error
-	)
+	certs, err := generateCertificates(ctx, mgr, certDir, namespace, name, mode, url)
+	if err != nil {
+		return nil, err
+	}
+	return certs.caBundle, nil
+}
 
+func generateCertificates(ctx context.Context, mgr manager.Manager, certDir, namespace, name, mode, url string) (*webhookCertificates, error) {
 	// If the namespace is not set then the webhook controller is running locally. We simply generate a new certificate in this case.
 	if len(namespace) == 0 {
-		caCert, serverCert, err = generateNewCAAndServerCert(mode, namespace, name, url)
+		certs, err := generateNewCertificates(mode, namespace, name, url)
 		if err != nil {
 			return nil, errors.Wrapf(err, "error generating new certificates for webhook server")
 		}
-		return writeCertificates(certDir, caCert, serverCert)
+		return certs, writeCertificates(certDir, certs.server)
 	}
 
 	// The controller stores the generated webhook certificate in a secret in the cluster. It tries to read it. If it does not exist a
@@ -72,6 +78,16 @@ func GenerateCertificates(ctx context.Context, mgr manager.Manager, certDir, nam
 		return nil, err
 	}
 
+	certs, err := syncCertificatesSecret(ctx, c, namespace, name, mode, url, time.Now(), DefaultCAOverlapPeriod)
+	if err != nil {
+		return nil, err
+	}
+	return certs, writeCertificates(certDir, certs.server)
+}
+
+// syncCertificatesSecret reads the certificates from the cert secret, renews them if required and stores them in the
+// secret again. If the secret does not exist then new certificates are generated.
+func syncCertificatesSecret(ctx context.Context, c client.Client, namespace, name, mode, url string, now time.Time, caOverlapPeriod time.Duration) (*webhookCertificates, error) {
 	secret := &corev1.Secret{}
 	if err := c.Get(ctx, kutil.Key(namespace, certSecretName), secret); err != nil {
 		if !apierrors.IsNotFound(err) {
@@ -79,45 +95,88 @@ func GenerateCertificates(ctx context.Context, mgr manager.Manager, certDir, nam
 		}
 
 		// The secret was not found, let's generate new certificates and store them in the secret afterwards.
-		caCert, serverCert, err = generateNewCAAndServerCert(mode, namespace, name, url)
+		certs, err := generateNewCertificates(mode, namespace, name, url)
 		if err != nil {
 			return nil, errors.Wrapf(err, "error generating new certificates for webhook server")
 		}
 
 		secret.ObjectMeta = metav1.ObjectMeta{Namespace: namespace, Name: certSecretName}
 		secret.Type = corev1.SecretTypeOpaque
-		secret.Data = map[string][]byte{
-			secrets.DataKeyCertificateCA: caCert.CertificatePEM,
-			secrets.DataKeyPrivateKeyCA:  caCert.PrivateKeyPEM,
-			secrets.DataKeyCertificate:   serverCert.CertificatePEM,
-			secrets.DataKeyPrivateKey:    serverCert.PrivateKeyPEM,
-		}
+		secret.Data = certs.secretData()
 		if err := c.Create(ctx, secret); err != nil {
 			return nil, err
 		}
-
-		return writeCertificates(certDir, caCert, serverCert)
+		return certs, nil
 	}
 
 	// The secret has been found and we are now trying to read the stored certificate inside it.
-	caCert, serverCert, err = loadExistingCAAndServerCert(secret.Data)
+	certs, err := loadExistingCertificates(secret.Data)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error reading data of secret %s/%s", namespace, certSecretName)
 	}
-	return writeCertificates(certDir, caCert, serverCert)
+
+	rotated, err := certs.rotate(now, caOverlapPeriod, mode, namespace, name, url)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error renewing certificates for webhook server")
+	}
+	if !rotated {
+		return certs, nil
+	}
+
+	// The update fails with a conflict if another replica has rotated the certificates in the meantime, i.e., all
+	// replicas end up with the same certificates.
+	secret.Data = certs.secretData()
+	if err := c.Update(ctx, secret); err != nil {
+		return nil, errors.Wrapf(err, "error updating cert secret")
+	}
+	return certs, nil
+}
+
+// webhookCertificates contains the certificates of the webhook server.
+type webhookCertificates struct {
+	// ca is the CA that signs new server certificates.
+	ca *secrets.Certificate
+	// server is the serving certificate of the webhook server.
+	server *secrets.Certificate
+	// caBundle contains all CA certificates that are currently trusted, i.e., the old and the new CA certificate while
+	// the CA is being rolled.
+	caBundle []byte
+}
+
+func (w *webhookCertificates) secretData() map[string][]byte {
+	return map[string][]byte{
+		secrets.DataKeyCertificateCA: w.ca.CertificatePEM,
+		secrets.DataKeyPrivateKeyCA:  w.ca.PrivateKeyPEM,
+		secrets.DataKeyCertificate:   w.server.CertificatePEM,
+		secrets.DataKeyPrivateKey:    w.server.PrivateKeyPEM,
+		dataKeyCABundle:              w.caBundle,
+	}
+}
+
+func generateNewCertificates(mode, namespace, name, url string) (*webhookCertificates, error) {
+	caCert, err := generateNewCA()
+	if err != nil {
+		return nil, err
+	}
+
+	serverCert, err := generateNewServerCert(caCert, mode, namespace, name, url)
+	if err != nil {
+		return nil, err
+	}
+
+	return &webhookCertificates{ca: caCert, server: serverCert, caBundle: caCert.CertificatePEM}, nil
 }
 
-func generateNewCAAndServerCert(mode, namespace, name, url string) (*secrets.Certificate, *secrets.Certificate, error) {
+func generateNewCA() (*secrets.Certificate, error) {
 	caConfig := &secrets.CertificateSecretConfig{
 		CommonName: "webhook-ca",
 		CertType:   secrets.CACert,
 	}
 
-	caCert, err := caConfig.GenerateCertificate()
-	if err != nil {
-		return nil, nil, err
-	}
+	return caConfig.GenerateCertificate()
+}
 
+func generateNewServerCert(caCert *secrets.Certificate, mode, namespace, name, url string) (*secrets.Certificate, error) {
 	var dnsNames []string
 	switch mode {
 	case ModeURL:
@@ -139,61 +198,80 @@ func generateNewCAAndServerCert(mode, namespace, name, url string) (*secrets.Cer
 		SigningCA:  caCert,
 	}
 
-	serverCert, err := serverConfig.GenerateCertificate()
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return caCert, serverCert, nil
+	return serverConfig.GenerateCertificate()
 }
 
-func loadExistingCAAndServerCert(data map[string][]byte) (*secrets.Certificate, *secrets.Certificate, error) {
+func loadExistingCertificates(data map[string][]byte) (*webhookCertificates, error) {
 	secretDataCACert, ok := data[secrets.DataKeyCertificateCA]
 	if !ok {
-		return nil, nil, fmt.Errorf("secret does not contain %s key", secrets.DataKeyCertificateCA)
+		return nil, fmt.Errorf("secret does not contain %s key", secrets.DataKeyCertificateCA)
 	}
 	secretDataCAKey, ok := data[secrets.DataKeyPrivateKeyCA]
 	if !ok {
-		return nil, nil, fmt.Errorf("secret does not contain %s key", secrets.DataKeyPrivateKeyCA)
+		return nil, fmt.Errorf("secret does not contain %s key", secrets.DataKeyPrivateKeyCA)
 	}
 	caCert, err := secrets.LoadCertificate("", secretDataCAKey, secretDataCACert)
 	if err != nil {
-		return nil, nil, fmt.Errorf("could not load ca certificate")
+		return nil, fmt.Errorf("could not load ca certificate")
 	}
 
 	secretDataServerCert, ok := data[secrets.DataKeyCertificate]
 	if !ok {
-		return nil, nil, fmt.Errorf("secret does not contain %s key", secrets.DataKeyCertificate)
+		return nil, fmt.Errorf("secret does not contain %s key", secrets.DataKeyCertificate)
 	}
 	secretDataServerKey, ok := data[secrets.DataKeyPrivateKey]
 	if !ok {
-		return nil, nil, fmt.Errorf("secret does not contain %s key", secrets.DataKeyPrivateKey)
+		return nil, fmt.Errorf("secret does not contain %s key", secrets.DataKeyPrivateKey)
 	}
 	serverCert, err := secrets.LoadCertificate("", secretDataServerKey, secretDataServerCert)
 	if err != nil {
-		return nil, nil, fmt.Errorf("could not load server certificate")
+		return nil, fmt.Errorf("could not load server certificate")
+	}
+
+	// Secrets created before the CA bundle was introduced only trust the current CA.
+	caBundle, ok := data[dataKeyCABundle]
+	if !ok {
+		caBundle = caCert.CertificatePEM
 	}
 
-	return caCert, serverCert, nil
+	return &webhookCertificates{ca: caCert, server: serverCert, caBundle: caBundle}, nil
 }
 
-func writeCertificates(certDir string, caCert, serverCert *secrets.Certificate) ([]byte, error) {
+func writeCertificates(certDir string, serverCert *secrets.Certificate) error {
 	var (
 		serverKeyPath  = filepath.Join(certDir, secrets.DataKeyPrivateKey)
 		serverCertPath = filepath.Join(certDir, secrets.DataKeyCertificate)
 	)
 
 	if err := os.MkdirAll(certDir, 0755); err != nil {
-		return nil, err
+		return err
 	}
-	if err := ioutil.WriteFile(serverKeyPath, serverCert.PrivateKeyPEM, 0666); err != nil {
-		return nil, err
+	if err := writeFileAtomically(serverKeyPath, serverCert.PrivateKeyPEM, 0666); err != nil {
+		return err
 	}
-	if err := ioutil.WriteFile(serverCertPath, serverCert.CertificatePEM, 0666); err != nil {
-		return nil, err
+	return writeFileAtomically(serverCertPath, serverCert.CertificatePEM, 0666)
+}
+
+// writeFileAtomically writes the data to a temporary file in the directory of the given file and renames it afterwards,
+// hence, the webhook server never reads a partially written file.
+func writeFileAtomically(filename string, data []byte, perm os.FileMode) error {
+	tmpFile, err := ioutil.TempFile(filepath.Dir(filename), "."+filepath.Base(filename))
+	if err != nil {
+		return err
 	}
+	defer os.Remove(tmpFile.Name())
 
-	return caCert.CertificatePEM, nil
+	if _, err := tmpFile.Write(data); err != nil {
+		tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmpFile.Name(), perm); err != nil {
+		return err
+	}
+	return os.Rename(tmpFile.Name(), filename)
 }
 
 func getClient(mgr manager.Manager) (client.Client, error) {
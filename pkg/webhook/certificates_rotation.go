@@ -0,0 +1,229 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"time"
+
+	"github.com/gardener/gardener-extensions/pkg/util"
+
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/secrets"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	// DefaultCertificateSyncPeriod is the default interval in which the webhook certificates are checked.
+	DefaultCertificateSyncPeriod = time.Hour
+	// DefaultCAOverlapPeriod is the default period during which both the old and the new CA are trusted after the CA
+	// has been renewed. It must be long enough for the new CA bundle to be propagated to all webhook configurations,
+	// including the shoot webhook configurations which are only updated when the control planes are reconciled.
+	DefaultCAOverlapPeriod = 7 * 24 * time.Hour
+
+	// renewalThreshold is the fraction of the validity of a certificate after which it is renewed.
+	renewalThreshold = 0.8
+)
+
+// rotate renews the CA and the server certificate if required and returns whether they have been changed. The CA is
+// rolled in two steps: First, a new CA is generated and added to the CA bundle while the server still serves the
+// certificate signed by the old CA. After the overlap period, a new server certificate signed by the new CA is
+// generated and the old CA is removed from the CA bundle.
+func (w *webhookCertificates) rotate(now time.Time, caOverlapPeriod time.Duration, mode, namespace, name, url string) (bool, error) {
+	signedByCurrentCA, err := isSignedBy(w.server, w.ca)
+	if err != nil {
+		return false, err
+	}
+
+	switch {
+	case !now.Before(w.ca.Certificate.NotAfter):
+		// The CA has already expired, hence, there is nothing to overlap with.
+		certs, err := generateNewCertificates(mode, namespace, name, url)
+		if err != nil {
+			return false, err
+		}
+		*w = *certs
+		return true, nil
+
+	case signedByCurrentCA && needsRenewal(w.ca.Certificate, now):
+		caCert, err := generateNewCA()
+		if err != nil {
+			return false, err
+		}
+		w.ca = caCert
+		w.caBundle = concatCertificates(caCert.CertificatePEM, w.caBundle)
+		return true, nil
+
+	case !signedByCurrentCA && now.Sub(w.ca.Certificate.NotBefore) < caOverlapPeriod && !needsRenewal(w.server.Certificate, now):
+		// The CA is being rolled, the server certificate is replaced after the overlap period.
+		return false, nil
+
+	case !signedByCurrentCA || needsRenewal(w.server.Certificate, now):
+		serverCert, err := generateNewServerCert(w.ca, mode, namespace, name, url)
+		if err != nil {
+			return false, err
+		}
+		w.server = serverCert
+		w.caBundle = w.ca.CertificatePEM
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// isSignedBy checks whether the given certificate has been signed by the given CA. The certificates are decoded from
+// their PEM encoding as generated certificates only carry the template they have been created from.
+func isSignedBy(cert, ca *secrets.Certificate) (bool, error) {
+	x509Cert, err := utils.DecodeCertificate(cert.CertificatePEM)
+	if err != nil {
+		return false, err
+	}
+	x509CA, err := utils.DecodeCertificate(ca.CertificatePEM)
+	if err != nil {
+		return false, err
+	}
+	return x509Cert.CheckSignatureFrom(x509CA) == nil, nil
+}
+
+// needsRenewal checks whether the given certificate has exceeded the renewal threshold of its validity.
+func needsRenewal(cert *x509.Certificate, now time.Time) bool {
+	validity := cert.NotAfter.Sub(cert.NotBefore)
+	return !now.Before(cert.NotBefore.Add(time.Duration(float64(validity) * renewalThreshold)))
+}
+
+func concatCertificates(certs ...[]byte) []byte {
+	var bundle []byte
+	for _, cert := range certs {
+		bundle = append(bundle, bytes.TrimSpace(cert)...)
+		bundle = append(bundle, '\n')
+	}
+	return bundle
+}
+
+// CertificateRotatorArgs are arguments for creating a CertificateRotator.
+type CertificateRotatorArgs struct {
+	// CertDir is the directory that contains the webhook server key and certificate.
+	CertDir string
+	// Namespace is the namespace of the cert secret.
+	Namespace string
+	// Name is the name of the webhook server.
+	Name string
+	// Mode is the webhook config mode.
+	Mode string
+	// URL is the URL that is used to register the webhooks in Kubernetes.
+	URL string
+	// Port is the port of the webhook server.
+	Port int
+	// Webhooks are the webhooks that are registered again whenever the CA bundle changes.
+	Webhooks []*Webhook
+	// ShootWebhooks are the shoot webhooks as returned by RegisterWebhooks. They are replaced by the shoot webhooks
+	// with the new CA bundle, hence, the shoot webhook configurations pick up the new CA bundle when the control planes
+	// are reconciled.
+	ShootWebhooks *ShootWebhooks
+	// SyncPeriod is the interval in which the certificates are checked. Defaults to DefaultCertificateSyncPeriod.
+	SyncPeriod time.Duration
+	// CAOverlapPeriod is the period during which both the old and the new CA are trusted. Defaults to
+	// DefaultCAOverlapPeriod.
+	CAOverlapPeriod time.Duration
+}
+
+// CertificateRotator periodically checks the CA and the server certificate of the webhook server and renews them
+// before they expire. Whenever the certificates change, the server key and certificate in the cert directory are
+// rewritten (the webhook server reloads them automatically) and the webhooks are registered again with the new CA bundle.
+// The certificates are stored in the cert secret, hence, the rotator must only be used if the webhook config namespace
+// is set. Locally running webhook servers generate new certificates on every start and don't need to rotate them.
+type CertificateRotator struct {
+	args   CertificateRotatorArgs
+	mgr    manager.Manager
+	logger logr.Logger
+
+	certs    *webhookCertificates
+	caBundle []byte
+}
+
+// NewCertificateRotator creates a new CertificateRotator for the given manager and the given CA bundle that is
+// currently registered in the webhook configurations.
+func NewCertificateRotator(mgr manager.Manager, caBundle []byte, args CertificateRotatorArgs) *CertificateRotator {
+	if args.SyncPeriod == 0 {
+		args.SyncPeriod = DefaultCertificateSyncPeriod
+	}
+	if args.CAOverlapPeriod == 0 {
+		args.CAOverlapPeriod = DefaultCAOverlapPeriod
+	}
+
+	return &CertificateRotator{
+		args:     args,
+		mgr:      mgr,
+		logger:   log.Log.WithName("webhook-certificate-rotator"),
+		caBundle: caBundle,
+	}
+}
+
+// Start implements manager.Runnable interface.
+func (r *CertificateRotator) Start(stop <-chan struct{}) error {
+	ctx := util.ContextFromStopChannel(stop)
+
+	c, err := getClient(r.mgr)
+	if err != nil {
+		return err
+	}
+
+	wait.Until(func() {
+		if err := r.sync(ctx, c, time.Now()); err != nil {
+			r.logger.Error(err, "Could not rotate webhook certificates")
+		}
+	}, r.args.SyncPeriod, stop)
+
+	return nil
+}
+
+// sync renews the certificates if required, writes them to the cert directory and registers the webhooks again if the
+// CA bundle has changed. Certificates that have been rotated by another replica are picked up from the cert secret.
+func (r *CertificateRotator) sync(ctx context.Context, c client.Client, now time.Time) error {
+	certs, err := syncCertificatesSecret(ctx, c, r.args.Namespace, r.args.Name, r.args.Mode, r.args.URL, now, r.args.CAOverlapPeriod)
+	if err != nil {
+		return err
+	}
+
+	if r.certs == nil || !bytes.Equal(r.certs.server.CertificatePEM, certs.server.CertificatePEM) {
+		r.logger.Info("Writing webhook server certificate", "notAfter", certs.server.Certificate.NotAfter)
+		if err := writeCertificates(r.args.CertDir, certs.server); err != nil {
+			return err
+		}
+	}
+	r.certs = certs
+
+	if bytes.Equal(r.caBundle, certs.caBundle) {
+		return nil
+	}
+
+	r.logger.Info("Registering webhooks with renewed CA bundle")
+	_, shootWebhooks, err := RegisterWebhooks(ctx, r.mgr, r.args.Namespace, r.args.Name, r.args.Port, r.args.Mode, r.args.URL, certs.caBundle, r.args.Webhooks)
+	if err != nil {
+		return err
+	}
+	if r.args.ShootWebhooks != nil {
+		r.args.ShootWebhooks.Set(shootWebhooks)
+	}
+	r.caBundle = certs.caBundle
+
+	return nil
+}
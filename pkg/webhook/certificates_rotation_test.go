@@ -0,0 +1,180 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/secrets"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Certificates", func() {
+	const (
+		namespace = "extension-provider-foo"
+		name      = "provider-foo"
+	)
+
+	var (
+		certs *webhookCertificates
+		err   error
+
+		countCertificates = func(bundle []byte) int {
+			return bytes.Count(bundle, []byte("BEGIN CERTIFICATE"))
+		}
+	)
+
+	BeforeEach(func() {
+		certs, err = generateNewCertificates(ModeService, namespace, name, "")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("#rotate", func() {
+		It("should not renew valid certificates", func() {
+			old := *certs
+
+			rotated, err := certs.rotate(time.Now(), DefaultCAOverlapPeriod, ModeService, namespace, name, "")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rotated).To(BeFalse())
+			Expect(*certs).To(Equal(old))
+		})
+
+		It("should roll the CA with an overlap period", func() {
+			var (
+				oldCA     = certs.ca
+				oldServer = certs.server
+				now       = certs.ca.Certificate.NotBefore.AddDate(9, 0, 0)
+			)
+
+			By("adding a new CA to the CA bundle")
+			rotated, err := certs.rotate(now, DefaultCAOverlapPeriod, ModeService, namespace, name, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rotated).To(BeTrue())
+			Expect(certs.ca).NotTo(Equal(oldCA))
+			Expect(certs.server).To(Equal(oldServer))
+			Expect(countCertificates(certs.caBundle)).To(Equal(2))
+
+			By("keeping the server certificate during the overlap period")
+			rotated, err = certs.rotate(certs.ca.Certificate.NotBefore.Add(DefaultCAOverlapPeriod/2), DefaultCAOverlapPeriod, ModeService, namespace, name, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rotated).To(BeFalse())
+			Expect(certs.server).To(Equal(oldServer))
+
+			By("replacing the server certificate and the CA bundle after the overlap period")
+			rotated, err = certs.rotate(certs.ca.Certificate.NotBefore.Add(DefaultCAOverlapPeriod), DefaultCAOverlapPeriod, ModeService, namespace, name, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rotated).To(BeTrue())
+			Expect(certs.server).NotTo(Equal(oldServer))
+			Expect(isSignedBy(certs.server, certs.ca)).To(BeTrue())
+			Expect(certs.caBundle).To(Equal(certs.ca.CertificatePEM))
+		})
+
+		It("should replace expired certificates immediately", func() {
+			oldCA := certs.ca
+
+			rotated, err := certs.rotate(certs.ca.Certificate.NotAfter, DefaultCAOverlapPeriod, ModeService, namespace, name, "")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rotated).To(BeTrue())
+			Expect(certs.ca).NotTo(Equal(oldCA))
+			Expect(isSignedBy(certs.server, certs.ca)).To(BeTrue())
+			Expect(countCertificates(certs.caBundle)).To(Equal(1))
+		})
+	})
+
+	Describe("#syncCertificatesSecret", func() {
+		var (
+			ctx = context.TODO()
+			c   client.Client
+		)
+
+		BeforeEach(func() {
+			c = fake.NewFakeClientWithScheme(scheme.Scheme)
+		})
+
+		It("should generate new certificates and store them in the cert secret", func() {
+			certs, err := syncCertificatesSecret(ctx, c, namespace, name, ModeService, "", time.Now(), DefaultCAOverlapPeriod)
+			Expect(err).NotTo(HaveOccurred())
+
+			secret := &corev1.Secret{}
+			Expect(c.Get(ctx, kutil.Key(namespace, certSecretName), secret)).To(Succeed())
+			Expect(secret.Data).To(Equal(certs.secretData()))
+		})
+
+		It("should load existing certificates without CA bundle", func() {
+			Expect(c.Create(ctx, &corev1.Secret{
+				ObjectMeta: kutil.ObjectMeta(namespace, certSecretName),
+				Data: map[string][]byte{
+					secrets.DataKeyCertificateCA: certs.ca.CertificatePEM,
+					secrets.DataKeyPrivateKeyCA:  certs.ca.PrivateKeyPEM,
+					secrets.DataKeyCertificate:   certs.server.CertificatePEM,
+					secrets.DataKeyPrivateKey:    certs.server.PrivateKeyPEM,
+				},
+			})).To(Succeed())
+
+			loaded, err := syncCertificatesSecret(ctx, c, namespace, name, ModeService, "", time.Now(), DefaultCAOverlapPeriod)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(loaded.server.CertificatePEM).To(Equal(certs.server.CertificatePEM))
+			Expect(loaded.caBundle).To(Equal(certs.ca.CertificatePEM))
+		})
+
+		It("should store rotated certificates in the cert secret", func() {
+			Expect(c.Create(ctx, &corev1.Secret{
+				ObjectMeta: kutil.ObjectMeta(namespace, certSecretName),
+				Data:       certs.secretData(),
+			})).To(Succeed())
+
+			rotated, err := syncCertificatesSecret(ctx, c, namespace, name, ModeService, "", certs.ca.Certificate.NotBefore.AddDate(9, 0, 0), DefaultCAOverlapPeriod)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(countCertificates(rotated.caBundle)).To(Equal(2))
+
+			secret := &corev1.Secret{}
+			Expect(c.Get(ctx, kutil.Key(namespace, certSecretName), secret)).To(Succeed())
+			Expect(secret.Data).To(Equal(rotated.secretData()))
+		})
+	})
+
+	Describe("#writeCertificates", func() {
+		It("should replace the server key and certificate without leaving temporary files behind", func() {
+			certDir, err := ioutil.TempDir("", "webhook-certs")
+			Expect(err).NotTo(HaveOccurred())
+			defer os.RemoveAll(certDir)
+
+			Expect(writeCertificates(certDir, certs.server)).To(Succeed())
+			renewed, err := generateNewServerCert(certs.ca, ModeService, namespace, name, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(writeCertificates(certDir, renewed)).To(Succeed())
+
+			files, err := ioutil.ReadDir(certDir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(files).To(HaveLen(2))
+			Expect(ioutil.ReadFile(filepath.Join(certDir, secrets.DataKeyCertificate))).To(Equal(renewed.CertificatePEM))
+			Expect(ioutil.ReadFile(filepath.Join(certDir, secrets.DataKeyPrivateKey))).To(Equal(renewed.PrivateKeyPEM))
+		})
+	})
+})
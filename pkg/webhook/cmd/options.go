@@ -214,7 +214,8 @@ type AddToManagerConfig struct {
 
 // AddToManager instantiates all webhooks of this configuration. If there are any webhooks, it creates a
 // webhook server, registers the webhooks and adds the server to the manager. Otherwise, it is a no-op.
-func (c *AddToManagerConfig) AddToManager(mgr manager.Manager) ([]admissionregistrationv1beta1.Webhook, []admissionregistrationv1beta1.Webhook, error) {
+// It also adds a certificate rotator to the manager that renews the webhook certificates before they expire.
+func (c *AddToManagerConfig) AddToManager(mgr manager.Manager) ([]admissionregistrationv1beta1.Webhook, *extensionswebhook.ShootWebhooks, error) {
 	ctx := context.Background()
 
 	webhooks, err := c.Switch.WebhooksFactory(mgr)
@@ -238,10 +239,29 @@ func (c *AddToManagerConfig) AddToManager(mgr manager.Manager) ([]admissionregis
 		return nil, nil, errors.Wrap(err, "could not generate certificates")
 	}
 
-	seedWebhooks, shootWebhooks, err := extensionswebhook.RegisterWebhooks(ctx, mgr, c.Server.Namespace, c.serverName, webhookServer.Port, c.Server.Mode, c.Server.URL, caBundle, webhooks)
+	seedWebhooks, webhooksToRegisterShoot, err := extensionswebhook.RegisterWebhooks(ctx, mgr, c.Server.Namespace, c.serverName, webhookServer.Port, c.Server.Mode, c.Server.URL, caBundle, webhooks)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "could not create webhooks")
 	}
+	shootWebhooks := extensionswebhook.NewShootWebhooks(webhooksToRegisterShoot)
+
+	// The certificates are only stored in the cluster if the namespace is set, otherwise they are regenerated on every
+	// start of the webhook server.
+	if len(c.Server.Namespace) != 0 {
+		rotator := extensionswebhook.NewCertificateRotator(mgr, caBundle, extensionswebhook.CertificateRotatorArgs{
+			CertDir:       c.Server.CertDir,
+			Namespace:     c.Server.Namespace,
+			Name:          c.serverName,
+			Mode:          c.Server.Mode,
+			URL:           c.Server.URL,
+			Port:          webhookServer.Port,
+			Webhooks:      webhooks,
+			ShootWebhooks: shootWebhooks,
+		})
+		if err := mgr.Add(rotator); err != nil {
+			return nil, nil, errors.Wrap(err, "could not add webhook certificate rotator")
+		}
+	}
 
 	return seedWebhooks, shootWebhooks, nil
 }
@@ -165,19 +165,25 @@ var _ = Describe("Mutator", func() {
 		It("should invoke ensurer.EnsureKubeControllerManagerDeployment with a kube-controller-manager deployment", func() {
 			var (
 				dep = &appsv1.Deployment{
-					ObjectMeta: metav1.ObjectMeta{Name: v1alpha1constants.DeploymentNameKubeControllerManager},
+					ObjectMeta: metav1.ObjectMeta{Name: v1alpha1constants.DeploymentNameKubeControllerManager, Namespace: namespace},
 				}
 			)
 
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().Get(context.TODO(), clusterKey, &extensionsv1alpha1.Cluster{}).DoAndReturn(clientGet(clusterObject(cluster)))
+
 			// Create mock ensurer
 			ensurer := mockgenericmutator.NewMockEnsurer(ctrl)
-			ensurer.EXPECT().EnsureKubeControllerManagerDeployment(context.TODO(), dep).Return(nil)
+			ensurer.EXPECT().EnsureKubeControllerManagerDeployment(context.TODO(), dep, cluster).Return(nil)
 
 			// Create mutator
 			mutator := NewMutator(ensurer, nil, nil, nil, logger)
+			err := mutator.(inject.Client).InjectClient(client)
+			Expect(err).To(Not(HaveOccurred()))
 
 			// Call Mutate method and check the result
-			err := mutator.Mutate(context.TODO(), dep)
+			err = mutator.Mutate(context.TODO(), dep)
 			Expect(err).To(Not(HaveOccurred()))
 		})
 
@@ -346,8 +352,8 @@ var _ = Describe("Mutator", func() {
 			// Create mock ensurer
 			ensurer := mockgenericmutator.NewMockEnsurer(ctrl)
 			ensurer.EXPECT().EnsureKubeletServiceUnitOptions(context.TODO(), oldUnitOptions).Return(newUnitOptions, nil)
-			ensurer.EXPECT().EnsureKubeletConfiguration(context.TODO(), oldKubeletConfig).DoAndReturn(
-				func(ctx context.Context, kubeletConfig *kubeletconfigv1beta1.KubeletConfiguration) error {
+			ensurer.EXPECT().EnsureKubeletConfiguration(context.TODO(), oldKubeletConfig, cluster).DoAndReturn(
+				func(ctx context.Context, kubeletConfig *kubeletconfigv1beta1.KubeletConfiguration, _ *extensionscontroller.Cluster) error {
 					*kubeletConfig = *newKubeletConfig
 					return nil
 				},
@@ -393,11 +399,17 @@ var _ = Describe("Mutator", func() {
 			fcic.EXPECT().Encode([]byte(newKubernetesGeneralConfigData), "").Return(&extensionsv1alpha1.FileContentInline{Data: newKubernetesGeneralConfigData}, nil)
 			fcic.EXPECT().Encode([]byte(cloudproviderconf), encoding).Return(&extensionsv1alpha1.FileContentInline{Data: cloudproviderconfEncoded, Encoding: encoding}, nil)
 
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().Get(context.TODO(), clusterKey, &extensionsv1alpha1.Cluster{}).DoAndReturn(clientGet(clusterObject(cluster)))
+
 			// Create mutator
 			mutator := NewMutator(ensurer, us, kcc, fcic, logger)
+			err := mutator.(inject.Client).InjectClient(client)
+			Expect(err).To(Not(HaveOccurred()))
 
 			// Call Mutate method and check the result
-			err := mutator.Mutate(context.TODO(), osc)
+			err = mutator.Mutate(context.TODO(), osc)
 			Expect(err).To(Not(HaveOccurred()))
 			checkOperatingSystemConfig(osc)
 		})
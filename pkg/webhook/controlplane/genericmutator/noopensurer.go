@@ -40,7 +40,7 @@ func (e *NoopEnsurer) EnsureKubeAPIServerDeployment(context.Context, *appsv1.Dep
 }
 
 // EnsureKubeControllerManagerDeployment ensures that the kube-controller-manager deployment conforms to the provider requirements.
-func (e *NoopEnsurer) EnsureKubeControllerManagerDeployment(context.Context, *appsv1.Deployment) error {
+func (e *NoopEnsurer) EnsureKubeControllerManagerDeployment(context.Context, *appsv1.Deployment, *extensionscontroller.Cluster) error {
 	return nil
 }
 
@@ -60,7 +60,7 @@ func (e *NoopEnsurer) EnsureKubeletServiceUnitOptions(_ context.Context, opts []
 }
 
 // EnsureKubeletConfiguration ensures that the kubelet configuration conforms to the provider requirements.
-func (e *NoopEnsurer) EnsureKubeletConfiguration(context.Context, *kubeletconfigv1beta1.KubeletConfiguration) error {
+func (e *NoopEnsurer) EnsureKubeletConfiguration(context.Context, *kubeletconfigv1beta1.KubeletConfiguration, *extensionscontroller.Cluster) error {
 	return nil
 }
 
@@ -19,6 +19,8 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"github.com/gardener/gardener-extensions/pkg/metrics"
+
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -101,7 +103,8 @@ func handle(ctx context.Context, req admission.Request, r *http.Request, f mutat
 
 	// Mutate the resource
 	newObj := obj.DeepCopyObject()
-	if err = f(ctx, newObj, r); err != nil {
+	observe := metrics.StartWebhookMutation(ar.Kind.Kind, string(ar.Operation))
+	if err = observe(f(ctx, newObj, r)); err != nil {
 		return admission.Errored(http.StatusInternalServerError,
 			errors.Wrapf(err, "could not mutate %s %s/%s", ar.Kind.Kind, accessor.GetNamespace(), accessor.GetName()))
 	}
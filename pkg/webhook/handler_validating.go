@@ -0,0 +1,144 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// NewValidatingHandler creates a new handler for the given types, using the given validator, and logger.
+func NewValidatingHandler(mgr manager.Manager, types []runtime.Object, validator Validator, logger logr.Logger) (*validatingHandler, error) {
+	// Build a map of the given types keyed by their GVKs
+	typesMap, err := buildTypesMap(mgr, types)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create and return a handler
+	return &validatingHandler{
+		typesMap:  typesMap,
+		validator: validator,
+		logger:    logger.WithName("validating-handler"),
+	}, nil
+}
+
+type validatingHandler struct {
+	typesMap  map[metav1.GroupVersionKind]runtime.Object
+	validator Validator
+	decoder   *admission.Decoder
+	logger    logr.Logger
+}
+
+// InjectDecoder injects the given decoder into the handler.
+func (h *validatingHandler) InjectDecoder(d *admission.Decoder) error {
+	h.decoder = d
+	return nil
+}
+
+// InjectFunc injects the fields of the manager (client, scheme, etc.) into the validator.
+func (h *validatingHandler) InjectFunc(f inject.Func) error {
+	if err := f(h.validator); err != nil {
+		return errors.Wrap(err, "could not inject into the validator")
+	}
+	return nil
+}
+
+// Handle handles the given admission request.
+func (h *validatingHandler) Handle(ctx context.Context, req admission.Request) admission.Response {
+	ar := req.AdmissionRequest
+
+	// Decode object
+	t, ok := h.typesMap[ar.Kind]
+	if !ok {
+		return admission.Errored(http.StatusBadRequest, errors.Errorf("unexpected request kind %s", ar.Kind.String()))
+	}
+	obj := t.DeepCopyObject()
+	if err := h.decoder.Decode(req, obj); err != nil {
+		return admission.Errored(http.StatusBadRequest, errors.Wrapf(err, "could not decode request %v", ar))
+	}
+
+	// Decode old object for updates
+	var oldObj runtime.Object
+	if ar.Operation == admissionv1beta1.Update {
+		oldObj = t.DeepCopyObject()
+		if err := h.decoder.DecodeRaw(ar.OldObject, oldObj); err != nil {
+			return admission.Errored(http.StatusBadRequest, errors.Wrapf(err, "could not decode old object of request %v", ar))
+		}
+	}
+
+	// Get object accessor
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, errors.Wrapf(err, "could not get accessor for %v", obj))
+	}
+
+	// Skip validation for objects that are being deleted, e.g. when their finalizers are removed
+	if accessor.GetDeletionTimestamp() != nil {
+		return admission.Allowed("")
+	}
+
+	// Skip validation for updates that do not change the spec, e.g. annotation or finalizer updates
+	if oldObj != nil {
+		unchanged, err := specUnchanged(obj, oldObj)
+		if err != nil {
+			return admission.Errored(http.StatusBadRequest, errors.Wrapf(err, "could not compare spec of %v", obj))
+		}
+		if unchanged {
+			return admission.Allowed("")
+		}
+	}
+
+	// Validate the resource
+	if err := h.validator.Validate(ctx, obj, oldObj); err != nil {
+		h.logger.Info("Rejecting invalid object", "kind", ar.Kind.Kind, "namespace", accessor.GetNamespace(), "name", accessor.GetName(), "reason", err.Error())
+		return admission.Denied(errors.Wrapf(err, "invalid %s %s/%s", ar.Kind.Kind, accessor.GetNamespace(), accessor.GetName()).Error())
+	}
+
+	return admission.Allowed("")
+}
+
+// specUnchanged checks whether the given object and old object both have a spec and whether these specs are equal.
+func specUnchanged(obj, oldObj runtime.Object) (bool, error) {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return false, err
+	}
+	oldU, err := runtime.DefaultUnstructuredConverter.ToUnstructured(oldObj)
+	if err != nil {
+		return false, err
+	}
+
+	spec, ok := u["spec"]
+	if !ok {
+		return false, nil
+	}
+	oldSpec, ok := oldU["spec"]
+	if !ok {
+		return false, nil
+	}
+	return equality.Semantic.DeepEqual(spec, oldSpec), nil
+}
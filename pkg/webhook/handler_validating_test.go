@@ -0,0 +1,196 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	mockmanager "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/manager"
+	mockwebhook "github.com/gardener/gardener-extensions/pkg/mock/gardener-extensions/webhook"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var _ = Describe("ValidatingHandler", func() {
+	const (
+		name      = "foo"
+		namespace = "default"
+	)
+
+	var (
+		ctrl    *gomock.Controller
+		mgr     *mockmanager.MockManager
+		decoder *admission.Decoder
+		err     error
+
+		objTypes = []runtime.Object{&corev1.Service{}}
+		svc      = &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		}
+		oldSvc = &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+		}
+
+		req = admission.Request{
+			AdmissionRequest: admissionv1beta1.AdmissionRequest{
+				Kind:      metav1.GroupVersionKind{Group: "", Version: "v1", Kind: "Service"},
+				Name:      name,
+				Namespace: namespace,
+				Operation: admissionv1beta1.Create,
+				Object:    runtime.RawExtension{Raw: encode(svc)},
+			},
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		// Build scheme
+		scheme := runtime.NewScheme()
+		_ = corev1.AddToScheme(scheme)
+
+		// Create mock manager
+		mgr = mockmanager.NewMockManager(ctrl)
+		mgr.EXPECT().GetScheme().Return(scheme)
+
+		decoder, err = admission.NewDecoder(scheme)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#Handle", func() {
+		It("should return an allowing response if the validator accepted the resource", func() {
+			// Create mock validator
+			validator := mockwebhook.NewMockValidator(ctrl)
+			validator.EXPECT().Validate(context.TODO(), svc, nil).Return(nil)
+
+			// Create handler
+			h, err := NewValidatingHandler(mgr, objTypes, validator, logger)
+			Expect(err).NotTo(HaveOccurred())
+			err = h.InjectDecoder(decoder)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call Handle and check response
+			resp := h.Handle(context.TODO(), req)
+			Expect(resp).To(Equal(admission.Response{
+				AdmissionResponse: admissionv1beta1.AdmissionResponse{
+					Allowed: true,
+					Result: &metav1.Status{
+						Code: http.StatusOK,
+					},
+				},
+			}))
+		})
+
+		It("should pass the old resource to the validator for updates", func() {
+			// Create mock validator
+			validator := mockwebhook.NewMockValidator(ctrl)
+			validator.EXPECT().Validate(context.TODO(), svc, oldSvc).Return(nil)
+
+			// Create handler
+			h, err := NewValidatingHandler(mgr, objTypes, validator, logger)
+			Expect(err).NotTo(HaveOccurred())
+			err = h.InjectDecoder(decoder)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call Handle and check response
+			updateReq := admission.Request{AdmissionRequest: *req.AdmissionRequest.DeepCopy()}
+			updateReq.Operation = admissionv1beta1.Update
+			updateReq.OldObject = runtime.RawExtension{Raw: encode(oldSvc)}
+			resp := h.Handle(context.TODO(), updateReq)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("should not validate updates that do not change the spec", func() {
+			// Create mock validator
+			validator := mockwebhook.NewMockValidator(ctrl)
+
+			// Create handler
+			h, err := NewValidatingHandler(mgr, objTypes, validator, logger)
+			Expect(err).NotTo(HaveOccurred())
+			err = h.InjectDecoder(decoder)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call Handle and check response
+			labeledSvc := svc.DeepCopy()
+			labeledSvc.Labels = map[string]string{"foo": "bar"}
+			updateReq := admission.Request{AdmissionRequest: *req.AdmissionRequest.DeepCopy()}
+			updateReq.Operation = admissionv1beta1.Update
+			updateReq.Object = runtime.RawExtension{Raw: encode(labeledSvc)}
+			updateReq.OldObject = runtime.RawExtension{Raw: encode(svc)}
+			resp := h.Handle(context.TODO(), updateReq)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("should not validate resources that are being deleted", func() {
+			// Create mock validator
+			validator := mockwebhook.NewMockValidator(ctrl)
+
+			// Create handler
+			h, err := NewValidatingHandler(mgr, objTypes, validator, logger)
+			Expect(err).NotTo(HaveOccurred())
+			err = h.InjectDecoder(decoder)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call Handle and check response
+			now := metav1.Now()
+			deletedSvc := svc.DeepCopy()
+			deletedSvc.DeletionTimestamp = &now
+			updateReq := admission.Request{AdmissionRequest: *req.AdmissionRequest.DeepCopy()}
+			updateReq.Operation = admissionv1beta1.Update
+			updateReq.Object = runtime.RawExtension{Raw: encode(deletedSvc)}
+			updateReq.OldObject = runtime.RawExtension{Raw: encode(oldSvc)}
+			resp := h.Handle(context.TODO(), updateReq)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("should return a denying response if the validator rejected the resource", func() {
+			// Create mock validator
+			validator := mockwebhook.NewMockValidator(ctrl)
+			validator.EXPECT().Validate(context.TODO(), svc, nil).Return(errors.New("test error"))
+
+			// Create handler
+			h, err := NewValidatingHandler(mgr, objTypes, validator, logger)
+			Expect(err).NotTo(HaveOccurred())
+			err = h.InjectDecoder(decoder)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call Handle and check response
+			resp := h.Handle(context.TODO(), req)
+			Expect(resp).To(Equal(admission.Response{
+				AdmissionResponse: admissionv1beta1.AdmissionResponse{
+					Allowed: false,
+					Result: &metav1.Status{
+						Code:   http.StatusForbidden,
+						Reason: "invalid Service default/foo: test error",
+					},
+				},
+			}))
+		})
+	})
+})
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/pkg/errors"
 	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
@@ -29,11 +30,14 @@ import (
 )
 
 // RegisterWebhooks registers the given webhooks in the Kubernetes cluster targeted by the provided manager.
+// Validating seed webhooks are registered in a separate ValidatingWebhookConfiguration and are not returned.
 func RegisterWebhooks(ctx context.Context, mgr manager.Manager, namespace, providerName string, port int, mode, url string, caBundle []byte, webhooks []*Webhook) (webhooksToRegisterSeed []admissionregistrationv1beta1.Webhook, webhooksToRegisterShoot []admissionregistrationv1beta1.Webhook, err error) {
 	var (
-		fail                             = admissionregistrationv1beta1.Fail
-		ignore                           = admissionregistrationv1beta1.Ignore
-		mutatingWebhookConfigurationSeed = &admissionregistrationv1beta1.MutatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "gardener-extension-" + providerName}}
+		fail                               = admissionregistrationv1beta1.Fail
+		ignore                             = admissionregistrationv1beta1.Ignore
+		mutatingWebhookConfigurationSeed   = &admissionregistrationv1beta1.MutatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "gardener-extension-" + providerName}}
+		validatingWebhookConfigurationSeed = &admissionregistrationv1beta1.ValidatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "gardener-extension-" + providerName}}
+		validatingWebhooksToRegisterSeed   []admissionregistrationv1beta1.Webhook
 	)
 
 	for _, webhook := range webhooks {
@@ -56,8 +60,19 @@ func RegisterWebhooks(ctx context.Context, mgr manager.Manager, namespace, provi
 		case TargetSeed:
 			webhookToRegister.FailurePolicy = &fail
 			webhookToRegister.ClientConfig = buildClientConfigFor(webhook, namespace, providerName, port, mode, url, caBundle)
-			webhooksToRegisterSeed = append(webhooksToRegisterSeed, webhookToRegister)
+
+			switch webhook.Action {
+			case "", ActionMutating:
+				webhooksToRegisterSeed = append(webhooksToRegisterSeed, webhookToRegister)
+			case ActionValidating:
+				validatingWebhooksToRegisterSeed = append(validatingWebhooksToRegisterSeed, webhookToRegister)
+			default:
+				return nil, nil, fmt.Errorf("invalid webhook action: %s", webhook.Action)
+			}
 		case TargetShoot:
+			if webhook.Action == ActionValidating {
+				return nil, nil, fmt.Errorf("validating webhooks are not supported for target %s", webhook.Target)
+			}
 			webhookToRegister.FailurePolicy = &ignore
 			webhookToRegister.ClientConfig = buildClientConfigFor(webhook, namespace, providerName, port, ModeURLWithServiceName, url, caBundle)
 			webhooksToRegisterShoot = append(webhooksToRegisterShoot, webhookToRegister)
@@ -66,17 +81,28 @@ func RegisterWebhooks(ctx context.Context, mgr manager.Manager, namespace, provi
 		}
 	}
 
-	if len(webhooksToRegisterSeed) > 0 {
+	if len(webhooksToRegisterSeed) > 0 || len(validatingWebhooksToRegisterSeed) > 0 {
 		c, err := getClient(mgr)
 		if err != nil {
 			return nil, nil, err
 		}
 
-		if _, err := controllerutil.CreateOrUpdate(ctx, c, mutatingWebhookConfigurationSeed, func() error {
-			mutatingWebhookConfigurationSeed.Webhooks = webhooksToRegisterSeed
-			return nil
-		}); err != nil {
-			return nil, nil, err
+		if len(webhooksToRegisterSeed) > 0 {
+			if _, err := controllerutil.CreateOrUpdate(ctx, c, mutatingWebhookConfigurationSeed, func() error {
+				mutatingWebhookConfigurationSeed.Webhooks = webhooksToRegisterSeed
+				return nil
+			}); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		if len(validatingWebhooksToRegisterSeed) > 0 {
+			if _, err := controllerutil.CreateOrUpdate(ctx, c, validatingWebhookConfigurationSeed, func() error {
+				validatingWebhookConfigurationSeed.Webhooks = validatingWebhooksToRegisterSeed
+				return nil
+			}); err != nil {
+				return nil, nil, err
+			}
 		}
 	}
 
@@ -97,7 +123,8 @@ func buildRule(mgr manager.Manager, t runtime.Object) (*admissionregistrationv1b
 		return nil, errors.Wrapf(err, "could not get REST mapping from GroupVersionKind '%s'", gvk.String())
 	}
 
-	// Create and return RuleWithOperations
+	// Create and return RuleWithOperations. Note that validating handlers allow updates that do not change the spec
+	// as well as any request for objects that are being deleted without validating them.
 	return &admissionregistrationv1beta1.RuleWithOperations{
 		Operations: []admissionregistrationv1beta1.OperationType{
 			admissionregistrationv1beta1.Create,
@@ -135,3 +162,49 @@ func buildClientConfigFor(webhook *Webhook, namespace, providerName string, port
 
 	return clientConfig
 }
+
+// ShootWebhooks holds the shoot webhooks as returned by RegisterWebhooks. Their CA bundle is updated by the
+// CertificateRotator whenever the CA is renewed, hence, it is safe for concurrent use.
+type ShootWebhooks struct {
+	lock     sync.RWMutex
+	webhooks []admissionregistrationv1beta1.Webhook
+}
+
+// NewShootWebhooks creates a new ShootWebhooks object holding a copy of the given webhooks.
+func NewShootWebhooks(webhooks []admissionregistrationv1beta1.Webhook) *ShootWebhooks {
+	s := &ShootWebhooks{}
+	s.Set(webhooks)
+	return s
+}
+
+// Get returns a copy of the shoot webhooks. It returns nil if the given ShootWebhooks object is nil.
+func (s *ShootWebhooks) Get() []admissionregistrationv1beta1.Webhook {
+	if s == nil {
+		return nil
+	}
+
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return copyWebhooks(s.webhooks)
+}
+
+// Set replaces the shoot webhooks by a copy of the given webhooks.
+func (s *ShootWebhooks) Set(webhooks []admissionregistrationv1beta1.Webhook) {
+	webhooks = copyWebhooks(webhooks)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.webhooks = webhooks
+}
+
+func copyWebhooks(webhooks []admissionregistrationv1beta1.Webhook) []admissionregistrationv1beta1.Webhook {
+	if webhooks == nil {
+		return nil
+	}
+
+	out := make([]admissionregistrationv1beta1.Webhook, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		out = append(out, *webhook.DeepCopy())
+	}
+	return out
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+)
+
+var _ = Describe("ShootWebhooks", func() {
+	var webhooks []admissionregistrationv1beta1.Webhook
+
+	BeforeEach(func() {
+		webhooks = []admissionregistrationv1beta1.Webhook{
+			{
+				Name:         "foo",
+				ClientConfig: admissionregistrationv1beta1.WebhookClientConfig{CABundle: []byte("ca")},
+			},
+		}
+	})
+
+	It("should return nil for a nil object", func() {
+		var shootWebhooks *ShootWebhooks
+
+		Expect(shootWebhooks.Get()).To(BeNil())
+	})
+
+	It("should not share the webhooks with the callers", func() {
+		shootWebhooks := NewShootWebhooks(webhooks)
+		webhooks[0].ClientConfig.CABundle[0] = 'x'
+
+		got := shootWebhooks.Get()
+		Expect(got[0].ClientConfig.CABundle).To(Equal([]byte("ca")))
+
+		got[0].Name = "bar"
+		Expect(shootWebhooks.Get()[0].Name).To(Equal("foo"))
+	})
+
+	It("should replace the webhooks", func() {
+		shootWebhooks := NewShootWebhooks(webhooks)
+		renewed := []admissionregistrationv1beta1.Webhook{
+			{
+				Name:         "foo",
+				ClientConfig: admissionregistrationv1beta1.WebhookClientConfig{CABundle: []byte("new-ca")},
+			},
+		}
+
+		shootWebhooks.Set(renewed)
+
+		Expect(shootWebhooks.Get()).To(Equal(renewed))
+	})
+})
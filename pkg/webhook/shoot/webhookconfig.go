@@ -0,0 +1,98 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+
+	"github.com/gardener/gardener-resource-manager/pkg/manager"
+	"github.com/pkg/errors"
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/serializer/json"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// MarshalWebhooks marshals the given webhooks into a MutatingWebhookConfiguration named after the given name.
+func MarshalWebhooks(webhooks []admissionregistrationv1beta1.Webhook, name string) ([]byte, error) {
+	var (
+		buf     = new(bytes.Buffer)
+		encoder = json.NewYAMLSerializer(json.DefaultMetaFactory, nil, nil)
+
+		apiVersion, kind             = admissionregistrationv1beta1.SchemeGroupVersion.WithKind("MutatingWebhookConfiguration").ToAPIVersionAndKind()
+		mutatingWebhookConfiguration = admissionregistrationv1beta1.MutatingWebhookConfiguration{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: apiVersion,
+				Kind:       kind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: fmt.Sprintf("gardener-extension-%s-shoot", name),
+			},
+			Webhooks: webhooks,
+		}
+	)
+
+	if err := encoder.Encode(&mutatingWebhookConfiguration, buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// ReconcileWebhookConfig deploys the given shoot webhooks into the shoot cluster of the given namespace with a managed
+// resource of the given name. It also ensures the network policy that allows the kube-apiserver to reach the webhook server.
+func ReconcileWebhookConfig(ctx context.Context, c client.Client, namespace, providerName, managedResourceName string, serverPort int, webhooks []admissionregistrationv1beta1.Webhook) error {
+	if err := EnsureNetworkPolicy(ctx, c, namespace, providerName, serverPort); err != nil {
+		return errors.Wrapf(err, "could not create or update network policy for shoot webhooks in namespace '%s'", namespace)
+	}
+
+	webhookConfiguration, err := MarshalWebhooks(webhooks, providerName)
+	if err != nil {
+		return err
+	}
+
+	if err := manager.
+		NewSecret(c).
+		WithNamespacedName(namespace, managedResourceName).
+		WithKeyValues(map[string][]byte{"mutatingwebhookconfiguration.yaml": webhookConfiguration}).
+		Reconcile(ctx); err != nil {
+		return errors.Wrapf(err, "could not create or update secret '%s/%s' of managed resource containing shoot webhooks", namespace, managedResourceName)
+	}
+
+	if err := manager.
+		NewManagedResource(c).
+		WithNamespacedName(namespace, managedResourceName).
+		WithSecretRef(managedResourceName).
+		Reconcile(ctx); err != nil {
+		return errors.Wrapf(err, "could not create or update managed resource '%s/%s' containing shoot webhooks", namespace, managedResourceName)
+	}
+
+	return nil
+}
+
+// DeleteWebhookConfig deletes the network policy and the managed resource that have been created by ReconcileWebhookConfig.
+// It does not wait until the managed resource is gone.
+func DeleteWebhookConfig(ctx context.Context, c client.Client, namespace, providerName, managedResourceName string) error {
+	networkPolicy := GetNetworkPolicyMeta(namespace, providerName)
+	if err := c.Delete(ctx, networkPolicy); client.IgnoreNotFound(err) != nil {
+		return errors.Wrapf(err, "could not delete network policy for shoot webhooks in namespace '%s'", namespace)
+	}
+
+	return extensionscontroller.DeleteManagedResource(ctx, c, namespace, managedResourceName)
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
+
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const (
+	// WebhookName is the webhook name.
+	WebhookName = "validator"
+)
+
+var logger = log.Log.WithName("validator-webhook")
+
+// AddArgs are arguments for adding a validating webhook to a manager.
+type AddArgs struct {
+	// Provider is the provider of this webhook.
+	Provider string
+	// Types is a list of resource types.
+	Types []runtime.Object
+	// Validator is a validator to be used by the admission handler.
+	Validator extensionswebhook.Validator
+}
+
+// Add creates a new validating webhook for the extension resources in the shoot namespaces of the given provider
+// and adds it to the given Manager.
+func Add(mgr manager.Manager, args AddArgs) (*extensionswebhook.Webhook, error) {
+	logger := logger.WithValues("provider", args.Provider)
+
+	// Create handler
+	handler, err := extensionswebhook.NewValidatingHandler(mgr, args.Types, args.Validator, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create webhook
+	var (
+		name = WebhookName
+		path = WebhookName
+	)
+
+	logger.Info("Creating validating webhook", "name", name)
+	return &extensionswebhook.Webhook{
+		Name:     name,
+		Provider: args.Provider,
+		Types:    args.Types,
+		Target:   extensionswebhook.TargetSeed,
+		Action:   extensionswebhook.ActionValidating,
+		Path:     path,
+		Webhook:  &admission.Webhook{Handler: handler},
+		Selector: buildSelector(args.Provider),
+	}, nil
+}
+
+// buildSelector creates and returns a LabelSelector selecting the shoot namespaces of the given provider.
+func buildSelector(provider string) *metav1.LabelSelector {
+	return &metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{Key: v1alpha1constants.LabelShootProvider, Operator: metav1.LabelSelectorOpIn, Values: []string{provider}},
+		},
+	}
+}
+
+// PrefixErrors prefixes the fields of the given errors with the given path, e.g., with the path of a provider config
+// within an extension resource.
+func PrefixErrors(fldPath *field.Path, errs field.ErrorList) field.ErrorList {
+	prefixed := make(field.ErrorList, 0, len(errs))
+	for _, err := range errs {
+		prefixedErr := *err
+		prefixedErr.Field = fldPath.String() + "." + err.Field
+		prefixed = append(prefixed, &prefixedErr)
+	}
+	return prefixed
+}
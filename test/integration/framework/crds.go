@@ -0,0 +1,74 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"fmt"
+	"strings"
+
+	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	extensionsGroup = "extensions.gardener.cloud"
+	resourcesGroup  = "resources.gardener.cloud"
+	crdVersion      = "v1alpha1"
+)
+
+func newCRD(group, kind, plural string, scope apiextensionsv1beta1.ResourceScope) *apiextensionsv1beta1.CustomResourceDefinition {
+	singular := strings.ToLower(kind)
+	return &apiextensionsv1beta1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: fmt.Sprintf("%s.%s", plural, group),
+		},
+		Spec: apiextensionsv1beta1.CustomResourceDefinitionSpec{
+			Group:   group,
+			Version: crdVersion,
+			Scope:   scope,
+			Names: apiextensionsv1beta1.CustomResourceDefinitionNames{
+				Kind:     kind,
+				ListKind: kind + "List",
+				Plural:   plural,
+				Singular: singular,
+			},
+			Subresources: &apiextensionsv1beta1.CustomResourceSubresources{
+				Status: &apiextensionsv1beta1.CustomResourceSubresourceStatus{},
+			},
+		},
+	}
+}
+
+// ExtensionsCRDs returns the CRDs of the extensions.gardener.cloud API group.
+func ExtensionsCRDs() []*apiextensionsv1beta1.CustomResourceDefinition {
+	return []*apiextensionsv1beta1.CustomResourceDefinition{
+		newCRD(extensionsGroup, "BackupBucket", "backupbuckets", apiextensionsv1beta1.ClusterScoped),
+		newCRD(extensionsGroup, "BackupEntry", "backupentries", apiextensionsv1beta1.ClusterScoped),
+		newCRD(extensionsGroup, "Cluster", "clusters", apiextensionsv1beta1.ClusterScoped),
+		newCRD(extensionsGroup, "ControlPlane", "controlplanes", apiextensionsv1beta1.NamespaceScoped),
+		newCRD(extensionsGroup, "Extension", "extensions", apiextensionsv1beta1.NamespaceScoped),
+		newCRD(extensionsGroup, "Infrastructure", "infrastructures", apiextensionsv1beta1.NamespaceScoped),
+		newCRD(extensionsGroup, "Network", "networks", apiextensionsv1beta1.NamespaceScoped),
+		newCRD(extensionsGroup, "OperatingSystemConfig", "operatingsystemconfigs", apiextensionsv1beta1.NamespaceScoped),
+		newCRD(extensionsGroup, "Worker", "workers", apiextensionsv1beta1.NamespaceScoped),
+	}
+}
+
+// ResourcesCRDs returns the CRDs of the resources.gardener.cloud API group.
+func ResourcesCRDs() []*apiextensionsv1beta1.CustomResourceDefinition {
+	return []*apiextensionsv1beta1.CustomResourceDefinition{
+		newCRD(resourcesGroup, "ManagedResource", "managedresources", apiextensionsv1beta1.NamespaceScoped),
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package framework contains a harness for running the reconcile loops of extension controllers against an
+// envtest control plane. The etcd and kube-apiserver binaries are looked up in $KUBEBUILDER_ASSETS (or via
+// $TEST_ASSET_ETCD and $TEST_ASSET_KUBE_APISERVER), falling back to /usr/local/kubebuilder/bin.
+//
+// A typical test starts an Environment, registers the controllers of an extension with StartManager, creates
+// the namespace and Cluster of a shoot and then waits for the LastOperation of the created extension resource:
+//
+//	env := &framework.Environment{AddToScheme: []func(*runtime.Scheme) error{install.AddToScheme}}
+//	err := env.Start(ctx)
+//	_, err = env.StartManager(infrastructure.AddToManager)
+//	...
+//	err = framework.WaitForLastOperation(ctx, env.Client(), infra, gardencorev1alpha1.LastOperationTypeCreate, gardencorev1alpha1.LastOperationStateSucceeded, time.Second, time.Minute)
+//
+// See controllers/networking-calico/test/integration for an example.
+package framework
@@ -0,0 +1,158 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
+
+	apiextensionsscheme "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/scheme"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	// EnvKubebuilderAssets is the environment variable pointing to the directory containing the etcd and
+	// kube-apiserver binaries.
+	EnvKubebuilderAssets = "KUBEBUILDER_ASSETS"
+	// EnvEtcdBinary is the environment variable pointing to the etcd binary.
+	EnvEtcdBinary = "TEST_ASSET_ETCD"
+	// EnvKubeAPIServerBinary is the environment variable pointing to the kube-apiserver binary.
+	EnvKubeAPIServerBinary = "TEST_ASSET_KUBE_APISERVER"
+
+	defaultAssetsDir = "/usr/local/kubebuilder/bin"
+)
+
+// BinariesAvailable checks whether the etcd and kube-apiserver binaries required by envtest can be found.
+func BinariesAvailable() bool {
+	for _, binary := range []string{binaryPath("etcd", EnvEtcdBinary), binaryPath("kube-apiserver", EnvKubeAPIServerBinary)} {
+		if _, err := os.Stat(binary); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func binaryPath(name, env string) string {
+	if path := os.Getenv(env); path != "" {
+		return path
+	}
+	if dir := os.Getenv(EnvKubebuilderAssets); dir != "" {
+		return filepath.Join(dir, name)
+	}
+	return filepath.Join(defaultAssetsDir, name)
+}
+
+// Environment is an envtest control plane with all CRDs required by the extension controllers installed.
+type Environment struct {
+	// ControlPlane is the envtest environment the harness runs on. The CRDs required by the extension
+	// controllers are added to its CRDs when the environment is started.
+	ControlPlane envtest.Environment
+	// AddToScheme are additional functions to add types to the scheme of the environment, e.g. the
+	// provider configuration API of an extension.
+	AddToScheme []func(*runtime.Scheme) error
+
+	config *rest.Config
+	scheme *runtime.Scheme
+	client client.Client
+	stopCh chan struct{}
+}
+
+// Start starts the envtest control plane, installs the CRDs and initializes the client.
+func (e *Environment) Start(ctx context.Context) error {
+	e.scheme = runtime.NewScheme()
+	for _, addToScheme := range append([]func(*runtime.Scheme) error{extensionscontroller.AddToScheme, apiextensionsscheme.AddToScheme}, e.AddToScheme...) {
+		if err := addToScheme(e.scheme); err != nil {
+			return err
+		}
+	}
+
+	e.ControlPlane.CRDs = append(e.ControlPlane.CRDs, append(ExtensionsCRDs(), ResourcesCRDs()...)...)
+	config, err := e.ControlPlane.Start()
+	if err != nil {
+		_ = e.ControlPlane.Stop()
+		return fmt.Errorf("could not start envtest control plane: %v", err)
+	}
+	e.config = config
+
+	c, err := client.New(e.config, client.Options{Scheme: e.scheme})
+	if err != nil {
+		return err
+	}
+	e.client = c
+
+	return worker.ApplyMachineResources(ctx, c)
+}
+
+// StartManager creates a new manager, adds the given controllers to it and starts it in the background.
+// The manager is stopped together with the environment.
+func (e *Environment) StartManager(addToManager ...func(manager.Manager) error) (manager.Manager, error) {
+	if e.stopCh != nil {
+		return nil, fmt.Errorf("manager has already been started")
+	}
+
+	mgr, err := manager.New(e.config, manager.Options{
+		Scheme:             e.scheme,
+		MetricsBindAddress: "0",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range addToManager {
+		if err := f(mgr); err != nil {
+			return nil, err
+		}
+	}
+
+	e.stopCh = make(chan struct{})
+	go func(stopCh <-chan struct{}) {
+		_ = mgr.Start(stopCh)
+	}(e.stopCh)
+
+	return mgr, nil
+}
+
+// Config returns the rest config for the envtest control plane.
+func (e *Environment) Config() *rest.Config {
+	return e.config
+}
+
+// Scheme returns the scheme of the environment.
+func (e *Environment) Scheme() *runtime.Scheme {
+	return e.scheme
+}
+
+// Client returns a client for the envtest control plane.
+func (e *Environment) Client() client.Client {
+	return e.client
+}
+
+// Stop stops the manager (if started) and the envtest control plane.
+func (e *Environment) Stop() error {
+	if e.stopCh != nil {
+		close(e.stopCh)
+		e.stopCh = nil
+	}
+	return e.ControlPlane.Stop()
+}
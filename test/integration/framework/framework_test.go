@@ -0,0 +1,170 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework_test
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	. "github.com/gardener/gardener-extensions/test/integration/framework"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Framework", func() {
+	var ctx = context.TODO()
+
+	Describe("#ExtensionsCRDs", func() {
+		It("should return the CRDs with correct names and scopes", func() {
+			scopes := map[string]apiextensionsv1beta1.ResourceScope{}
+			for _, crd := range ExtensionsCRDs() {
+				Expect(crd.Name).To(Equal(crd.Spec.Names.Plural + "." + crd.Spec.Group))
+				Expect(crd.Spec.Subresources.Status).NotTo(BeNil())
+				scopes[crd.Name] = crd.Spec.Scope
+			}
+
+			Expect(scopes).To(HaveKeyWithValue("clusters.extensions.gardener.cloud", apiextensionsv1beta1.ClusterScoped))
+			Expect(scopes).To(HaveKeyWithValue("backupentries.extensions.gardener.cloud", apiextensionsv1beta1.ClusterScoped))
+			Expect(scopes).To(HaveKeyWithValue("infrastructures.extensions.gardener.cloud", apiextensionsv1beta1.NamespaceScoped))
+			Expect(scopes).To(HaveKeyWithValue("operatingsystemconfigs.extensions.gardener.cloud", apiextensionsv1beta1.NamespaceScoped))
+		})
+	})
+
+	Describe("#CreateCluster", func() {
+		It("should embed the garden objects with their type information", func() {
+			c := fake.NewFakeClientWithScheme(extensionscontroller.ExtensionsScheme)
+
+			cluster, err := CreateCluster(ctx, c, "shoot--foo--bar",
+				&gardenv1beta1.CloudProfile{ObjectMeta: metav1.ObjectMeta{Name: "profile"}},
+				&gardenv1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed"}},
+				&gardenv1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"}},
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			shoot := map[string]interface{}{}
+			Expect(json.Unmarshal(cluster.Spec.Shoot.Raw, &shoot)).To(Succeed())
+			Expect(shoot).To(HaveKeyWithValue("apiVersion", "garden.sapcloud.io/v1beta1"))
+			Expect(shoot).To(HaveKeyWithValue("kind", "Shoot"))
+
+			decoder, err := extensionscontroller.NewGardenDecoder()
+			Expect(err).NotTo(HaveOccurred())
+			seed, err := extensionscontroller.SeedFromCluster(decoder, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(seed.Name).To(Equal("seed"))
+		})
+	})
+
+	Describe("#WaitForLastOperation", func() {
+		var (
+			c     client.Client
+			infra *extensionsv1alpha1.Infrastructure
+		)
+
+		BeforeEach(func() {
+			c = fake.NewFakeClientWithScheme(extensionscontroller.ExtensionsScheme)
+			infra = &extensionsv1alpha1.Infrastructure{ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar", Name: "infra", Generation: 2}}
+		})
+
+		setLastOperation := func(operationType gardencorev1alpha1.LastOperationType, state gardencorev1alpha1.LastOperationState, observedGeneration int64) {
+			infra.Status.ObservedGeneration = observedGeneration
+			infra.Status.LastOperation = &gardencorev1alpha1.LastOperation{Type: operationType, State: state, Description: "foo"}
+			Expect(c.Create(ctx, infra)).To(Succeed())
+		}
+
+		It("should succeed if the last operation has the expected type and state", func() {
+			setLastOperation(gardencorev1alpha1.LastOperationTypeCreate, gardencorev1alpha1.LastOperationStateSucceeded, 2)
+
+			Expect(WaitForLastOperation(ctx, c, infra, gardencorev1alpha1.LastOperationTypeCreate, gardencorev1alpha1.LastOperationStateSucceeded, 10*time.Millisecond, time.Second)).To(Succeed())
+		})
+
+		It("should fail early if the last operation failed", func() {
+			setLastOperation(gardencorev1alpha1.LastOperationTypeCreate, gardencorev1alpha1.LastOperationStateFailed, 2)
+
+			Expect(WaitForLastOperation(ctx, c, infra, gardencorev1alpha1.LastOperationTypeCreate, gardencorev1alpha1.LastOperationStateSucceeded, 10*time.Millisecond, time.Minute)).To(MatchError(ContainSubstring("reached final state Failed")))
+		})
+
+		It("should not consider a last operation of a different type", func() {
+			setLastOperation(gardencorev1alpha1.LastOperationTypeReconcile, gardencorev1alpha1.LastOperationStateSucceeded, 2)
+
+			Expect(WaitForLastOperation(ctx, c, infra, gardencorev1alpha1.LastOperationTypeCreate, gardencorev1alpha1.LastOperationStateSucceeded, 10*time.Millisecond, 50*time.Millisecond)).To(HaveOccurred())
+		})
+
+		It("should not consider a last operation of an older generation", func() {
+			setLastOperation(gardencorev1alpha1.LastOperationTypeReconcile, gardencorev1alpha1.LastOperationStateFailed, 1)
+
+			Expect(WaitForLastOperation(ctx, c, infra, gardencorev1alpha1.LastOperationTypeReconcile, gardencorev1alpha1.LastOperationStateSucceeded, 10*time.Millisecond, 50*time.Millisecond)).To(MatchError(wait.ErrWaitTimeout))
+		})
+
+		It("should time out if the last operation does not reach the expected state", func() {
+			setLastOperation(gardencorev1alpha1.LastOperationTypeCreate, gardencorev1alpha1.LastOperationStateProcessing, 2)
+
+			Expect(WaitForLastOperation(ctx, c, infra, gardencorev1alpha1.LastOperationTypeCreate, gardencorev1alpha1.LastOperationStateSucceeded, 10*time.Millisecond, 50*time.Millisecond)).To(HaveOccurred())
+		})
+	})
+
+	Describe("Environment", func() {
+		var env *Environment
+
+		BeforeEach(func() {
+			if !BinariesAvailable() {
+				Skip("etcd and kube-apiserver binaries are not available")
+			}
+
+			env = &Environment{}
+			Expect(env.Start(ctx)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			if env != nil {
+				Expect(env.Stop()).To(Succeed())
+			}
+		})
+
+		It("should install the CRDs and run the manager", func() {
+			_, err := env.StartManager()
+			Expect(err).NotTo(HaveOccurred())
+
+			namespace, err := CreateNamespace(ctx, env.Client(), "shoot--foo--bar")
+			Expect(err).NotTo(HaveOccurred())
+
+			infra := &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace.Name, Name: "infra"},
+				Spec: extensionsv1alpha1.InfrastructureSpec{
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "foo"},
+					SecretRef:   corev1.SecretReference{Namespace: namespace.Name, Name: "cloudprovider"},
+				},
+			}
+			Expect(env.Client().Create(ctx, infra)).To(Succeed())
+
+			infra.Status.ObservedGeneration = infra.Generation
+			infra.Status.LastOperation = &gardencorev1alpha1.LastOperation{Type: gardencorev1alpha1.LastOperationTypeCreate, State: gardencorev1alpha1.LastOperationStateSucceeded}
+			Expect(env.Client().Status().Update(ctx, infra)).To(Succeed())
+
+			Expect(WaitForLastOperation(ctx, env.Client(), infra, gardencorev1alpha1.LastOperationTypeCreate, gardencorev1alpha1.LastOperationStateSucceeded, 100*time.Millisecond, 5*time.Second)).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,128 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+var gardenScheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(gardenv1beta1.AddToScheme(gardenScheme))
+	utilruntime.Must(gardencorev1alpha1.AddToScheme(gardenScheme))
+}
+
+// CreateNamespace creates a namespace with the given name.
+func CreateNamespace(ctx context.Context, c client.Client, name string) (*corev1.Namespace, error) {
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	return namespace, c.Create(ctx, namespace)
+}
+
+// CreateCluster creates a Cluster resource with the given name that embeds the given cloud profile, seed
+// and shoot. The objects must be types of the Garden or Gardener core API.
+func CreateCluster(ctx context.Context, c client.Client, name string, cloudProfile, seed, shoot runtime.Object) (*extensionsv1alpha1.Cluster, error) {
+	cloudProfileRaw, err := encodeGardenObject(cloudProfile)
+	if err != nil {
+		return nil, err
+	}
+	seedRaw, err := encodeGardenObject(seed)
+	if err != nil {
+		return nil, err
+	}
+	shootRaw, err := encodeGardenObject(shoot)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster := &extensionsv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: extensionsv1alpha1.ClusterSpec{
+			CloudProfile: runtime.RawExtension{Raw: cloudProfileRaw},
+			Seed:         runtime.RawExtension{Raw: seedRaw},
+			Shoot:        runtime.RawExtension{Raw: shootRaw},
+		},
+	}
+	return cluster, c.Create(ctx, cluster)
+}
+
+func encodeGardenObject(obj runtime.Object) ([]byte, error) {
+	gvk, err := apiutil.GVKForObject(obj, gardenScheme)
+	if err != nil {
+		return nil, err
+	}
+
+	obj = obj.DeepCopyObject()
+	obj.GetObjectKind().SetGroupVersionKind(gvk)
+	return json.Marshal(obj)
+}
+
+// ExtensionObject is an extension resource that can be read with a client.
+type ExtensionObject interface {
+	extensionsv1alpha1.Object
+	runtime.Object
+}
+
+// WaitForLastOperation waits until the extension controller has observed the current generation of the given
+// extension object and its last operation has the given type and state. It fails early if such an operation
+// reached a different final state.
+func WaitForLastOperation(ctx context.Context, c client.Client, obj ExtensionObject, operationType gardencorev1alpha1.LastOperationType, state gardencorev1alpha1.LastOperationState, interval, timeout time.Duration) error {
+	key, err := client.ObjectKeyFromObject(obj)
+	if err != nil {
+		return err
+	}
+
+	return wait.PollImmediate(interval, timeout, func() (bool, error) {
+		if err := c.Get(ctx, key, obj); err != nil {
+			return false, err
+		}
+
+		status := obj.GetExtensionStatus()
+		if status.GetObservedGeneration() != obj.GetGeneration() {
+			return false, nil
+		}
+
+		lastOperation := status.GetLastOperation()
+		if lastOperation == nil || lastOperation.GetType() != operationType {
+			return false, nil
+		}
+
+		switch currentState := lastOperation.GetState(); {
+		case currentState == state:
+			return true, nil
+		case isFinalState(currentState):
+			return false, fmt.Errorf("last %s operation of %s reached final state %s: %s", operationType, key, currentState, lastOperation.GetDescription())
+		}
+		return false, nil
+	})
+}
+
+func isFinalState(state gardencorev1alpha1.LastOperationState) bool {
+	return state == gardencorev1alpha1.LastOperationStateFailed || state == gardencorev1alpha1.LastOperationStateAborted
+}